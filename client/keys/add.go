@@ -28,6 +28,7 @@ const (
 	flagAccount     = "account"
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
+	flagWeights     = "multisig-weights"
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
 	flagKeyAlgo     = "algo"
@@ -57,6 +58,9 @@ You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
 required through --multisig-threshold. The keys are sorted by address, unless
 the flag --nosort is set.
+Pass one weight per key to --multisig-weights to create a weighted multisig key,
+whose --multisig-threshold is the total weight of the keys that must sign. The keys
+passed to --multisig may themselves be multisig keys.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
@@ -65,6 +69,7 @@ the flag --nosort is set.
 	cmd.Flags().StringSlice(flagMultisig, nil, "Construct and store a multisig public key (implies --pubkey)")
 	cmd.Flags().Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	cmd.Flags().Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
+	cmd.Flags().UintSlice(flagWeights, nil, "Weight of each key passed to --multisig, in the same order. For use in conjunction with --multisig")
 	cmd.Flags().String(FlagPublicKey, "", "Parse a public key in bech32 format and save it to disk")
 	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	cmd.Flags().Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
@@ -156,7 +161,12 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 			var pks []crypto.PubKey
 
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			weights, _ := cmd.Flags().GetUintSlice(flagWeights)
+			if len(weights) != 0 {
+				if err := validateMultisigWeights(multisigThreshold, weights, len(multisigKeys)); err != nil {
+					return err
+				}
+			} else if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
 				return err
 			}
 
@@ -170,12 +180,18 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 			}
 
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
-				})
+				sortMultisigKeys(pks, weights)
 			}
 
-			pk := multisig.NewPubKeyMultisigThreshold(multisigThreshold, pks)
+			var pk crypto.PubKey
+			if len(weights) != 0 {
+				pk, err = multisig.NewPubKeyMultisigWeighted(uint(multisigThreshold), pks, weights)
+				if err != nil {
+					return err
+				}
+			} else {
+				pk = multisig.NewPubKeyMultisigThreshold(multisigThreshold, pks)
+			}
 			if _, err := kb.SaveMultisig(name, pk); err != nil {
 				return err
 			}
@@ -332,3 +348,52 @@ func printCreate(cmd *cobra.Command, info keyring.Info, showMnemonic bool, mnemo
 
 	return nil
 }
+
+// sortMultisigKeys sorts the keys of a multisig by address. If weights are
+// given, they are kept aligned with their keys.
+func sortMultisigKeys(pks []crypto.PubKey, weights []uint) {
+	idx := make([]int, len(pks))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		return bytes.Compare(pks[idx[i]].Address(), pks[idx[j]].Address()) < 0
+	})
+
+	sortedPks := make([]crypto.PubKey, len(pks))
+	for i, j := range idx {
+		sortedPks[i] = pks[j]
+	}
+	copy(pks, sortedPks)
+
+	if len(weights) != 0 {
+		sortedWeights := make([]uint, len(weights))
+		for i, j := range idx {
+			sortedWeights[i] = weights[j]
+		}
+		copy(weights, sortedWeights)
+	}
+}
+
+func validateMultisigWeights(threshold int, weights []uint, nKeys int) error {
+	if threshold <= 0 {
+		return fmt.Errorf("threshold must be a positive integer")
+	}
+	if len(weights) != nKeys {
+		return fmt.Errorf("got %d weights for %d keys", len(weights), nKeys)
+	}
+
+	var total uint
+	for _, w := range weights {
+		if w == 0 {
+			return fmt.Errorf("weights must be positive integers")
+		}
+		total += w
+	}
+	if total < uint(threshold) {
+		return fmt.Errorf("weighted multisignature: total weight %d < threshold %d", total, threshold)
+	}
+
+	return nil
+}
@@ -80,3 +80,26 @@ func Test_runAddCmdBasic(t *testing.T) {
 
 	require.NoError(t, cmd.Execute())
 }
+
+func Test_validateMultisigWeights(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int
+		weights   []uint
+		nKeys     int
+		wantErr   bool
+	}{
+		{"zero threshold", 0, []uint{1, 1}, 2, true},
+		{"weights mismatch", 1, []uint{1}, 2, true},
+		{"zero weight", 1, []uint{1, 0}, 2, true},
+		{"threshold too high", 4, []uint{2, 1}, 2, true},
+		{"valid", 3, []uint{2, 1}, 2, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMultisigWeights(tt.threshold, tt.weights, tt.nKeys)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -6,11 +6,44 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("multi"), hd.MultiType)
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
 }
+
+func TestSecp256r1Algo(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.NewFundraiserParams(0, 118, 0).String()
+
+	bz, err := hd.Secp256r1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+
+	// derivation is deterministic and differs from secp256k1's
+	bz2, err := hd.Secp256r1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+	require.Equal(t, bz2, bz)
+
+	bz2, err = hd.Secp256k1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+	require.NotEqual(t, bz2, bz)
+
+	priv := hd.Secp256r1.Generate()(bz)
+	require.IsType(t, secp256r1.PrivKeySecp256r1{}, priv)
+	require.True(t, secp256r1.IsValidPrivKey(bz))
+
+	sig, err := priv.Sign([]byte("message"))
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().VerifyBytes([]byte("message"), sig))
+
+	// bytes that are not a valid scalar still generate a valid key
+	invalid := make([]byte, secp256r1.PrivKeySize)
+	priv = hd.Secp256r1.Generate()(invalid)
+	privR1 := priv.(secp256r1.PrivKeySecp256r1)
+	require.True(t, secp256r1.IsValidPrivKey(privR1[:]))
+}
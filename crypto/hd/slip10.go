@@ -0,0 +1,112 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ComputeP256MastersFromSeed returns the NIST P-256 master secret key and chain
+// code of a seed, as specified by SLIP-10, see
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func ComputeP256MastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	curveIdentifier := []byte("Nist256p1 seed")
+	secret, chainCode = i64(curveIdentifier, seed)
+
+	// a secret which is not a valid scalar is derived again from the whole HMAC
+	for !isValidP256Scalar(secret[:]) {
+		data := append(secret[:], chainCode[:]...)
+		secret, chainCode = i64(curveIdentifier, data)
+	}
+
+	return
+}
+
+// DeriveP256PrivateKeyForPath derives the NIST P-256 private key by following
+// the BIP 32/44 path from privKeyBytes, using the given chainCode, as specified
+// by SLIP-10.
+func DeriveP256PrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
+	data := privKeyBytes
+	parts := strings.Split(path, "/")
+
+	for _, part := range parts {
+		// do we have an apostrophe?
+		harden := part[len(part)-1:] == "'"
+		// harden == private derivation, else public derivation:
+		if harden {
+			part = part[:len(part)-1]
+		}
+
+		idx, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid BIP 32 path: %s", err)
+		}
+
+		data, chainCode = deriveP256PrivateKey(data, chainCode, uint32(idx), harden)
+	}
+
+	return data, nil
+}
+
+// deriveP256PrivateKey derives the NIST P-256 child private key with index and
+// chainCode. If harden is true, the derivation is 'hardened'. It returns the
+// new private key and new chain code.
+func deriveP256PrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	var data []byte
+
+	if harden {
+		index |= 0x80000000
+
+		data = append([]byte{byte(0)}, privKeyBytes[:]...)
+	} else {
+		data = p256CompressedPubKey(privKeyBytes[:])
+	}
+
+	data = append(data, uint32ToBytes(index)...)
+	il, ir := i64(chainCode[:], data)
+
+	n := elliptic.P256().Params().N
+	for {
+		// a child key which is not a valid scalar is derived again from the
+		// right half of the HMAC
+		ilInt := new(big.Int).SetBytes(il[:])
+		if ilInt.Cmp(n) < 0 {
+			key := ilInt.Add(ilInt, new(big.Int).SetBytes(privKeyBytes[:]))
+			key.Mod(key, n)
+
+			if key.Sign() != 0 {
+				return p256Scalar(key), ir
+			}
+		}
+
+		data = append(append([]byte{byte(1)}, ir[:]...), uint32ToBytes(index)...)
+		il, ir = i64(chainCode[:], data)
+	}
+}
+
+// p256CompressedPubKey returns the compressed NIST P-256 public key of a secret.
+func p256CompressedPubKey(secret []byte) []byte {
+	x, y := elliptic.P256().ScalarBaseMult(secret)
+
+	xBytes := p256Scalar(x)
+
+	return append([]byte{byte(2 + y.Bit(0))}, xBytes[:]...)
+}
+
+// isValidP256Scalar returns true if the secret is a non zero scalar lower than
+// the order of the NIST P-256 curve.
+func isValidP256Scalar(secret []byte) bool {
+	s := new(big.Int).SetBytes(secret)
+	return s.Sign() != 0 && s.Cmp(elliptic.P256().Params().N) < 0
+}
+
+// p256Scalar returns the big endian, zero padded bytes of a scalar.
+func p256Scalar(s *big.Int) [32]byte {
+	var bz [32]byte
+	b := s.Bytes()
+	copy(bz[32-len(b):], b)
+
+	return bz
+}
@@ -0,0 +1,27 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+// Test vector 1 for nist256p1 of SLIP-10.
+func TestP256DerivationVectors(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	master, chainCode := hd.ComputeP256MastersFromSeed(seed)
+	require.Equal(t, "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2", hex.EncodeToString(master[:]))
+	require.Equal(t, "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea", hex.EncodeToString(chainCode[:]))
+
+	derived, err := hd.DeriveP256PrivateKeyForPath(master, chainCode, "0'")
+	require.NoError(t, err)
+	require.Equal(t, "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c", hex.EncodeToString(derived[:]))
+
+	_, err = hd.DeriveP256PrivateKeyForPath(master, chainCode, "0'/a")
+	require.Error(t, err)
+}
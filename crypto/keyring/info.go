@@ -191,18 +191,26 @@ type multiInfo struct {
 
 // NewMultiInfo creates a new multiInfo instance
 func NewMultiInfo(name string, pub crypto.PubKey) Info {
-	multiPK := pub.(multisig.PubKeyMultisigThreshold)
+	multiPK := pub.(multisig.PubKey)
 
-	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
-	for i, pk := range multiPK.PubKeys {
-		// TODO: Recursively check pk for total weight?
-		pubKeys[i] = multisigPubKeyInfo{pk, 1}
+	var weights []uint
+	if weighted, ok := multiPK.(multisig.PubKeyMultisigWeighted); ok {
+		weights = weighted.GetWeights()
+	}
+
+	pubKeys := make([]multisigPubKeyInfo, len(multiPK.GetPubKeys()))
+	for i, pk := range multiPK.GetPubKeys() {
+		weight := uint(1)
+		if weights != nil {
+			weight = weights[i]
+		}
+		pubKeys[i] = multisigPubKeyInfo{pk, weight}
 	}
 
 	return &multiInfo{
 		Name:      name,
 		PubKey:    pub,
-		Threshold: multiPK.K,
+		Threshold: multiPK.GetThreshold(),
 		PubKeys:   pubKeys,
 	}
 }
@@ -29,3 +29,22 @@ func TestBech32KeysOutput(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, outputs[0])
 }
+
+func TestBech32KeysOutputWeightedMultisig(t *testing.T) {
+	tmpKey1 := secp256k1.GenPrivKey().PubKey()
+	tmpKey2 := secp256k1.GenPrivKey().PubKey()
+	nested := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{tmpKey2})
+
+	multisigPks, err := multisig.NewPubKeyMultisigWeighted(3, []crypto.PubKey{tmpKey1, nested}, []uint{2, 1})
+	require.NoError(t, err)
+	multiInfo := NewMultiInfo("multisig", multisigPks)
+
+	outputs, err := Bech32KeysOutput([]Info{multiInfo})
+	require.NoError(t, err)
+	require.Equal(t, uint(3), outputs[0].Threshold)
+	require.Len(t, outputs[0].PubKeys, 2)
+	require.Equal(t, sdk.AccAddress(tmpKey1.Address()).String(), outputs[0].PubKeys[0].Address)
+	require.Equal(t, uint(2), outputs[0].PubKeys[0].Weight)
+	require.Equal(t, sdk.AccAddress(nested.Address()).String(), outputs[0].PubKeys[1].Address)
+	require.Equal(t, uint(1), outputs[0].PubKeys[1].Weight)
+}
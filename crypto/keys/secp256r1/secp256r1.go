@@ -0,0 +1,291 @@
+// Package secp256r1 implements ECDSA keys over the NIST P-256 (secp256r1) curve,
+// as supported by the secure enclaves of most mobile platforms.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+//-------------------------------------
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size, in bytes, of a secp256r1 private key.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of a compressed secp256r1 public key.
+	PubKeySize = 33
+	// SignatureSize is the size, in bytes, of a secp256r1 signature, which is
+	// the concatenation of its big endian R and S values.
+	SignatureSize = 64
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeySecp256r1{},
+		PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{},
+		PrivKeyAminoName, nil)
+}
+
+var (
+	curve  = elliptic.P256()
+	params = curve.Params()
+
+	// halfOrder is used to reject signatures with a high S value, which would
+	// otherwise make every signature malleable.
+	halfOrder = new(big.Int).Rsh(params.N, 1)
+
+	one = big.NewInt(1)
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements crypto.PrivKey. It holds the big endian secret
+// scalar of the key.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	x, y := curve.ScalarBaseMult(privKey[:])
+	return compressPubKey(x, y)
+}
+
+// Sign creates an ECDSA signature on curve secp256r1 over the SHA-256 hash of
+// msg. The signature is returned as R || S, with S normalized to the lower half
+// of the curve order.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	priv := privKey.toECDSA()
+	hash := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), priv, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(params.N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	fillBytes(r, sig[:SignatureSize/2])
+	fillBytes(s, sig[SignatureSize/2:])
+
+	return sig, nil
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	priv := new(ecdsa.PrivateKey)
+	priv.Curve = curve
+	priv.D = new(big.Int).SetBytes(privKey[:])
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey[:])
+
+	return priv
+}
+
+// GenPrivKey generates a new ECDSA private key on curve secp256r1.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	var privKeyBytes [PrivKeySize]byte
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		// break if we found a valid scalar (i.e. > 0 and < N == curve order)
+		if IsValidPrivKey(privKeyBytes[:]) {
+			break
+		}
+	}
+
+	return PrivKeySecp256r1(privKeyBytes)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output to create the private key. It makes sure the private key is a valid
+// scalar by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKeySecp256r1 {
+	secHash := sha256.Sum256(secret)
+
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(params.N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	var privKey PrivKeySecp256r1
+	fillBytes(fe, privKey[:])
+
+	return privKey
+}
+
+// IsValidPrivKey returns true if bz is the big endian encoding of a valid
+// secp256r1 secret scalar, i.e. a value in [1, n) where n is the curve order.
+func IsValidPrivKey(bz []byte) bool {
+	d := new(big.Int).SetBytes(bz)
+	return len(bz) == PrivKeySize && d.Sign() > 0 && d.Cmp(params.N) < 0
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey. It is the compressed form of the
+// public key: a 0x02 or 0x03 prefix, depending on the parity of the Y
+// coordinate, followed by the big endian X coordinate.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the address of the public key, which is the truncated
+// SHA-256 hash of the compressed key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies a signature created with PrivKeySecp256r1.Sign. The
+// signature must be R || S with S in the lower half of the curve order.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	pub, err := decompressPubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:SignatureSize/2])
+	s := new(big.Int).SetBytes(sig[SignatureSize/2:])
+
+	// reject malleable signatures
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return ecdsa.Verify(pub, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns true if other is a PubKeySecp256r1 with the same bytes.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
+
+// compressPubKey returns the compressed form of the point (x, y).
+func compressPubKey(x, y *big.Int) PubKeySecp256r1 {
+	var pubKey PubKeySecp256r1
+	pubKey[0] = byte(2 + y.Bit(0))
+	fillBytes(x, pubKey[1:])
+
+	return pubKey
+}
+
+// decompressPubKey recovers the full public key from its compressed form. As
+// the curve is y² = x³ - 3x + b and the field prime p satisfies p ≡ 3 mod 4,
+// y is computed as (x³ - 3x + b)^((p+1)/4) mod p.
+func decompressPubKey(pubKey PubKeySecp256r1) (*ecdsa.PublicKey, error) {
+	if pubKey[0] != 2 && pubKey[0] != 3 {
+		return nil, fmt.Errorf("invalid public key prefix: %X", pubKey[0])
+	}
+
+	p := params.P
+	x := new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(p) >= 0 {
+		return nil, fmt.Errorf("invalid public key X coordinate")
+	}
+
+	// y² = x³ - 3x + b
+	x3 := new(big.Int).Mul(x, x)
+	x3.Mul(x3, x)
+
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+
+	y2 := new(big.Int).Sub(x3, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, p)
+
+	exp := new(big.Int).Add(p, one)
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(y2, exp, p)
+
+	if new(big.Int).Exp(y, big.NewInt(2), p).Cmp(y2) != 0 {
+		return nil, fmt.Errorf("public key is not on curve secp256r1")
+	}
+
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(p, y)
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// fillBytes sets buf to the absolute value of x as a zero-extended big endian
+// byte slice.
+func fillBytes(x *big.Int, buf []byte) {
+	for i := range buf {
+		buf[i] = 0
+	}
+
+	bz := x.Bytes()
+	copy(buf[len(buf)-len(bz):], bz)
+}
@@ -0,0 +1,142 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// wrong message
+	require.False(t, pub.VerifyBytes([]byte("hello worlds"), sig))
+
+	// tampered signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pub.VerifyBytes(msg, sig))
+	sig[7] ^= byte(0x01)
+
+	// wrong key
+	require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// wrong length
+	require.False(t, pub.VerifyBytes(msg, sig[:SignatureSize-1]))
+}
+
+func TestRejectHighS(t *testing.T) {
+	priv := GenPrivKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	// the signature with S replaced by N - S is valid ECDSA, but malleable
+	s := new(big.Int).SetBytes(sig[SignatureSize/2:])
+	require.True(t, s.Cmp(halfOrder) <= 0)
+	highS := new(big.Int).Sub(params.N, s)
+
+	malleated := make([]byte, SignatureSize)
+	copy(malleated, sig[:SignatureSize/2])
+	fillBytes(highS, malleated[SignatureSize/2:])
+
+	pub, err := decompressPubKey(priv.PubKey().(PubKeySecp256r1))
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(msg)
+	r := new(big.Int).SetBytes(sig[:SignatureSize/2])
+	require.True(t, ecdsa.Verify(pub, hash[:], r, highS))
+	require.False(t, priv.PubKey().VerifyBytes(msg, malleated))
+}
+
+func TestPubKeyCompression(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		var priv PrivKeySecp256r1
+		fillBytes(key.D, priv[:])
+
+		pub := priv.PubKey().(PubKeySecp256r1)
+		require.Equal(t, PubKeySecp256r1(compressPubKey(key.X, key.Y)), pub)
+
+		decompressed, err := decompressPubKey(pub)
+		require.NoError(t, err)
+		require.Equal(t, 0, key.X.Cmp(decompressed.X))
+		require.Equal(t, 0, key.Y.Cmp(decompressed.Y))
+	}
+
+	// invalid prefix
+	pub := GenPrivKey().PubKey().(PubKeySecp256r1)
+	pub[0] = 0x04
+	_, err := decompressPubKey(pub)
+	require.Error(t, err)
+	require.False(t, pub.VerifyBytes([]byte{}, make([]byte, SignatureSize)))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+
+	require.True(t, pub.Equals(priv.PubKey()))
+	require.False(t, pub.Equals(GenPrivKey().PubKey()))
+	require.False(t, pub.Equals(secp256k1.GenPrivKey().PubKey()))
+
+	require.True(t, priv.Equals(priv))
+	require.False(t, priv.Equals(GenPrivKey()))
+}
+
+func TestPubKeyAddress(t *testing.T) {
+	pub := GenPrivKey().PubKey().(PubKeySecp256r1)
+	require.Equal(t, crypto.AddressHash(pub[:]), pub.Address())
+	require.Len(t, pub.Address(), crypto.AddressSize)
+}
+
+func TestAminoRoundTrip(t *testing.T) {
+	priv := GenPrivKey()
+
+	var priv2 crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(priv.Bytes(), &priv2))
+	require.Equal(t, priv, priv2)
+
+	var pub crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(priv.PubKey().Bytes(), &pub))
+	require.Equal(t, priv.PubKey(), pub)
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secrets := [][]byte{{}, []byte("secret"), make([]byte, 64)}
+
+	for _, secret := range secrets {
+		priv := GenPrivKeyFromSecret(secret)
+		require.True(t, IsValidPrivKey(priv[:]))
+		require.Equal(t, priv, GenPrivKeyFromSecret(secret))
+	}
+}
+
+func TestIsValidPrivKey(t *testing.T) {
+	require.False(t, IsValidPrivKey(make([]byte, PrivKeySize)))
+	require.False(t, IsValidPrivKey([]byte{0x01}))
+
+	n := make([]byte, PrivKeySize)
+	fillBytes(params.N, n)
+	require.False(t, IsValidPrivKey(n))
+
+	nMinusOne := make([]byte, PrivKeySize)
+	fillBytes(new(big.Int).Sub(params.N, one), nMinusOne)
+	require.True(t, IsValidPrivKey(nMinusOne))
+}
@@ -34,6 +34,7 @@ type PublicKey struct {
 	//	*PublicKey_Sr25519
 	//	*PublicKey_Multisig
 	//	*PublicKey_Secp256R1
+	//	*PublicKey_MultisigWeighted
 	//	*PublicKey_AnyPubkey
 	Sum isPublicKey_Sum `protobuf_oneof:"sum"`
 }
@@ -92,16 +93,20 @@ type PublicKey_Multisig struct {
 type PublicKey_Secp256R1 struct {
 	Secp256R1 []byte `protobuf:"bytes,5,opt,name=secp256r1,proto3,oneof" json:"secp256r1,omitempty"`
 }
+type PublicKey_MultisigWeighted struct {
+	MultisigWeighted *PubKeyMultisigWeighted `protobuf:"bytes,6,opt,name=multisig_weighted,json=multisigWeighted,proto3,oneof" json:"multisig_weighted,omitempty"`
+}
 type PublicKey_AnyPubkey struct {
 	AnyPubkey *types.Any `protobuf:"bytes,15,opt,name=any_pubkey,json=anyPubkey,proto3,oneof" json:"any_pubkey,omitempty"`
 }
 
-func (*PublicKey_Secp256K1) isPublicKey_Sum() {}
-func (*PublicKey_Ed25519) isPublicKey_Sum()   {}
-func (*PublicKey_Sr25519) isPublicKey_Sum()   {}
-func (*PublicKey_Multisig) isPublicKey_Sum()  {}
-func (*PublicKey_Secp256R1) isPublicKey_Sum() {}
-func (*PublicKey_AnyPubkey) isPublicKey_Sum() {}
+func (*PublicKey_Secp256K1) isPublicKey_Sum()        {}
+func (*PublicKey_Ed25519) isPublicKey_Sum()          {}
+func (*PublicKey_Sr25519) isPublicKey_Sum()          {}
+func (*PublicKey_Multisig) isPublicKey_Sum()         {}
+func (*PublicKey_Secp256R1) isPublicKey_Sum()        {}
+func (*PublicKey_MultisigWeighted) isPublicKey_Sum() {}
+func (*PublicKey_AnyPubkey) isPublicKey_Sum()        {}
 
 func (m *PublicKey) GetSum() isPublicKey_Sum {
 	if m != nil {
@@ -145,6 +150,13 @@ func (m *PublicKey) GetSecp256R1() []byte {
 	return nil
 }
 
+func (m *PublicKey) GetMultisigWeighted() *PubKeyMultisigWeighted {
+	if x, ok := m.GetSum().(*PublicKey_MultisigWeighted); ok {
+		return x.MultisigWeighted
+	}
+	return nil
+}
+
 func (m *PublicKey) GetAnyPubkey() *types.Any {
 	if x, ok := m.GetSum().(*PublicKey_AnyPubkey); ok {
 		return x.AnyPubkey
@@ -160,6 +172,7 @@ func (*PublicKey) XXX_OneofWrappers() []interface{} {
 		(*PublicKey_Sr25519)(nil),
 		(*PublicKey_Multisig)(nil),
 		(*PublicKey_Secp256R1)(nil),
+		(*PublicKey_MultisigWeighted)(nil),
 		(*PublicKey_AnyPubkey)(nil),
 	}
 }
@@ -218,6 +231,68 @@ func (m *PubKeyMultisigThreshold) GetPubKeys() []*PublicKey {
 	return nil
 }
 
+// PubKeyMultisigWeighted specifies a public key type which nests multiple public
+// keys, each with a voting weight, and a weight threshold
+type PubKeyMultisigWeighted struct {
+	Threshold uint32       `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	PubKeys   []*PublicKey `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" yaml:"pubkeys"`
+	Weights   []uint32     `protobuf:"varint,3,rep,packed,name=weights,proto3" json:"weights,omitempty" yaml:"weights"`
+}
+
+func (m *PubKeyMultisigWeighted) Reset()         { *m = PubKeyMultisigWeighted{} }
+func (m *PubKeyMultisigWeighted) String() string { return proto.CompactTextString(m) }
+func (*PubKeyMultisigWeighted) ProtoMessage()    {}
+func (*PubKeyMultisigWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fa415c569c5d31a, []int{2}
+}
+func (m *PubKeyMultisigWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyMultisigWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyMultisigWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyMultisigWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyMultisigWeighted.Merge(m, src)
+}
+func (m *PubKeyMultisigWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyMultisigWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyMultisigWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyMultisigWeighted proto.InternalMessageInfo
+
+func (m *PubKeyMultisigWeighted) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *PubKeyMultisigWeighted) GetPubKeys() []*PublicKey {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *PubKeyMultisigWeighted) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 // MultiSignature wraps the signatures from a PubKeyMultisigThreshold.
 // See cosmos_sdk.tx.v1.ModeInfo.Multi for how to specify which signers signed
 // and with which modes
@@ -230,7 +305,7 @@ func (m *MultiSignature) Reset()         { *m = MultiSignature{} }
 func (m *MultiSignature) String() string { return proto.CompactTextString(m) }
 func (*MultiSignature) ProtoMessage()    {}
 func (*MultiSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fa415c569c5d31a, []int{2}
+	return fileDescriptor_5fa415c569c5d31a, []int{3}
 }
 func (m *MultiSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +353,7 @@ type CompactBitArray struct {
 func (m *CompactBitArray) Reset()      { *m = CompactBitArray{} }
 func (*CompactBitArray) ProtoMessage() {}
 func (*CompactBitArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fa415c569c5d31a, []int{3}
+	return fileDescriptor_5fa415c569c5d31a, []int{4}
 }
 func (m *CompactBitArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,6 +399,7 @@ func (m *CompactBitArray) GetElems() []byte {
 func init() {
 	proto.RegisterType((*PublicKey)(nil), "cosmos.crypto.PublicKey")
 	proto.RegisterType((*PubKeyMultisigThreshold)(nil), "cosmos.crypto.PubKeyMultisigThreshold")
+	proto.RegisterType((*PubKeyMultisigWeighted)(nil), "cosmos.crypto.PubKeyMultisigWeighted")
 	proto.RegisterType((*MultiSignature)(nil), "cosmos.crypto.MultiSignature")
 	proto.RegisterType((*CompactBitArray)(nil), "cosmos.crypto.CompactBitArray")
 }
@@ -331,38 +407,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crypto/crypto.proto", fileDescriptor_5fa415c569c5d31a) }
 
 var fileDescriptor_5fa415c569c5d31a = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xfe, 0x61, 0xd4, 0xdd, 0x56, 0xb0, 0x2a, 0x91, 0x55, 0x22, 0xa9, 0x72, 0x40,
-	0x05, 0x89, 0x54, 0x2d, 0xea, 0x10, 0xbd, 0x2d, 0xe3, 0x30, 0xa9, 0x42, 0xaa, 0x32, 0x0e, 0xc0,
-	0xa5, 0x4a, 0x52, 0x93, 0x46, 0x4d, 0xe2, 0xc8, 0x76, 0x24, 0xfc, 0x09, 0xb8, 0x72, 0xe4, 0x08,
-	0x77, 0x3e, 0x08, 0xc7, 0x1e, 0x39, 0x55, 0x28, 0xfd, 0x06, 0xfb, 0x04, 0xa8, 0x76, 0xb2, 0x0d,
-	0xd0, 0x4e, 0xce, 0xfb, 0xfc, 0x5e, 0xc7, 0xcf, 0xf3, 0xda, 0xb0, 0x17, 0x10, 0x96, 0x10, 0x36,
-	0x0c, 0xa8, 0xc8, 0x38, 0x29, 0x17, 0x3b, 0xa3, 0x84, 0x13, 0x74, 0xa4, 0x98, 0xad, 0xc4, 0x5e,
-	0x37, 0x24, 0x21, 0x91, 0x64, 0xb8, 0xff, 0x52, 0x4d, 0xbd, 0x93, 0x90, 0x90, 0x30, 0xc6, 0x43,
-	0x59, 0xf9, 0xf9, 0xc7, 0xa1, 0x97, 0x0a, 0x85, 0xac, 0xcf, 0x35, 0xd8, 0x9a, 0xe7, 0x7e, 0x1c,
-	0x05, 0x33, 0x2c, 0x90, 0x01, 0x5b, 0x0c, 0x07, 0xd9, 0x78, 0x72, 0xba, 0x1e, 0xe9, 0xa0, 0x0f,
-	0x06, 0x87, 0x17, 0x9a, 0x7b, 0x23, 0xa1, 0x1e, 0x3c, 0xc0, 0xcb, 0xf1, 0x64, 0x32, 0x7a, 0xa5,
-	0xd7, 0x4a, 0x5a, 0x09, 0x7b, 0xc6, 0xa8, 0x62, 0xf5, 0x8a, 0x95, 0x02, 0x7a, 0x0d, 0xef, 0x27,
-	0x79, 0xcc, 0x23, 0x16, 0x85, 0x7a, 0xa3, 0x0f, 0x06, 0xed, 0xf1, 0x13, 0xfb, 0x2f, 0xe3, 0xf6,
-	0x3c, 0xf7, 0x67, 0x58, 0xbc, 0x29, 0x9b, 0xde, 0xae, 0x28, 0x66, 0x2b, 0x12, 0x2f, 0x2f, 0x34,
-	0xf7, 0x7a, 0xe7, 0x2d, 0x77, 0x74, 0xa4, 0x37, 0xff, 0x71, 0x47, 0x47, 0x68, 0x02, 0xa1, 0x97,
-	0x8a, 0x45, 0x96, 0xfb, 0x6b, 0x2c, 0xf4, 0x8e, 0x3c, 0xa7, 0x6b, 0xab, 0xec, 0x76, 0x95, 0xdd,
-	0x3e, 0x4b, 0xc5, 0x7e, 0x9b, 0x97, 0x8a, 0xb9, 0x6c, 0x74, 0x9a, 0xb0, 0xce, 0xf2, 0xc4, 0xfa,
-	0x01, 0xe0, 0xa3, 0x3b, 0x5c, 0xa0, 0x97, 0xb0, 0xc5, 0xab, 0x42, 0xce, 0xe5, 0xc8, 0x39, 0x29,
-	0xb6, 0x26, 0x98, 0x5d, 0x6d, 0xcd, 0x07, 0xc2, 0x4b, 0xe2, 0xa9, 0x75, 0xcd, 0x2d, 0xf7, 0xa6,
-	0x17, 0xbd, 0x83, 0xed, 0x4c, 0x4e, 0x77, 0xb1, 0xc6, 0x82, 0xe9, 0xb5, 0x7e, 0x7d, 0xd0, 0x1e,
-	0xeb, 0xff, 0x67, 0x57, 0xf3, 0x77, 0x1e, 0x17, 0x5b, 0xf3, 0x40, 0x99, 0x60, 0x57, 0x5b, 0xf3,
-	0x58, 0xfd, 0x5a, 0x05, 0x62, 0x96, 0x0b, 0xb3, 0xaa, 0x93, 0x59, 0xa7, 0xf0, 0x58, 0xfa, 0xbc,
-	0x8c, 0xc2, 0xd4, 0xe3, 0x39, 0xc5, 0xc8, 0x80, 0x90, 0x55, 0x05, 0xd3, 0x41, 0xbf, 0x3e, 0x38,
-	0x74, 0x6f, 0x29, 0xd3, 0xc6, 0xe6, 0xbb, 0x09, 0xac, 0xf7, 0xb0, 0x73, 0x4e, 0x92, 0xcc, 0x0b,
-	0xb8, 0x13, 0xf1, 0x33, 0x4a, 0x3d, 0x81, 0x9e, 0xc1, 0x87, 0xf8, 0x13, 0xa7, 0xde, 0xc2, 0x8f,
-	0x38, 0x5b, 0x30, 0x4e, 0x28, 0x2e, 0x53, 0xba, 0x1d, 0x09, 0x9c, 0x88, 0xb3, 0x4b, 0x29, 0xa3,
-	0x2e, 0x6c, 0xe2, 0x18, 0x27, 0x4c, 0xdd, 0xbf, 0xab, 0x8a, 0x69, 0xe3, 0xeb, 0x37, 0x53, 0x73,
-	0xce, 0x7f, 0x16, 0x06, 0xd8, 0x14, 0x06, 0xf8, 0x5d, 0x18, 0xe0, 0xcb, 0xce, 0xd0, 0x36, 0x3b,
-	0x43, 0xfb, 0xb5, 0x33, 0xb4, 0x0f, 0x4f, 0xc3, 0x88, 0xaf, 0x72, 0xdf, 0x0e, 0x48, 0x32, 0xac,
-	0x1e, 0xb3, 0x5c, 0x9e, 0xb3, 0xe5, 0xba, 0x7a, 0xd7, 0x5c, 0x64, 0x98, 0xf9, 0xf7, 0xe4, 0x45,
-	0xbd, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x73, 0x8c, 0x25, 0x71, 0xf5, 0x02, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x8f, 0xd2, 0x40,
+	0x1c, 0x6d, 0xb7, 0xb0, 0xc8, 0xb0, 0x2c, 0xbb, 0x13, 0xa2, 0x5d, 0x12, 0x5b, 0xd2, 0x44, 0x83,
+	0x46, 0x4b, 0xc0, 0xb0, 0x46, 0x6e, 0xdb, 0xf5, 0x40, 0x42, 0x4c, 0x48, 0x77, 0x13, 0xff, 0x5c,
+	0x48, 0x5b, 0xc6, 0xd2, 0xd0, 0x32, 0x4d, 0x67, 0x1a, 0x9d, 0x6f, 0xe1, 0xd1, 0xa3, 0xde, 0xfd,
+	0x20, 0x1e, 0x39, 0x78, 0xf0, 0x22, 0x31, 0xf0, 0x0d, 0xf6, 0x13, 0x18, 0x66, 0xda, 0x65, 0xdd,
+	0x55, 0xaf, 0x9e, 0x86, 0xdf, 0x7b, 0x6f, 0x66, 0xde, 0xef, 0xfd, 0x98, 0x82, 0x86, 0x87, 0x49,
+	0x84, 0x49, 0xdb, 0x4b, 0x58, 0x4c, 0x71, 0xb6, 0x98, 0x71, 0x82, 0x29, 0x86, 0x55, 0xc1, 0x99,
+	0x02, 0x6c, 0xd4, 0x7d, 0xec, 0x63, 0xce, 0xb4, 0x37, 0xbf, 0x84, 0xa8, 0x71, 0xe4, 0x63, 0xec,
+	0x87, 0xa8, 0xcd, 0x2b, 0x37, 0x7d, 0xdb, 0x76, 0xe6, 0x4c, 0x50, 0xc6, 0x8f, 0x1d, 0x50, 0x1e,
+	0xa5, 0x6e, 0x18, 0x78, 0x43, 0xc4, 0xa0, 0x06, 0xca, 0x04, 0x79, 0x71, 0xb7, 0x77, 0x3c, 0xeb,
+	0xa8, 0x72, 0x53, 0x6e, 0xed, 0x0d, 0x24, 0x7b, 0x0b, 0xc1, 0x06, 0x28, 0xa1, 0x49, 0xb7, 0xd7,
+	0xeb, 0x3c, 0x53, 0x77, 0x32, 0x36, 0x07, 0x36, 0x1c, 0x49, 0x04, 0xa7, 0xe4, 0x5c, 0x06, 0xc0,
+	0xe7, 0xe0, 0x56, 0x94, 0x86, 0x34, 0x20, 0x81, 0xaf, 0x16, 0x9a, 0x72, 0xab, 0xd2, 0xbd, 0x6f,
+	0xfe, 0x66, 0xdc, 0x1c, 0xa5, 0xee, 0x10, 0xb1, 0x17, 0x99, 0xe8, 0x7c, 0x9a, 0x20, 0x32, 0xc5,
+	0xe1, 0x64, 0x20, 0xd9, 0x97, 0x3b, 0xaf, 0xb8, 0x4b, 0x3a, 0x6a, 0xf1, 0x9a, 0xbb, 0xa4, 0x03,
+	0xcf, 0xc1, 0x61, 0xae, 0x1d, 0xbf, 0x43, 0x81, 0x3f, 0xa5, 0x68, 0xa2, 0xee, 0xf2, 0xeb, 0xee,
+	0xfd, 0xf3, 0xba, 0x97, 0x99, 0x78, 0x20, 0xd9, 0x07, 0xd1, 0x35, 0x0c, 0xf6, 0x00, 0x70, 0xe6,
+	0x6c, 0x1c, 0xa7, 0xee, 0x0c, 0x31, 0xb5, 0xc6, 0x8f, 0xab, 0x9b, 0x22, 0x51, 0x33, 0x4f, 0xd4,
+	0x3c, 0x99, 0xb3, 0x8d, 0x19, 0x67, 0xce, 0x46, 0x5c, 0x68, 0x15, 0x81, 0x42, 0xd2, 0xc8, 0xf8,
+	0x22, 0x83, 0x3b, 0x7f, 0xe9, 0x0d, 0x3e, 0x05, 0x65, 0x9a, 0x17, 0x3c, 0xed, 0xaa, 0x75, 0xb4,
+	0x5a, 0xea, 0xf2, 0xf0, 0x62, 0xa9, 0x1f, 0x30, 0x27, 0x0a, 0xfb, 0xc6, 0x25, 0x6f, 0xd8, 0x5b,
+	0x2d, 0x7c, 0x05, 0x2a, 0x31, 0x9f, 0xd9, 0x78, 0x86, 0x18, 0x51, 0x77, 0x9a, 0x4a, 0xab, 0xd2,
+	0x55, 0x6f, 0xb6, 0x28, 0xa6, 0x6a, 0xdd, 0x5d, 0x2d, 0xf5, 0x92, 0x30, 0x41, 0x2e, 0x96, 0xfa,
+	0xbe, 0x38, 0x5a, 0x34, 0x44, 0x0c, 0x1b, 0xc4, 0xb9, 0x92, 0x18, 0xdf, 0x64, 0x70, 0xfb, 0xcf,
+	0xd9, 0xc0, 0xee, 0x4d, 0xb7, 0xf5, 0xff, 0x66, 0x14, 0x3e, 0x02, 0x25, 0x31, 0x62, 0xa2, 0x2a,
+	0x4d, 0xa5, 0x55, 0xb5, 0xe0, 0x76, 0x43, 0x46, 0x18, 0x76, 0x2e, 0x31, 0x8e, 0xc1, 0x3e, 0xef,
+	0xe7, 0x2c, 0xf0, 0xe7, 0x0e, 0x4d, 0x13, 0x04, 0x35, 0x00, 0x48, 0x5e, 0x10, 0x55, 0x6e, 0x2a,
+	0xad, 0x3d, 0xfb, 0x0a, 0xd2, 0x2f, 0x2c, 0x3e, 0xeb, 0xb2, 0xf1, 0x1a, 0xd4, 0x4e, 0x71, 0x14,
+	0x3b, 0x1e, 0xb5, 0x02, 0x7a, 0x92, 0x24, 0x0e, 0x83, 0x0f, 0xc1, 0x21, 0x7a, 0x4f, 0x13, 0x67,
+	0xec, 0x06, 0x94, 0x8c, 0x09, 0xc5, 0x09, 0xca, 0xe2, 0xb0, 0x6b, 0x9c, 0xb0, 0x02, 0x4a, 0xce,
+	0x38, 0x0c, 0xeb, 0xa0, 0x88, 0x42, 0x14, 0x11, 0xf1, 0x58, 0x6c, 0x51, 0xf4, 0x0b, 0x1f, 0x3f,
+	0xe9, 0x92, 0x75, 0xfa, 0x75, 0xa5, 0xc9, 0x8b, 0x95, 0x26, 0xff, 0x5c, 0x69, 0xf2, 0x87, 0xb5,
+	0x26, 0x2d, 0xd6, 0x9a, 0xf4, 0x7d, 0xad, 0x49, 0x6f, 0x1e, 0xf8, 0x01, 0x9d, 0xa6, 0xae, 0xe9,
+	0xe1, 0xa8, 0x9d, 0xbf, 0x7c, 0xbe, 0x3c, 0x26, 0x93, 0x59, 0xfe, 0x11, 0xa0, 0x2c, 0x46, 0xc4,
+	0xdd, 0xe5, 0xff, 0xbf, 0x27, 0xbf, 0x06, 0x00, 0x70, 0x4d, 0xc3, 0xd2, 0x22, 0x04, 0x00, 0x00,
 }
 
 func (m *PublicKey) Marshal() (dAtA []byte, err error) {
@@ -482,6 +562,27 @@ func (m *PublicKey_Secp256R1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *PublicKey_MultisigWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublicKey_MultisigWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MultisigWeighted != nil {
+		{
+			size, err := m.MultisigWeighted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrypto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *PublicKey_AnyPubkey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -545,6 +646,66 @@ func (m *PubKeyMultisigThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyMultisigWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyMultisigWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyMultisigWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA5 := make([]byte, len(m.Weights)*10)
+		var j4 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintCrypto(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrypto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintCrypto(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MultiSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -699,6 +860,18 @@ func (m *PublicKey_Secp256R1) Size() (n int) {
 	}
 	return n
 }
+func (m *PublicKey_MultisigWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigWeighted != nil {
+		l = m.MultisigWeighted.Size()
+		n += 1 + l + sovCrypto(uint64(l))
+	}
+	return n
+}
 func (m *PublicKey_AnyPubkey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -729,6 +902,31 @@ func (m *PubKeyMultisigThreshold) Size() (n int) {
 	return n
 }
 
+func (m *PubKeyMultisigWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovCrypto(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovCrypto(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovCrypto(uint64(e))
+		}
+		n += 1 + sovCrypto(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *MultiSignature) Size() (n int) {
 	if m == nil {
 		return 0
@@ -965,6 +1163,41 @@ func (m *PublicKey) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Sum = &PublicKey_Secp256R1{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigWeighted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PubKeyMultisigWeighted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PublicKey_MultisigWeighted{v}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AnyPubkey", wireType)
@@ -1130,6 +1363,188 @@ func (m *PubKeyMultisigThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PubKeyMultisigWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrypto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyMultisigWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyMultisigWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &PublicKey{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrypto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrypto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCrypto
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCrypto
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCrypto
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrypto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MultiSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
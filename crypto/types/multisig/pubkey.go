@@ -19,6 +19,7 @@ type PubKey interface {
 	GetPubKeys() []crypto.PubKey
 
 	// GetThreshold returns the threshold number of signatures that must be obtained to verify a signature.
+	// For weighted multisigs it is the total weight of the keys that must sign.
 	GetThreshold() uint
 }
 
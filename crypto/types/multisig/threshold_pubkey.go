@@ -95,26 +95,8 @@ func (pk PubKeyMultisigThreshold) VerifyMultisignature(getSignBytes GetSignBytes
 	sigIndex := 0
 	for i := 0; i < size; i++ {
 		if bitarray.GetIndex(i) {
-			si := sig.Signatures[sigIndex]
-			switch si := si.(type) {
-			case *signing.SingleSignatureData:
-				msg, err := getSignBytes(si.SignMode)
-				if err != nil {
-					return err
-				}
-				if !pk.PubKeys[i].VerifyBytes(msg, si.Signature) {
-					return err
-				}
-			case *signing.MultiSignatureData:
-				nestedMultisigPk, ok := pk.PubKeys[i].(PubKey)
-				if !ok {
-					return fmt.Errorf("unable to parse pubkey of index %d", i)
-				}
-				if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
-					return err
-				}
-			default:
-				return fmt.Errorf("improper signature data type for index %d", sigIndex)
+			if err := verifySignatureData(pk.PubKeys[i], getSignBytes, sigs[sigIndex], i); err != nil {
+				return err
 			}
 			sigIndex++
 		}
@@ -122,6 +104,32 @@ func (pk PubKeyMultisigThreshold) VerifyMultisignature(getSignBytes GetSignBytes
 	return nil
 }
 
+// verifySignatureData verifies the signature data of the key at the given index
+// of a multisig, recursing into nested multisig keys.
+func verifySignatureData(pubKey crypto.PubKey, getSignBytes GetSignBytesFunc, data signing.SignatureData, index int) error {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		msg, err := getSignBytes(data.SignMode)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifyBytes(msg, data.Signature) {
+			return fmt.Errorf("unable to verify signature of index %d", index)
+		}
+	case *signing.MultiSignatureData:
+		nestedMultisigPk, ok := pubKey.(PubKey)
+		if !ok {
+			return fmt.Errorf("unable to parse pubkey of index %d", index)
+		}
+		if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, data); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("improper signature data type for index %d", index)
+	}
+	return nil
+}
+
 // GetPubKeys implements the PubKey.GetPubKeys method
 func (pk PubKeyMultisigThreshold) GetPubKeys() []crypto.PubKey {
 	return pk.PubKeys
@@ -131,6 +131,22 @@ func TestThresholdMultisigDuplicateSignatures(t *testing.T) {
 	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
 }
 
+func TestThresholdMultisigInvalidSignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4, 5}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	_, otherSigs := generatePubKeysAndSignatures(3, []byte{6, 7, 8})
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubkeys)
+	multisignature := multisig.NewMultisig(3)
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[0], pubkeys[0], pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, otherSigs[1], pubkeys[1], pubkeys))
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[1], pubkeys[1], pubkeys))
+	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+}
+
 // TODO: Fully replace this test with table driven tests
 func TestMultiSigPubKeyEquality(t *testing.T) {
 	msg := []byte{1, 2, 3, 4}
@@ -193,7 +209,7 @@ func TestMultiSigMigration(t *testing.T) {
 	err := multisig.AddSignatureFromPubKey(multisignature, sigs[0], pkSet[0], pkSet)
 
 	// create a StdSignature for msg, and convert it to sigV2
-	sig := authtypes.StdSignature{PubKey: pkSet[1], Signature: msg}
+	sig := authtypes.StdSignature{PubKey: pkSet[1], Signature: sigs[1].(*signing.SingleSignatureData).Signature}
 	sigV2, err := authtypes.StdSignatureToSignatureV2(cdc, sig)
 	require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pkSet))
 
@@ -0,0 +1,172 @@
+package multisig
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// PubKeyMultisigWeighted implements a weighted multisig. Each key carries a
+// weight and a signature is valid once the keys that signed carry a total
+// weight of at least the threshold. Keys may themselves be multisig keys.
+type PubKeyMultisigWeighted struct {
+	Threshold uint            `json:"threshold"`
+	PubKeys   []crypto.PubKey `json:"pubkeys"`
+	Weights   []uint          `json:"weights"`
+}
+
+var _ PubKey = PubKeyMultisigWeighted{}
+
+// NewPubKeyMultisigWeighted returns a new PubKeyMultisigWeighted.
+// Returns an error if threshold is 0, if the weights do not match the keys,
+// if any key is nil or has a weight of 0, or if the total weight is less than
+// threshold.
+func NewPubKeyMultisigWeighted(threshold uint, pubkeys []crypto.PubKey, weights []uint) (PubKey, error) {
+	if threshold == 0 {
+		return nil, fmt.Errorf("weighted multisignature: threshold == 0")
+	}
+	if len(pubkeys) != len(weights) {
+		return nil, fmt.Errorf("weighted multisignature: len(pubkeys) %d != len(weights) %d", len(pubkeys), len(weights))
+	}
+	var total uint
+	for i, pubkey := range pubkeys {
+		if pubkey == nil {
+			return nil, fmt.Errorf("weighted multisignature: nil pubkey %d", i)
+		}
+		if weights[i] == 0 {
+			return nil, fmt.Errorf("weighted multisignature: weight %d == 0", i)
+		}
+		total += weights[i]
+	}
+	if total < threshold {
+		return nil, fmt.Errorf("weighted multisignature: total weight %d < threshold %d", total, threshold)
+	}
+	return PubKeyMultisigWeighted{threshold, pubkeys, weights}, nil
+}
+
+// VerifyBytes expects sig to be an amino encoded version of a MultiSignature.
+// Returns true iff the keys with a signature set carry at least the threshold
+// weight and all signatures are valid.
+//
+// NOTE: VerifyMultisignature should preferred to VerifyBytes which only works
+// with amino multisignatures.
+func (pk PubKeyMultisigWeighted) VerifyBytes(msg []byte, marshalledSig []byte) bool {
+	var sig AminoMultisignature
+	err := Cdc.UnmarshalBinaryBare(marshalledSig, &sig)
+	if err != nil {
+		return false
+	}
+	size := sig.BitArray.Count()
+	// ensure bit array is the correct size
+	if len(pk.PubKeys) != size || len(pk.Weights) != size {
+		return false
+	}
+	// ensure size of signature list
+	if len(sig.Sigs) != sig.BitArray.NumTrueBitsBefore(size) {
+		return false
+	}
+	// ensure the signers carry enough weight
+	if pk.signedWeight(sig.BitArray.GetIndex) < pk.Threshold {
+		return false
+	}
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if sig.BitArray.GetIndex(i) {
+			if !pk.PubKeys[i].VerifyBytes(msg, sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+	return true
+}
+
+// VerifyMultisignature implements the PubKey.VerifyMultisignature method
+func (pk PubKeyMultisigWeighted) VerifyMultisignature(getSignBytes GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	bitarray := sig.BitArray
+	sigs := sig.Signatures
+	size := bitarray.Count()
+	// ensure bit array is the correct size
+	if len(pk.PubKeys) != size || len(pk.Weights) != size {
+		return fmt.Errorf("bit array size is incorrect %d", size)
+	}
+	// ensure size of signature list
+	if len(sigs) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	}
+	// ensure the signers carry enough weight
+	if weight := pk.signedWeight(bitarray.GetIndex); weight < pk.Threshold {
+		return fmt.Errorf("minimum signature weight not reached, have %d, expected %d", weight, pk.Threshold)
+	}
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if bitarray.GetIndex(i) {
+			if err := verifySignatureData(pk.PubKeys[i], getSignBytes, sigs[sigIndex], i); err != nil {
+				return err
+			}
+			sigIndex++
+		}
+	}
+	return nil
+}
+
+// signedWeight returns the total weight of the keys for which signed returns
+// true.
+func (pk PubKeyMultisigWeighted) signedWeight(signed func(i int) bool) uint {
+	var weight uint
+	for i, w := range pk.Weights {
+		if signed(i) {
+			weight += w
+		}
+	}
+	return weight
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (pk PubKeyMultisigWeighted) GetPubKeys() []crypto.PubKey {
+	return pk.PubKeys
+}
+
+// GetWeights returns the weight of each key, in the same order as GetPubKeys
+func (pk PubKeyMultisigWeighted) GetWeights() []uint {
+	return pk.Weights
+}
+
+// Bytes returns the amino encoded version of the PubKeyMultisigWeighted
+func (pk PubKeyMultisigWeighted) Bytes() []byte {
+	return Cdc.MustMarshalBinaryBare(pk)
+}
+
+// Address returns tmhash(PubKeyMultisigWeighted.Bytes())
+func (pk PubKeyMultisigWeighted) Address() crypto.Address {
+	return crypto.AddressHash(pk.Bytes())
+}
+
+// Equals returns true iff pk and other both have the same threshold, and all
+// constituent keys and weights are the same, and in the same order.
+func (pk PubKeyMultisigWeighted) Equals(other crypto.PubKey) bool {
+	otherKey, sameType := other.(PubKeyMultisigWeighted)
+	if !sameType {
+		return false
+	}
+	if pk.Threshold != otherKey.Threshold ||
+		len(pk.PubKeys) != len(otherKey.PubKeys) ||
+		len(pk.Weights) != len(otherKey.Weights) {
+		return false
+	}
+	for i := 0; i < len(pk.PubKeys); i++ {
+		if !pk.PubKeys[i].Equals(otherKey.PubKeys[i]) || pk.Weights[i] != otherKey.Weights[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method
+func (pk PubKeyMultisigWeighted) GetThreshold() uint {
+	return pk.Threshold
+}
@@ -0,0 +1,169 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestNewPubKeyMultisigWeighted(t *testing.T) {
+	pubkeys, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4})
+
+	testCases := []struct {
+		name      string
+		threshold uint
+		pubkeys   []crypto.PubKey
+		weights   []uint
+		expErr    bool
+	}{
+		{"zero threshold", 0, pubkeys, []uint{1, 1, 1}, true},
+		{"missing weight", 2, pubkeys, []uint{1, 1}, true},
+		{"zero weight", 2, pubkeys, []uint{1, 0, 1}, true},
+		{"threshold above total weight", 4, pubkeys, []uint{1, 1, 1}, true},
+		{"nil pubkey", 1, []crypto.PubKey{nil}, []uint{1}, true},
+		{"valid", 3, pubkeys, []uint{1, 1, 1}, false},
+	}
+
+	for _, tc := range testCases {
+		_, err := multisig.NewPubKeyMultisigWeighted(tc.threshold, tc.pubkeys, tc.weights)
+		require.Equal(t, tc.expErr, err != nil, tc.name)
+	}
+}
+
+func newPubKeyMultisigWeighted(t *testing.T, threshold uint, pubkeys []crypto.PubKey, weights []uint) multisig.PubKey {
+	pk, err := multisig.NewPubKeyMultisigWeighted(threshold, pubkeys, weights)
+	require.NoError(t, err)
+	return pk
+}
+
+func TestWeightedMultisig(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// the first key counts double
+	multisigKey := newPubKeyMultisigWeighted(t, 3, pubkeys, []uint{2, 1, 1})
+
+	cases := []struct {
+		signers []int
+		expPass bool
+	}{
+		{[]int{}, false},
+		{[]int{0}, false},
+		{[]int{1, 2}, false},
+		{[]int{0, 1}, true},
+		{[]int{0, 2}, true},
+		{[]int{0, 1, 2}, true},
+	}
+
+	for i, tc := range cases {
+		multisignature := multisig.NewMultisig(3)
+		for _, signer := range tc.signers {
+			require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[signer], pubkeys[signer], pubkeys))
+		}
+
+		err := multisigKey.VerifyMultisignature(signBytesFn, multisignature)
+		if tc.expPass {
+			require.NoError(t, err, "tc %d", i)
+		} else {
+			require.Error(t, err, "tc %d", i)
+		}
+	}
+}
+
+func TestWeightedMultisigInvalidSignatures(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	_, otherSigs := generatePubKeysAndSignatures(3, []byte{5, 6, 7})
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	multisigKey := newPubKeyMultisigWeighted(t, 2, pubkeys, []uint{1, 1, 1})
+
+	// a bad signature fails even when the others carry enough weight
+	multisignature := multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[0], pubkeys[0], pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[1], pubkeys[1], pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, otherSigs[2], pubkeys[2], pubkeys))
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// the number of signatures must match the bit array
+	multisignature = multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[0], pubkeys[0], pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[1], pubkeys[1], pubkeys))
+	multisignature.Signatures = append(multisignature.Signatures, sigs[2])
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// the bit array must match the keys
+	multisignature = multisig.NewMultisig(2)
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[0], pubkeys[0], pubkeys[:2]))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[1], pubkeys[1], pubkeys[:2]))
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+}
+
+func TestNestedWeightedMultisig(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// a 2-of-3 board is a member of a treasury where the CEO counts double
+	boardKeys, boardSigs := generatePubKeysAndSignatures(3, msg)
+	board := multisig.NewPubKeyMultisigThreshold(2, boardKeys)
+	officerKeys, officerSigs := generatePubKeysAndSignatures(2, msg)
+	ceo, cfo := officerKeys[0], officerKeys[1]
+	for _, sig := range append(boardSigs, officerSigs...) {
+		sig.(*signing.SingleSignatureData).SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+
+	treasuryKeys := []crypto.PubKey{ceo, cfo, board}
+	treasury := newPubKeyMultisigWeighted(t, 3, treasuryKeys, []uint{2, 1, 1})
+
+	boardSig := multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(boardSig, boardSigs[0], boardKeys[0], boardKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(boardSig, boardSigs[2], boardKeys[2], boardKeys))
+	require.NoError(t, board.VerifyMultisignature(signBytesFn, boardSig))
+
+	// the CFO and the board do not carry enough weight
+	treasurySig := multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, officerSigs[1], cfo, treasuryKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, boardSig, board, treasuryKeys))
+	require.Error(t, treasury.VerifyMultisignature(signBytesFn, treasurySig))
+
+	// the CEO and the board do
+	treasurySig = multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, officerSigs[0], ceo, treasuryKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, boardSig, board, treasuryKeys))
+	require.NoError(t, treasury.VerifyMultisignature(signBytesFn, treasurySig))
+
+	// amino encoded multisignatures are verified recursively as well
+	cdc := codec.New()
+	aminoSig, err := authtypes.SignatureDataToAminoSignature(cdc, treasurySig)
+	require.NoError(t, err)
+	require.True(t, treasury.VerifyBytes(msg, aminoSig))
+	require.False(t, treasury.VerifyBytes([]byte{5, 6, 7, 8}, aminoSig))
+
+	// the board needs 2 of its 3 signatures
+	boardSig = multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(boardSig, boardSigs[1], boardKeys[1], boardKeys))
+	treasurySig = multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, officerSigs[0], ceo, treasuryKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(treasurySig, boardSig, board, treasuryKeys))
+	require.Error(t, treasury.VerifyMultisignature(signBytesFn, treasurySig))
+}
+
+func TestWeightedMultisigPubKeyEquality(t *testing.T) {
+	pubkeys, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4})
+	multisigKey := newPubKeyMultisigWeighted(t, 2, pubkeys, []uint{1, 2, 1})
+
+	var unmarshalledMultisig crypto.PubKey
+	multisig.Cdc.MustUnmarshalBinaryBare(multisigKey.Bytes(), &unmarshalledMultisig)
+	require.True(t, multisigKey.Equals(unmarshalledMultisig))
+	require.Len(t, multisigKey.Address().Bytes(), 20)
+
+	require.False(t, multisigKey.Equals(newPubKeyMultisigWeighted(t, 3, pubkeys, []uint{1, 2, 1})))
+	require.False(t, multisigKey.Equals(newPubKeyMultisigWeighted(t, 2, pubkeys, []uint{2, 1, 1})))
+	require.False(t, multisigKey.Equals(multisig.NewPubKeyMultisigThreshold(2, pubkeys)))
+}
@@ -6,18 +6,28 @@ const (
 	DefaultWeightMsgMultiSend                   int = 10
 	DefaultWeightMsgSetWithdrawAddress          int = 50
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
+	DefaultWeightMsgWithdrawAllDelegatorRewards int = 20
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoRestake              int = 20
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
+	DefaultWeightMsgVoteWeighted                int = 33
+	DefaultWeightMsgRegisterRepresentative      int = 5
+	DefaultWeightMsgDelegateVotingPower         int = 20
 	DefaultWeightMsgUnjail                      int = 100
+	DefaultWeightMsgEnterMaintenance            int = 5
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgRotateConsPubKey            int = 5
 
-	DefaultWeightCommunitySpendProposal int = 5
-	DefaultWeightTextProposal           int = 5
-	DefaultWeightParamChangeProposal    int = 5
+	DefaultWeightCommunitySpendProposal                int = 5
+	DefaultWeightCommunityContinuousFundProposal       int = 5
+	DefaultWeightCancelCommunityContinuousFundProposal int = 5
+	DefaultWeightTextProposal                          int = 5
+	DefaultWeightParamChangeProposal                   int = 5
 )
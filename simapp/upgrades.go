@@ -0,0 +1,68 @@
+package simapp
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeName is the name of the upgrade migrating the state of the chains
+// created before the store changes of this release.
+const UpgradeName = "v0.41"
+
+// upgradeStoreUpgrades are the changes of the mounted stores applied when
+// loading the store at the height of the upgrade.
+var upgradeStoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{tokenfactorytypes.StoreKey},
+}
+
+// registerUpgradeHandlers registers the handlers of the upgrades the app knows
+// how to perform. The handlers run in the begin blocker of the upgrade height.
+func (app *SimApp) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		app.migrateStores(ctx)
+	})
+}
+
+// setUpgradeStoreLoader sets the store loader adding the stores of the modules
+// introduced by the upgrade, if the node was halted for it. It must be called
+// before the latest version is loaded.
+func (app *SimApp) setUpgradeStoreLoader() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &upgradeStoreUpgrades))
+	}
+}
+
+// migrateStores migrates the module stores from the previous release.
+func (app *SimApp) migrateStores(ctx sdk.Context) {
+	// store keys holding addresses are length prefixed
+	app.BankKeeper.MigrateAddressKeys(ctx)
+	app.StakingKeeper.MigrateAddressKeys(ctx)
+	app.DistrKeeper.MigrateAddressKeys(ctx)
+
+	// the bank supply is stored per denom
+	if err := app.BankKeeper.MigrateSupplyStore(ctx); err != nil {
+		panic(err)
+	}
+
+	// the denom owners index is built from the migrated balances
+	app.BankKeeper.MigrateDenomAddressIndex(ctx)
+
+	// the slashing missed blocks are stored as chunked bitmaps
+	app.SlashingKeeper.MigrateMissedBlockBitArrays(ctx)
+
+	// params added since the previous release don't exist in the params store
+	app.AccountKeeper.MigrateParams(ctx)
+	app.DistrKeeper.MigrateParams(ctx)
+	app.GovKeeper.MigrateParams(ctx)
+	app.SlashingKeeper.MigrateParams(ctx)
+	app.StakingKeeper.MigrateParams(ctx, stakingtypes.DefaultMinCommissionRate)
+	app.TokenFactoryKeeper.MigrateParams(ctx)
+}
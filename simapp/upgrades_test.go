@@ -0,0 +1,196 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestUpgradeHandler(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	delAddr, withdrawAddr := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(addrs[1])
+	pks := CreateTestPubKeys(1)
+
+	// write the state with the legacy fixed length address keys
+	bankStore := ctx.KVStore(app.GetKey(banktypes.StoreKey))
+	balance := sdk.NewInt64Coin("legacy", 100)
+	bankStore.Set(
+		append(append(sdk.CopyBytes(banktypes.BalancesPrefix), delAddr...), []byte(balance.Denom)...),
+		app.AppCodec().MustMarshalBinaryBare(&balance),
+	)
+	supplyBz, err := codec.MarshalAny(app.AppCodec(), banktypes.NewSupply(sdk.NewCoins(balance)))
+	require.NoError(t, err)
+	bankStore.Set(banktypes.SupplyKey, supplyBz)
+
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+	validator := stakingtypes.NewValidator(valAddr, pks[0], stakingtypes.Description{})
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(10))
+	stakingStore.Set(append(sdk.CopyBytes(stakingtypes.ValidatorsKey), valAddr...), stakingtypes.MustMarshalValidator(app.AppCodec(), validator))
+	delegation := stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(10))
+	stakingStore.Set(
+		append(append(sdk.CopyBytes(stakingtypes.DelegationKey), delAddr...), valAddr...),
+		stakingtypes.MustMarshalDelegation(app.AppCodec(), delegation),
+	)
+
+	slashingStore := ctx.KVStore(app.GetKey(slashingtypes.StoreKey))
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	slashingStore.Set(
+		slashingtypes.ValidatorMissedBlockBitArrayKey(consAddr, 3),
+		app.AppCodec().MustMarshalBinaryBare(&gogotypes.BoolValue{Value: true}),
+	)
+
+	// params added since the previous release are missing on upgraded chains
+	stakingParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(stakingtypes.ModuleName+"/"))
+	stakingParamsStore.Delete(stakingtypes.KeyMinCommissionRate)
+	stakingParamsStore.Delete(stakingtypes.KeyKeyRotationFee)
+	stakingParamsStore.Delete(stakingtypes.KeyGlobalLiquidStakingCap)
+	stakingParamsStore.Delete(stakingtypes.KeyValidatorLiquidStakingCap)
+	stakingParamsStore.Delete(stakingtypes.KeyMaxVotingPowerRatio)
+
+	authParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(authtypes.ModuleName+"/"))
+	authParamsStore.Delete(authtypes.KeySigVerifyCostSecp256r1)
+
+	slashingParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(slashingtypes.ModuleName+"/"))
+	slashingParamsStore.Delete(slashingtypes.KeyMaxMaintenanceDuration)
+	slashingParamsStore.Delete(slashingtypes.KeyMaintenanceEpoch)
+	slashingParamsStore.Delete(slashingtypes.KeyMaxMaintenanceWindows)
+	slashingParamsStore.Delete(slashingtypes.KeyDowntimeInfractionLookback)
+	slashingParamsStore.Delete(slashingtypes.KeyDowntimeJailDurationMultiplier)
+	slashingParamsStore.Delete(slashingtypes.KeySlashFractionDowntimeMultiplier)
+	slashingParamsStore.Delete(slashingtypes.KeyReporterRewardFraction)
+
+	distrParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(distrtypes.ModuleName+"/"))
+	distrParamsStore.Delete(distrtypes.ParamStoreKeyMaxAutoRestakesPerBlock)
+
+	// the gov params are stored without the expedited proposal params
+	govParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(govtypes.ModuleName+"/"))
+	setLegacyGovParams := func(key []byte, params interface{}, removed string) {
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(app.Codec().MustMarshalJSON(params), &fields))
+		delete(fields, removed)
+		bz, err := json.Marshal(fields)
+		require.NoError(t, err)
+		govParamsStore.Set(key, bz)
+	}
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("legacy", 10))
+	setLegacyGovParams(govtypes.ParamStoreKeyDepositParams, govtypes.NewDepositParams(minDeposit, govtypes.DefaultPeriod, nil), "min_expedited_deposit")
+	setLegacyGovParams(govtypes.ParamStoreKeyVotingParams, govtypes.NewVotingParams(govtypes.DefaultPeriod, 0), "expedited_voting_period")
+	setLegacyGovParams(govtypes.ParamStoreKeyTallyParams, govtypes.DefaultTallyParams(), "expedited_threshold")
+	require.True(t, app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold.IsNil())
+
+	tokenfactoryParamsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(tokenfactorytypes.ModuleName+"/"))
+	tokenfactoryParamsStore.Delete(tokenfactorytypes.KeyDenomCreationFee)
+
+	distrStore := ctx.KVStore(app.GetKey(distrtypes.StoreKey))
+	distrStore.Set(append(sdk.CopyBytes(distrtypes.DelegatorWithdrawAddrPrefix), delAddr...), withdrawAddr)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeName, Height: ctx.BlockHeight()})
+
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, delAddr, balance.Denom))
+	require.False(t, bankStore.Has(banktypes.SupplyKey))
+	require.Equal(t, balance, app.BankKeeper.GetSupply(ctx, balance.Denom))
+	owners, err := app.BankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{Denom: balance.Denom})
+	require.NoError(t, err)
+	require.Len(t, owners.DenomOwners, 1)
+	require.Equal(t, delAddr, owners.DenomOwners[0].Address)
+
+	gotValidator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, gotValidator.Tokens)
+
+	gotDelegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, gotDelegation.Shares)
+
+	var powerIndexed []sdk.ValAddress
+	iterator := app.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		powerIndexed = append(powerIndexed, sdk.ValAddress(iterator.Value()))
+	}
+	iterator.Close()
+	require.Contains(t, powerIndexed, valAddr)
+
+	require.Equal(t, withdrawAddr, app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr))
+
+	require.Equal(t, distrtypes.DefaultParams().MaxAutoRestakesPerBlock, app.DistrKeeper.GetMaxAutoRestakesPerBlock(ctx))
+
+	require.Equal(t, authtypes.DefaultSigVerifyCostSecp256r1, app.AccountKeeper.GetParams(ctx).SigVerifyCostSecp256r1)
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	require.Equal(t, stakingtypes.DefaultParams().MinCommissionRate, stakingParams.MinCommissionRate)
+	require.Equal(t, stakingtypes.DefaultParams().KeyRotationFee, stakingParams.KeyRotationFee)
+	require.Equal(t, stakingtypes.DefaultGlobalLiquidStakingCap, stakingParams.GlobalLiquidStakingCap)
+	require.Equal(t, stakingtypes.DefaultValidatorLiquidStakingCap, stakingParams.ValidatorLiquidStakingCap)
+	require.Equal(t, stakingtypes.DefaultMaxVotingPowerRatio, stakingParams.MaxVotingPowerRatio)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("legacy", 50)), app.GovKeeper.GetDepositParams(ctx).MinExpeditedDeposit)
+	require.Equal(t, govtypes.DefaultExpeditedPeriod, app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+	require.Equal(t, govtypes.DefaultExpeditedThreshold, app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold)
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	require.Equal(t, slashingtypes.DefaultMaxMaintenanceDuration, slashingParams.MaxMaintenanceDuration)
+	require.Equal(t, slashingtypes.DefaultMaintenanceEpoch, slashingParams.MaintenanceEpoch)
+	require.Equal(t, slashingtypes.DefaultMaxMaintenanceWindows, slashingParams.MaxMaintenanceWindows)
+	require.Equal(t, slashingtypes.DefaultDowntimeInfractionLookback, slashingParams.DowntimeInfractionLookback)
+	require.Equal(t, slashingtypes.DefaultDowntimeJailDurationMultiplier, slashingParams.DowntimeJailDurationMultiplier)
+	require.Equal(t, slashingtypes.DefaultSlashFractionDowntimeMultiplier, slashingParams.SlashFractionDowntimeMultiplier)
+	require.Equal(t, slashingtypes.DefaultReporterRewardFraction, slashingParams.ReporterRewardFraction)
+
+	require.Equal(t, tokenfactorytypes.DefaultParams(), app.TokenFactoryKeeper.GetParams(ctx))
+
+	require.False(t, slashingStore.Has(slashingtypes.ValidatorMissedBlockBitArrayKey(consAddr, 3)))
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 3))
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	homeDir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	// commit the stores of the previous release, without the tokenfactory store
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0)
+	cms := rootmulti.NewStore(db)
+	for name, key := range app.keys {
+		if name != tokenfactorytypes.StoreKey {
+			cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+		}
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.Commit()
+
+	// the node halted for the upgrade at the committed height
+	require.NoError(t, app.UpgradeKeeper.DumpUpgradeInfoToDisk(1, UpgradeName))
+
+	app = NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, homeDir, 0)
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	// the tokenfactory store is added
+	store := app.BaseApp.NewUncachedContext(false, abci.Header{}).KVStore(app.GetKey(tokenfactorytypes.StoreKey))
+	require.False(t, store.Iterator(nil, nil).Valid())
+	store.Set([]byte("key"), []byte("value"))
+	require.Equal(t, []byte("value"), store.Get([]byte("key")))
+}
@@ -6,7 +6,6 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // ----------------------------------------------------------------------------
@@ -25,13 +24,11 @@ func MakeCodec(bm module.BasicManager) *codec.Codec {
 }
 
 func RegisterCodec(cdc *codec.Codec) {
-	vesting.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	cryptocodec.RegisterCrypto(cdc)
 }
 
-// RegisterInterfaces registers Interfaces from sdk/types and vesting
+// RegisterInterfaces registers Interfaces from sdk/types
 func RegisterInterfaces(interfaceRegistry types.InterfaceRegistry) {
 	sdk.RegisterInterfaces(interfaceRegistry)
-	vesting.RegisterInterfaces(interfaceRegistry)
 }
@@ -3,6 +3,7 @@ package std
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 
@@ -29,6 +30,14 @@ func (cdc DefaultPublicKeyCodec) Decode(key *types.PublicKey) (crypto.PubKey, er
 		var res secp256k1.PubKeySecp256k1
 		copy(res[:], key.Secp256K1)
 		return res, nil
+	case *types.PublicKey_Secp256R1:
+		n := len(key.Secp256R1)
+		if n != secp256r1.PubKeySize {
+			return nil, fmt.Errorf("wrong length %d for secp256r1 public key", n)
+		}
+		var res secp256r1.PubKeySecp256r1
+		copy(res[:], key.Secp256R1)
+		return res, nil
 	case *types.PublicKey_Ed25519:
 		n := len(key.Ed25519)
 		if n != ed255192.PubKeyEd25519Size {
@@ -58,6 +67,23 @@ func (cdc DefaultPublicKeyCodec) Decode(key *types.PublicKey) (crypto.PubKey, er
 		}
 
 		return multisig.NewPubKeyMultisigThreshold(int(key.Multisig.K), resKeys), nil
+	case *types.PublicKey_MultisigWeighted:
+		pubKeys := key.MultisigWeighted.PubKeys
+		resKeys := make([]crypto.PubKey, len(pubKeys))
+		for i, k := range pubKeys {
+			dk, err := cdc.Decode(k)
+			if err != nil {
+				return nil, err
+			}
+			resKeys[i] = dk
+		}
+		weights := key.MultisigWeighted.Weights
+		resWeights := make([]uint, len(weights))
+		for i, w := range weights {
+			resWeights[i] = uint(w)
+		}
+
+		return multisig.NewPubKeyMultisigWeighted(uint(key.MultisigWeighted.Threshold), resKeys, resWeights)
 	default:
 		return nil, fmt.Errorf("can't decode PubKey of type %T. Use a custom PublicKeyCodec instead", key)
 	}
@@ -68,6 +94,8 @@ func (cdc DefaultPublicKeyCodec) Encode(key crypto.PubKey) (*types.PublicKey, er
 	switch key := key.(type) {
 	case secp256k1.PubKeySecp256k1:
 		return &types.PublicKey{Sum: &types.PublicKey_Secp256K1{Secp256K1: key[:]}}, nil
+	case secp256r1.PubKeySecp256r1:
+		return &types.PublicKey{Sum: &types.PublicKey_Secp256R1{Secp256R1: key[:]}}, nil
 	case ed255192.PubKeyEd25519:
 		return &types.PublicKey{Sum: &types.PublicKey_Ed25519{Ed25519: key[:]}}, nil
 	case sr25519.PubKeySr25519:
@@ -86,6 +114,25 @@ func (cdc DefaultPublicKeyCodec) Encode(key crypto.PubKey) (*types.PublicKey, er
 			K:       uint32(key.K),
 			PubKeys: resKeys,
 		}}}, nil
+	case multisig.PubKeyMultisigWeighted:
+		pubKeys := key.PubKeys
+		resKeys := make([]*types.PublicKey, len(pubKeys))
+		weights := make([]uint32, len(key.Weights))
+		for i, k := range pubKeys {
+			dk, err := cdc.Encode(k)
+			if err != nil {
+				return nil, err
+			}
+			resKeys[i] = dk
+		}
+		for i, w := range key.Weights {
+			weights[i] = uint32(w)
+		}
+		return &types.PublicKey{Sum: &types.PublicKey_MultisigWeighted{MultisigWeighted: &types.PubKeyMultisigWeighted{
+			Threshold: uint32(key.Threshold),
+			PubKeys:   resKeys,
+			Weights:   weights,
+		}}}, nil
 	default:
 		return nil, fmt.Errorf("can't encode PubKey of type %T. Use a custom PublicKeyCodec instead", key)
 	}
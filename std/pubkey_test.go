@@ -11,6 +11,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
@@ -34,8 +36,45 @@ func TestDefaultPublicKeyCodec(t *testing.T) {
 	pubKeySr25519 := sr25519.GenPrivKey().PubKey()
 	roundTripTest(t, pubKeySr25519)
 
+	pubKeySecp256r1 := secp256r1.GenPrivKey().PubKey()
+	roundTripTest(t, pubKeySecp256r1)
+
 	pubKeyMultisig := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
-		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519,
+		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519, pubKeySecp256r1,
 	})
 	roundTripTest(t, pubKeyMultisig)
+
+	pubKeyWeighted, err := multisig.NewPubKeyMultisigWeighted(3, []crypto.PubKey{
+		pubKeySecp256k1, pubKeyMultisig, pubKeySecp256r1,
+	}, []uint{2, 1, 1})
+	require.NoError(t, err)
+	roundTripTest(t, pubKeyWeighted)
+}
+
+func TestDecodeInvalidMultisigWeighted(t *testing.T) {
+	cdc := std.DefaultPublicKeyCodec{}
+	pubKey, err := cdc.Encode(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		threshold uint32
+		weights   []uint32
+	}{
+		{"zero threshold", 0, []uint32{1}},
+		{"missing weight", 1, nil},
+		{"zero weight", 1, []uint32{0}},
+		{"threshold above total weight", 2, []uint32{1}},
+	}
+
+	for _, tc := range testCases {
+		_, err := cdc.Decode(&types.PublicKey{Sum: &types.PublicKey_MultisigWeighted{
+			MultisigWeighted: &types.PubKeyMultisigWeighted{
+				Threshold: tc.threshold,
+				PubKeys:   []*types.PublicKey{pubKey},
+				Weights:   tc.weights,
+			},
+		}})
+		require.Error(t, err, tc.name)
+	}
 }
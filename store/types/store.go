@@ -43,6 +43,7 @@ type Queryable interface {
 
 // StoreUpgrades defines a series of transformations to apply the multistore db upon load
 type StoreUpgrades struct {
+	Added   []string      `json:"added"`
 	Renamed []StoreRename `json:"renamed"`
 	Deleted []string      `json:"deleted"`
 }
@@ -62,6 +63,19 @@ type StoreRename struct {
 	NewKey string `json:"new_key"`
 }
 
+// IsAdded returns true if the given key should be added
+func (s *StoreUpgrades) IsAdded(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, a := range s.Added {
+		if a == key {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDeleted returns true if the given key should be deleted
 func (s *StoreUpgrades) IsDeleted(key string) bool {
 	if s == nil {
@@ -10,6 +10,10 @@ import (
 
 func TestStoreUpgrades(t *testing.T) {
 	t.Parallel()
+	type toAdd struct {
+		key   string
+		added bool
+	}
 	type toDelete struct {
 		key    string
 		delete bool
@@ -21,18 +25,22 @@ func TestStoreUpgrades(t *testing.T) {
 
 	cases := map[string]struct {
 		upgrades     *StoreUpgrades
+		expectAdd    []toAdd
 		expectDelete []toDelete
 		expectRename []toRename
 	}{
 		"empty upgrade": {
+			expectAdd:    []toAdd{{"foo", false}},
 			expectDelete: []toDelete{{"foo", false}},
 			expectRename: []toRename{{"foo", ""}},
 		},
 		"simple matches": {
 			upgrades: &StoreUpgrades{
+				Added:   []string{"qux"},
 				Deleted: []string{"foo"},
 				Renamed: []StoreRename{{"bar", "baz"}},
 			},
+			expectAdd:    []toAdd{{"qux", true}, {"foo", false}, {"baz", false}},
 			expectDelete: []toDelete{{"foo", true}, {"bar", false}, {"baz", false}},
 			expectRename: []toRename{{"foo", ""}, {"bar", ""}, {"baz", "bar"}},
 		},
@@ -49,6 +57,9 @@ func TestStoreUpgrades(t *testing.T) {
 	for name, tc := range cases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
+			for _, a := range tc.expectAdd {
+				assert.Equal(t, tc.upgrades.IsAdded(a.key), a.added)
+			}
 			for _, d := range tc.expectDelete {
 				assert.Equal(t, tc.upgrades.IsDeleted(d.key), d.delete)
 			}
@@ -12,6 +12,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	tmamino "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
@@ -28,7 +29,8 @@ const (
 	//	config.SetFullFundraiserPath(yourFullFundraiserPath)
 	//	config.Seal()
 
-	// AddrLen defines a valid address length
+	// AddrLen defines the length of addresses derived from public keys. Module
+	// derived addresses are longer, see the types/address package.
 	AddrLen = 20
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32MainPrefix = "cosmos"
@@ -110,8 +112,11 @@ func VerifyAddressFormat(bz []byte) error {
 	if verifier != nil {
 		return verifier(bz)
 	}
-	if len(bz) != AddrLen {
-		return errors.New("incorrect address length")
+	if len(bz) == 0 {
+		return errors.New("addresses cannot be empty")
+	}
+	if len(bz) > address.MaxAddrLen {
+		return fmt.Errorf("address max length is %d, got %d", address.MaxAddrLen, len(bz))
 	}
 	return nil
 }
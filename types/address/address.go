@@ -0,0 +1,50 @@
+package address
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAddrLen is the maximum allowed length (in bytes) for an address.
+const MaxAddrLen = 255
+
+// LengthPrefix prefixes the address bytes with its length, this is used
+// for example for variable-length components in store keys.
+func LengthPrefix(bz []byte) ([]byte, error) {
+	bzLen := len(bz)
+	if bzLen == 0 {
+		return bz, nil
+	}
+
+	if bzLen > MaxAddrLen {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "address length should be max %d bytes, got %d", MaxAddrLen, bzLen)
+	}
+
+	return append([]byte{byte(bzLen)}, bz...), nil
+}
+
+// MustLengthPrefix is LengthPrefix with panic on error.
+func MustLengthPrefix(bz []byte) []byte {
+	res, err := LengthPrefix(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// ParseLengthPrefixed returns the address at the start of a length prefixed
+// key and the remainder of the key. It panics if the key is too short.
+func ParseLengthPrefixed(key []byte) (addr []byte, rest []byte) {
+	if len(key) == 0 {
+		panic("empty length prefixed key")
+	}
+
+	addrLen := int(key[0])
+	if len(key) < 1+addrLen {
+		panic(fmt.Sprintf("unexpected length prefixed key length; got: %d, expected at least: %d", len(key), 1+addrLen))
+	}
+
+	return key[1 : 1+addrLen], key[1+addrLen:]
+}
@@ -0,0 +1,61 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestLengthPrefix(t *testing.T) {
+	addr := []byte{1, 2, 3}
+
+	bz, err := address.LengthPrefix(addr)
+	require.NoError(t, err)
+	require.Equal(t, []byte{3, 1, 2, 3}, bz)
+
+	bz, err = address.LengthPrefix(nil)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	_, err = address.LengthPrefix(make([]byte, address.MaxAddrLen+1))
+	require.Error(t, err)
+	require.Panics(t, func() { address.MustLengthPrefix(make([]byte, address.MaxAddrLen+1)) })
+
+	bz = address.MustLengthPrefix(make([]byte, address.MaxAddrLen))
+	require.Len(t, bz, address.MaxAddrLen+1)
+	require.Equal(t, byte(address.MaxAddrLen), bz[0])
+}
+
+func TestParseLengthPrefixed(t *testing.T) {
+	key := append(address.MustLengthPrefix([]byte{1, 2, 3}), 4, 5)
+
+	addr, rest := address.ParseLengthPrefixed(key)
+	require.Equal(t, []byte{1, 2, 3}, addr)
+	require.Equal(t, []byte{4, 5}, rest)
+
+	require.Panics(t, func() { address.ParseLengthPrefixed(nil) })
+	require.Panics(t, func() { address.ParseLengthPrefixed([]byte{3, 1, 2}) })
+}
+
+func TestHash(t *testing.T) {
+	key := []byte{1, 2, 3}
+
+	addr := address.Hash("type", key)
+	require.Len(t, addr, address.Len)
+	require.Equal(t, addr, address.Hash("type", key))
+	require.NotEqual(t, addr, address.Hash("other", key))
+	require.NotEqual(t, addr, address.Hash("type", []byte{1, 2}))
+}
+
+func TestModule(t *testing.T) {
+	addr := address.Module("bank", []byte("key"))
+	require.Len(t, addr, address.Len)
+	require.Equal(t, addr, address.Module("bank", []byte("key")))
+	require.NotEqual(t, addr, address.Module("bank", []byte("key2")))
+	require.NotEqual(t, addr, address.Module("staking", []byte("key")))
+
+	// the separator prevents collisions between names and keys
+	require.NotEqual(t, address.Module("ab", []byte("c")), address.Module("a", []byte("bc")))
+}
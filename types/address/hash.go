@@ -0,0 +1,39 @@
+package address
+
+import (
+	"crypto/sha256"
+)
+
+// Len is the length of the addresses derived by Hash and Module.
+const Len = sha256.Size
+
+// Hash creates a new address from an address type and key. The type
+// separates the address spaces of different derivation schemes, so that
+// equal keys of different types never produce the same address.
+func Hash(typ string, key []byte) []byte {
+	hasher := sha256.New()
+	_, err := hasher.Write(typeHash(typ))
+	// the error always nil, it's here only to satisfy the io.Writer interface
+	if err != nil {
+		panic(err)
+	}
+	_, err = hasher.Write(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// Module is a specialized version of a composed address for modules. Each
+// module account is constructed from a module name and a derivation key,
+// which lets a module own any number of accounts.
+func Module(moduleName string, key []byte) []byte {
+	mKey := append([]byte(moduleName), 0)
+	return Hash("module", append(mKey, key...))
+}
+
+func typeHash(typ string) []byte {
+	th := sha256.Sum256([]byte(typ))
+	return th[:]
+}
@@ -0,0 +1,44 @@
+package address
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// LegacyAddrLen is the fixed length of the addresses stored in keys before
+// they were length prefixed.
+const LegacyAddrLen = 20
+
+// MigratePrefixAddresses rewrites every key under the given prefix which starts
+// with numAddrs fixed length addresses, so that each address is length prefixed.
+// The remainder of the key and the value are kept as is. It is meant to be run
+// once, from the upgrade handler migrating the store to length prefixed keys.
+func MigratePrefixAddresses(store storetypes.KVStore, keyPrefix []byte, numAddrs int) {
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	// delete every legacy key first so that no rewritten key can be clobbered
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for i, key := range keys {
+		oldKey := key[len(keyPrefix):]
+		if len(oldKey) < numAddrs*LegacyAddrLen {
+			panic("unexpected legacy key length")
+		}
+
+		newKey := make([]byte, 0, len(keyPrefix)+len(oldKey)+numAddrs)
+		newKey = append(newKey, keyPrefix...)
+		for j := 0; j < numAddrs; j++ {
+			newKey = append(newKey, MustLengthPrefix(oldKey[j*LegacyAddrLen:(j+1)*LegacyAddrLen])...)
+		}
+		newKey = append(newKey, oldKey[numAddrs*LegacyAddrLen:]...)
+
+		store.Set(newKey, values[i])
+	}
+}
@@ -0,0 +1,30 @@
+package address_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestMigratePrefixAddresses(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	addr1 := bytes.Repeat([]byte{1}, address.LegacyAddrLen)
+	addr2 := bytes.Repeat([]byte{2}, address.LegacyAddrLen)
+
+	legacyKey := append(append(append([]byte{0x31}, addr1...), addr2...), []byte("rest")...)
+	otherKey := append([]byte{0x32}, addr1...)
+	store.Set(legacyKey, []byte("value"))
+	store.Set(otherKey, []byte("other"))
+
+	address.MigratePrefixAddresses(store, []byte{0x31}, 2)
+
+	newKey := append(append(append([]byte{0x31}, address.MustLengthPrefix(addr1)...), address.MustLengthPrefix(addr2)...), []byte("rest")...)
+	require.False(t, store.Has(legacyKey))
+	require.Equal(t, []byte("value"), store.Get(newKey))
+	require.Equal(t, []byte("other"), store.Get(otherKey))
+}
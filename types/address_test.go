@@ -16,6 +16,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var invalidStrs = []string{
@@ -331,34 +332,63 @@ func TestCustomAddressVerifier(t *testing.T) {
 	accBech := types.AccAddress(addr).String()
 	valBech := types.ValAddress(addr).String()
 	consBech := types.ConsAddress(addr).String()
-	// Verifiy that the default logic rejects this 10 byte address
+	// Verifiy that the default logic accepts this 10 byte address
 	err := types.VerifyAddressFormat(addr)
-	require.NotNil(t, err)
+	require.Nil(t, err)
 	_, err = types.AccAddressFromBech32(accBech)
-	require.NotNil(t, err)
+	require.Nil(t, err)
 	_, err = types.ValAddressFromBech32(valBech)
-	require.NotNil(t, err)
+	require.Nil(t, err)
 	_, err = types.ConsAddressFromBech32(consBech)
-	require.NotNil(t, err)
+	require.Nil(t, err)
 
-	// Set a custom address verifier that accepts 10 or 20 byte addresses
+	// Set a custom address verifier that only accepts 20 byte addresses
 	types.GetConfig().SetAddressVerifier(func(bz []byte) error {
 		n := len(bz)
-		if n == 10 || n == types.AddrLen {
+		if n == types.AddrLen {
 			return nil
 		}
 		return fmt.Errorf("incorrect address length %d", n)
 	})
+	defer types.GetConfig().SetAddressVerifier(nil)
 
-	// Verifiy that the custom logic accepts this 10 byte address
+	// Verifiy that the custom logic rejects this 10 byte address
 	err = types.VerifyAddressFormat(addr)
-	require.Nil(t, err)
+	require.NotNil(t, err)
 	_, err = types.AccAddressFromBech32(accBech)
-	require.Nil(t, err)
+	require.NotNil(t, err)
 	_, err = types.ValAddressFromBech32(valBech)
-	require.Nil(t, err)
+	require.NotNil(t, err)
 	_, err = types.ConsAddressFromBech32(consBech)
-	require.Nil(t, err)
+	require.NotNil(t, err)
+}
+
+func TestVerifyAddressFormat(t *testing.T) {
+	addr0 := make([]byte, 0)
+	addr5 := make([]byte, 5)
+	addr20 := make([]byte, 20)
+	addr32 := make([]byte, 32)
+	addr256 := make([]byte, 256)
+
+	require.EqualError(t, types.VerifyAddressFormat(addr0), "addresses cannot be empty")
+	require.NoError(t, types.VerifyAddressFormat(addr5))
+	require.NoError(t, types.VerifyAddressFormat(addr20))
+	require.NoError(t, types.VerifyAddressFormat(addr32))
+	require.EqualError(t, types.VerifyAddressFormat(addr256), "address max length is 255, got 256")
+}
+
+func TestModuleDerivedAddressBech32(t *testing.T) {
+	addr := types.AccAddress(address.Module("module", []byte("key")))
+	require.Len(t, addr, 32)
+
+	res, err := types.AccAddressFromBech32(addr.String())
+	require.NoError(t, err)
+	require.Equal(t, addr, res)
+
+	val := types.ValAddress(addr)
+	resVal, err := types.ValAddressFromBech32(val.String())
+	require.NoError(t, err)
+	require.Equal(t, val, resVal)
 }
 
 func TestBech32ifyAddressBytes(t *testing.T) {
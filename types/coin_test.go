@@ -69,6 +69,9 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"a very long coin denom", NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
 		{Coin{"     ", NewInt(1)}, false},
+		{Coin{"factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/atom", NewInt(1)}, true},
+		{Coin{"a" + strings.Repeat("b", 127), NewInt(1)}, true},
+		{Coin{"a" + strings.Repeat("b", 128), NewInt(1)}, false},
 	}
 
 	for i, tc := range cases {
@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -888,6 +889,134 @@ func (suite *AnteTestSuite) TestAnteHandlerSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerChangedPubKey() {
+	suite.SetupTest(false) // setup
+
+	// Same data for every test cases
+	accounts := suite.CreateTestAccounts(1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	newPriv, _, _ := testdata.KeyTestPubAddr()
+
+	// Replace the account's public key, as done by MsgChangePubKey.
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress())
+	suite.Require().NoError(acc.SetPubKey(newPriv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	// Variable data per test case
+	var (
+		accNums []uint64
+		msgs    []sdk.Msg
+		privs   []crypto.PrivKey
+		accSeqs []uint64
+	)
+
+	testCases := []TestCase{
+		{
+			"test tx signed with the replaced key",
+			func() {
+				privs, accNums, accSeqs = []crypto.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+				msgs = []sdk.Msg{testdata.NewTestMsg(accounts[0].acc.GetAddress())}
+			},
+			false,
+			false,
+			sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			"test tx signed with the new key",
+			func() {
+				privs, accNums, accSeqs = []crypto.PrivKey{newPriv}, []uint64{0}, []uint64{0}
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"make sure the new public key is kept",
+			func() {
+				acc0 := suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress())
+				suite.Require().Equal(newPriv.PubKey(), acc0.GetPubKey())
+
+				privs, accNums, accSeqs = []crypto.PrivKey{newPriv}, []uint64{0}, []uint64{1}
+			},
+			false,
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			suite.RunTestCase(privs, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), tc)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerSecp256r1() {
+	suite.SetupTest(false) // setup
+
+	priv := secp256r1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetAccountNumber(0))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))))
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+
+	var (
+		privs   []crypto.PrivKey
+		accSeqs []uint64
+	)
+
+	testCases := []TestCase{
+		{
+			"test tx signed with a wrong secp256r1 key",
+			func() {
+				privs, accSeqs = []crypto.PrivKey{secp256r1.GenPrivKey()}, []uint64{0}
+			},
+			false,
+			false,
+			sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			"test good secp256r1 tx",
+			func() {
+				privs, accSeqs = []crypto.PrivKey{priv}, []uint64{0}
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"make sure the secp256r1 public key has been set",
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+				suite.Require().Equal(priv.PubKey(), acc.GetPubKey())
+
+				accSeqs = []uint64{1}
+			},
+			false,
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			suite.RunTestCase(privs, msgs, feeAmount, gasLimit, []uint64{0}, accSeqs, suite.ctx.ChainID(), tc)
+		})
+	}
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []crypto.PubKey, signatures [][]byte) {
 	pubkeys = make([]crypto.PubKey, n)
 	signatures = make([][]byte, n)
@@ -1110,9 +1239,9 @@ func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostSecp256r1)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
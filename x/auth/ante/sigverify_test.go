@@ -13,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -81,6 +82,18 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		suite.Require().NoError(err)
 	}
 
+	// a weighted multisig nesting the threshold multisig
+	priv2 := secp256k1.GenPrivKey()
+	sig2, err := priv2.Sign(msg)
+	suite.Require().NoError(err)
+	pkSet2 := []crypto.PubKey{priv2.PubKey(), multisigKey1}
+	multisigKey2, err := multisig.NewPubKeyMultisigWeighted(2, pkSet2, []uint{1, 1})
+	suite.Require().NoError(err)
+	multisignature2 := multisig.NewMultisig(len(pkSet2))
+	multisig.AddSignature(multisignature2, &signing.SingleSignatureData{Signature: sig2}, 0)
+	multisig.AddSignature(multisignature2, multisignature1, 1)
+	expectedCost2 := types.DefaultSigVerifyCostSecp256k1 + expectedCost1
+
 	type args struct {
 		meter  sdk.GasMeter
 		sig    signing.SignatureData
@@ -95,7 +108,10 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"Nested weighted multisig", args{sdk.NewInfiniteGasMeter(), multisignature2, multisigKey2, params}, expectedCost2, false},
+		{"Multisig without multisignature", args{sdk.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: sig2}, multisigKey2, params}, 0, true},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
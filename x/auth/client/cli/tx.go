@@ -4,6 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -21,6 +24,44 @@ func GetTxCmd() *cobra.Command {
 		GetSignCommand(),
 		GetValidateSignaturesCommand(),
 		GetSignBatchCommand(),
+		NewChangePubKeyCmd(),
 	)
 	return txCmd
 }
+
+// NewChangePubKeyCmd returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func NewChangePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Replace the public key of the sender's account",
+		Long: `Replace the public key of the sender's account with the given bech32 encoded
+account public key, which may be of a different type or a multisig key. The
+account keeps its address, balances and delegations, but all subsequent
+transactions must be signed with the new key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangePubKey(clientCtx.GetFromAddress(), pubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -39,6 +39,12 @@ $ %s multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 If the flag --signature-only flag is on, it outputs a JSON representation
 of the generated signature only.
 
+A multisig key may be a member of another multisig key. To sign for such a nested
+key, run multisign for it with the --signature-only, --offline, --account-number
+and --sequence flags, using the account number and sequence of the outermost
+multisig account, and pass the output as one of the [signature] files when
+running multisign for the outer key.
+
 The --offline flag makes sure that the client will not reach out to an external node.
 Thus account number or sequence number lookups will not be performed and it is
 recommended to set such parameters manually.
@@ -98,8 +104,8 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("%q must be of type %s: %s", args[1], keyring.TypeMulti, multisigInfo.GetType())
 		}
 
-		multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub := multisigInfo.GetPubKey().(multisig.PubKey)
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := types.NewAccountRetriever(clientCtx.JSONMarshaler).GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
 			if err != nil {
@@ -128,7 +134,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) error {
 					return fmt.Errorf("couldn't verify signature")
 				}
 
-				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.PubKeys); err != nil {
+				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,57 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestHandleMsgChangePubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := auth.NewHandler(app.AccountKeeper)
+
+	_, pub, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(pub))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	accNum := acc.GetAccountNumber()
+
+	// replace a secp256k1 key with an ed25519 key
+	newPub := ed25519.GenPrivKey().PubKey()
+	res, err := handler(ctx, types.NewMsgChangePubKey(addr, newPub))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	require.Equal(t, newPub, acc.GetPubKey())
+	require.Equal(t, accNum, acc.GetAccountNumber())
+	require.NoError(t, acc.(types.GenesisAccount).Validate())
+
+	// replace it again with a multisig key
+	_, pub1, _ := testdata.KeyTestPubAddr()
+	_, pub2, _ := testdata.KeyTestPubAddr()
+	multisigPub := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{pub1, pub2})
+	_, err = handler(ctx, types.NewMsgChangePubKey(addr, multisigPub))
+	require.NoError(t, err)
+	require.Equal(t, multisigPub, app.AccountKeeper.GetAccount(ctx, addr).GetPubKey())
+
+	// the account must exist
+	_, _, unknown := testdata.KeyTestPubAddr()
+	_, err = handler(ctx, types.NewMsgChangePubKey(unknown, newPub))
+	require.Error(t, err)
+
+	// module accounts cannot have a public key
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	_, err = handler(ctx, types.NewMsgChangePubKey(feeCollector.GetAddress(), newPub))
+	require.Error(t, err)
+}
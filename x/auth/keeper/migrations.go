@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MigrateParams sets the params added since the previous release, which don't
+// exist in the params store of the chains created before it. It is meant to be
+// called once from an x/upgrade handler.
+func (ak AccountKeeper) MigrateParams(ctx sdk.Context) {
+	if !ak.paramSubspace.Has(ctx, types.KeySigVerifyCostSecp256r1) {
+		ak.paramSubspace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+	}
+}
@@ -88,6 +88,7 @@ func TestVerifySignature(t *testing.T) {
 	err = multisig.AddSignatureFromPubKey(multisignature, sig2V2.Data, pkSet[1], pkSet)
 	require.NoError(t, err)
 
+	stdTx = types.NewStdTx(msgs, fee, []types.StdSignature{stdSig1, stdSig2}, memo)
 	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
 }
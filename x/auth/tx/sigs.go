@@ -71,6 +71,10 @@ func ModeInfoAndSigToSignatureData(modeInfo *tx.ModeInfo, sig []byte) (signing.S
 			return nil, err
 		}
 
+		if len(sigs) != len(multi.ModeInfos) {
+			return nil, fmt.Errorf("expected %d signatures, got %d", len(multi.ModeInfos), len(sigs))
+		}
+
 		sigv2s := make([]signing.SignatureData, len(sigs))
 		for i, mi := range multi.ModeInfos {
 			sigv2s[i], err = ModeInfoAndSigToSignatureData(mi, sigs[i])
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestDecodeMultisignatures(t *testing.T) {
@@ -38,3 +40,27 @@ func TestDecodeMultisignatures(t *testing.T) {
 
 	require.Equal(t, testSigs, decodedSigs)
 }
+
+func TestModeInfoAndSigToSignatureDataMultiMismatch(t *testing.T) {
+	bitArray := types.NewCompactBitArray(3)
+	bitArray.SetIndex(0, true)
+	bitArray.SetIndex(2, true)
+	data := &signing.MultiSignatureData{
+		BitArray: bitArray,
+		Signatures: []signing.SignatureData{
+			&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("dummy1")},
+			&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("dummy2")},
+		},
+	}
+
+	modeInfo, sig := SignatureDataToModeInfoAndSig(data)
+	decoded, err := ModeInfoAndSigToSignatureData(modeInfo, sig)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	// more mode infos than signatures
+	multi := modeInfo.Sum.(*tx.ModeInfo_Multi_).Multi
+	multi.ModeInfos = append(multi.ModeInfos, multi.ModeInfos[0])
+	_, err = ModeInfoAndSigToSignatureData(modeInfo, sig)
+	require.Error(t, err)
+}
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +10,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var (
@@ -103,11 +103,15 @@ func (acc *BaseAccount) SetSequence(seq uint64) error {
 	return nil
 }
 
-// Validate checks for errors on the account fields
+// Validate checks for errors on the account fields. The public key is not
+// required to match the account address, as it may have been replaced with a
+// MsgChangePubKey.
 func (acc BaseAccount) Validate() error {
-	if len(acc.PubKey) != 0 && acc.Address != nil &&
-		!bytes.Equal(acc.GetPubKey().Address().Bytes(), acc.Address.Bytes()) {
-		return errors.New("account address and pubkey address do not match")
+	if len(acc.PubKey) != 0 {
+		var pk crypto.PubKey
+		if err := amino.UnmarshalBinaryBare(acc.PubKey, &pk); err != nil {
+			return fmt.Errorf("invalid account pubkey: %w", err)
+		}
 	}
 
 	return nil
@@ -155,6 +159,13 @@ func NewModuleAddress(name string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
 }
 
+// NewModuleDerivedAddress creates a 32 byte AccAddress for an account owned by
+// a module, derived from the module's name and a derivation key. Unlike
+// NewModuleAddress, a module can derive any number of distinct addresses.
+func NewModuleDerivedAddress(name string, key []byte) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(name, key))
+}
+
 // NewEmptyModuleAccount creates a empty ModuleAccount from a string
 func NewEmptyModuleAccount(name string, permissions ...string) *ModuleAccount {
 	moduleAddress := NewModuleAddress(name)
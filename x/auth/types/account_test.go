@@ -97,8 +97,13 @@ func TestGenesisAccountValidate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid base valid account",
+			"valid base account with replaced pubkey",
 			types.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
+			false,
+		},
+		{
+			"invalid base account pubkey",
+			&types.BaseAccount{Address: addr, PubKey: []byte{0x01}},
 			true,
 		},
 	}
@@ -207,3 +212,14 @@ func TestGenesisAccountsContains(t *testing.T) {
 	genAccounts = append(genAccounts, acc)
 	require.True(t, genAccounts.Contains(acc.GetAddress()))
 }
+
+func TestNewModuleDerivedAddress(t *testing.T) {
+	addr := types.NewModuleDerivedAddress("module", []byte("key"))
+	require.Len(t, addr, 32)
+	require.NoError(t, sdk.VerifyAddressFormat(addr))
+	require.NotEqual(t, addr, types.NewModuleDerivedAddress("module", []byte("key2")))
+	require.NotEqual(t, types.NewModuleAddress("module"), addr)
+
+	acc := types.NewBaseAccountWithAddress(addr)
+	require.NoError(t, acc.Validate())
+}
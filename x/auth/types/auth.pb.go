@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -113,6 +114,7 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,57 +184,108 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+// MsgChangePubKey defines a message for replacing the public key of an account.
+// The account keeps its address, but all subsequent transactions must be signed
+// by the new key.
+type MsgChangePubKey struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	PubKey  []byte                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key,omitempty" yaml:"public_key"`
+}
+
+func (m *MsgChangePubKey) Reset()         { *m = MsgChangePubKey{} }
+func (m *MsgChangePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePubKey) ProtoMessage()    {}
+func (*MsgChangePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec2401f40a84da7e, []int{3}
+}
+func (m *MsgChangePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePubKey.Merge(m, src)
+}
+func (m *MsgChangePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangePubKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.Params")
+	proto.RegisterType((*MsgChangePubKey)(nil), "cosmos.auth.MsgChangePubKey")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/auth.proto", fileDescriptor_ec2401f40a84da7e) }
 
 var fileDescriptor_ec2401f40a84da7e = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x49, 0x7e, 0xfc, 0xd9, 0x00, 0x12, 0x26, 0x80, 0xc9, 0xaf, 0xf2, 0x46, 0x3e, 0x51,
-	0xa9, 0x09, 0x82, 0x8a, 0x4a, 0xe4, 0x50, 0x15, 0xd3, 0x56, 0x42, 0x14, 0x84, 0x16, 0xa9, 0xaa,
-	0x7a, 0xb1, 0xd6, 0xce, 0x36, 0x58, 0x64, 0xb3, 0xc6, 0xbb, 0xae, 0x62, 0x3e, 0x41, 0x8f, 0x3d,
-	0x55, 0x3d, 0xf2, 0x21, 0x7a, 0xeb, 0x17, 0xe8, 0x11, 0xf5, 0xd4, 0x93, 0x5b, 0x85, 0x4b, 0xd5,
-	0xa3, 0x8f, 0x3d, 0x55, 0xde, 0x35, 0xc1, 0x41, 0xb4, 0x97, 0x64, 0xe7, 0xcd, 0xbc, 0x37, 0xe3,
-	0x37, 0xda, 0x05, 0xcb, 0x1e, 0xe3, 0x94, 0xf1, 0x75, 0x1c, 0x89, 0x13, 0xf9, 0xd3, 0x0a, 0x42,
-	0x26, 0x98, 0x5e, 0x55, 0x78, 0x2b, 0x83, 0xea, 0xab, 0x2a, 0x70, 0x64, 0x6a, 0x3d, 0xcf, 0xc8,
-	0xa0, 0x5e, 0xeb, 0xb2, 0x2e, 0x53, 0x78, 0x76, 0x52, 0xa8, 0xf5, 0x61, 0x02, 0x54, 0x6d, 0xcc,
-	0xc9, 0x8e, 0xe7, 0xb1, 0xa8, 0x2f, 0xf4, 0x7d, 0x30, 0x85, 0x3b, 0x9d, 0x90, 0x70, 0x6e, 0x68,
-	0x0d, 0x6d, 0x6d, 0xd6, 0xde, 0xf8, 0x9d, 0xc0, 0x66, 0xd7, 0x17, 0x27, 0x91, 0xdb, 0xf2, 0x18,
-	0xcd, 0x35, 0xf3, 0xbf, 0x26, 0xef, 0x9c, 0xae, 0x8b, 0x38, 0x20, 0xbc, 0xb5, 0xe3, 0x79, 0x3b,
-	0x8a, 0x88, 0xae, 0x15, 0xf4, 0xe7, 0x60, 0x2a, 0x88, 0x5c, 0xe7, 0x94, 0xc4, 0xc6, 0x84, 0x14,
-	0x6b, 0xfe, 0x4a, 0x60, 0x2d, 0x88, 0xdc, 0x9e, 0xef, 0x65, 0xe8, 0x03, 0x46, 0x7d, 0x41, 0x68,
-	0x20, 0xe2, 0x34, 0x81, 0x0b, 0x31, 0xa6, 0xbd, 0xb6, 0x75, 0x93, 0xb5, 0xd0, 0x64, 0x10, 0xb9,
-	0xfb, 0x24, 0xd6, 0x9f, 0x80, 0x79, 0xac, 0xe6, 0x73, 0xfa, 0x11, 0x75, 0x49, 0x68, 0x94, 0x1b,
-	0xda, 0x5a, 0xc5, 0x5e, 0x4d, 0x13, 0xb8, 0xa4, 0x68, 0xe3, 0x79, 0x0b, 0xcd, 0xe5, 0xc0, 0xa1,
-	0x8c, 0xf5, 0x3a, 0x98, 0xe6, 0xe4, 0x2c, 0x22, 0x7d, 0x8f, 0x18, 0x95, 0x8c, 0x8b, 0x46, 0x71,
-	0xbb, 0xf6, 0xee, 0x02, 0x96, 0x3e, 0x5e, 0xc0, 0xd2, 0xd7, 0x4f, 0xcd, 0xe9, 0xdc, 0x87, 0x3d,
-	0xeb, 0xb3, 0x06, 0xe6, 0x0e, 0x58, 0x27, 0xea, 0x8d, 0xac, 0x79, 0x05, 0x66, 0x5d, 0xcc, 0x89,
-	0x93, 0x2b, 0x4b, 0x7f, 0xaa, 0x9b, 0x46, 0xab, 0xe0, 0x7f, 0xab, 0x60, 0xa5, 0xfd, 0xff, 0x65,
-	0x02, 0xb5, 0x34, 0x81, 0x8b, 0x6a, 0xc2, 0x22, 0xd7, 0x42, 0x55, 0xb7, 0x60, 0xba, 0x0e, 0x2a,
-	0x7d, 0x4c, 0x89, 0x34, 0x69, 0x06, 0xc9, 0xb3, 0xde, 0x00, 0xd5, 0x80, 0x84, 0xd4, 0xe7, 0xdc,
-	0x67, 0x7d, 0x6e, 0x94, 0x1b, 0xe5, 0xb5, 0x19, 0x54, 0x84, 0xda, 0xf5, 0xc2, 0xdc, 0xf3, 0x63,
-	0xa3, 0xee, 0x59, 0xdf, 0xcb, 0x60, 0xf2, 0x08, 0x87, 0x98, 0x72, 0xfd, 0x10, 0x2c, 0x52, 0x3c,
-	0x70, 0x28, 0xa1, 0xcc, 0xf1, 0x4e, 0x70, 0x88, 0x3d, 0x41, 0x42, 0xb5, 0xdd, 0x8a, 0x6d, 0xa6,
-	0x09, 0xac, 0xab, 0xf9, 0xee, 0x28, 0xb2, 0xd0, 0x02, 0xc5, 0x83, 0x03, 0x42, 0xd9, 0xee, 0x08,
-	0xd3, 0xb7, 0xc1, 0xac, 0x18, 0x38, 0xdc, 0xef, 0x3a, 0x3d, 0x9f, 0xfa, 0x42, 0x0e, 0x5d, 0xb1,
-	0x57, 0x6e, 0x3e, 0xb4, 0x98, 0xb5, 0x10, 0x10, 0x83, 0x63, 0xbf, 0xfb, 0x22, 0x0b, 0x74, 0x04,
-	0x96, 0x64, 0xf2, 0x9c, 0x38, 0x1e, 0xe3, 0xc2, 0x09, 0x48, 0xe8, 0xb8, 0xb1, 0x20, 0xf9, 0x3a,
-	0x1b, 0x69, 0x02, 0xef, 0x15, 0x34, 0x6e, 0x97, 0x59, 0x68, 0x21, 0x13, 0x3b, 0x27, 0xbb, 0x8c,
-	0x8b, 0x23, 0x12, 0xda, 0xb1, 0x20, 0xfa, 0x19, 0x58, 0xc9, 0xba, 0xbd, 0x25, 0xa1, 0xff, 0x26,
-	0x56, 0xf5, 0xa4, 0xb3, 0xb9, 0xb5, 0xb5, 0xb1, 0xad, 0x16, 0x6d, 0xb7, 0x87, 0x09, 0xac, 0x1d,
-	0xfb, 0xdd, 0x97, 0xb2, 0x22, 0xa3, 0x3e, 0x7b, 0x2a, 0xf3, 0x69, 0x02, 0x4d, 0xd5, 0xed, 0x2f,
-	0x02, 0x16, 0xaa, 0xf1, 0x31, 0x9e, 0x82, 0xf5, 0x18, 0xac, 0xde, 0x66, 0x70, 0xe2, 0x05, 0x9b,
-	0x5b, 0x8f, 0x4e, 0x37, 0x8c, 0xff, 0x64, 0xd3, 0xc7, 0xc3, 0x04, 0x2e, 0x8f, 0x35, 0x3d, 0xbe,
-	0xae, 0x48, 0x13, 0xd8, 0xb8, 0xbb, 0xed, 0x48, 0xc4, 0x42, 0xcb, 0xfc, 0x4e, 0x6e, 0x7b, 0x3a,
-	0xdb, 0xf7, 0xcf, 0x0b, 0xa8, 0xd9, 0xbb, 0x5f, 0x86, 0xa6, 0x76, 0x39, 0x34, 0xb5, 0x1f, 0x43,
-	0x53, 0x7b, 0x7f, 0x65, 0x96, 0x2e, 0xaf, 0xcc, 0xd2, 0xb7, 0x2b, 0xb3, 0xf4, 0xfa, 0xfe, 0x3f,
-	0x6f, 0xeb, 0x40, 0x3d, 0x20, 0xf2, 0xd2, 0xba, 0x93, 0xf2, 0x11, 0x78, 0xf8, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x10, 0x68, 0x06, 0x78, 0x5c, 0x04, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x4f, 0xdb, 0x40,
+	0x14, 0x8f, 0x21, 0x0d, 0xe1, 0x02, 0x54, 0x98, 0x00, 0x26, 0xad, 0x7c, 0x91, 0x27, 0x2a, 0x35,
+	0x41, 0xa1, 0xa2, 0x12, 0x19, 0xaa, 0xe2, 0xb4, 0x95, 0x10, 0x05, 0x21, 0x23, 0x55, 0x55, 0x17,
+	0xcb, 0x76, 0xae, 0x8e, 0x45, 0x2e, 0x67, 0x7c, 0xe7, 0x2a, 0xe6, 0x13, 0x30, 0x76, 0xaa, 0x3a,
+	0xf2, 0x11, 0x3a, 0x74, 0xeb, 0x17, 0xe8, 0x88, 0x3a, 0x55, 0x1d, 0xac, 0x2a, 0x2c, 0x55, 0xc7,
+	0x8c, 0x9d, 0x2a, 0xdf, 0x99, 0xe0, 0x20, 0xa0, 0x6b, 0x97, 0xc4, 0xef, 0xf7, 0xde, 0xef, 0x4f,
+	0xde, 0xc5, 0x07, 0x96, 0x1c, 0x42, 0x31, 0xa1, 0x6b, 0x56, 0xc8, 0x3a, 0xfc, 0xa3, 0xee, 0x07,
+	0x84, 0x11, 0xb9, 0x24, 0xf0, 0x7a, 0x02, 0x55, 0x56, 0x44, 0x61, 0xf2, 0xd6, 0x5a, 0xda, 0xe1,
+	0x45, 0xa5, 0xec, 0x12, 0x97, 0x08, 0x3c, 0x79, 0x12, 0xa8, 0xf6, 0x61, 0x02, 0x94, 0x74, 0x8b,
+	0xa2, 0x2d, 0xc7, 0x21, 0x61, 0x8f, 0xc9, 0x3b, 0x60, 0xca, 0x6a, 0xb7, 0x03, 0x44, 0xa9, 0x22,
+	0x55, 0xa5, 0xd5, 0x19, 0xbd, 0xf1, 0x27, 0x86, 0x35, 0xd7, 0x63, 0x9d, 0xd0, 0xae, 0x3b, 0x04,
+	0xa7, 0x9a, 0xe9, 0x57, 0x8d, 0xb6, 0x0f, 0xd7, 0x58, 0xe4, 0x23, 0x5a, 0xdf, 0x72, 0x9c, 0x2d,
+	0x41, 0x34, 0x2e, 0x14, 0xe4, 0x17, 0x60, 0xca, 0x0f, 0x6d, 0xf3, 0x10, 0x45, 0xca, 0x04, 0x17,
+	0xab, 0xfd, 0x8e, 0x61, 0xd9, 0x0f, 0xed, 0xae, 0xe7, 0x24, 0xe8, 0x43, 0x82, 0x3d, 0x86, 0xb0,
+	0xcf, 0xa2, 0x61, 0x0c, 0xe7, 0x23, 0x0b, 0x77, 0x9b, 0xda, 0x65, 0x57, 0x33, 0x0a, 0x7e, 0x68,
+	0xef, 0xa0, 0x48, 0x7e, 0x0a, 0xe6, 0x2c, 0x91, 0xcf, 0xec, 0x85, 0xd8, 0x46, 0x81, 0x32, 0x59,
+	0x95, 0x56, 0xf3, 0xfa, 0xca, 0x30, 0x86, 0x8b, 0x82, 0x36, 0xde, 0xd7, 0x8c, 0xd9, 0x14, 0xd8,
+	0xe3, 0xb5, 0x5c, 0x01, 0x45, 0x8a, 0x8e, 0x42, 0xd4, 0x73, 0x90, 0x92, 0x4f, 0xb8, 0xc6, 0xa8,
+	0x6e, 0x96, 0x4f, 0x4e, 0x61, 0xee, 0xe3, 0x29, 0xcc, 0x7d, 0xfb, 0x5c, 0x2b, 0xa6, 0x7b, 0xd8,
+	0xd6, 0xbe, 0x48, 0x60, 0x76, 0x97, 0xb4, 0xc3, 0xee, 0x68, 0x35, 0xaf, 0xc1, 0x8c, 0x6d, 0x51,
+	0x64, 0xa6, 0xca, 0x7c, 0x3f, 0xa5, 0x75, 0xa5, 0x9e, 0xd9, 0x7f, 0x3d, 0xb3, 0x4a, 0xfd, 0xde,
+	0x59, 0x0c, 0xa5, 0x61, 0x0c, 0x17, 0x44, 0xc2, 0x2c, 0x57, 0x33, 0x4a, 0x76, 0x66, 0xe9, 0x32,
+	0xc8, 0xf7, 0x2c, 0x8c, 0xf8, 0x92, 0xa6, 0x0d, 0xfe, 0x2c, 0x57, 0x41, 0xc9, 0x47, 0x01, 0xf6,
+	0x28, 0xf5, 0x48, 0x8f, 0x2a, 0x93, 0xd5, 0xc9, 0xd5, 0x69, 0x23, 0x0b, 0x35, 0x2b, 0x99, 0xdc,
+	0x73, 0x63, 0x51, 0xb7, 0xb5, 0x1f, 0x79, 0x50, 0xd8, 0xb7, 0x02, 0x0b, 0x53, 0x79, 0x0f, 0x2c,
+	0x60, 0xab, 0x6f, 0x62, 0x84, 0x89, 0xe9, 0x74, 0xac, 0xc0, 0x72, 0x18, 0x0a, 0xc4, 0xe9, 0xe6,
+	0x75, 0x75, 0x18, 0xc3, 0x8a, 0xc8, 0x77, 0xcd, 0x90, 0x66, 0xcc, 0x63, 0xab, 0xbf, 0x8b, 0x30,
+	0x69, 0x8d, 0x30, 0x79, 0x13, 0xcc, 0xb0, 0xbe, 0x49, 0x3d, 0xd7, 0xec, 0x7a, 0xd8, 0x63, 0x3c,
+	0x74, 0x5e, 0x5f, 0xbe, 0xfc, 0xa1, 0xd9, 0xae, 0x66, 0x00, 0xd6, 0x3f, 0xf0, 0xdc, 0x97, 0x49,
+	0x21, 0x1b, 0x60, 0x91, 0x37, 0x8f, 0x91, 0xe9, 0x10, 0xca, 0x4c, 0x1f, 0x05, 0xa6, 0x1d, 0x31,
+	0x94, 0x1e, 0x67, 0x75, 0x18, 0xc3, 0xfb, 0x19, 0x8d, 0xab, 0x63, 0x9a, 0x31, 0x9f, 0x88, 0x1d,
+	0xa3, 0x16, 0xa1, 0x6c, 0x1f, 0x05, 0x7a, 0xc4, 0x90, 0x7c, 0x04, 0x96, 0x13, 0xb7, 0x77, 0x28,
+	0xf0, 0xde, 0x46, 0x62, 0x1e, 0xb5, 0xd7, 0x37, 0x36, 0x1a, 0x9b, 0xe2, 0xa0, 0xf5, 0xe6, 0x20,
+	0x86, 0xe5, 0x03, 0xcf, 0x7d, 0xc5, 0x27, 0x12, 0xea, 0xf3, 0x67, 0xbc, 0x3f, 0x8c, 0xa1, 0x2a,
+	0xdc, 0x6e, 0x10, 0xd0, 0x8c, 0x32, 0x1d, 0xe3, 0x09, 0x58, 0x8e, 0xc0, 0xca, 0x55, 0x06, 0x45,
+	0x8e, 0xbf, 0xbe, 0xf1, 0xf8, 0xb0, 0xa1, 0xdc, 0xe1, 0xa6, 0x4f, 0x06, 0x31, 0x5c, 0x1a, 0x33,
+	0x3d, 0xb8, 0x98, 0x18, 0xc6, 0xb0, 0x7a, 0xbd, 0xed, 0x48, 0x44, 0x33, 0x96, 0xe8, 0xb5, 0xdc,
+	0x5b, 0xac, 0x83, 0x86, 0x52, 0xb8, 0xdd, 0x3a, 0xf8, 0xb7, 0x75, 0x70, 0x93, 0x75, 0xd0, 0x68,
+	0x16, 0x93, 0xbf, 0xda, 0xaf, 0x53, 0x28, 0x69, 0x9f, 0x24, 0x70, 0x77, 0x97, 0xba, 0xad, 0x8e,
+	0xd5, 0x73, 0xd1, 0xbe, 0x78, 0x45, 0xff, 0xc7, 0x7b, 0xa3, 0x59, 0x3c, 0x49, 0x23, 0xeb, 0xad,
+	0xaf, 0x03, 0x55, 0x3a, 0x1b, 0xa8, 0xd2, 0xcf, 0x81, 0x2a, 0xbd, 0x3f, 0x57, 0x73, 0x67, 0xe7,
+	0x6a, 0xee, 0xfb, 0xb9, 0x9a, 0x7b, 0xf3, 0xe0, 0xd6, 0x8c, 0x7d, 0x71, 0xdd, 0xf2, 0xa8, 0x76,
+	0x81, 0x5f, 0x99, 0x8f, 0xfe, 0x0e, 0x00, 0xf1, 0x0c, 0x5a, 0x88, 0x8a, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +322,36 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	return true
+}
+func (this *MsgChangePubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgChangePubKey)
+	if !ok {
+		that2, ok := that.(MsgChangePubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -389,6 +472,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -417,6 +505,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgChangePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -495,6 +620,26 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256r1))
+	}
+	return n
+}
+
+func (m *MsgChangePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -940,6 +1085,146 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
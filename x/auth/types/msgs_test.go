@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	_, pub, addr := testdata.KeyTestPubAddr()
+
+	cases := []struct {
+		name      string
+		msg       *types.MsgChangePubKey
+		expectErr bool
+	}{
+		{"valid", types.NewMsgChangePubKey(addr, pub), false},
+		{"missing address", types.NewMsgChangePubKey(nil, pub), true},
+		{"missing pubkey", types.NewMsgChangePubKey(addr, nil), true},
+		{"invalid pubkey", &types.MsgChangePubKey{Address: addr, PubKey: []byte{0x01}}, true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	msg := types.NewMsgChangePubKey(addr, pub)
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgChangePubKey, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+
+	pk, err := msg.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pub, pk)
+}
@@ -116,13 +116,13 @@ func (ss StdSignature) MarshalYAML() (interface{}, error) {
 
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub crypto.PubKey) int {
-	v, ok := pub.(multisig.PubKeyMultisigThreshold)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
 
 	numKeys := 0
-	for _, subkey := range v.PubKeys {
+	for _, subkey := range v.GetPubKeys() {
 		numKeys += CountSubKeys(subkey)
 	}
 
@@ -428,13 +428,16 @@ func pubKeySigToSigData(cdc *codec.Codec, key crypto.PubKey, sig []byte) (signin
 	sigIdx := 0
 	for i := 0; i < n; i++ {
 		if bitArray.GetIndex(i) {
+			if i >= len(pubKeys) || sigIdx >= len(sigs) {
+				return nil, fmt.Errorf("multisignature does not match the public key")
+			}
 			data, err := pubKeySigToSigData(cdc, pubKeys[i], multiSig.Sigs[sigIdx])
 			if err != nil {
 				return nil, sdkerrors.Wrapf(err, "Unable to convert Signature to SigData %d", sigIdx)
 			}
 
 			sigDatas[sigIdx] = data
-			multisig.AddSignature(signatures, data, sigIdx)
+			multisig.AddSignature(signatures, data, i)
 			sigIdx++
 		}
 	}
@@ -253,6 +253,30 @@ func TestSignatureV2Conversions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, multiPK, sigV2.PubKey)
 	require.Equal(t, msigData, sigV2.Data)
+
+	// nested weighted multisig where only the last key signed
+	_, pubKey3, _ := testdata.KeyTestPubAddr()
+	weightedPK, err := multisig.NewPubKeyMultisigWeighted(2, []crypto.PubKey{
+		pubKey3, multiPK,
+	}, []uint{1, 2})
+	require.NoError(t, err)
+	bitArray = types.NewCompactBitArray(2)
+	bitArray.SetIndex(1, true)
+	weightedData := &signing.MultiSignatureData{
+		BitArray:   bitArray,
+		Signatures: []signing.SignatureData{msigData},
+	}
+
+	wsig, err := SignatureDataToAminoSignature(cdc, weightedData)
+	require.NoError(t, err)
+
+	sigV2, err = StdSignatureToSignatureV2(cdc, StdSignature{
+		PubKey:    weightedPK,
+		Signature: wsig,
+	})
+	require.NoError(t, err)
+	require.Equal(t, weightedPK, sigV2.PubKey)
+	require.Equal(t, weightedData, sigV2.Data)
 }
 
 func TestGetSignaturesV2(t *testing.T) {
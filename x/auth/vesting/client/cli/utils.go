@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type (
+	// VestingPeriodJSON defines a single vesting period in a vesting schedule
+	// file.
+	VestingPeriodJSON struct {
+		Coins  string `json:"coins" yaml:"coins"`
+		Length int64  `json:"length_seconds" yaml:"length_seconds"`
+	}
+
+	// VestingScheduleJSON defines the start time and vesting periods of a
+	// clawback vesting account.
+	VestingScheduleJSON struct {
+		StartTime int64               `json:"start_time" yaml:"start_time"`
+		Periods   []VestingPeriodJSON `json:"periods" yaml:"periods"`
+	}
+)
+
+// ParseVestingScheduleJSON reads and parses a VestingScheduleJSON from a file
+// and returns its start time along with the parsed vesting periods.
+func ParseVestingScheduleJSON(scheduleFile string) (int64, types.Periods, error) {
+	var schedule VestingScheduleJSON
+
+	contents, err := ioutil.ReadFile(scheduleFile)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &schedule); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make(types.Periods, len(schedule.Periods))
+	for i, p := range schedule.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return schedule.StartTime, periods, nil
+}
@@ -0,0 +1,191 @@
+package vesting
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewHandler returns a handler for x/auth/vesting type messages.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgCreateClawbackVestingAccount:
+			return handleMsgCreateClawbackVestingAccount(ctx, ak, bk, msg)
+
+		case *types.MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, sk, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCreateClawbackVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg *types.MsgCreateClawbackVestingAccount,
+) (*sdk.Result, error) {
+	amount := msg.TotalAmount()
+
+	if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(msg.ToAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, msg.ToAddress); acc != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountExists, "%s", msg.ToAddress)
+	}
+
+	baseAccount, ok := ak.NewAccountWithAddress(ctx, msg.ToAddress).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount")
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, msg.FromAddress, amount, msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, acc)
+
+	defer telemetry.IncrCounter(1, "new", "account")
+
+	if err := bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateClawbackVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgClawback returns all unvested coins of a clawback vesting account to
+// the funder (or the requested destination). Unvested coins are recovered from
+// the account's balance first, then from its unbonding delegations and finally
+// from its bonded delegations, which are transferred to the destination rather
+// than unbonded.
+func handleMsgClawback(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, msg *types.MsgClawback,
+) (*sdk.Result, error) {
+	acc := ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotClawbackAccount, "%s", msg.Address)
+	}
+
+	if !va.GetFunder().Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFunder, "%s", va.GetFunder())
+	}
+
+	dest := msg.GetDestination()
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	// Delegations transferred to the destination are not tracked as vesting, so
+	// a vesting account cannot receive them.
+	if destAcc := ak.GetAccount(ctx, dest); destAcc != nil {
+		if _, isVesting := destAcc.(exported.VestingAccount); isVesting {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "clawback destination %s cannot be a vesting account", dest)
+		}
+	}
+
+	// truncate the vesting schedule, nothing is locked from here on
+	unvested := va.ComputeClawback(ctx.BlockTime())
+	ak.SetAccount(ctx, va)
+
+	toXfer := coinsMin(unvested, bk.SpendableCoins(ctx, msg.Address))
+	if !toXfer.IsZero() {
+		if err := bk.SendCoins(ctx, msg.Address, dest, toXfer); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom := sk.BondDenom(ctx)
+	want := unvested.Sub(toXfer).AmountOf(bondDenom)
+	staked := sdk.ZeroInt()
+
+	// unbonding tokens remain tracked as delegated until the unbonding completes
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, msg.Address, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		transferred := sk.TransferUnbonding(ctx, msg.Address, dest, ubd.ValidatorAddress, want)
+		staked = staked.Add(transferred)
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, msg.Address, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		transferred := sk.TransferDelegation(ctx, msg.Address, dest, delegation.ValidatorAddress, want)
+		staked = staked.Add(transferred)
+		want = want.Sub(transferred)
+	}
+
+	if staked.IsPositive() {
+		stakedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, staked))
+
+		// the account is reloaded as the transfers may withdraw rewards to it
+		va = ak.GetAccount(ctx, msg.Address).(*types.ClawbackVestingAccount)
+		va.TrackUndelegation(stakedCoins)
+		ak.SetAccount(ctx, va)
+
+		toXfer = toXfer.Add(stakedCoins...)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, toXfer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// coinsMin returns the minimum of each denomination present in a.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amt := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return min
+}
@@ -0,0 +1,174 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	handler sdk.Handler
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	now     time.Time
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.now = time.Unix(1600000000, 0).UTC()
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: suite.now})
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	suite.addrs = simapp.AddTestAddrs(app, suite.ctx, 3, sdk.NewInt(100000000))
+}
+
+func (suite *HandlerTestSuite) periods() types.Periods {
+	return types.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))},
+	}
+}
+
+func (suite *HandlerTestSuite) balance(addr sdk.AccAddress) sdk.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	funder := suite.addrs[0]
+	addr := sdk.AccAddress([]byte("clawback-account----"))
+	funderBalance := suite.balance(funder)
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, suite.now.Unix(), suite.periods())
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(funder, acc.GetFunder())
+	suite.Require().Equal(suite.now.Add(3*time.Hour).Unix(), acc.GetEndTime())
+	suite.Require().Equal(sdk.NewInt(3000), suite.balance(addr))
+	suite.Require().Equal(funderBalance.SubRaw(3000), suite.balance(funder))
+	suite.Require().NoError(acc.Validate())
+
+	// the account now exists and cannot be created again
+	_, err = suite.handler(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	// an existing non-vesting account cannot be turned into a vesting account
+	msg = types.NewMsgCreateClawbackVestingAccount(funder, suite.addrs[1], suite.now.Unix(), suite.periods())
+	_, err = suite.handler(suite.ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	funder, other := suite.addrs[0], suite.addrs[1]
+	addr := sdk.AccAddress([]byte("clawback-account----"))
+
+	_, err := suite.handler(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, suite.now.Unix(), suite.periods()))
+	suite.Require().NoError(err)
+
+	// only the funder may claw back
+	_, err = suite.handler(suite.ctx, types.NewMsgClawback(other, addr, nil))
+	suite.Require().Error(err)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = suite.handler(suite.ctx, types.NewMsgClawback(funder, other, nil))
+	suite.Require().Error(err)
+
+	// claw back after the first period has vested
+	suite.ctx = suite.ctx.WithBlockTime(suite.now.Add(90 * time.Minute))
+	otherBalance := suite.balance(other)
+
+	_, err = suite.handler(suite.ctx, types.NewMsgClawback(funder, addr, other))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.balance(addr))
+	suite.Require().Equal(otherBalance.AddRaw(2000), suite.balance(other))
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), acc.GetOriginalVesting())
+	suite.Require().True(acc.LockedCoins(suite.ctx.BlockTime()).IsZero())
+	suite.Require().Len(acc.GetVestingPeriods(), 1)
+	suite.Require().NoError(acc.Validate())
+
+	// a second clawback has nothing left to recover
+	_, err = suite.handler(suite.ctx, types.NewMsgClawback(funder, addr, other))
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherBalance.AddRaw(2000), suite.balance(other))
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackDelegated() {
+	funder, valOperator := suite.addrs[0], suite.addrs[2]
+	addr := sdk.AccAddress([]byte("clawback-account----"))
+	valAddr := sdk.ValAddress(valOperator)
+	stakingHandler := staking.NewHandler(suite.app.StakingKeeper)
+
+	_, err := stakingHandler(suite.ctx, stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000),
+		stakingtypes.Description{}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	suite.Require().NoError(err)
+
+	_, err = suite.handler(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, suite.now.Unix(), suite.periods()))
+	suite.Require().NoError(err)
+
+	// delegate 2500 of the 3000 vesting tokens and start unbonding 500 of them
+	_, err = stakingHandler(suite.ctx, stakingtypes.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500)))
+	suite.Require().NoError(err)
+	_, err = stakingHandler(suite.ctx, stakingtypes.NewMsgUndelegate(addr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+	suite.Require().NoError(err)
+
+	// claw back after the first period has vested: 500 tokens are recovered
+	// from the balance, 500 from the unbonding delegation and 1000 from the
+	// delegation
+	suite.ctx = suite.ctx.WithBlockTime(suite.now.Add(90 * time.Minute))
+	funderBalance := suite.balance(funder)
+
+	_, err = suite.handler(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(funderBalance.AddRaw(500), suite.balance(funder))
+	suite.Require().True(suite.balance(addr).IsZero())
+
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, funder, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(sdk.NewInt(500), ubd.Entries[0].Balance)
+
+	_, found = suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, addr, valAddr)
+	suite.Require().False(found)
+
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, funder, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	delegation, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(acc.GetDelegatedVesting().IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), acc.GetDelegatedFree())
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
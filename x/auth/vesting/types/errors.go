@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth/vesting module sentinel errors
+var (
+	ErrNotClawbackAccount = sdkerrors.Register(ModuleName, 2, "account is not a clawback vesting account")
+	ErrNotFunder          = sdkerrors.Register(ModuleName, 3, "clawback can only be requested by the funder")
+	ErrAccountExists      = sdkerrors.Register(ModuleName, 4, "account already exists")
+	ErrInvalidPeriods     = sdkerrors.Register(ModuleName, 5, "invalid vesting periods")
+)
@@ -0,0 +1,13 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	EventTypeClawback                     = "clawback"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+
+	AttributeValueCategory = ModuleName
+)
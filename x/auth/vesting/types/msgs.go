@@ -0,0 +1,137 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods,
+) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	if msg.StartTime <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid start time")
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPeriods, "at least one vesting period is required")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(ErrInvalidPeriods, "period #%d has a non-positive length: %d", i, period.Length)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(ErrInvalidPeriods, "period #%d has an invalid amount: %s", i, period.Amount)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// TotalAmount returns the sum of the amounts of all vesting periods, which is
+// the amount the funder transfers to the new account.
+func (msg MsgCreateClawbackVestingAccount) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range msg.VestingPeriods {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty
+// destination returns the unvested coins to the funder.
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress: funder,
+		Address:       addr,
+		DestAddress:   dest,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// GetDestination returns the address that receives the clawed back coins.
+func (msg MsgClawback) GetDestination() sdk.AccAddress {
+	if msg.DestAddress.Empty() {
+		return msg.FunderAddress
+	}
+
+	return msg.DestAddress
+}
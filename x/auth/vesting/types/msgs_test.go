@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateClawbackVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))
+	periods := types.Periods{{Length: 10, Amount: coins}, {Length: 20, Amount: coins}}
+
+	cases := []struct {
+		name      string
+		msg       *types.MsgCreateClawbackVestingAccount
+		expectErr bool
+	}{
+		{"valid", types.NewMsgCreateClawbackVestingAccount(from, to, 1, periods), false},
+		{"missing from", types.NewMsgCreateClawbackVestingAccount(nil, to, 1, periods), true},
+		{"missing to", types.NewMsgCreateClawbackVestingAccount(from, nil, 1, periods), true},
+		{"invalid start time", types.NewMsgCreateClawbackVestingAccount(from, to, 0, periods), true},
+		{"no periods", types.NewMsgCreateClawbackVestingAccount(from, to, 1, nil), true},
+		{"zero length period", types.NewMsgCreateClawbackVestingAccount(from, to, 1, types.Periods{{Length: 0, Amount: coins}}), true},
+		{"empty period amount", types.NewMsgCreateClawbackVestingAccount(from, to, 1, types.Periods{{Length: 10}}), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20)), tc.msg.TotalAmount())
+			require.Equal(t, []sdk.AccAddress{from}, tc.msg.GetSigners())
+			require.NotEmpty(t, tc.msg.GetSignBytes())
+		}
+	}
+}
+
+func TestMsgClawbackValidateBasic(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+
+	cases := []struct {
+		name      string
+		msg       *types.MsgClawback
+		expectErr bool
+	}{
+		{"valid", types.NewMsgClawback(funder, addr, dest), false},
+		{"valid without destination", types.NewMsgClawback(funder, addr, nil), false},
+		{"missing funder", types.NewMsgClawback(nil, addr, dest), true},
+		{"missing address", types.NewMsgClawback(funder, nil, dest), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{funder}, tc.msg.GetSigners())
+		}
+	}
+
+	require.Equal(t, dest, types.NewMsgClawback(funder, addr, dest).GetDestination())
+	require.Equal(t, funder, types.NewMsgClawback(funder, addr, nil).GetDestination())
+}
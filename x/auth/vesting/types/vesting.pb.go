@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
@@ -238,56 +239,344 @@ func (m *PeriodicVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PeriodicVestingAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// periodically like a PeriodicVestingAccount, but the funder of the account
+// may claw back any coins that have not vested yet.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	FunderAddress       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"funder_address,omitempty" yaml:"funder_address"`
+	StartTime           int64                                         `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	VestingPeriods      []Period                                      `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae36726ee12abd18, []int{5}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
+// MsgCreateClawbackVestingAccount defines a message that creates a
+// ClawbackVestingAccount funded by the sender, who becomes its funder.
+type MsgCreateClawbackVestingAccount struct {
+	FromAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"from_address,omitempty" yaml:"from_address"`
+	ToAddress      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to_address,omitempty" yaml:"to_address"`
+	StartTime      int64                                         `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	VestingPeriods []Period                                      `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *MsgCreateClawbackVestingAccount) Reset()         { *m = MsgCreateClawbackVestingAccount{} }
+func (m *MsgCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccount) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae36726ee12abd18, []int{6}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateClawbackVestingAccount) GetFromAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetToAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgClawback defines a message that returns the unvested coins of a
+// ClawbackVestingAccount to its funder, or to an optional destination.
+type MsgClawback struct {
+	FunderAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"funder_address,omitempty" yaml:"funder_address"`
+	Address       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	DestAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"dest_address,omitempty" yaml:"dest_address"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae36726ee12abd18, []int{7}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetDestAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DestAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.ContinuousVestingAccount")
 	proto.RegisterType((*DelayedVestingAccount)(nil), "cosmos.vesting.DelayedVestingAccount")
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.PeriodicVestingAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.ClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "cosmos.vesting.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.MsgClawback")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/vesting.proto", fileDescriptor_ae36726ee12abd18) }
 
 var fileDescriptor_ae36726ee12abd18 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xb1, 0x6e, 0x13, 0x31,
-	0x18, 0xc7, 0xcf, 0x49, 0x08, 0xc5, 0x29, 0x49, 0xeb, 0x36, 0xe1, 0x54, 0xa1, 0xbb, 0xe8, 0xa6,
-	0x2c, 0x5c, 0xa0, 0x30, 0x65, 0xeb, 0x15, 0x21, 0xa1, 0x32, 0xa0, 0x13, 0xea, 0xd0, 0x25, 0x72,
-	0x72, 0xe6, 0x62, 0x91, 0x3b, 0x47, 0x67, 0x07, 0x91, 0x81, 0x4e, 0x48, 0x30, 0xb2, 0x20, 0x31,
-	0x56, 0x8c, 0x3c, 0x00, 0xcf, 0xd0, 0x31, 0x23, 0x53, 0x40, 0xc9, 0x1b, 0xe4, 0x09, 0x50, 0x6c,
-	0x5f, 0x42, 0xae, 0x11, 0x52, 0x19, 0x10, 0x4b, 0x72, 0xfe, 0xec, 0xef, 0xff, 0xfd, 0xfc, 0xf9,
-	0x6f, 0xc3, 0xbb, 0x5d, 0xc6, 0x23, 0xc6, 0x9b, 0xaf, 0x09, 0x17, 0x34, 0x0e, 0xd3, 0x7f, 0x77,
-	0x90, 0x30, 0xc1, 0x50, 0x59, 0xcd, 0xba, 0x3a, 0x7a, 0xb0, 0x1f, 0xb2, 0x90, 0xc9, 0xa9, 0xe6,
-	0xe2, 0x4b, 0xad, 0x3a, 0xd8, 0xd3, 0x1a, 0x7a, 0xb1, 0x0a, 0xd6, 0x74, 0x10, 0x0f, 0x45, 0x4f,
-	0xfe, 0xa8, 0xb8, 0xf3, 0xa5, 0x00, 0x91, 0x87, 0x39, 0x39, 0x55, 0x92, 0x47, 0xdd, 0x2e, 0x1b,
-	0xc6, 0x02, 0x1d, 0xc1, 0xed, 0x0e, 0xe6, 0xa4, 0x8d, 0xd5, 0xd8, 0x04, 0x75, 0xd0, 0x28, 0x1d,
-	0x9a, 0xae, 0xd6, 0x94, 0x02, 0x8b, 0x34, 0xbd, 0xde, 0x2b, 0x8c, 0x27, 0x36, 0xf0, 0x4b, 0x9d,
-	0x55, 0x08, 0xbd, 0x03, 0x70, 0x87, 0x25, 0x34, 0xa4, 0x31, 0xee, 0xb7, 0x35, 0xb1, 0x99, 0xab,
-	0xe7, 0x1b, 0xa5, 0xc3, 0xed, 0x54, 0xe7, 0x98, 0xd1, 0xd8, 0x3b, 0xb9, 0x9c, 0xd8, 0xc6, 0x7c,
-	0x62, 0xdf, 0x19, 0xe1, 0xa8, 0xdf, 0x72, 0xb2, 0x39, 0xce, 0xd7, 0x1f, 0x76, 0x23, 0xa4, 0xa2,
-	0x37, 0xec, 0xb8, 0x5d, 0x16, 0x35, 0xd7, 0x76, 0x77, 0x8f, 0x07, 0xaf, 0x9a, 0x62, 0x34, 0x20,
-	0x4a, 0x8b, 0xfb, 0x95, 0x34, 0x5d, 0x6f, 0x08, 0x9d, 0xc3, 0x72, 0x40, 0xfa, 0x24, 0xc4, 0x82,
-	0x04, 0xed, 0x97, 0x09, 0x21, 0x66, 0x7e, 0x03, 0xc3, 0x53, 0xcd, 0x50, 0x55, 0x0c, 0xeb, 0x19,
-	0xd7, 0x23, 0xb8, 0xbd, 0x4c, 0x7e, 0x92, 0x10, 0x82, 0xde, 0x03, 0xb8, 0xbb, 0x92, 0x4b, 0xfb,
-	0x50, 0xd8, 0xc0, 0xf0, 0x4c, 0x33, 0x98, 0x59, 0x86, 0xbf, 0x6a, 0xc4, 0xce, 0x32, 0x3f, 0xed,
-	0x84, 0x0b, 0xb7, 0x48, 0x1c, 0xb4, 0x05, 0x8d, 0x88, 0x79, 0xa3, 0x0e, 0x1a, 0x79, 0x6f, 0x6f,
-	0x3e, 0xb1, 0x2b, 0xaa, 0x5a, 0x3a, 0xe3, 0xf8, 0x37, 0x49, 0x1c, 0xbc, 0xa0, 0x11, 0x69, 0x6d,
-	0x7d, 0xb8, 0xb0, 0x8d, 0xcf, 0x17, 0xb6, 0xe1, 0x7c, 0x03, 0xd0, 0x3c, 0x66, 0xb1, 0xa0, 0xf1,
-	0x90, 0x0d, 0x79, 0xc6, 0x2a, 0x67, 0x70, 0x5f, 0x5a, 0x45, 0x53, 0x66, 0x2c, 0xe3, 0xb8, 0xeb,
-	0x9e, 0x75, 0xaf, 0x9a, 0x4d, 0x9b, 0x07, 0x75, 0xae, 0xda, 0xf0, 0x11, 0x84, 0x5c, 0xe0, 0x44,
-	0x28, 0xe8, 0x9c, 0x84, 0xae, 0xce, 0x27, 0xf6, 0xae, 0x82, 0x5e, 0xcd, 0x39, 0xfe, 0x2d, 0x39,
-	0xc8, 0x80, 0xbf, 0x85, 0xd5, 0xc7, 0xa4, 0x8f, 0x47, 0xcb, 0x26, 0xfc, 0x03, 0xe8, 0xdf, 0xca,
-	0x9f, 0xc3, 0xe2, 0x73, 0x92, 0x50, 0x16, 0xa0, 0x1a, 0x2c, 0xf6, 0x49, 0x1c, 0x8a, 0x9e, 0xac,
-	0x90, 0xf7, 0xf5, 0x08, 0x9d, 0xc2, 0x22, 0x8e, 0x64, 0xe5, 0x4d, 0x37, 0xe3, 0xfe, 0xc2, 0x11,
-	0xd7, 0x3a, 0x75, 0xad, 0xd6, 0x2a, 0xc8, 0xfa, 0x9f, 0x72, 0xb0, 0xa6, 0x00, 0x68, 0xf7, 0x7f,
-	0x3f, 0x35, 0xd4, 0x86, 0x95, 0x14, 0x66, 0x20, 0x99, 0xb9, 0xbe, 0xa9, 0xb5, 0x2c, 0x8c, 0xda,
-	0x92, 0x67, 0xe9, 0xfb, 0x52, 0x53, 0xb2, 0x99, 0x64, 0xc7, 0x2f, 0xeb, 0x88, 0x5a, 0xce, 0x57,
-	0xe7, 0xe2, 0x9d, 0x5c, 0x4e, 0x2d, 0x30, 0x9e, 0x5a, 0xe0, 0xe7, 0xd4, 0x02, 0x1f, 0x67, 0x96,
-	0x31, 0x9e, 0x59, 0xc6, 0xf7, 0x99, 0x65, 0x9c, 0x3d, 0xf8, 0x63, 0xa7, 0xdf, 0xa8, 0xe7, 0x33,
-	0x7d, 0x9c, 0x65, 0xe3, 0x3b, 0x45, 0xf9, 0x90, 0x3e, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0x69, 0xaa, 0x1c, 0xbb, 0x05, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xc5, 0x21, 0x6d, 0x2f, 0x69, 0xda, 0xba, 0x6d, 0x88, 0x2a, 0x14, 0x57, 0x9e, 0xb2,
+	0x34, 0xa1, 0x85, 0xa9, 0x5b, 0x1c, 0x54, 0x01, 0x05, 0x09, 0x59, 0xa8, 0x43, 0x97, 0xe8, 0x62,
+	0x5f, 0x5d, 0xab, 0xb1, 0xaf, 0xf8, 0x2e, 0x40, 0x07, 0x18, 0x10, 0x12, 0x1d, 0x11, 0x12, 0x12,
+	0x63, 0xc5, 0xc8, 0x1f, 0xc0, 0xcc, 0xd8, 0xb1, 0x23, 0x93, 0x41, 0xed, 0xc2, 0x9c, 0x91, 0x09,
+	0xf9, 0xee, 0x9c, 0x1f, 0x6e, 0x40, 0x34, 0xa8, 0xa8, 0x4b, 0x9b, 0xbb, 0xef, 0xbe, 0xf7, 0x3d,
+	0xbf, 0xf7, 0x6c, 0x1d, 0xbc, 0x61, 0x11, 0xea, 0x11, 0x5a, 0x7b, 0x8a, 0x29, 0x73, 0x7d, 0x27,
+	0xfe, 0x5f, 0xdd, 0x0f, 0x08, 0x23, 0x6a, 0x41, 0x54, 0xab, 0x72, 0x77, 0x69, 0xc1, 0x21, 0x0e,
+	0xe1, 0xa5, 0x5a, 0xf4, 0x4b, 0x9c, 0x5a, 0x9a, 0x97, 0x18, 0xf2, 0xb0, 0xd8, 0x2c, 0xca, 0x4d,
+	0xd4, 0x61, 0xbb, 0xfc, 0x8f, 0xd8, 0xd7, 0x3f, 0x66, 0xa0, 0x6a, 0x20, 0x8a, 0xb7, 0x04, 0x64,
+	0xdd, 0xb2, 0x48, 0xc7, 0x67, 0x6a, 0x1d, 0xe6, 0x5b, 0x88, 0xe2, 0x26, 0x12, 0xeb, 0x12, 0x58,
+	0x06, 0x95, 0xdc, 0x5a, 0xa9, 0x2a, 0x31, 0x39, 0x40, 0xd4, 0x26, 0xcf, 0x1b, 0x99, 0x93, 0x50,
+	0x03, 0x66, 0xae, 0xd5, 0xdf, 0x52, 0x5f, 0x03, 0x38, 0x4b, 0x02, 0xd7, 0x71, 0x7d, 0xd4, 0x6e,
+	0x4a, 0xc6, 0xa5, 0xf4, 0xb2, 0x52, 0xc9, 0xad, 0xe5, 0x63, 0x9c, 0x06, 0x71, 0x7d, 0x63, 0xf3,
+	0x38, 0xd4, 0x52, 0xdd, 0x50, 0xbb, 0x7e, 0x80, 0xbc, 0xf6, 0xba, 0x9e, 0xec, 0xd1, 0x3f, 0x7d,
+	0xd3, 0x2a, 0x8e, 0xcb, 0x76, 0x3b, 0xad, 0xaa, 0x45, 0xbc, 0xda, 0xd0, 0xd3, 0xad, 0x50, 0x7b,
+	0xaf, 0xc6, 0x0e, 0xf6, 0xb1, 0xc0, 0xa2, 0xe6, 0x4c, 0xdc, 0x2e, 0x1f, 0x48, 0x7d, 0x09, 0x0b,
+	0x36, 0x6e, 0x63, 0x07, 0x31, 0x6c, 0x37, 0x77, 0x02, 0x8c, 0x4b, 0xca, 0x08, 0x0e, 0xf7, 0x24,
+	0x87, 0x45, 0xc1, 0x61, 0xb8, 0xe3, 0x62, 0x0c, 0xa6, 0x7b, 0xcd, 0x1b, 0x01, 0xc6, 0xea, 0x1b,
+	0x00, 0xe7, 0xfa, 0x70, 0xb1, 0x0e, 0x99, 0x11, 0x1c, 0x1e, 0x48, 0x0e, 0xa5, 0x24, 0x87, 0xb1,
+	0x84, 0x98, 0xed, 0xf5, 0xc7, 0x4a, 0x54, 0xe1, 0x24, 0xf6, 0xed, 0x26, 0x73, 0x3d, 0x5c, 0xba,
+	0xb6, 0x0c, 0x2a, 0x8a, 0x31, 0xdf, 0x0d, 0xb5, 0x19, 0x31, 0x2d, 0xae, 0xe8, 0xe6, 0x04, 0xf6,
+	0xed, 0xc7, 0xae, 0x87, 0xd7, 0x27, 0x0f, 0x8f, 0xb4, 0xd4, 0x87, 0x23, 0x2d, 0xa5, 0x7f, 0x06,
+	0xb0, 0xd4, 0x20, 0x3e, 0x73, 0xfd, 0x0e, 0xe9, 0xd0, 0x44, 0x54, 0xb6, 0xe1, 0x02, 0x8f, 0x8a,
+	0x64, 0x99, 0x88, 0x8c, 0x5e, 0x1d, 0xce, 0x6c, 0xf5, 0x7c, 0xd8, 0x64, 0x78, 0xd4, 0xd6, 0xf9,
+	0x18, 0xde, 0x86, 0x90, 0x32, 0x14, 0x30, 0x41, 0x3a, 0xcd, 0x49, 0x2f, 0x76, 0x43, 0x6d, 0x4e,
+	0x90, 0xee, 0xd7, 0x74, 0x73, 0x8a, 0x2f, 0x12, 0xc4, 0x5f, 0xc0, 0xc5, 0x3b, 0xb8, 0x8d, 0x0e,
+	0xb0, 0x9d, 0x00, 0xbe, 0x44, 0xd2, 0x03, 0xe3, 0x5f, 0x01, 0x98, 0x7d, 0x84, 0x03, 0x97, 0xd8,
+	0x6a, 0x11, 0x66, 0xdb, 0xd8, 0x77, 0xd8, 0x2e, 0x1f, 0xa1, 0x98, 0x72, 0xa5, 0x6e, 0xc1, 0x2c,
+	0xf2, 0xf8, 0xe8, 0x51, 0xaf, 0xc6, 0xcd, 0x28, 0x12, 0x17, 0xb2, 0x5d, 0xa2, 0xad, 0x4f, 0x46,
+	0x04, 0x7e, 0x1c, 0x69, 0x40, 0x7f, 0x9f, 0x86, 0x45, 0x41, 0xc2, 0xb5, 0xae, 0xba, 0x75, 0x6a,
+	0x13, 0xce, 0xc4, 0x64, 0xf6, 0x39, 0x67, 0x2a, 0x5f, 0xd7, 0x62, 0x92, 0x8c, 0x78, 0x24, 0xa3,
+	0x2c, 0x5f, 0x9a, 0xa2, 0x80, 0x4d, 0x34, 0xeb, 0x66, 0x41, 0xee, 0x88, 0xe3, 0x74, 0xc0, 0x9c,
+	0x43, 0x05, 0x16, 0x1b, 0x6d, 0xf4, 0xac, 0x85, 0xac, 0xbd, 0xff, 0xa8, 0xcb, 0x13, 0x58, 0xd8,
+	0xe9, 0xf8, 0x36, 0x0e, 0x9a, 0xc8, 0xb6, 0x03, 0x4c, 0x29, 0xd7, 0x26, 0x6f, 0xdc, 0xef, 0x7f,
+	0x7d, 0x86, 0xeb, 0xfa, 0xcf, 0x50, 0x5b, 0xf9, 0x0b, 0xff, 0xeb, 0x96, 0x55, 0x17, 0x1d, 0xe6,
+	0xb4, 0x40, 0x90, 0xcb, 0x84, 0x15, 0xca, 0xf8, 0x56, 0x64, 0x2e, 0xc9, 0x8a, 0x77, 0x0a, 0xd4,
+	0x1e, 0x52, 0xa7, 0x11, 0x60, 0xc4, 0xf0, 0x6f, 0x3c, 0xd9, 0x83, 0xf9, 0x9d, 0x80, 0x78, 0x3d,
+	0xd5, 0x00, 0x57, 0xed, 0x6e, 0x37, 0xd4, 0xe6, 0xa5, 0x6a, 0x03, 0xd5, 0x31, 0x34, 0xcb, 0x45,
+	0xfd, 0xb1, 0x62, 0x18, 0x42, 0x46, 0x12, 0x06, 0x6d, 0xf4, 0x15, 0x63, 0xe4, 0x1f, 0x06, 0x4d,
+	0x31, 0x72, 0xc5, 0x8d, 0xc9, 0xf0, 0xef, 0xc6, 0x97, 0x34, 0xcc, 0x45, 0xa6, 0x48, 0x3b, 0x46,
+	0x04, 0x17, 0x5c, 0x76, 0x70, 0x37, 0xe1, 0xc4, 0xb0, 0x07, 0xab, 0x17, 0x87, 0x8c, 0x11, 0xa2,
+	0x00, 0xd9, 0x98, 0xb2, 0x1e, 0x7b, 0x25, 0x19, 0xa0, 0xc1, 0xea, 0x38, 0x01, 0x8a, 0xfa, 0xe5,
+	0x42, 0x48, 0x68, 0x6c, 0x1e, 0x9f, 0x96, 0xc1, 0xc9, 0x69, 0x19, 0x7c, 0x3f, 0x2d, 0x83, 0xb7,
+	0x67, 0xe5, 0xd4, 0xc9, 0x59, 0x39, 0xf5, 0xf5, 0xac, 0x9c, 0xda, 0x5e, 0xfd, 0x23, 0xf6, 0x73,
+	0x71, 0x4d, 0x8b, 0x2f, 0x81, 0x7c, 0x54, 0x2b, 0xcb, 0x2f, 0x6c, 0xb7, 0x7e, 0x0d, 0x00, 0x4a,
+	0x52, 0x0c, 0xfb, 0x23, 0x0a, 0x00, 0x00,
+}
+
+func (this *Period) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Period)
+	if !ok {
+		that2, ok := that.(Period)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgCreateClawbackVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateClawbackVestingAccount)
+	if !ok {
+		that2, ok := that.(MsgCreateClawbackVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.FromAddress, that1.FromAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ToAddress, that1.ToAddress) {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if len(this.VestingPeriods) != len(that1.VestingPeriods) {
+		return false
+	}
+	for i := range this.VestingPeriods {
+		if !this.VestingPeriods[i].Equal(&that1.VestingPeriods[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgClawback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*MsgClawback)
+	if !ok {
+		that2, ok := that.(MsgClawback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.FunderAddress, that1.FunderAddress) {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.DestAddress, that1.DestAddress) {
+		return false
+	}
+	return true
+}
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -541,6 +830,167 @@ func (m *PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -655,10 +1105,83 @@ func (m *PeriodicVestingAccount) Size() (n int) {
 	return n
 }
 
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
 	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
@@ -793,10 +1316,332 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Period) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -823,30 +1668,11 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
-			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVesting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -871,7 +1697,7 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -894,10 +1720,10 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -955,6 +1781,40 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -979,7 +1839,7 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,10 +1862,10 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1044,6 +1904,93 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = append(m.FunderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FunderAddress == nil {
+				m.FunderAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1068,7 +2015,7 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Period) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,17 +2038,17 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			m.Length = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1111,14 +2058,82 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,8 +2160,8 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1174,7 +2189,7 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,17 +2212,17 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1217,33 +2232,31 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseVestingAccount == nil {
-				m.BaseVestingAccount = &BaseVestingAccount{}
-			}
-			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FunderAddress = append(m.FunderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FunderAddress == nil {
+				m.FunderAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.StartTime = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1253,16 +2266,31 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1272,24 +2300,24 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DestAddress = append(m.DestAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestAddress == nil {
+				m.DestAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -596,8 +596,13 @@ func TestGenesisAccountValidate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid base valid account",
+			"valid base account with replaced pubkey",
 			authtypes.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
+			false,
+		},
+		{
+			"invalid base account pubkey",
+			&authtypes.BaseAccount{Address: addr, PubKey: []byte{0x01}},
 			true,
 		},
 		{
@@ -789,3 +794,120 @@ func TestDelayedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, endTime.Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require 50% of coins vested after period 1
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require period 2 coins don't vest until period is over
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(15*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(endTime))
+	require.Nil(t, va.GetVestingCoins(endTime))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// clawback before the start time returns everything
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, va.ComputeClawback(now))
+	require.True(t, va.GetOriginalVesting().IsZero())
+	require.Empty(t, va.GetVestingPeriods())
+	require.Equal(t, now.Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// clawback during period 2 returns periods 2 and 3
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, va.GetDelegatedVesting())
+
+	unvested := va.ComputeClawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetOriginalVesting())
+	require.Equal(t, types.Periods{periods[0]}, va.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.True(t, va.GetDelegatedVesting().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, va.GetDelegatedFree())
+	require.True(t, va.LockedCoins(now.Add(15*time.Hour)).IsZero())
+	require.NoError(t, va.Validate())
+
+	// clawback after the end time returns nothing
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.True(t, va.ComputeClawback(now.Add(48*time.Hour)).IsZero())
+	require.Equal(t, origCoins, va.GetOriginalVesting())
+	require.NoError(t, va.Validate())
+}
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.ClawbackVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+}
@@ -13,6 +13,9 @@ type GenesisBalance interface {
 
 // SupplyI defines an inflationary supply interface for modules that handle
 // token supply.
+//
+// Deprecated: the supply is now stored per denom. SupplyI is only kept to
+// decode the legacy supply object when migrating the store.
 type SupplyI interface {
 	GetTotal() sdk.Coins
 	SetTotal(total sdk.Coins)
@@ -30,7 +30,9 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		genState.Supply = totalSupply
 	}
 
-	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+	for _, supply := range genState.Supply {
+		k.SetSupply(ctx, supply)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -56,5 +58,5 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		})
 	}
 
-	return types.NewGenesisState(k.GetParams(ctx), balances, k.GetSupply(ctx).GetTotal(), k.GetAllDenomMetaData(ctx))
+	return types.NewGenesisState(k.GetParams(ctx), balances, k.GetTotalSupply(ctx), k.GetAllDenomMetaData(ctx))
 }
@@ -49,8 +49,7 @@ func (q BaseKeeper) AllBalances(c context.Context, req *types.QueryAllBalancesRe
 
 	balances := sdk.NewCoins()
 	store := ctx.KVStore(q.storeKey)
-	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
-	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+	accountStore := prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
 
 	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
 		var result sdk.Coin
@@ -70,11 +69,18 @@ func (q BaseKeeper) AllBalances(c context.Context, req *types.QueryAllBalancesRe
 }
 
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (q BaseKeeper) TotalSupply(c context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+func (q BaseKeeper) TotalSupply(c context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	totalSupply := q.GetSupply(ctx).GetTotal()
+	totalSupply, pageRes, err := q.GetPaginatedTotalSupply(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryTotalSupplyResponse{Supply: totalSupply}, nil
+	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
 // SupplyOf implements the Query/SupplyOf gRPC method
@@ -88,7 +94,123 @@ func (q BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := q.GetSupply(ctx).GetTotal().AmountOf(req.Denom)
+	supply := q.GetSupply(ctx, req.Denom)
+
+	return &types.QuerySupplyOfResponse{Amount: supply}, nil
+}
+
+// SpendableBalances implements the Query/SpendableBalances gRPC method
+func (q BaseKeeper) SpendableBalances(c context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr := req.Address
+	if addr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	locked := q.LockedCoins(ctx, addr)
+	balances := sdk.NewCoins()
+	accountStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CreateAccountBalancesPrefix(addr))
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
+		var balance sdk.Coin
+		if err := q.cdc.UnmarshalBinaryBare(value, &balance); err != nil {
+			return err
+		}
+
+		spendable := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+		if spendable.IsNegative() {
+			spendable = sdk.ZeroInt()
+		}
+
+		balances = append(balances, sdk.NewCoin(balance.Denom, spendable))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata := q.GetDenomMetaData(ctx, req.Denom)
+	if metadata.Base == "" {
+		return nil, status.Errorf(codes.NotFound, "no metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (q BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadatas := []types.Metadata{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomMetadataPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var metadata types.Metadata
+		if err := q.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// DenomOwners implements the Query/DenomOwners gRPC method
+func (q BaseKeeper) DenomOwners(c context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owners := []types.DenomOwner{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.CreateDenomAddressPrefix(req.Denom))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		addr := types.AddressFromBalancesStore(key)
+
+		owners = append(owners, types.DenomOwner{
+			Address: addr,
+			Balance: q.GetBalance(ctx, addr, req.Denom),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply)}, nil
+	return &types.QueryDenomOwnersResponse{DenomOwners: owners, Pagination: pageRes}, nil
 }
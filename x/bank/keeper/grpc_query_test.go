@@ -2,11 +2,16 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
-	"github.com/cosmos/cosmos-sdk/types/query"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -84,14 +89,29 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000), sdk.NewInt64Coin("test2", 700000000))
+	for _, coin := range expectedTotalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
 
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+	suite.Require().Equal(expectedTotalSupply, res.Supply)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[:1], res.Supply)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[1:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -99,8 +119,8 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	test1Supply := sdk.NewInt64Coin("test1", 4000000)
 	test2Supply := sdk.NewInt64Coin("test2", 700000000)
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(test1Supply, test2Supply))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	app.BankKeeper.SetSupply(ctx, test1Supply)
+	app.BankKeeper.SetSupply(ctx, test2Supply)
 
 	_, err := queryClient.SupplyOf(gocontext.Background(), &types.QuerySupplyOfRequest{})
 	suite.Require().Error(err)
@@ -111,3 +131,122 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQuerySpendableBalances() {
+	app := suite.app
+	now := tmtime.Now()
+	ctx := suite.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	_, err := app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	origCoins := sdk.NewCoins(newBarCoin(30), newFooCoin(100))
+	vestingCoins := sdk.NewCoins(newFooCoin(100))
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), endTime.Unix())
+
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, origCoins))
+
+	// half of the vesting coins are unlocked
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	req := &types.QuerySpendableBalancesRequest{Address: addr}
+
+	res, err := app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(50)), res.Balances)
+
+	req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30)), res.Balances)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: fooDenom})
+	suite.Require().Error(err)
+
+	barMetadata := types.Metadata{
+		Description: "The bar coin",
+		DenomUnits:  []*types.DenomUnits{{Denom: barDenom, Exponent: 0}},
+		Base:        barDenom,
+		Display:     barDenom,
+	}
+	fooMetadata := types.Metadata{
+		Description: "The foo coin",
+		DenomUnits:  []*types.DenomUnits{{Denom: fooDenom, Exponent: 0}},
+		Base:        fooDenom,
+		Display:     fooDenom,
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, fooMetadata)
+	app.BankKeeper.SetDenomMetaData(ctx, barMetadata)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fooMetadata, res.Metadata)
+
+	allRes, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{barMetadata, fooMetadata}, allRes.Metadatas)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	allRes, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{barMetadata}, allRes.Metadatas)
+	suite.Require().Equal(uint64(2), allRes.Pagination.Total)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(20))))
+
+	res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{
+		{Address: addr1, Balance: newFooCoin(100)},
+		{Address: addr2, Balance: newFooCoin(20)},
+	}, res.DenomOwners)
+
+	// sending the whole balance removes the sender from the owners
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newFooCoin(20))))
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{
+		{Address: addr1, Balance: newFooCoin(100)},
+		{Address: addr3, Balance: newFooCoin(20)},
+	}, res.DenomOwners)
+
+	// clearing the balances removes the account from every denom
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins()))
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DenomOwners)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom, Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{{Address: addr3, Balance: newFooCoin(20)}}, res.DenomOwners)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
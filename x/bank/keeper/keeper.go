@@ -8,9 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -25,8 +25,15 @@ type Keeper interface {
 	InitGenesis(sdk.Context, types.GenesisState)
 	ExportGenesis(sdk.Context) types.GenesisState
 
-	GetSupply(ctx sdk.Context) exported.SupplyI
-	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	SetSupply(ctx sdk.Context, coin sdk.Coin)
+	HasSupply(ctx sdk.Context, denom string) bool
+	GetTotalSupply(ctx sdk.Context) sdk.Coins
+	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	MigrateAddressKeys(ctx sdk.Context)
+	MigrateSupplyStore(ctx sdk.Context) error
+	MigrateDenomAddressIndex(ctx sdk.Context)
 
 	GetDenomMetaData(ctx sdk.Context, denom string) types.Metadata
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
@@ -42,8 +49,6 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
-	MarshalSupply(supplyI exported.SupplyI) ([]byte, error)
-	UnmarshalSupply(bz []byte) (exported.SupplyI, error)
 
 	types.QueryServer
 }
@@ -153,31 +158,100 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
-// GetSupply retrieves the Supply from store
-func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
+// GetSupply retrieves the total supply of a denom from the store. A denom
+// without supply has a zero supply.
+func (k BaseKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	bz := store.Get([]byte(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply of %s: %w", denom, err))
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetSupply sets the total supply of a denom. A zero supply removes the denom
+// from the store.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	if coin.IsZero() {
+		store.Delete([]byte(coin.Denom))
+		return
 	}
 
-	supply, err := k.UnmarshalSupply(bz)
+	bz, err := coin.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	return supply
+	store.Set([]byte(coin.Denom), bz)
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.MarshalSupply(supply)
+// HasSupply returns whether a denom has a non-zero supply.
+func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+	return store.Has([]byte(denom))
+}
+
+// GetTotalSupply returns the total supply of every denom. It iterates over all
+// the denoms, so GetSupply or GetPaginatedTotalSupply should be preferred.
+func (k BaseKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	totalSupply := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		totalSupply = append(totalSupply, coin)
+		return false
+	})
+
+	return totalSupply
+}
+
+// GetPaginatedTotalSupply returns a page of the total supply of every denom.
+func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	supply := sdk.NewCoins()
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return fmt.Errorf("unable to unmarshal supply of %s: %w", key, err)
+		}
+
+		supply = append(supply, sdk.NewCoin(string(key), amount))
+		return nil
+	})
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
 
-	store.Set(types.SupplyKey, bz)
+	return supply, pageRes, nil
+}
+
+// IterateTotalSupply iterates over the total supply of every denom, in denom
+// order, and calls the provided callback. If true is returned from the
+// callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal supply of %s: %w", iterator.Key(), err))
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
 }
 
 // GetDenomMetaData retrieves the denomination metadata
@@ -336,10 +410,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Add(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -365,9 +439,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Sub(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
@@ -385,6 +460,7 @@ func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockT
 	if ok {
 		// TODO: return error on account.TrackDelegation
 		vacc.TrackDelegation(blockTime, balance, amt)
+		k.ak.SetAccount(ctx, vacc)
 	}
 
 	return nil
@@ -400,26 +476,8 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	if ok {
 		// TODO: return error on account.TrackUndelegation
 		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, vacc)
 	}
 
 	return nil
 }
-
-// MarshalSupply marshals a Supply interface. If the given type implements
-// the Marshaler interface, it is treated as a Proto-defined message and
-// serialized that way. Otherwise, it falls back on the internal Amino codec.
-func (k BaseKeeper) MarshalSupply(supplyI exported.SupplyI) ([]byte, error) {
-	return codec.MarshalAny(k.cdc, supplyI)
-}
-
-// UnmarshalSupply returns a Supply interface from raw encoded supply
-// bytes of a Proto-based Supply type. An error is returned upon decoding
-// failure.
-func (k BaseKeeper) UnmarshalSupply(bz []byte) (exported.SupplyI, error) {
-	var evi exported.SupplyI
-	if err := codec.UnmarshalAny(k.cdc, &evi, bz); err != nil {
-		return nil, err
-	}
-
-	return evi, nil
-}
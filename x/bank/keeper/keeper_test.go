@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	gocontext "context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,13 +12,16 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -88,11 +93,68 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	initialPower := int64(100)
 	initTokens := sdk.TokensFromConsensusPower(initialPower)
 
-	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
-	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens), newFooCoin(100))
+	for _, coin := range totalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
+
+	suite.Require().Equal(totalSupply, app.BankKeeper.GetTotalSupply(ctx))
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetSupply(ctx, fooDenom))
+	suite.Require().True(app.BankKeeper.HasSupply(ctx, fooDenom))
+
+	// a denom that was never minted has a zero supply
+	suite.Require().Equal(newBarCoin(0), app.BankKeeper.GetSupply(ctx, barDenom))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, barDenom))
+
+	// setting a zero supply removes the denom
+	app.BankKeeper.SetSupply(ctx, newFooCoin(0))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, fooDenom))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens)), app.BankKeeper.GetTotalSupply(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestMigrateSupplyStore() {
+	app, ctx := suite.app, suite.ctx
+
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens), newFooCoin(100), newBarCoin(50))
+
+	// store the supply in the legacy format, as a single Supply object
+	bz, err := codec.MarshalAny(app.AppCodec(), types.NewSupply(totalSupply))
+	suite.Require().NoError(err)
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.SupplyKey, bz)
+
+	suite.Require().NoError(app.BankKeeper.MigrateSupplyStore(ctx))
+	suite.Require().False(store.Has(types.SupplyKey))
+	suite.Require().Equal(totalSupply, app.BankKeeper.GetTotalSupply(ctx))
+	suite.Require().Equal(newBarCoin(50), app.BankKeeper.GetSupply(ctx, barDenom))
+
+	// migrating an already migrated store is a no-op
+	suite.Require().NoError(app.BankKeeper.MigrateSupplyStore(ctx))
+	suite.Require().Equal(totalSupply, app.BankKeeper.GetTotalSupply(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestMigrateDenomAddressIndex() {
+	app, ctx := suite.app, suite.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(100))))
+
+	// drop the index, as on a store written before it existed
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(append(types.CreateDenomAddressPrefix(fooDenom), address.MustLengthPrefix(addr)...))
 
-	total := app.BankKeeper.GetSupply(ctx).GetTotal()
-	suite.Require().Equal(totalSupply, total)
+	req := &types.QueryDenomOwnersRequest{Denom: fooDenom}
+	res, err := suite.queryClient.DenomOwners(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DenomOwners)
+
+	app.BankKeeper.MigrateDenomAddressIndex(ctx)
+
+	res, err = suite.queryClient.DenomOwners(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{{Address: addr, Balance: newFooCoin(100)}}, res.DenomOwners)
 }
 
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
@@ -120,7 +182,7 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
 	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
 
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	keeper.SetSupply(ctx, initCoins[0])
 	authKeeper.SetModuleAccount(ctx, holderAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 	authKeeper.SetAccount(ctx, baseAcc)
@@ -185,7 +247,7 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 	authKeeper.SetModuleAccount(ctx, randomPermAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
+	initialSupply := keeper.GetTotalSupply(ctx)
 
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }, "invalid permission") // nolint:errcheck
@@ -199,16 +261,16 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Minter))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins...), keeper.GetTotalSupply(ctx))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = keeper.GetTotalSupply(ctx)
 
 	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins...), keeper.GetTotalSupply(ctx))
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
@@ -235,27 +297,25 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	keeper.SetSupply(ctx, initCoins[0])
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom).Add(initCoins[0])
 	keeper.SetSupply(ctx, initialSupply)
 
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                        // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")         // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.GetTotal()) }, "random permission") // nolint:errcheck
-	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply.GetTotal())
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                           // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")            // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, sdk.NewCoins(initialSupply)) }, "random permission") // nolint:errcheck
+	err := keeper.BurnCoins(ctx, authtypes.Burner, sdk.NewCoins(initialSupply))
 	suite.Require().Error(err, "insufficient coins")
 
 	err = keeper.BurnCoins(ctx, authtypes.Burner, initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom).Add(initCoins[0])
 	keeper.SetSupply(ctx, initialSupply)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, multiPermAcc.GetAddress(), initCoins))
@@ -264,7 +324,7 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
@@ -405,6 +465,110 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(expected, acc2Balances)
 }
 
+// denomSendHook only allows transfers of a denom between allowed addresses,
+// redirecting transfers to a redirected address.
+type denomSendHook struct {
+	denom      string
+	allowed    map[string]bool
+	redirects  map[string]sdk.AccAddress
+	recipients []sdk.AccAddress
+}
+
+func (h *denomSendHook) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	h.recipients = append(h.recipients, toAddr)
+
+	if redirect, ok := h.redirects[toAddr.String()]; ok {
+		toAddr = redirect
+	}
+
+	if amt.AmountOf(h.denom).IsZero() {
+		return toAddr, nil
+	}
+
+	if !h.allowed[fromAddr.String()] || !h.allowed[toAddr.String()] {
+		return nil, fmt.Errorf("transfer of %s from %s to %s not allowed", h.denom, fromAddr, toAddr)
+	}
+
+	return toAddr, nil
+}
+
+func (suite *IntegrationTestSuite) TestSendHooks() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	addr4 := sdk.AccAddress([]byte("addr4"))
+	addr5 := sdk.AccAddress([]byte("addr5"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	blockedAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+
+	hook := &denomSendHook{
+		denom:     fooDenom,
+		allowed:   map[string]bool{addr1.String(): true, addr2.String(): true},
+		redirects: map[string]sdk.AccAddress{addr4.String(): addr2, addr5.String(): blockedAddr},
+	}
+	// the second hook sees the recipient returned by the first one
+	next := &denomSendHook{denom: "none"}
+	app.BankKeeper.AppendSendHooks(hook, next)
+
+	// transfers of other denoms are not restricted
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(newBarCoin(10), app.BankKeeper.GetBalance(ctx, addr3, barDenom))
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(10), app.BankKeeper.GetBalance(ctx, addr2, fooDenom))
+
+	// transfers to addr4 are redirected to addr2
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr4, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(20), app.BankKeeper.GetBalance(ctx, addr2, fooDenom))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr4).Empty())
+	suite.Require().Equal(addr2, next.recipients[len(next.recipients)-1])
+
+	// transfers cannot be redirected to blocked addresses
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr5, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, blockedAddr).Empty())
+
+	// hooks apply to multi-sends
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	outputs[1].Address = addr5
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	outputs[1].Address = addr4
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(newFooCoin(40), app.BankKeeper.GetBalance(ctx, addr2, fooDenom))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr4).Empty())
+
+	// multi-sends with several inputs cannot be checked by the hooks
+	inputs = []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(newBarCoin(1))},
+		{Address: addr2, Coins: sdk.NewCoins(newBarCoin(1))},
+	}
+	outputs = []types.Output{{Address: addr3, Coins: sdk.NewCoins(newBarCoin(2))}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	// hooks apply to transfers from module accounts
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newFooCoin(10))))
+
+	hook.allowed[app.AccountKeeper.GetModuleAddress(minttypes.ModuleName).String()] = true
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr4, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(50), app.BankKeeper.GetBalance(ctx, addr2, fooDenom))
+
+	// hooks registered on the app keeper apply to the copies held by other modules
+	suite.Require().Error(app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(newFooCoin(10)), addr1))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateAddressKeys migrates the balances keys holding fixed length addresses
+// to length prefixed addresses. It is meant to be called once from an
+// x/upgrade handler.
+func (k BaseKeeper) MigrateAddressKeys(ctx sdk.Context) {
+	address.MigratePrefixAddresses(ctx.KVStore(k.storeKey), types.BalancesPrefix, 1)
+}
+
+// MigrateSupplyStore migrates the total supply from the single Supply object
+// previously stored under SupplyKey to one entry per denom. Chains upgrading
+// from the previous store format must run it once, e.g. from an x/upgrade
+// handler, before any coin is minted or burned. It is a no-op on a store that
+// has already been migrated.
+func (k BaseKeeper) MigrateSupplyStore(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	var supply exported.SupplyI
+	if err := codec.UnmarshalAny(k.cdc, &supply, bz); err != nil {
+		return err
+	}
+
+	store.Delete(types.SupplyKey)
+
+	for _, coin := range supply.GetTotal() {
+		k.SetSupply(ctx, coin)
+	}
+
+	return nil
+}
+
+// MigrateDenomAddressIndex builds the reverse index from each denom to the
+// accounts holding it, which backs the DenomOwners query, from the existing
+// balances. Chains upgrading from a store without the index must run it once,
+// e.g. from an x/upgrade handler.
+func (k BaseKeeper) MigrateDenomAddressIndex(ctx sdk.Context) {
+	var (
+		addrs    []sdk.AccAddress
+		balances []sdk.Coin
+	)
+
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if !balance.IsZero() {
+			addrs = append(addrs, addr)
+			balances = append(balances, balance)
+		}
+
+		return false
+	})
+
+	for i, addr := range addrs {
+		k.setDenomAddressIndex(ctx, balances[i].Denom, addr)
+	}
+}
@@ -90,8 +90,8 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupply() {
 	app, ctx := suite.app, suite.ctx
 	legacyQuerierCdc := codec.NewAminoCodec(app.Codec())
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
+	app.BankKeeper.SetSupply(ctx, expectedTotalSupply[0])
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryTotalSupply),
@@ -111,7 +111,7 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupply() {
 
 	var resp sdk.Coins
 	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &resp))
-	suite.Require().Equal(expectedTotalSupply.Total, resp)
+	suite.Require().Equal(expectedTotalSupply, resp)
 }
 
 func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupplyOf() {
@@ -120,8 +120,8 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupplyOf() {
 
 	test1Supply := sdk.NewInt64Coin("test1", 4000000)
 	test2Supply := sdk.NewInt64Coin("test2", 700000000)
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(test1Supply, test2Supply))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	app.BankKeeper.SetSupply(ctx, test1Supply)
+	app.BankKeeper.SetSupply(ctx, test2Supply)
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySupplyOf),
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -31,6 +32,8 @@ type SendKeeper interface {
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendHooks(hooks ...types.SendHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -47,6 +50,16 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// hooks called before every transfer of coins
+	sendHooks *sendHooks
+}
+
+// sendHooks holds the send hooks of a BaseSendKeeper. It is shared by pointer
+// so that hooks registered after the keeper has been passed to other modules
+// apply to every copy of the keeper.
+type sendHooks struct {
+	hooks types.MultiSendHooks
 }
 
 func NewBaseSendKeeper(
@@ -60,7 +73,35 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		sendHooks:      &sendHooks{},
+	}
+}
+
+// AppendSendHooks registers hooks to be called, after the already registered
+// ones, before every transfer of coins. Hooks must be registered before the
+// application starts processing blocks.
+func (k BaseSendKeeper) AppendSendHooks(hooks ...types.SendHooks) {
+	k.sendHooks.hooks = append(k.sendHooks.hooks, hooks...)
+}
+
+// beforeSend runs the registered send hooks and returns the recipient the
+// coins must be sent to. The hooks cannot redirect the coins to an address
+// which is not allowed to receive funds.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if len(k.sendHooks.hooks) == 0 {
+		return toAddr, nil
+	}
+
+	redirectAddr, err := k.sendHooks.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
 	}
+
+	if !redirectAddr.Equals(toAddr) && k.BlockedAddr(redirectAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", redirectAddr)
+	}
+
+	return redirectAddr, nil
 }
 
 // GetParams returns the total set of bank parameters.
@@ -77,6 +118,9 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// When send hooks are registered, they are run for every output with the
+// address of the input as sender, so only a single input is allowed.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -84,6 +128,24 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if len(k.sendHooks.hooks) > 0 {
+		if len(inputs) != 1 {
+			return sdkerrors.Wrap(types.ErrMultipleSenders, "send hooks require a single input")
+		}
+
+		hookedOutputs := make([]types.Output, len(outputs))
+		for i, out := range outputs {
+			toAddr, err := k.beforeSend(ctx, inputs[0].Address, out.Address, out.Coins)
+			if err != nil {
+				return err
+			}
+
+			hookedOutputs[i] = types.NewOutput(toAddr, out.Coins)
+		}
+
+		outputs = hookedOutputs
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -126,9 +188,15 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	return nil
 }
 
-// SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// SendCoins transfers amt coins from a sending account to a receiving account,
+// unless a send hook redirects them to another account. An error is returned
+// upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	toAddr, err := k.beforeSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -142,7 +210,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
+	_, err = k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -230,11 +298,11 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 	})
 
 	store := ctx.KVStore(k.storeKey)
-	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
-	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+	accountStore := prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		k.deleteDenomAddressIndex(ctx, string(key), addr)
 	}
 }
 
@@ -261,15 +329,34 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
-	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+	accountStore := prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
 
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	// keep the reverse index from denom to holders in sync with the balance
+	if balance.IsZero() {
+		k.deleteDenomAddressIndex(ctx, balance.Denom, addr)
+	} else {
+		k.setDenomAddressIndex(ctx, balance.Denom, addr)
+	}
+
 	return nil
 }
 
+// setDenomAddressIndex records that an account holds a non-zero balance of a
+// denom.
+func (k BaseSendKeeper) setDenomAddressIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDenomAddressPrefix(denom))
+	store.Set(address.MustLengthPrefix(addr), []byte{0})
+}
+
+// deleteDenomAddressIndex removes an account from the holders of a denom.
+func (k BaseSendKeeper) deleteDenomAddressIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDenomAddressPrefix(denom))
+	store.Delete(address.MustLengthPrefix(addr))
+}
+
 // SendEnabledCoins checks the coins provide and returns an ErrSendDisabled if
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
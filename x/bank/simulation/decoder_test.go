@@ -6,7 +6,6 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
@@ -14,16 +13,15 @@ import (
 )
 
 func TestDecodeStore(t *testing.T) {
-	app := simapp.Setup(false)
-	dec := simulation.NewDecodeStore(app.BankKeeper)
+	dec := simulation.NewDecodeStore()
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	supply := sdk.NewInt(1000)
 
-	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
+	supplyBz, err := supply.Marshal()
 	require.NoError(t, err)
 
 	kvPairs := kv.Pairs{
-		kv.Pair{Key: types.SupplyKey, Value: supplyBz},
+		kv.Pair{Key: append(types.SupplyKey, []byte(sdk.DefaultBondDenom)...), Value: supplyBz},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -31,7 +29,7 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendHooks defines the hooks called by the bank keeper before every transfer
+// of coins between accounts, including transfers from and to module accounts.
+// A hook vetoes the transfer by returning an error and redirects it by
+// returning a recipient other than toAddr.
+type SendHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error)
+}
+
+// MultiSendHooks combines multiple send hooks. The hooks are run in array
+// sequence, each one receiving the recipient returned by the previous one, and
+// the first error aborts the transfer.
+type MultiSendHooks []SendHooks
+
+// NewMultiSendHooks creates a new MultiSendHooks from the given hooks.
+func NewMultiSendHooks(hooks ...SendHooks) MultiSendHooks {
+	return hooks
+}
+
+// BeforeSend runs the BeforeSend hook of every combined hook.
+func (h MultiSendHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for i := range h {
+		var err error
+		if toAddr, err = h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
@@ -1,9 +1,8 @@
 package types
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,6 +24,7 @@ var (
 	BalancesPrefix      = []byte("balances")
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	DenomAddressPrefix  = []byte{0x2}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -33,14 +33,25 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
-// AddressFromBalancesStore returns an account address from a balances prefix
-// store. The key must not contain the perfix BalancesPrefix as the prefix store
-// iterator discards the actual prefix.
-func AddressFromBalancesStore(key []byte) sdk.AccAddress {
-	addr := key[:sdk.AddrLen]
-	if len(addr) != sdk.AddrLen {
-		panic(fmt.Sprintf("unexpected account address key length; got: %d, expected: %d", len(addr), sdk.AddrLen))
-	}
+// CreateAccountBalancesPrefix creates the prefix for an account's balances.
+func CreateAccountBalancesPrefix(addr []byte) []byte {
+	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
+}
 
+// CreateDenomAddressPrefix creates the prefix of the reverse index from a denom
+// to the addresses holding it. The denom is terminated by a 0x00 byte, which
+// no denom contains, so that a denom is not a prefix of another one.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
+
+// AddressFromBalancesStore returns an account address from a balances or a
+// denom address prefix store. The key must not contain the store prefix as the
+// prefix store iterator discards the actual prefix.
+func AddressFromBalancesStore(key []byte) sdk.AccAddress {
+	addr, _ := address.ParseLengthPrefixed(key)
 	return sdk.AccAddress(addr)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -20,7 +21,31 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	addr, err := sdk.AccAddressFromBech32("cosmos1n88uc38xhjgxzw9nwre4ep2c8ga4fjxcar6mn7")
 	require.NoError(t, err)
 
-	key := cloneAppend(addr.Bytes(), []byte("stake"))
+	key := cloneAppend(address.MustLengthPrefix(addr.Bytes()), []byte("stake"))
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
+
+	// module derived addresses are longer than public key addresses
+	addr = sdk.AccAddress(address.Module("bank", []byte("key")))
+	key = cloneAppend(address.MustLengthPrefix(addr.Bytes()), []byte("stake"))
+	res = types.AddressFromBalancesStore(key)
+	require.Equal(t, res, addr)
+}
+
+func TestCreateAccountBalancesPrefix(t *testing.T) {
+	addr := sdk.AccAddress(address.Module("bank", []byte("key")))
+	prefix := types.CreateAccountBalancesPrefix(addr)
+
+	require.Equal(t, types.BalancesPrefix, prefix[:len(types.BalancesPrefix)])
+	require.Equal(t, byte(len(addr)), prefix[len(types.BalancesPrefix)])
+	require.Equal(t, addr.Bytes(), prefix[len(types.BalancesPrefix)+1:])
+}
+
+func TestCreateDenomAddressPrefix(t *testing.T) {
+	require.Equal(t, []byte{0x2, 'a', 'b', 'c', 0x0}, types.CreateDenomAddressPrefix("abc"))
+
+	// a denom must not be a prefix of a longer denom
+	prefix := types.CreateDenomAddressPrefix("abc")
+	other := types.CreateDenomAddressPrefix("abcd")
+	require.NotEqual(t, prefix, other[:len(prefix)])
 }
@@ -11,8 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// BeginBlocker sets the proposer for determining distribution during endblock,
+// distribute rewards for the previous block and pays the continuous funds
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -35,4 +35,14 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay the continuous funds from the community pool
+	k.PayContinuousFunds(ctx)
+}
+
+// EndBlocker restakes the rewards of the delegations which enabled it
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.AutoRestake(ctx)
 }
@@ -32,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryDelegatorAutoRestakes(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
 	)
 
 	return distQueryCmd
@@ -316,3 +319,136 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoRestakes implements the query delegator auto-restakes command.
+func GetCmdQueryDelegatorAutoRestakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator automatically restakes rewards with",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose delegation rewards are automatically restaked by a delegator.
+
+Example:
+$ %s query distribution auto-restakes cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestakes(
+				context.Background(),
+				&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delegatorAddr},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFund implements the query continuous fund command.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [recipient-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the active continuous fund of a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount streamed every block from the community pool to a recipient and its end time.
+
+Example:
+$ %s query distribution continuous-fund cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFund(
+				context.Background(),
+				&types.QueryContinuousFundRequest{Recipient: recipient},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Fund)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds implements the query continuous funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query all the active continuous funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the amounts streamed every block from the community pool to recipients.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(
+				context.Background(),
+				&types.QueryContinuousFundsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
-	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -42,6 +42,8 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
+		NewSetAutoRestakeCmd(),
 	)
 
 	return distTxCmd
@@ -122,6 +124,8 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 	}
 
 	cmd.Flags().Bool(FlagCommission, false, "Withdraw the validator's commission in addition to the rewards")
+	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	_ = cmd.Flags().MarkDeprecated(FlagMaxMessagesPerTx, "the rewards are withdrawn in a single message")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -130,14 +134,16 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
-		Short: "withdraw all delegations rewards for a delegator",
+		Short: "withdraw all delegations rewards for a delegator, and optionally withdraw validator commission if the delegator is a validator operator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator in a single message,
+and optionally withdraw validator commission if the delegator is a validator operator.
 
 Example:
 $ %s tx distribution withdraw-all-rewards --from mykey
+$ %s tx distribution withdraw-all-rewards --from mykey --commission
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -149,24 +155,18 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 			}
 
 			delAddr := clientCtx.GetFromAddress()
+			commission, _ := cmd.Flags().GetBool(FlagCommission)
 
-			// The transaction cannot be generated offline since it requires a query
-			// to get all the validators.
-			if clientCtx.Offline {
-				return fmt.Errorf("cannot generate tx in offline mode")
-			}
-
-			msgs, err := common.WithdrawAllDelegatorRewards(clientCtx, delAddr)
-			if err != nil {
+			msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, commission)
+			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
-			return newSplitAndApply(tx.GenerateOrBroadcastTxCLI, clientCtx, cmd.Flags(), msgs, chunkSize)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(FlagCommission, false, "Withdraw the validator's commission in addition to the rewards")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -254,6 +254,90 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegations of all the tokenize share records owned by an address.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoRestakeCmd returns a CLI command handler for creating a MsgSetAutoRestake transaction.
+func NewSetAutoRestakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [enabled]",
+		Short: "enable or disable the automatic restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a delegation.
+Once enabled, the rewards in the bond denom are periodically withdrawn and delegated
+to the same validator, the other rewards being sent to the withdraw address.
+
+Example:
+$ %s tx distribution set-auto-restake cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -305,7 +389,143 @@ Where proposal.json contains:
 			from := clientCtx.GetFromAddress()
 			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, amount)
 
-			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, false)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitContinuousFundProposal implements the command to submit a community-pool-continuous-fund proposal
+func GetCmdSubmitContinuousFundProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-continuous-fund [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool continuous fund proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to stream an amount from the community pool to a recipient
+every block until the end time, along with an initial deposit. The proposal
+details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-continuous-fund <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Continuous Fund",
+  "description": "Pay me some Atoms every block!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount_per_block": "10stake",
+  "end_time": "2021-01-01T00:00:00Z",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCommunityPoolContinuousFundProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			amountPerBlock, err := sdk.ParseCoins(proposal.AmountPerBlock)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCommunityPoolContinuousFundProposal(
+				proposal.Title, proposal.Description, proposal.Recipient, amountPerBlock, proposal.EndTime,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, false)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitCancelContinuousFundProposal implements the command to submit a cancel-community-pool-continuous-fund proposal
+func GetCmdSubmitCancelContinuousFundProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-continuous-fund [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool continuous fund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the continuous fund of a recipient along with an
+initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-continuous-fund <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Community Pool Continuous Fund",
+  "description": "The milestones have not been met",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCancelCommunityPoolContinuousFundProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolContinuousFundProposal(proposal.Title, proposal.Description, proposal.Recipient)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, false)
 			if err != nil {
 				return err
 			}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -89,3 +90,31 @@ func TestParseProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.Deposit)
 	require.Equal(t, "1000stake", proposal.Amount)
 }
+
+func TestParseContinuousFundProposal(t *testing.T) {
+	cdc := codec.New()
+	okJSON, cleanup := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Continuous Fund",
+  "description": "Pay me some Atoms every block!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount_per_block": "10stake",
+  "end_time": "2021-01-01T00:00:00Z",
+  "deposit": "1000stake"
+}
+`)
+	t.Cleanup(cleanup)
+
+	proposal, err := ParseCommunityPoolContinuousFundProposalJSON(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	addr, err := sdk.AccAddressFromBech32("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq")
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Continuous Fund", proposal.Title)
+	require.Equal(t, "Pay me some Atoms every block!", proposal.Description)
+	require.Equal(t, addr, proposal.Recipient)
+	require.Equal(t, "1000stake", proposal.Deposit)
+	require.Equal(t, "10stake", proposal.AmountPerBlock)
+	require.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), proposal.EndTime)
+}
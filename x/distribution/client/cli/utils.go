@@ -2,6 +2,7 @@ package cli
 
 import (
 	"io/ioutil"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,24 @@ type (
 		Amount      string         `json:"amount" yaml:"amount"`
 		Deposit     string         `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolContinuousFundProposalJSON defines a CommunityPoolContinuousFundProposal with a deposit
+	CommunityPoolContinuousFundProposalJSON struct {
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerBlock string         `json:"amount_per_block" yaml:"amount_per_block"`
+		EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+		Deposit        string         `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelCommunityPoolContinuousFundProposalJSON defines a CancelCommunityPoolContinuousFundProposal with a deposit
+	CancelCommunityPoolContinuousFundProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Deposit     string         `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +52,35 @@ func ParseCommunityPoolSpendProposalJSON(cdc codec.JSONMarshaler, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolContinuousFundProposalJSON reads and parses a CommunityPoolContinuousFundProposalJSON from a file.
+func ParseCommunityPoolContinuousFundProposalJSON(cdc codec.JSONMarshaler, proposalFile string) (CommunityPoolContinuousFundProposalJSON, error) {
+	proposal := CommunityPoolContinuousFundProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolContinuousFundProposalJSON reads and parses a CancelCommunityPoolContinuousFundProposalJSON from a file.
+func ParseCancelCommunityPoolContinuousFundProposalJSON(cdc codec.JSONMarshaler, proposalFile string) (CancelCommunityPoolContinuousFundProposalJSON, error) {
+	proposal := CancelCommunityPoolContinuousFundProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -52,6 +52,9 @@ func QueryValidatorCommission(clientCtx client.Context, validatorAddr sdk.ValAdd
 
 // WithdrawAllDelegatorRewards builds a multi-message slice to be used
 // to withdraw all delegations rewards for the given delegator.
+//
+// Deprecated: use a single MsgWithdrawAllDelegatorRewards built by
+// types.NewMsgWithdrawAllDelegatorRewards instead.
 func WithdrawAllDelegatorRewards(clientCtx client.Context, delegatorAddr sdk.AccAddress) ([]sdk.Msg, error) {
 	// retrieve the comprehensive list of all validators which the
 	// delegator had submitted delegations to
@@ -6,7 +6,10 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 )
 
-// ProposalHandler is the community spend proposal handler.
+// ProposalHandler is the community spend proposal handler, ContinuousFundProposalHandler
+// and CancelContinuousFundProposalHandler are the community pool continuous fund ones.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler                     = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ContinuousFundProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitContinuousFundProposal, rest.ContinuousFundProposalRESTHandler)
+	CancelContinuousFundProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelContinuousFundProposal, rest.CancelContinuousFundProposalRESTHandler)
 )
@@ -27,6 +27,22 @@ func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	}
 }
 
+// ContinuousFundProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool continuous fund REST handler with a given sub-route.
+func ContinuousFundProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_continuous_fund",
+		Handler:  postContinuousFundProposalHandlerFn(clientCtx),
+	}
+}
+
+// CancelContinuousFundProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool continuous fund cancellation REST handler with a given sub-route.
+func CancelContinuousFundProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_community_pool_continuous_fund",
+		Handler:  postCancelContinuousFundProposalHandlerFn(clientCtx),
+	}
+}
+
 func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req CommunityPoolSpendProposalReq
@@ -41,7 +57,59 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 
 		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
 
-		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postContinuousFundProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolContinuousFundProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolContinuousFundProposal(req.Title, req.Description, req.Recipient, req.AmountPerBlock, req.EndTime)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postCancelContinuousFundProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelCommunityPoolContinuousFundProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelCommunityPoolContinuousFundProposal(req.Title, req.Description, req.Recipient)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
@@ -17,4 +19,28 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolContinuousFundProposalReq defines a community pool continuous fund proposal request body.
+	CommunityPoolContinuousFundProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerBlock sdk.Coins      `json:"amount_per_block" yaml:"amount_per_block"`
+		EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+		Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelCommunityPoolContinuousFundProposalReq defines a community pool continuous fund cancellation proposal request body.
+	CancelCommunityPoolContinuousFundProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
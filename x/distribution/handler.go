@@ -22,12 +22,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgWithdrawDelegatorReward:
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 
+		case *types.MsgWithdrawAllDelegatorRewards:
+			return handleMsgWithdrawAllDelegatorRewards(ctx, msg, k)
+
 		case *types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
 
 		case *types.MsgFundCommunityPool:
 			return handleMsgFundCommunityPool(ctx, msg, k)
 
+		case *types.MsgWithdrawTokenizeShareRecordReward:
+			return handleMsgWithdrawTokenizeShareRecordReward(ctx, msg, k)
+
+		case *types.MsgSetAutoRestake:
+			return handleMsgSetAutoRestake(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -80,6 +89,40 @@ func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg *types.MsgWithdrawDel
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgWithdrawAllDelegatorRewards(ctx sdk.Context, msg *types.MsgWithdrawAllDelegatorRewards, k keeper.Keeper) (*sdk.Result, error) {
+	rewards, commission, err := k.WithdrawAllDelegationRewards(ctx, msg.DelegatorAddress, msg.WithdrawCommission)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range rewards {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", "withdraw_reward"},
+				float32(a.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+			)
+		}
+		for _, a := range commission {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", "withdraw_commission"},
+				float32(a.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+			)
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg *types.MsgWithdrawValidatorCommission, k keeper.Keeper) (*sdk.Result, error) {
 	amount, err := k.WithdrawValidatorCommission(ctx, msg.ValidatorAddress)
 	if err != nil {
@@ -123,12 +166,61 @@ func handleMsgFundCommunityPool(ctx sdk.Context, msg *types.MsgFundCommunityPool
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgSetAutoRestake(ctx sdk.Context, msg *types.MsgSetAutoRestake, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.SetAutoRestake(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgWithdrawTokenizeShareRecordReward(ctx sdk.Context, msg *types.MsgWithdrawTokenizeShareRecordReward, k keeper.Keeper) (*sdk.Result, error) {
+	amount, err := k.WithdrawTokenizeShareRecordReward(ctx, msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", "withdraw_tokenize_share_reward"},
+				float32(a.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+			)
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case *types.CommunityPoolContinuousFundProposal:
+			return keeper.HandleCommunityPoolContinuousFundProposal(ctx, k, c)
+
+		case *types.CancelCommunityPoolContinuousFundProposal:
+			return keeper.HandleCancelCommunityPoolContinuousFundProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetAutoRestake enables or disables the automatic restaking of the rewards of
+// a delegation. Enabling it requires the delegation to exist.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+		k.SetDelegatorAutoRestake(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// AutoRestake restakes the rewards of up to MaxAutoRestakesPerBlock delegations
+// which enabled it, resuming after the last delegation restaked in a previous
+// block and wrapping around once all of them have been visited.
+func (k Keeper) AutoRestake(ctx sdk.Context) {
+	max := int(k.GetMaxAutoRestakesPerBlock(ctx))
+	if max == 0 {
+		return
+	}
+
+	// collect the keys first, as restaking writes to the store
+	keys := k.nextAutoRestakeKeys(ctx, max)
+	if len(keys) == 0 {
+		return
+	}
+
+	for _, key := range keys {
+		delAddr, valAddr := types.GetDelegatorAutoRestakeAddresses(key)
+		k.restakeDelegationRewards(ctx, delAddr, valAddr)
+	}
+
+	k.SetAutoRestakeCursor(ctx, keys[len(keys)-1])
+}
+
+// nextAutoRestakeKeys returns the keys of up to max delegations to restake the
+// rewards of, starting after the cursor.
+func (k Keeper) nextAutoRestakeKeys(ctx sdk.Context, max int) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	prefixEnd := sdk.PrefixEndBytes(types.DelegatorAutoRestakePrefix)
+
+	keys := make([][]byte, 0, max)
+	collect := func(start, end []byte) {
+		iter := store.Iterator(start, end)
+		defer iter.Close()
+		for ; iter.Valid() && len(keys) < max; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}
+
+	cursor := k.GetAutoRestakeCursor(ctx)
+	if cursor == nil {
+		collect(types.DelegatorAutoRestakePrefix, prefixEnd)
+		return keys
+	}
+
+	// the first key following the cursor is the cursor followed by a zero byte
+	next := append(append([]byte{}, cursor...), 0x00)
+	collect(next, prefixEnd)
+	if len(keys) < max {
+		collect(types.DelegatorAutoRestakePrefix, next)
+	}
+
+	return keys
+}
+
+// restakeDelegationRewards withdraws the rewards of a delegation and delegates
+// those in the bond denom to the same validator, the other ones being sent to
+// the withdraw address of the delegator. Nothing is written if the rewards
+// cannot be restaked.
+func (k Keeper) restakeDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if val == nil || del == nil {
+		k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	rewards, err := k.withdrawDelegationRewardsTo(cacheCtx, val, del, delAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw rewards to restake", "delegator", delAddr, "validator", valAddr, "err", err)
+		return
+	}
+	k.initializeDelegation(cacheCtx, valAddr, delAddr)
+
+	bondDenom := k.stakingKeeper.BondDenom(cacheCtx)
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return
+	}
+
+	withdrawAddr := k.GetDelegatorWithdrawAddr(cacheCtx, delAddr)
+	if other := rewards.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, amount))); !other.IsZero() && !withdrawAddr.Equals(delAddr) {
+		if err := k.bankKeeper.SendCoins(cacheCtx, delAddr, withdrawAddr, other); err != nil {
+			k.Logger(ctx).Error("failed to send rewards to withdraw address", "delegator", delAddr, "validator", valAddr, "err", err)
+			return
+		}
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+	if !found {
+		return
+	}
+
+	// the staking hooks withdraw the (now empty) rewards of the delegation and
+	// reinitialize it, keeping the reward periods consistent
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		k.Logger(ctx).Error("failed to restake rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+		return
+	}
+	if err := k.stakingKeeper.ValidateMaxVotingPowerRatio(cacheCtx, valAddr); err != nil {
+		return
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestake,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSetAutoRestake(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	// the delegation must exist to be restaked
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true)
+	require.Equal(t, types.ErrNoDelegationExists, err)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[1], valAddrs[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[0], true))
+	require.True(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[0], valAddrs[0]))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, app.DistrKeeper.GetDelegatorAutoRestakeValidators(ctx, addr[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[0], false))
+	require.False(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[0], valAddrs[0]))
+	require.Empty(t, app.DistrKeeper.GetDelegatorAutoRestakeValidators(ctx, addr[0]))
+}
+
+func TestAutoRestake(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission and a delegation of the same amount
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	createMsg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, createMsg)
+	require.NoError(t, err)
+
+	delMsg := stakingtypes.NewMsgDelegate(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens))
+	_, err = sh(ctx, delMsg)
+	require.NoError(t, err)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// restake a single delegation per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.MaxAutoRestakesPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true))
+
+	// allocate some rewards, a quarter of them for each delegation
+	initial := sdk.TokensFromConsensusPower(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	reward := initial.QuoRaw(4)
+
+	delegationTokens := func(delAddr sdk.AccAddress) sdk.Int {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+	balances := []sdk.Coins{
+		app.BankKeeper.GetAllBalances(ctx, addr[0]),
+		app.BankKeeper.GetAllBalances(ctx, addr[1]),
+	}
+
+	// the first block restakes the rewards of one of the delegations
+	app.DistrKeeper.AutoRestake(ctx)
+	restaked := 0
+	for _, delAddr := range addr {
+		if delegationTokens(delAddr).Equal(valTokens.Add(reward)) {
+			restaked++
+		} else {
+			require.Equal(t, valTokens, delegationTokens(delAddr))
+		}
+	}
+	require.Equal(t, 1, restaked)
+
+	// the next one restakes the rewards of the other delegation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.DistrKeeper.AutoRestake(ctx)
+	for i, delAddr := range addr {
+		require.Equal(t, valTokens.Add(reward), delegationTokens(delAddr))
+		require.Equal(t, balances[i], app.BankKeeper.GetAllBalances(ctx, delAddr))
+	}
+
+	// only the commission is left outstanding
+	require.Equal(t,
+		sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))},
+		app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0]),
+	)
+
+	// the periods of the delegations are consistent with the new stakes, up to
+	// the rounding of the reward ratio
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	for _, delAddr := range addr {
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+		require.NoError(t, err)
+		require.True(t, reward.Sub(rewards.AmountOf(sdk.DefaultBondDenom)).LTE(sdk.OneInt()), rewards.String())
+	}
+
+	// the record is removed along with the delegation
+	_, err = app.StakingKeeper.Undelegate(ctx, addr[1], valAddrs[0], sdk.NewDecFromInt(valTokens.Add(reward)))
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[1], valAddrs[0]))
+	require.True(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[0], valAddrs[0]))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// PayContinuousFunds pays the amount per block of every continuous fund from
+// the community pool, removing the funds which reached their end time. A fund
+// is not paid for the block if the community pool cannot cover its amount.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) {
+	// collect the funds first, as paying them writes to the store
+	funds := k.GetAllContinuousFunds(ctx)
+
+	for _, fund := range funds {
+		if fund.IsExpired(ctx.BlockTime()) {
+			k.DeleteContinuousFund(ctx, fund.Recipient)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeContinuousFundExpired,
+					sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient.String()),
+				),
+			)
+			continue
+		}
+
+		if err := k.DistributeFromFeePool(ctx, fund.AmountPerBlock, fund.Recipient); err != nil {
+			k.Logger(ctx).Error("failed to pay continuous fund", "recipient", fund.Recipient, "err", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundPayment,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fund.AmountPerBlock.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestPayContinuousFunds(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(1000, 0).UTC()})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+
+	// fund the community pool
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), pool))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(pool...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	// the first fund ends after two blocks, the second one after three
+	amountPerBlock := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	app.DistrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addr[0], amountPerBlock, ctx.BlockTime().Add(2*time.Second)))
+	app.DistrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addr[1], amountPerBlock, ctx.BlockTime().Add(3*time.Second)))
+
+	payBlocks := func(n int) {
+		for i := 0; i < n; i++ {
+			app.DistrKeeper.PayContinuousFunds(ctx)
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+		}
+	}
+
+	payBlocks(2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), app.BankKeeper.GetAllBalances(ctx, addr[0]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+
+	// the first fund expired, the second one is paid with what is left in the pool
+	payBlocks(1)
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, addr[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), app.BankKeeper.GetAllBalances(ctx, addr[0]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	require.True(t, app.DistrKeeper.GetFeePoolCommunityCoins(ctx).IsZero())
+
+	// the second fund expired as well
+	payBlocks(1)
+	require.Empty(t, app.DistrKeeper.GetAllContinuousFunds(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+}
+
+func TestPayContinuousFundsInsufficientPool(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(1000, 0).UTC()})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())
+
+	amountPerBlock := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	app.DistrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addr[0], amountPerBlock, ctx.BlockTime().Add(time.Hour)))
+
+	// nothing is paid, but the fund is kept until the pool can cover it
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[0]).IsZero())
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, addr[0])
+	require.True(t, found)
+}
@@ -137,6 +137,15 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val exported.Validat
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI) (sdk.Coins, error) {
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+	return k.withdrawDelegationRewardsTo(ctx, val, del, withdrawAddr)
+}
+
+// withdrawDelegationRewardsTo withdraws the rewards of a delegation to the
+// given address
+func (k Keeper) withdrawDelegationRewardsTo(
+	ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI, withdrawAddr sdk.AccAddress,
+) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrEmptyDelegationDistInfo
@@ -162,7 +171,6 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
 		if err != nil {
 			return nil, err
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -696,3 +697,61 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// no records to withdraw from
+	_, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.Equal(t, types.ErrNoTokenizeShareRecords, err)
+
+	// create validator with 50% commission
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := sdk.TokensFromConsensusPower(1)
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens), stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize a fifth of the self-delegation to the second address
+	tokenizeMsg := stakingtypes.NewMsgTokenizeShares(
+		sdk.AccAddress(valAddrs[0]), valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(5)), addr[1],
+	)
+	_, err = sh(ctx, tokenizeMsg)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	balance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+
+	// the record holds a fifth of the delegator rewards, i.e. half of the tokens
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))), rewards)
+	require.Equal(t, balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))), app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom))
+}
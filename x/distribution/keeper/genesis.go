@@ -39,6 +39,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, evt := range data.ValidatorSlashEvents {
 		k.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, ar := range data.AutoRestakes {
+		k.SetDelegatorAutoRestake(ctx, ar.DelegatorAddress, ar.ValidatorAddress)
+	}
+	for _, cf := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, cf)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -145,5 +151,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoRestakes := make([]types.DelegatorAutoRestake, 0)
+	k.IterateDelegatorAutoRestakes(ctx, func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+		autoRestakes = append(autoRestakes, types.DelegatorAutoRestake{
+			DelegatorAddress: del,
+			ValidatorAddress: val,
+		})
+		return false
+	})
+
+	continuousFunds := k.GetAllContinuousFunds(ctx)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoRestakes, continuousFunds)
 }
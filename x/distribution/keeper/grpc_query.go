@@ -213,3 +213,66 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// DelegatorAutoRestakes queries the validators a delegator automatically restakes rewards with
+func (k Keeper) DelegatorAutoRestakes(c context.Context, req *types.QueryDelegatorAutoRestakesRequest) (*types.QueryDelegatorAutoRestakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := k.GetDelegatorAutoRestakeValidators(ctx, req.DelegatorAddress)
+
+	return &types.QueryDelegatorAutoRestakesResponse{Validators: validators}, nil
+}
+
+// ContinuousFund queries the active continuous fund of a recipient
+func (k Keeper) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Recipient.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fund, found := k.GetContinuousFund(ctx, req.Recipient)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund for recipient %s not found", req.Recipient)
+	}
+
+	return &types.QueryContinuousFundResponse{Fund: fund}, nil
+}
+
+// ContinuousFunds queries all the active continuous funds
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	funds := make([]types.ContinuousFund, 0)
+	store := ctx.KVStore(k.storeKey)
+	fundsStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(key []byte, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.UnmarshalBinaryBare(value, &fund); err != nil {
+			return err
+		}
+
+		funds = append(funds, fund)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{Funds: funds, Pagination: pageRes}, nil
+}
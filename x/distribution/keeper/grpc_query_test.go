@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -657,6 +658,108 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCDelegatorAutoRestakes() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	app.DistrKeeper.SetDelegatorAutoRestake(ctx, addrs[0], valAddrs[0])
+
+	var req *types.QueryDelegatorAutoRestakesRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDelegatorAutoRestakesRequest{}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: addrs[0]}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			autoRestakes, err := queryClient.DelegatorAutoRestakes(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]sdk.ValAddress{valAddrs[0]}, autoRestakes.Validators)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(autoRestakes)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCContinuousFunds() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	fund := types.NewContinuousFund(addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), time.Unix(1000, 0).UTC())
+	app.DistrKeeper.SetContinuousFund(ctx, fund)
+
+	var req *types.QueryContinuousFundRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryContinuousFundRequest{}
+			},
+			false,
+		},
+		{
+			"no continuous fund",
+			func() {
+				req = &types.QueryContinuousFundRequest{Recipient: addrs[1]}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryContinuousFundRequest{Recipient: addrs[0]}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.ContinuousFund(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(fund, res.Fund)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	res, err := queryClient.ContinuousFunds(gocontext.Background(), &types.QueryContinuousFundsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ContinuousFund{fund}, res.Funds)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
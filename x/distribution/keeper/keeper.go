@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 // Keeper of the distribution store
@@ -112,6 +113,96 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator, along with the commission of the validator it operates if
+// withdrawCommission is set, and returns the rewards and the commission
+func (k Keeper) WithdrawAllDelegationRewards(
+	ctx sdk.Context, delAddr sdk.AccAddress, withdrawCommission bool,
+) (rewards sdk.Coins, commission sdk.Coins, err error) {
+	// collect the validators first, as withdrawing writes to the store
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del exported.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	if withdrawCommission {
+		if k.stakingKeeper.Validator(ctx, sdk.ValAddress(delAddr)) == nil {
+			return nil, nil, types.ErrNoValidatorExists
+		}
+	} else if len(valAddrs) == 0 {
+		return nil, nil, types.ErrNoDelegationExists
+	}
+
+	rewards = sdk.Coins{}
+	for _, valAddr := range valAddrs {
+		amount, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+		rewards = rewards.Add(amount...)
+	}
+
+	commission = sdk.Coins{}
+	if withdrawCommission {
+		// a validator without commission to withdraw is not an error here, as
+		// the commission is withdrawn along with the rewards
+		amount, err := k.WithdrawValidatorCommission(ctx, sdk.ValAddress(delAddr))
+		switch {
+		case err == nil:
+			commission = amount
+		case !types.ErrNoValidatorCommission.Is(err):
+			return nil, nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAllRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCommission, commission.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return rewards, commission, nil
+}
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of all the tokenize share records owned by an address and sends them to it
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.Coins{}
+	for _, record := range records {
+		recordAddr := record.GetModuleAddress()
+
+		// the rewards are withdrawn to the account of the record, which holds
+		// those withdrawn earlier when the shares of its delegation changed
+		if k.stakingKeeper.Delegation(ctx, recordAddr, record.Validator) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, record.Validator); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
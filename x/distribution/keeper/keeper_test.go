@@ -7,10 +7,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -89,6 +92,84 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// nothing to withdraw without delegations, nor commission without validator
+	_, _, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[2], false)
+	require.Equal(t, types.ErrNoDelegationExists, err)
+	_, _, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[2], true)
+	require.Equal(t, types.ErrNoValidatorExists, err)
+
+	// create two validators with 50% commission, the first one delegating to
+	// the second one as well
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	for i, pk := range []crypto.PubKey{valConsPk1, valConsPk2} {
+		msg := stakingtypes.NewMsgCreateValidator(
+			valAddrs[i], pk, sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+			stakingtypes.Description{}, commission, sdk.OneInt(),
+		)
+		_, err = sh(ctx, msg)
+		require.NoError(t, err)
+	}
+	_, err = sh(ctx, stakingtypes.NewMsgDelegate(addr[0], valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to both validators
+	initial := sdk.TokensFromConsensusPower(10)
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	}
+
+	// the delegator gets all the rewards of the first validator and half the
+	// ones of the second, along with the commission of the first one
+	balance := app.BankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom)
+	rewards, valCommission, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[0], true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(initial.QuoRaw(4)))), rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), valCommission)
+	require.Equal(t,
+		balance.Amount.Add(rewards.AmountOf(sdk.DefaultBondDenom)).Add(valCommission.AmountOf(sdk.DefaultBondDenom)),
+		app.BankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom).Amount,
+	)
+
+	// one event is emitted per delegation, along with the aggregated one
+	var withdrawEvents, withdrawAllEvents int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeWithdrawRewards:
+			withdrawEvents++
+		case types.EventTypeWithdrawAllRewards:
+			withdrawAllEvents++
+		}
+	}
+	require.Equal(t, 2, withdrawEvents)
+	require.Equal(t, 1, withdrawAllEvents)
+
+	// withdrawing again does not fail without commission left
+	rewards, valCommission, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[0], true)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+	require.True(t, valCommission.IsZero())
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
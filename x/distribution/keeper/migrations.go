@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MigrateAddressKeys migrates the store keys holding fixed length addresses to
+// length prefixed addresses. It is meant to be called once from an x/upgrade
+// handler.
+func (k Keeper) MigrateAddressKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	address.MigratePrefixAddresses(store, types.ValidatorOutstandingRewardsPrefix, 1)
+	address.MigratePrefixAddresses(store, types.DelegatorWithdrawAddrPrefix, 1)
+	address.MigratePrefixAddresses(store, types.DelegatorStartingInfoPrefix, 2)
+	address.MigratePrefixAddresses(store, types.ValidatorHistoricalRewardsPrefix, 1)
+	address.MigratePrefixAddresses(store, types.ValidatorCurrentRewardsPrefix, 1)
+	address.MigratePrefixAddresses(store, types.ValidatorAccumulatedCommissionPrefix, 1)
+	address.MigratePrefixAddresses(store, types.ValidatorSlashEventPrefix, 1)
+}
+
+// MigrateParams sets the params added since the previous release, which don't
+// exist in the params store of the chains created before it. It is meant to be
+// called once from an x/upgrade handler.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyMaxAutoRestakesPerBlock) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyMaxAutoRestakesPerBlock, types.DefaultParams().MaxAutoRestakesPerBlock)
+	}
+}
@@ -41,3 +41,10 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetMaxAutoRestakesPerBlock returns the current distribution maximum number of
+// delegations whose rewards are restaked per block.
+func (k Keeper) GetMaxAutoRestakesPerBlock(ctx sdk.Context) (max uint32) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxAutoRestakesPerBlock, &max)
+	return max
+}
@@ -23,3 +23,53 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCommunityPoolContinuousFundProposal is a handler for executing a passed community pool continuous fund proposal
+func HandleCommunityPoolContinuousFundProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolContinuousFundProposal) error {
+	if k.blockedAddrs[p.Recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	}
+
+	if !ctx.BlockTime().Before(p.EndTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalEndTime, "end time %s is not after the block time %s", p.EndTime, ctx.BlockTime())
+	}
+
+	if _, found := k.GetContinuousFund(ctx, p.Recipient); found {
+		return sdkerrors.Wrapf(types.ErrContinuousFundExists, "recipient %s", p.Recipient)
+	}
+
+	k.SetContinuousFund(ctx, types.NewContinuousFund(p.Recipient, p.AmountPerBlock, p.EndTime))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFund,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, p.AmountPerBlock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, p.EndTime.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("streaming %s per block from the community pool to recipient %s until %s", p.AmountPerBlock, p.Recipient, p.EndTime))
+	return nil
+}
+
+// HandleCancelCommunityPoolContinuousFundProposal is a handler for executing a passed continuous fund cancellation proposal
+func HandleCancelCommunityPoolContinuousFundProposal(ctx sdk.Context, k Keeper, p *types.CancelCommunityPoolContinuousFundProposal) error {
+	if _, found := k.GetContinuousFund(ctx, p.Recipient); !found {
+		return sdkerrors.Wrapf(types.ErrNoContinuousFund, "recipient %s", p.Recipient)
+	}
+
+	k.DeleteContinuousFund(ctx, p.Recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled the continuous fund of recipient %s", p.Recipient))
+	return nil
+}
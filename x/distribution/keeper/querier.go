@@ -42,6 +42,15 @@ func NewQuerier(k Keeper, legacyQuerierCdc codec.JSONMarshaler) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k, legacyQuerierCdc)
 
+		case types.QueryDelegatorAutoRestakes:
+			return queryDelegatorAutoRestakes(ctx, path[1:], req, k, legacyQuerierCdc)
+
+		case types.QueryContinuousFund:
+			return queryContinuousFund(ctx, path[1:], req, k, legacyQuerierCdc)
+
+		case types.QueryContinuousFunds:
+			return queryContinuousFunds(ctx, path[1:], req, k, legacyQuerierCdc)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -254,3 +263,51 @@ func queryCommunityPool(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keep
 
 	return bz, nil
 }
+
+func queryDelegatorAutoRestakes(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validators := k.GetDelegatorAutoRestakeValidators(ctx, params.DelegatorAddress)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, validators)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryContinuousFund(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryContinuousFundParams
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	fund, found := k.GetContinuousFund(ctx, params.Recipient)
+	if !found {
+		return nil, types.ErrNoContinuousFund
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, fund)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryContinuousFunds(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	funds := k.GetAllContinuousFunds(ctx)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, funds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
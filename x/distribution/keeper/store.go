@@ -43,6 +43,111 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// check whether the rewards of a delegation are automatically restaked
+func (k Keeper) HasDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// set the automatic restaking of the rewards of a delegation
+func (k Keeper) SetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorAutoRestakeKey(delAddr, valAddr), []byte{})
+}
+
+// delete the automatic restaking of the rewards of a delegation
+func (k Keeper) DeleteDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over the delegations whose rewards are automatically restaked
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetDelegatorAutoRestakeAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the validators a delegator automatically restakes the rewards with
+func (k Keeper) GetDelegatorAutoRestakeValidators(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoRestakePrefix(delAddr))
+	defer iter.Close()
+
+	vals := []sdk.ValAddress{}
+	for ; iter.Valid(); iter.Next() {
+		_, val := types.GetDelegatorAutoRestakeAddresses(iter.Key())
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// get the key of the last auto-restaked delegation, nil if none
+func (k Keeper) GetAutoRestakeCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.AutoRestakeCursorKey)
+}
+
+// set the key of the last auto-restaked delegation
+func (k Keeper) SetAutoRestakeCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoRestakeCursorKey, key)
+}
+
+// get the continuous fund of a recipient
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(recipient))
+	if b == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &fund)
+	return fund, true
+}
+
+// set the continuous fund of a recipient
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&fund)
+	store.Set(types.GetContinuousFundKey(fund.Recipient), b)
+}
+
+// delete the continuous fund of a recipient
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(recipient))
+}
+
+// iterate over the continuous funds
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// get all the continuous funds
+func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) []types.ContinuousFund {
+	funds := []types.ContinuousFund{}
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+	return funds
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
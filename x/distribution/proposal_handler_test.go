@@ -2,6 +2,7 @@ package distribution_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -71,3 +72,39 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestContinuousFundProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(1000, 0).UTC()})
+
+	recipient := delAddr1
+	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
+
+	// the end time must be after the block time
+	tp := types.NewCommunityPoolContinuousFundProposal("Test", "description", recipient, amount, ctx.BlockTime())
+	require.True(t, types.ErrInvalidProposalEndTime.Is(hdlr(ctx, tp)))
+
+	endTime := ctx.BlockTime().Add(time.Hour)
+	tp = types.NewCommunityPoolContinuousFundProposal("Test", "description", recipient, amount, endTime)
+	require.NoError(t, hdlr(ctx, tp))
+
+	fund, found := app.DistrKeeper.GetContinuousFund(ctx, recipient)
+	require.True(t, found)
+	require.Equal(t, types.NewContinuousFund(recipient, amount, endTime), fund)
+
+	// a recipient has a single continuous fund
+	require.True(t, types.ErrContinuousFundExists.Is(hdlr(ctx, tp)))
+
+	// module accounts cannot be funded
+	distrAddr := app.DistrKeeper.GetDistributionAccount(ctx).GetAddress()
+	tp = types.NewCommunityPoolContinuousFundProposal("Test", "description", distrAddr, amount, endTime)
+	require.Error(t, hdlr(ctx, tp))
+
+	cp := types.NewCancelCommunityPoolContinuousFundProposal("Test", "description", recipient)
+	require.NoError(t, hdlr(ctx, cp))
+	_, found = app.DistrKeeper.GetContinuousFund(ctx, recipient)
+	require.False(t, found)
+
+	// there is nothing left to cancel
+	require.True(t, types.ErrNoContinuousFund.Is(hdlr(ctx, cp)))
+}
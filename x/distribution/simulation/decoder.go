@@ -63,6 +63,22 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorAutoRestakePrefix):
+			delA, valA := types.GetDelegatorAutoRestakeAddresses(kvA.Key)
+			delB, valB := types.GetDelegatorAutoRestakeAddresses(kvB.Key)
+			return fmt.Sprintf("%v %v\n%v %v", delA, valA, delB, valB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeCursorKey):
+			delA, valA := types.GetDelegatorAutoRestakeAddresses(kvA.Value)
+			delB, valB := types.GetDelegatorAutoRestakeAddresses(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delA, valA, delB, valB)
+
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &fundA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
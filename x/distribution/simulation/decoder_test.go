@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -34,6 +35,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	continuousFund := types.NewContinuousFund(delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), time.Now().UTC())
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.FeePoolKey, Value: cdc.MustMarshalBinaryBare(&feePool)},
@@ -45,6 +47,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		kv.Pair{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 		kv.Pair{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 		kv.Pair{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+		kv.Pair{Key: types.GetDelegatorAutoRestakeKey(delAddr1, valAddr1), Value: []byte{}},
+		kv.Pair{Key: types.AutoRestakeCursorKey, Value: types.GetDelegatorAutoRestakeKey(delAddr1, valAddr1)},
+		kv.Pair{Key: types.GetContinuousFundKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&continuousFund)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -61,6 +66,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorAutoRestake", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"AutoRestakeCursor", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"ContinuousFund", fmt.Sprintf("%v\n%v", continuousFund, continuousFund)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
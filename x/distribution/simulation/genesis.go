@@ -19,6 +19,8 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	MaxAutoRestakesPerBlock = "max_auto_restakes_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +43,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMaxAutoRestakesPerBlock randomized MaxAutoRestakesPerBlock
+func GenMaxAutoRestakesPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(20))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,6 +74,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var maxAutoRestakesPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoRestakesPerBlock, &maxAutoRestakesPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoRestakesPerBlock = GenMaxAutoRestakesPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -74,6 +87,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			MaxAutoRestakesPerBlock: maxAutoRestakesPerBlock,
 		},
 	}
 
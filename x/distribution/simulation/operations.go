@@ -20,8 +20,10 @@ import (
 const (
 	OpWeightMsgSetWithdrawAddress          = "op_weight_msg_set_withdraw_address"
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
+	OpWeightMsgWithdrawAllDelegatorRewards = "op_weight_msg_withdraw_all_delegator_rewards"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSetAutoRestake              = "op_weight_msg_set_auto_restake"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -44,6 +46,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgWithdrawAllDelegatorRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawAllDelegatorRewards, &weightMsgWithdrawAllDelegatorRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawAllDelegatorRewards = simappparams.DefaultWeightMsgWithdrawAllDelegatorRewards
+		},
+	)
+
 	var weightMsgWithdrawValidatorCommission int
 	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawValidatorCommission, &weightMsgWithdrawValidatorCommission, nil,
 		func(_ *rand.Rand) {
@@ -58,6 +67,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetAutoRestake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoRestake, &weightMsgSetAutoRestake, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoRestake = simappparams.DefaultWeightMsgSetAutoRestake
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -67,6 +83,10 @@ func WeightedOperations(
 			weightMsgWithdrawDelegationReward,
 			SimulateMsgWithdrawDelegatorReward(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawAllDelegatorRewards,
+			SimulateMsgWithdrawAllDelegatorRewards(ak, bk, k, sk),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgWithdrawValidatorCommission,
 			SimulateMsgWithdrawValidatorCommission(ak, bk, k, sk),
@@ -75,6 +95,10 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoRestake,
+			SimulateMsgSetAutoRestake(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -176,6 +200,53 @@ func SimulateMsgWithdrawDelegatorReward(ak types.AccountKeeper, bk types.BankKee
 	}
 }
 
+// SimulateMsgWithdrawAllDelegatorRewards generates a MsgWithdrawAllDelegatorRewards with random values.
+func SimulateMsgWithdrawAllDelegatorRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// the commission can only be withdrawn by validator operators
+		withdrawCommission := r.Intn(2) == 0 && sk.Validator(ctx, sdk.ValAddress(simAccount.Address)) != nil
+		if !withdrawCommission && len(sk.GetAllDelegatorDelegations(ctx, simAccount.Address)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawAllDelegatorRewards, "number of delegations equal 0"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawAllDelegatorRewards, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgWithdrawAllDelegatorRewards(simAccount.Address, withdrawCommission)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgWithdrawValidatorCommission generates a MsgWithdrawValidatorCommission with random values.
 func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
 	return func(
@@ -285,3 +356,52 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgSetAutoRestake generates a MsgSetAutoRestake with random values.
+func SimulateMsgSetAutoRestake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "number of delegators equal 0"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "unable to generate fees"), nil, err
+		}
+
+		// enable the automatic restaking most of the time so that it is exercised
+		enabled := r.Intn(4) != 0
+		msg := types.NewMsgSetAutoRestake(simAccount.Address, delegation.GetValidatorAddr(), enabled)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -39,8 +39,10 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 	}{
 		{simappparams.DefaultWeightMsgSetWithdrawAddress, types.ModuleName, types.TypeMsgSetWithdrawAddress},
 		{simappparams.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, types.TypeMsgWithdrawDelegatorReward},
+		{simappparams.DefaultWeightMsgWithdrawAllDelegatorRewards, types.ModuleName, types.TypeMsgWithdrawAllDelegatorRewards},
 		{simappparams.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, types.TypeMsgWithdrawValidatorCommission},
 		{simappparams.DefaultWeightMsgFundCommunityPool, types.ModuleName, types.TypeMsgFundCommunityPool},
+		{simappparams.DefaultWeightMsgSetAutoRestake, types.ModuleName, types.TypeMsgSetAutoRestake},
 	}
 
 	for i, w := range weightesOps {
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +12,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
-const OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+// Simulation operation weights constants
+const (
+	// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
+	OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+	// OpWeightSubmitCommunityContinuousFundProposal app params key for community continuous fund proposal
+	OpWeightSubmitCommunityContinuousFundProposal = "op_weight_submit_community_continuous_fund_proposal"
+	// OpWeightSubmitCancelCommunityContinuousFundProposal app params key for community continuous fund cancellation proposal
+	OpWeightSubmitCancelCommunityContinuousFundProposal = "op_weight_submit_cancel_community_continuous_fund_proposal"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
@@ -22,6 +30,16 @@ func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
 			simappparams.DefaultWeightCommunitySpendProposal,
 			SimulateCommunityPoolSpendProposalContent(k),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitCommunityContinuousFundProposal,
+			simappparams.DefaultWeightCommunityContinuousFundProposal,
+			SimulateCommunityPoolContinuousFundProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitCancelCommunityContinuousFundProposal,
+			simappparams.DefaultWeightCancelCommunityContinuousFundProposal,
+			SimulateCancelCommunityPoolContinuousFundProposalContent(k),
+		),
 	}
 }
 
@@ -49,3 +67,50 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simtypes.Content
 		)
 	}
 }
+
+// SimulateCommunityPoolContinuousFundProposalContent generates random community-pool-continuous-fund proposal content
+func SimulateCommunityPoolContinuousFundProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		balance := k.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+
+		// stream a small fraction of the pool every block
+		denomIndex := r.Intn(len(balance))
+		amountPerBlock, err := simtypes.RandPositiveInt(r, balance[denomIndex].Amount.QuoInt64(1000).TruncateInt())
+		if err != nil {
+			return nil
+		}
+
+		endTime := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 7*24)) * time.Hour)
+
+		return types.NewCommunityPoolContinuousFundProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			simAccount.Address,
+			sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amountPerBlock)),
+			endTime,
+		)
+	}
+}
+
+// SimulateCancelCommunityPoolContinuousFundProposalContent generates random cancel-community-pool-continuous-fund proposal content
+func SimulateCancelCommunityPoolContinuousFundProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		funds := k.GetAllContinuousFunds(ctx)
+		if len(funds) == 0 {
+			return nil
+		}
+
+		fund := funds[r.Intn(len(funds))]
+
+		return types.NewCancelCommunityPoolContinuousFundProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			fund.Recipient,
+		)
+	}
+}
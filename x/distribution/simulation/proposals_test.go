@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestProposalContents(t *testing.T) {
@@ -26,9 +27,11 @@ func TestProposalContents(t *testing.T) {
 
 	// execute ProposalContents function
 	weightedProposalContent := simulation.ProposalContents(app.DistrKeeper)
-	require.Len(t, weightedProposalContent, 1)
+	require.Len(t, weightedProposalContent, 3)
 
 	w0 := weightedProposalContent[0]
+	w1 := weightedProposalContent[1]
+	w2 := weightedProposalContent[2]
 
 	// tests w0 interface:
 	require.Equal(t, simulation.OpWeightSubmitCommunitySpendProposal, w0.AppParamsKey())
@@ -46,4 +49,37 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "xKGLwQvuyN", content.GetTitle())
 	require.Equal(t, "distribution", content.ProposalRoute())
 	require.Equal(t, "CommunityPoolSpend", content.ProposalType())
+
+	// tests w1 interface:
+	require.Equal(t, simulation.OpWeightSubmitCommunityContinuousFundProposal, w1.AppParamsKey())
+	require.Equal(t, simappparams.DefaultWeightCommunityContinuousFundProposal, w1.DefaultWeight())
+
+	// the pool is too small to stream a fraction of it
+	require.Nil(t, w1.ContentSimulatorFn()(r, ctx, accounts))
+
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	content = w1.ContentSimulatorFn()(r, ctx, accounts)
+	fundProposal, ok := content.(*types.CommunityPoolContinuousFundProposal)
+	require.True(t, ok)
+	require.Equal(t, "distribution", content.ProposalRoute())
+	require.Equal(t, "CommunityPoolContinuousFund", content.ProposalType())
+	require.True(t, fundProposal.AmountPerBlock.IsAllPositive())
+	require.True(t, fundProposal.EndTime.After(ctx.BlockTime()))
+
+	// tests w2 interface:
+	require.Equal(t, simulation.OpWeightSubmitCancelCommunityContinuousFundProposal, w2.AppParamsKey())
+	require.Equal(t, simappparams.DefaultWeightCancelCommunityContinuousFundProposal, w2.DefaultWeight())
+
+	// there is no fund to cancel
+	require.Nil(t, w2.ContentSimulatorFn()(r, ctx, accounts))
+
+	app.DistrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(fundProposal.Recipient, fundProposal.AmountPerBlock, fundProposal.EndTime))
+
+	content = w2.ContentSimulatorFn()(r, ctx, accounts)
+	cancelProposal, ok := content.(*types.CancelCommunityPoolContinuousFundProposal)
+	require.True(t, ok)
+	require.Equal(t, "CancelCommunityPoolContinuousFund", content.ProposalType())
+	require.Equal(t, fundProposal.Recipient, cancelProposal.Recipient)
 }
@@ -12,23 +12,33 @@ import (
 // on the provided Amino codec. These types are used for Amino JSON serialization.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllDelegationRewards", nil)
 	cdc.RegisterConcrete(&MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
 	cdc.RegisterConcrete(&MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeReward", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolContinuousFundProposal{}, "cosmos-sdk/CommunityPoolContinuousFundProposal", nil)
+	cdc.RegisterConcrete(&CancelCommunityPoolContinuousFundProposal{}, "cosmos-sdk/CancelCommunityPoolContinuousFundProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgWithdrawDelegatorReward{},
+		&MsgWithdrawAllDelegatorRewards{},
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgSetAutoRestake{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CommunityPoolSpendProposal{},
+		&CommunityPoolContinuousFundProposal{},
+		&CancelCommunityPoolContinuousFundProposal{},
 	)
 }
 
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewContinuousFund creates a new ContinuousFund
+func NewContinuousFund(recipient sdk.AccAddress, amountPerBlock sdk.Coins, endTime time.Time) ContinuousFund {
+	return ContinuousFund{
+		Recipient:      recipient,
+		AmountPerBlock: amountPerBlock,
+		EndTime:        endTime,
+	}
+}
+
+// IsExpired returns true if the continuous fund has nothing left to pay at
+// the given block time
+func (cf ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(cf.EndTime)
+}
+
+// Validate performs a stateless validation of the continuous fund
+func (cf ContinuousFund) Validate() error {
+	if cf.Recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if !cf.AmountPerBlock.IsValid() || cf.AmountPerBlock.IsZero() {
+		return ErrInvalidProposalAmount
+	}
+	if cf.EndTime.IsZero() {
+		return ErrInvalidProposalEndTime
+	}
+
+	return nil
+}
+
+func (cf ContinuousFund) String() string {
+	out := fmt.Sprintf(`Continuous Fund:
+  Recipient:        %s
+  Amount Per Block: %s
+  End Time:         %s
+`, cf.Recipient, cf.AmountPerBlock, cf.EndTime)
+	return strings.TrimSpace(out)
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -132,6 +136,61 @@ func (m *MsgWithdrawDelegatorReward) GetValidatorAddress() github_com_cosmos_cos
 	return nil
 }
 
+// MsgWithdrawAllDelegatorRewards defines a Msg type that withdraws the rewards
+// of all the delegations of a delegator, and optionally the commission of the
+// validator it operates.
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	WithdrawCommission bool                                          `protobuf:"varint,2,opt,name=withdraw_commission,json=withdrawCommission,proto3" json:"withdraw_commission,omitempty" yaml:"withdraw_commission"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{2}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewards) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) GetWithdrawCommission() bool {
+	if m != nil {
+		return m.WithdrawCommission
+	}
+	return false
+}
+
 // msg struct for validator withdraw
 type MsgWithdrawValidatorCommission struct {
 	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
@@ -141,7 +200,7 @@ func (m *MsgWithdrawValidatorCommission) Reset()         { *m = MsgWithdrawValid
 func (m *MsgWithdrawValidatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{2}
+	return fileDescriptor_49870d4e3df20cf9, []int{3}
 }
 func (m *MsgWithdrawValidatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,6 +236,52 @@ func (m *MsgWithdrawValidatorCommission) GetValidatorAddress() github_com_cosmos
 	return nil
 }
 
+// MsgWithdrawTokenizeShareRecordReward defines a Msg type that withdraws the
+// rewards of all the tokenize share records owned by an account.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner_address,omitempty" yaml:"owner_address"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{4}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordReward) GetOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
 // MsgFundCommunityPool defines a Msg type that allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -188,7 +293,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{3}
+	return fileDescriptor_49870d4e3df20cf9, []int{5}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,18 +336,81 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// MsgSetAutoRestake defines a Msg type that enables or disables the automatic
+// restaking of the rewards of a delegation.
+type MsgSetAutoRestake struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Enabled          bool                                          `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{6}
+}
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+func (m *MsgSetAutoRestake) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoRestake) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoRestake) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 // Params defines the set of distribution parameters.
 type Params struct {
-	CommunityTax        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
-	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
-	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
-	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	CommunityTax            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
+	BaseProposerReward      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
+	BonusProposerReward     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
+	WithdrawAddrEnabled     bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	MaxAutoRestakesPerBlock uint32                                 `protobuf:"varint,5,opt,name=max_auto_restakes_per_block,json=maxAutoRestakesPerBlock,proto3" json:"max_auto_restakes_per_block,omitempty" yaml:"max_auto_restakes_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{4}
+	return fileDescriptor_49870d4e3df20cf9, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +446,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxAutoRestakesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoRestakesPerBlock
+	}
+	return 0
+}
+
 // historical rewards for a validator
 // height is implicit within the store key
 // cumulative reward ratio is the sum from the zeroeth period
@@ -286,10 +461,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // which might need to reference this historical entry
 // at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -299,7 +475,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{5}
+	return fileDescriptor_49870d4e3df20cf9, []int{8}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +530,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{6}
+	return fileDescriptor_49870d4e3df20cf9, []int{9}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +583,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{7}
+	return fileDescriptor_49870d4e3df20cf9, []int{10}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +629,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{8}
+	return fileDescriptor_49870d4e3df20cf9, []int{11}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +678,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{9}
+	return fileDescriptor_49870d4e3df20cf9, []int{12}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +722,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{10}
+	return fileDescriptor_49870d4e3df20cf9, []int{13}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +767,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{11}
+	return fileDescriptor_49870d4e3df20cf9, []int{14}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +814,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{12}
+	return fileDescriptor_49870d4e3df20cf9, []int{15}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,6 +843,151 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// CommunityPoolContinuousFundProposal streams a fixed amount from the community
+// pool to a recipient every block until the end time
+type CommunityPoolContinuousFundProposal struct {
+	Title          string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	AmountPerBlock github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=amount_per_block,json=amountPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_block" yaml:"amount_per_block"`
+	EndTime        time.Time                                     `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *CommunityPoolContinuousFundProposal) Reset()      { *m = CommunityPoolContinuousFundProposal{} }
+func (*CommunityPoolContinuousFundProposal) ProtoMessage() {}
+func (*CommunityPoolContinuousFundProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{16}
+}
+func (m *CommunityPoolContinuousFundProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolContinuousFundProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolContinuousFundProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolContinuousFundProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolContinuousFundProposal.Merge(m, src)
+}
+func (m *CommunityPoolContinuousFundProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolContinuousFundProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolContinuousFundProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolContinuousFundProposal proto.InternalMessageInfo
+
+// CancelCommunityPoolContinuousFundProposal cancels the continuous fund of a
+// recipient
+type CancelCommunityPoolContinuousFundProposal struct {
+	Title       string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+}
+
+func (m *CancelCommunityPoolContinuousFundProposal) Reset() {
+	*m = CancelCommunityPoolContinuousFundProposal{}
+}
+func (*CancelCommunityPoolContinuousFundProposal) ProtoMessage() {}
+func (*CancelCommunityPoolContinuousFundProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{17}
+}
+func (m *CancelCommunityPoolContinuousFundProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommunityPoolContinuousFundProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommunityPoolContinuousFundProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelCommunityPoolContinuousFundProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommunityPoolContinuousFundProposal.Merge(m, src)
+}
+func (m *CancelCommunityPoolContinuousFundProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommunityPoolContinuousFundProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommunityPoolContinuousFundProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCommunityPoolContinuousFundProposal proto.InternalMessageInfo
+
+// ContinuousFund defines a fixed amount paid from the community pool to a
+// recipient every block until the end time
+type ContinuousFund struct {
+	Recipient      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	AmountPerBlock github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=amount_per_block,json=amountPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_block" yaml:"amount_per_block"`
+	EndTime        time.Time                                     `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *ContinuousFund) Reset()      { *m = ContinuousFund{} }
+func (*ContinuousFund) ProtoMessage() {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{18}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetAmountPerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerBlock
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // starting info for a delegator reward period
 // tracks the previous validator period, the delegation's amount
 // of staking token, and the creation height (to check later on
@@ -684,7 +1005,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{13}
+	return fileDescriptor_49870d4e3df20cf9, []int{19}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +1059,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{14}
+	return fileDescriptor_49870d4e3df20cf9, []int{20}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,8 +1105,11 @@ func (m *DelegationDelegatorReward) GetReward() github_com_cosmos_cosmos_sdk_typ
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.MsgWithdrawDelegatorReward")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "cosmos.distribution.MsgWithdrawAllDelegatorRewards")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.MsgWithdrawValidatorCommission")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.MsgWithdrawTokenizeShareRecordReward")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.MsgFundCommunityPool")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "cosmos.distribution.MsgSetAutoRestake")
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.ValidatorCurrentRewards")
@@ -795,6 +1119,9 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.CommunityPoolSpendProposal")
+	proto.RegisterType((*CommunityPoolContinuousFundProposal)(nil), "cosmos.distribution.CommunityPoolContinuousFundProposal")
+	proto.RegisterType((*CancelCommunityPoolContinuousFundProposal)(nil), "cosmos.distribution.CancelCommunityPoolContinuousFundProposal")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.ContinuousFund")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.DelegationDelegatorReward")
 }
@@ -804,78 +1131,97 @@ func init() {
 }
 
 var fileDescriptor_49870d4e3df20cf9 = []byte{
-	// 1126 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xb8, 0xae, 0x9b, 0x4c, 0xd3, 0xa4, 0xdd, 0xd8, 0x49, 0x70, 0xc0, 0x1b, 0x8d, 0x44,
-	0x15, 0x09, 0xc5, 0xa1, 0xf4, 0x96, 0x03, 0x52, 0x9c, 0x0f, 0x01, 0x6a, 0x48, 0xb4, 0x09, 0x41,
-	0x42, 0x08, 0x6b, 0xbc, 0x3b, 0x71, 0x46, 0x59, 0xef, 0xac, 0x66, 0xc6, 0xf9, 0xe8, 0x05, 0xa9,
-	0xe2, 0xeb, 0x80, 0x44, 0x91, 0x38, 0x70, 0x40, 0xa8, 0x07, 0x0e, 0xd0, 0x7f, 0x82, 0x6b, 0x8f,
-	0xbd, 0x81, 0x38, 0xb8, 0x28, 0xb9, 0x71, 0x8c, 0xc4, 0x01, 0x4e, 0x68, 0x67, 0x66, 0x77, 0x6d,
-	0xc7, 0x85, 0x38, 0x8a, 0xe8, 0xcd, 0xfb, 0xe6, 0xcd, 0xef, 0xf7, 0x9b, 0xf7, 0xde, 0xbc, 0x37,
-	0x86, 0xb7, 0x5d, 0x26, 0x9a, 0x4c, 0xcc, 0x7b, 0x54, 0x48, 0x4e, 0xeb, 0x2d, 0x49, 0x59, 0xd0,
-	0xf5, 0x51, 0x09, 0x39, 0x93, 0xcc, 0x1a, 0xd7, 0x7e, 0x95, 0xce, 0xa5, 0x52, 0xa1, 0xc1, 0x1a,
-	0x4c, 0xad, 0xcf, 0x47, 0xbf, 0xb4, 0x6b, 0xc9, 0xb8, 0xce, 0x9b, 0x1d, 0xca, 0x88, 0xbe, 0xcc,
-	0xc2, 0xe2, 0x9a, 0x68, 0x6c, 0x12, 0xf9, 0x3e, 0x95, 0xbb, 0x1e, 0xc7, 0x07, 0x8b, 0x9e, 0xc7,
-	0x89, 0x10, 0xd6, 0x7d, 0x78, 0xcb, 0x23, 0x3e, 0x69, 0x60, 0xc9, 0x78, 0x0d, 0x6b, 0xe3, 0x14,
-	0x98, 0x01, 0xb3, 0x23, 0xd5, 0xb5, 0xd3, 0xb6, 0x3d, 0x75, 0x84, 0x9b, 0xfe, 0x02, 0x3a, 0xe3,
-	0x82, 0xfe, 0x6e, 0xdb, 0x73, 0x0d, 0x2a, 0x77, 0x5b, 0xf5, 0x8a, 0xcb, 0x9a, 0xf3, 0x5d, 0xa4,
-	0x73, 0xc2, 0xdb, 0x9b, 0x97, 0x47, 0x21, 0x11, 0x95, 0x45, 0xd7, 0x35, 0x4c, 0xce, 0xcd, 0x04,
-	0x24, 0xe6, 0x3e, 0x80, 0x37, 0x0f, 0x8c, 0x9c, 0x84, 0x3a, 0xab, 0xa8, 0xef, 0x9d, 0xb6, 0xed,
-	0x49, 0x4d, 0xdd, 0xeb, 0x71, 0x01, 0xe6, 0xb1, 0x83, 0xee, 0x43, 0xa3, 0x6f, 0xb2, 0xb0, 0xb4,
-	0x26, 0x1a, 0x71, 0x2c, 0x96, 0x63, 0x61, 0x0e, 0x39, 0xc0, 0xdc, 0x7b, 0xa1, 0x31, 0xb9, 0x0f,
-	0x6f, 0xed, 0x63, 0x9f, 0x7a, 0x5d, 0xdc, 0xd9, 0x5e, 0xee, 0x33, 0x2e, 0xe7, 0xe5, 0xde, 0xc6,
-	0x7e, 0xc2, 0x9d, 0x80, 0xc4, 0x61, 0xf9, 0x0e, 0xc0, 0x72, 0x47, 0x58, 0xb6, 0xe3, 0xf5, 0x25,
-	0xd6, 0x6c, 0x52, 0x21, 0x28, 0x0b, 0xfa, 0xcb, 0x03, 0xff, 0x8f, 0xbc, 0x9f, 0x01, 0x2c, 0xac,
-	0x89, 0xc6, 0x6a, 0x2b, 0xf0, 0x22, 0x45, 0xad, 0x80, 0xca, 0xa3, 0x0d, 0xc6, 0x7c, 0x6b, 0x1b,
-	0xe6, 0x71, 0x93, 0xb5, 0x02, 0x39, 0x05, 0x66, 0xae, 0xcc, 0x5e, 0x7f, 0x63, 0xa4, 0x62, 0x8a,
-	0x7f, 0x89, 0xd1, 0xa0, 0xfa, 0xfa, 0x93, 0xb6, 0x9d, 0x79, 0xfc, 0xcc, 0x9e, 0x3d, 0x07, 0x7f,
-	0xb4, 0x41, 0x38, 0x06, 0xcd, 0x5a, 0x87, 0xc3, 0x1e, 0x09, 0x99, 0xa0, 0x92, 0x71, 0x93, 0x83,
-	0x3b, 0x83, 0xe7, 0x38, 0xc5, 0x40, 0xbf, 0x5c, 0x81, 0xf9, 0x0d, 0xcc, 0x71, 0x53, 0x58, 0x7b,
-	0xf0, 0x86, 0x1b, 0x1f, 0xa2, 0x26, 0xf1, 0xa1, 0x0a, 0xe2, 0x70, 0x75, 0x35, 0x12, 0xfb, 0x5b,
-	0xdb, 0xbe, 0x7d, 0x0e, 0x8e, 0x65, 0xe2, 0x9e, 0xb6, 0xed, 0x82, 0x0e, 0x79, 0x17, 0x18, 0x72,
-	0x46, 0x92, 0xef, 0x2d, 0x7c, 0x68, 0x7d, 0x0c, 0x0b, 0x75, 0x2c, 0x48, 0x2d, 0xe4, 0x2c, 0x64,
-	0x82, 0xf0, 0x1a, 0x57, 0x85, 0xae, 0xce, 0x34, 0x5c, 0x5d, 0x1b, 0x98, 0x73, 0x5a, 0x73, 0xf6,
-	0xc3, 0x44, 0x8e, 0x15, 0x99, 0x37, 0x8c, 0xd5, 0xdc, 0xa8, 0x07, 0x00, 0x16, 0xeb, 0x2c, 0x68,
-	0x89, 0x33, 0x12, 0xae, 0x28, 0x09, 0xef, 0x0e, 0x2c, 0xe1, 0x65, 0x23, 0xa1, 0x1f, 0x28, 0x72,
-	0xc6, 0x95, 0xbd, 0x47, 0xc4, 0x16, 0x2c, 0x76, 0x35, 0x93, 0x1a, 0x09, 0x70, 0xdd, 0x27, 0xde,
-	0x54, 0x6e, 0x06, 0xcc, 0x0e, 0x55, 0x67, 0x52, 0xd4, 0xbe, 0x6e, 0xc8, 0x19, 0xef, 0xec, 0x23,
-	0x2b, 0xda, 0xba, 0x90, 0xfb, 0xf6, 0x91, 0x9d, 0x41, 0x0f, 0xb2, 0xb0, 0x94, 0xdc, 0x97, 0xb7,
-	0xa8, 0x90, 0x8c, 0x53, 0x17, 0xfb, 0x9a, 0x59, 0x58, 0xdf, 0x03, 0x38, 0xe9, 0xb6, 0x9a, 0x2d,
-	0x1f, 0x4b, 0xba, 0x4f, 0x8c, 0xcc, 0x1a, 0xc7, 0x92, 0x32, 0x53, 0xb3, 0x63, 0x71, 0xcd, 0x2e,
-	0x13, 0x57, 0x95, 0xed, 0x7b, 0x51, 0x48, 0x4e, 0xdb, 0x76, 0xd9, 0xe4, 0xb7, 0xff, 0x6e, 0xf4,
-	0xf8, 0x99, 0xfd, 0xda, 0xf9, 0x82, 0xa6, 0x6b, 0xbb, 0x98, 0x02, 0x69, 0x71, 0x4e, 0x04, 0x63,
-	0x2d, 0xc1, 0x31, 0x4e, 0x76, 0x08, 0x27, 0x81, 0x4b, 0x6a, 0xae, 0xba, 0x4b, 0x51, 0x71, 0xdc,
-	0xa8, 0x96, 0x4e, 0xdb, 0xf6, 0x84, 0x96, 0xd0, 0xe3, 0x80, 0x9c, 0xd1, 0xc4, 0xb2, 0xa4, 0x0c,
-	0x5f, 0x03, 0x38, 0x99, 0x36, 0x8d, 0x16, 0xe7, 0x24, 0x90, 0x71, 0x04, 0x3e, 0x82, 0xd7, 0xb4,
-	0x6e, 0xf1, 0xbc, 0x03, 0xdf, 0x35, 0xf7, 0x74, 0xa0, 0xe3, 0xc4, 0xa0, 0xd6, 0x04, 0xcc, 0x87,
-	0x84, 0x53, 0xa6, 0x8b, 0x3a, 0xe7, 0x98, 0x2f, 0xf4, 0x19, 0x80, 0xe5, 0x44, 0xd3, 0xa2, 0x6b,
-	0x4e, 0x4f, 0xbc, 0x8e, 0x9e, 0xe6, 0x41, 0xe8, 0x26, 0x5f, 0x97, 0xaa, 0xae, 0x03, 0x17, 0x7d,
-	0x05, 0xe0, 0x74, 0x22, 0x64, 0xbd, 0x25, 0x85, 0xc4, 0x81, 0x47, 0x83, 0x46, 0x1c, 0xa0, 0xf0,
-	0x3f, 0x03, 0xb4, 0x62, 0x2a, 0x62, 0x34, 0x4e, 0x87, 0xf2, 0x46, 0x17, 0x0d, 0x19, 0xfa, 0x09,
-	0xc0, 0xf1, 0x44, 0xd1, 0xa6, 0x8f, 0xc5, 0xee, 0xca, 0x3e, 0x09, 0xa4, 0xb5, 0x0a, 0xd3, 0xde,
-	0x5b, 0x33, 0x41, 0x8d, 0xba, 0x53, 0xae, 0x3a, 0x9d, 0x8e, 0xe5, 0x5e, 0x0f, 0xe4, 0x8c, 0x25,
-	0xa6, 0x0d, 0x65, 0xb1, 0xde, 0x81, 0x43, 0x3b, 0x1c, 0xbb, 0xd1, 0x5b, 0xc5, 0x74, 0x9a, 0xca,
-	0x60, 0xd7, 0xdc, 0x49, 0xf6, 0xa3, 0x1f, 0x00, 0x2c, 0xf4, 0xd1, 0x2a, 0xac, 0x4f, 0x01, 0x9c,
-	0x48, 0xb5, 0x88, 0x68, 0xa5, 0x46, 0xd4, 0x92, 0x09, 0xe3, 0x6c, 0xa5, 0xcf, 0xdb, 0xa9, 0xd2,
-	0x07, 0xab, 0xfa, 0xaa, 0x89, 0xef, 0x2b, 0xbd, 0x27, 0xec, 0x44, 0x45, 0x4e, 0x61, 0xbf, 0x8f,
-	0x0e, 0xd3, 0x06, 0x1e, 0x02, 0x78, 0x6d, 0x95, 0x10, 0x35, 0x95, 0x3e, 0x01, 0x70, 0x34, 0xed,
-	0xca, 0x21, 0x63, 0xfe, 0xf3, 0x12, 0x7b, 0xcf, 0x10, 0x17, 0x7b, 0x5b, 0x79, 0xb4, 0x69, 0xe0,
-	0xfc, 0xa6, 0x73, 0x25, 0x92, 0x81, 0x3e, 0xcf, 0xc2, 0x52, 0xd7, 0xb8, 0xdc, 0x0c, 0x49, 0xe0,
-	0xe9, 0xd6, 0x88, 0x7d, 0xab, 0x00, 0xaf, 0x4a, 0x2a, 0x7d, 0xa2, 0xe7, 0x8f, 0xa3, 0x3f, 0xac,
-	0x19, 0x78, 0xdd, 0x23, 0xc2, 0xe5, 0x34, 0x4c, 0xb3, 0xe7, 0x74, 0x9a, 0xa2, 0xd9, 0xc8, 0x89,
-	0x4b, 0x43, 0x4a, 0x02, 0xa9, 0x9a, 0xf8, 0xc5, 0x66, 0x63, 0x82, 0xd1, 0x31, 0xc4, 0x73, 0x97,
-	0x39, 0xc4, 0x17, 0x86, 0xbe, 0x78, 0x64, 0x67, 0x54, 0x72, 0xfe, 0x02, 0xb0, 0x98, 0x3c, 0xf5,
-	0x36, 0x25, 0xe6, 0x92, 0x06, 0x8d, 0xb7, 0x83, 0x1d, 0xd5, 0xfd, 0x42, 0x4e, 0xf6, 0x29, 0x8b,
-	0x66, 0x49, 0x67, 0xc1, 0x77, 0x74, 0xbf, 0x1e, 0x07, 0xe4, 0x8c, 0xc6, 0x16, 0x53, 0xee, 0x5b,
-	0xf0, 0xaa, 0x90, 0x78, 0x8f, 0x98, 0x5a, 0x7f, 0x73, 0xe0, 0x91, 0x36, 0xa2, 0x89, 0x14, 0x08,
-	0x72, 0x34, 0x98, 0xb5, 0x02, 0xf3, 0xbb, 0x84, 0x36, 0x76, 0x75, 0x90, 0x73, 0xd5, 0xb9, 0x3f,
-	0xda, 0xf6, 0x98, 0xcb, 0x49, 0xd4, 0xb5, 0x83, 0x9a, 0x5e, 0x4a, 0x45, 0xf6, 0x2c, 0x20, 0xc7,
-	0x6c, 0x46, 0x7f, 0x02, 0xf8, 0x92, 0x39, 0x3b, 0x65, 0x41, 0x9f, 0x07, 0xef, 0x8b, 0x7a, 0xd5,
-	0x59, 0x1f, 0xc2, 0x7c, 0xf2, 0x1a, 0xb9, 0xbc, 0xce, 0x6b, 0x30, 0xab, 0xeb, 0x3f, 0x1e, 0x97,
-	0xc1, 0x93, 0xe3, 0x32, 0x78, 0x7a, 0x5c, 0x06, 0xbf, 0x1f, 0x97, 0xc1, 0xc3, 0x93, 0x72, 0xe6,
-	0xe9, 0x49, 0x39, 0xf3, 0xeb, 0x49, 0x39, 0xf3, 0xc1, 0x9d, 0x7f, 0x85, 0x3c, 0xec, 0xfe, 0x5b,
-	0xa6, 0x18, 0xea, 0x79, 0xf5, 0x87, 0xea, 0xee, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xca,
-	0xc4, 0x1b, 0xba, 0x0d, 0x00, 0x00,
+	// 1435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1c, 0xc5,
+	0x12, 0x77, 0xaf, 0x1d, 0xc7, 0xee, 0x38, 0x76, 0x32, 0x5e, 0xc7, 0xfb, 0xd6, 0xef, 0xed, 0x58,
+	0xfd, 0x20, 0x32, 0x42, 0xd9, 0x25, 0xc9, 0x2d, 0x07, 0x24, 0xaf, 0x63, 0x8b, 0x40, 0x8c, 0xad,
+	0xb1, 0x09, 0x12, 0x42, 0x8c, 0x7a, 0x67, 0xda, 0xeb, 0x96, 0x67, 0xa7, 0x47, 0xdd, 0x3d, 0xb6,
+	0x93, 0x0b, 0x52, 0xf8, 0x3c, 0x20, 0x11, 0x10, 0x42, 0x1c, 0x10, 0xca, 0x01, 0x24, 0xc8, 0xff,
+	0x00, 0x88, 0x5b, 0x8e, 0x39, 0x22, 0x0e, 0x1b, 0xe4, 0xdc, 0x90, 0xb8, 0xac, 0xc4, 0x01, 0x4e,
+	0x68, 0x66, 0x7a, 0xbe, 0x36, 0x9b, 0xe0, 0xb5, 0x0c, 0x06, 0x71, 0xdb, 0xa9, 0xae, 0xae, 0xfa,
+	0xf5, 0xaf, 0xaa, 0xab, 0xaa, 0x17, 0x9e, 0xb5, 0x98, 0x68, 0x31, 0x51, 0xb3, 0xa9, 0x90, 0x9c,
+	0x36, 0x7c, 0x49, 0x99, 0x9b, 0xfb, 0xa8, 0x7a, 0x9c, 0x49, 0xa6, 0x4d, 0x46, 0x7a, 0xd5, 0xec,
+	0x52, 0xb9, 0xd8, 0x64, 0x4d, 0x16, 0xae, 0xd7, 0x82, 0x5f, 0x91, 0x6a, 0x59, 0x6f, 0x32, 0xd6,
+	0x74, 0x48, 0x2d, 0xfc, 0x6a, 0xf8, 0x1b, 0x35, 0x49, 0x5b, 0x44, 0x48, 0xdc, 0xf2, 0x94, 0x82,
+	0xb2, 0x55, 0x53, 0x26, 0x43, 0x21, 0x7a, 0xaf, 0x00, 0xa7, 0x96, 0x45, 0x73, 0x8d, 0xc8, 0x97,
+	0xa9, 0xdc, 0xb4, 0x39, 0xde, 0x99, 0xb7, 0x6d, 0x4e, 0x84, 0xd0, 0x6e, 0xc0, 0xd3, 0x36, 0x71,
+	0x48, 0x13, 0x4b, 0xc6, 0x4d, 0x1c, 0x09, 0x4b, 0x60, 0x16, 0xcc, 0x8d, 0xd5, 0x97, 0x3b, 0x6d,
+	0xbd, 0x74, 0x1d, 0xb7, 0x9c, 0x4b, 0xe8, 0x21, 0x15, 0xf4, 0x5b, 0x5b, 0x3f, 0xd7, 0xa4, 0x72,
+	0xd3, 0x6f, 0x54, 0x2d, 0xd6, 0xaa, 0xe5, 0x9c, 0x9e, 0x13, 0xf6, 0x56, 0x4d, 0x5e, 0xf7, 0x88,
+	0xa8, 0xce, 0x5b, 0x96, 0xf2, 0x64, 0x9c, 0x4a, 0x8c, 0xc4, 0xbe, 0x77, 0xe0, 0xa9, 0x1d, 0x05,
+	0x27, 0x71, 0x5d, 0x08, 0x5d, 0x5f, 0xed, 0xb4, 0xf5, 0xe9, 0xc8, 0x75, 0xb7, 0xc6, 0x01, 0x3c,
+	0x4f, 0xec, 0xe4, 0x0f, 0x8d, 0x3e, 0x2a, 0xc0, 0xf2, 0xb2, 0x68, 0xc6, 0x5c, 0x5c, 0x8e, 0x81,
+	0x19, 0x64, 0x07, 0x73, 0xfb, 0x48, 0x39, 0xb9, 0x01, 0x4f, 0x6f, 0x63, 0x87, 0xda, 0x39, 0xdf,
+	0x85, 0x6e, 0xdf, 0x0f, 0xa9, 0xec, 0xd7, 0xf7, 0x35, 0xec, 0x24, 0xbe, 0x13, 0x23, 0x31, 0x2d,
+	0x3f, 0x03, 0x58, 0xc9, 0xd0, 0x32, 0xef, 0x38, 0x5d, 0xcc, 0x1c, 0x6d, 0xba, 0xac, 0xc0, 0xc9,
+	0x24, 0x19, 0x2c, 0xd6, 0x6a, 0x51, 0x21, 0x28, 0x73, 0x43, 0x72, 0x46, 0xea, 0x95, 0x4e, 0x5b,
+	0x2f, 0x77, 0x65, 0x4c, 0xaa, 0x84, 0x0c, 0x2d, 0x96, 0x2e, 0xa4, 0xc2, 0x4f, 0xf3, 0xe7, 0xbd,
+	0x16, 0xf3, 0x91, 0xaa, 0xf4, 0x0e, 0x07, 0xf8, 0x6b, 0xc2, 0xf1, 0x31, 0x80, 0x4f, 0x64, 0xe0,
+	0xad, 0xb3, 0x2d, 0xe2, 0xd2, 0x1b, 0x64, 0x6d, 0x13, 0x73, 0x62, 0x10, 0x8b, 0x71, 0x5b, 0xe5,
+	0xab, 0x0b, 0x4f, 0xb2, 0x1d, 0x97, 0x74, 0x03, 0xbc, 0xd2, 0x69, 0xeb, 0xc5, 0x08, 0x60, 0x6e,
+	0xf9, 0x00, 0xc1, 0x18, 0x0b, 0x0d, 0xc4, 0xc0, 0xbe, 0x05, 0xb0, 0xb8, 0x2c, 0x9a, 0x4b, 0xbe,
+	0x6b, 0x07, 0x54, 0xf9, 0x2e, 0x95, 0xd7, 0x57, 0x19, 0x73, 0xb4, 0x6b, 0x70, 0x18, 0xb7, 0x98,
+	0xef, 0xca, 0x12, 0x98, 0x1d, 0x9c, 0x3b, 0x71, 0x61, 0xac, 0xaa, 0xaa, 0xd0, 0x02, 0xa3, 0x6e,
+	0xfd, 0x99, 0xbb, 0x6d, 0x7d, 0xe0, 0xce, 0x7d, 0x7d, 0x6e, 0x1f, 0xbe, 0x83, 0x0d, 0xc2, 0x50,
+	0xd6, 0xb4, 0x15, 0x38, 0x6a, 0x13, 0x8f, 0x09, 0x2a, 0x19, 0x57, 0x97, 0xe1, 0x7c, 0xff, 0x87,
+	0x48, 0x6d, 0xa0, 0x2f, 0x0a, 0xf0, 0x74, 0x54, 0x0f, 0xe7, 0x7d, 0xc9, 0x8c, 0xa0, 0x80, 0x6e,
+	0x91, 0x7f, 0xeb, 0xbd, 0xd7, 0x4a, 0xf0, 0x38, 0x71, 0x71, 0xc3, 0x21, 0x76, 0x69, 0x30, 0xb8,
+	0x4c, 0x46, 0xfc, 0x89, 0xbe, 0x1e, 0x82, 0xc3, 0xab, 0x98, 0xe3, 0x96, 0xd0, 0xb6, 0xe0, 0x49,
+	0x2b, 0x0e, 0xb6, 0x29, 0xf1, 0x6e, 0x48, 0xcc, 0x68, 0x7d, 0x29, 0x08, 0xea, 0x0f, 0x6d, 0xfd,
+	0xec, 0x3e, 0x40, 0x5c, 0x26, 0x56, 0x9a, 0x92, 0x39, 0x63, 0xc8, 0x18, 0x4b, 0xbe, 0xd7, 0xf1,
+	0xae, 0xf6, 0x3a, 0x2c, 0x36, 0xb0, 0x20, 0xa6, 0xc7, 0x99, 0xc7, 0x04, 0xe1, 0x26, 0x0f, 0x33,
+	0x3d, 0x24, 0x64, 0xb4, 0xbe, 0xdc, 0xb7, 0xcf, 0x99, 0xc8, 0x67, 0x2f, 0x9b, 0xc8, 0xd0, 0x02,
+	0xf1, 0xaa, 0x92, 0xaa, 0x2b, 0x75, 0x13, 0xc0, 0xa9, 0x06, 0x73, 0x7d, 0xf1, 0x10, 0x84, 0xc1,
+	0x10, 0xc2, 0x8b, 0x7d, 0x43, 0xf8, 0xaf, 0x82, 0xd0, 0xcb, 0x28, 0x32, 0x26, 0x43, 0x79, 0x17,
+	0x88, 0x75, 0x38, 0x95, 0xeb, 0x7e, 0x66, 0x1c, 0xa5, 0xa1, 0xb0, 0xe4, 0xcd, 0xa6, 0x56, 0x7b,
+	0xaa, 0x21, 0x63, 0x32, 0xdb, 0xf8, 0x16, 0x23, 0xa9, 0x66, 0xc3, 0x99, 0x16, 0xde, 0x35, 0xb1,
+	0x2f, 0x99, 0xc9, 0xa3, 0xcc, 0x17, 0xa6, 0x47, 0xb8, 0xd9, 0x70, 0x98, 0xb5, 0x55, 0x3a, 0x36,
+	0x0b, 0xe6, 0x4e, 0xd6, 0xcf, 0x76, 0xda, 0x3a, 0x8a, 0x6c, 0x3f, 0x46, 0x19, 0x19, 0xd3, 0x2d,
+	0xbc, 0x9b, 0xb9, 0x42, 0x62, 0x95, 0xf0, 0x7a, 0xb0, 0x72, 0x69, 0xe8, 0x93, 0xdb, 0xfa, 0x00,
+	0xba, 0x59, 0x80, 0xe5, 0xa4, 0xac, 0x3e, 0x47, 0x85, 0x64, 0x9c, 0x5a, 0xd8, 0x89, 0xbb, 0xc9,
+	0x67, 0x00, 0x4e, 0x5b, 0x7e, 0xcb, 0x77, 0xb0, 0xa4, 0xdb, 0x44, 0x91, 0x61, 0x72, 0x2c, 0x29,
+	0x53, 0x15, 0x64, 0x22, 0xae, 0x20, 0x97, 0x89, 0x15, 0x16, 0x91, 0x97, 0x02, 0xe2, 0x3b, 0x6d,
+	0xbd, 0xa2, 0xb2, 0xa8, 0xf7, 0x6e, 0x74, 0xe7, 0xbe, 0xfe, 0xf4, 0xfe, 0x42, 0x13, 0x55, 0x9a,
+	0xa9, 0xd4, 0x50, 0x04, 0xce, 0x08, 0xcc, 0x68, 0x0b, 0x70, 0x82, 0x93, 0x0d, 0xc2, 0x89, 0x6b,
+	0x11, 0xd3, 0x0a, 0x2b, 0x5b, 0x21, 0xe4, 0xa7, 0xdc, 0x69, 0xeb, 0x67, 0x22, 0x08, 0x5d, 0x0a,
+	0xc8, 0x18, 0x4f, 0x24, 0x0b, 0xa1, 0xe0, 0x03, 0x00, 0xa7, 0xd3, 0xde, 0xe2, 0x73, 0x4e, 0x5c,
+	0x19, 0x33, 0xf0, 0x1a, 0x3c, 0x1e, 0xe1, 0x16, 0x8f, 0x3a, 0xf0, 0x45, 0x55, 0x35, 0xfb, 0x3a,
+	0x4e, 0x6c, 0x54, 0x3b, 0x03, 0x87, 0x3d, 0xc2, 0x29, 0x8b, 0xae, 0xce, 0x90, 0xa1, 0xbe, 0xd0,
+	0xdb, 0x00, 0x56, 0x12, 0x4c, 0xf3, 0x96, 0x3a, 0x3d, 0xb1, 0x33, 0xad, 0xcf, 0x86, 0x30, 0xd3,
+	0x65, 0x0f, 0x13, 0x5d, 0xc6, 0x2e, 0x7a, 0x1f, 0xc0, 0x99, 0x04, 0xc8, 0x8a, 0x2f, 0x85, 0xc4,
+	0xae, 0x4d, 0xdd, 0x66, 0x4c, 0x90, 0xf7, 0x87, 0x04, 0x2d, 0xaa, 0x8c, 0x18, 0x8f, 0xc3, 0x11,
+	0x6a, 0xa3, 0x83, 0x52, 0x86, 0xbe, 0x02, 0x70, 0x32, 0x41, 0xb4, 0xe6, 0x60, 0xb1, 0xb9, 0xb8,
+	0x4d, 0x5c, 0xa9, 0x2d, 0xc1, 0xb4, 0x72, 0x9a, 0x8a, 0xd4, 0xa0, 0x06, 0x0e, 0xd5, 0x67, 0xd2,
+	0x69, 0xb5, 0x5b, 0x03, 0x19, 0x13, 0x89, 0x68, 0x35, 0x94, 0x68, 0xcf, 0xc3, 0x91, 0x0d, 0x8e,
+	0x2d, 0x19, 0xcf, 0x2e, 0xa3, 0xf5, 0x6a, 0x7f, 0xc5, 0xc4, 0x48, 0xf6, 0xa3, 0xcf, 0x01, 0x2c,
+	0xf6, 0xc0, 0x2a, 0xb4, 0xb7, 0x00, 0x3c, 0x93, 0x62, 0x11, 0xc1, 0x8a, 0x49, 0xc2, 0x25, 0x45,
+	0xe3, 0x5c, 0xb5, 0xc7, 0x9b, 0xa3, 0xda, 0xc3, 0x56, 0xfd, 0x49, 0xc5, 0xef, 0xff, 0xba, 0x4f,
+	0x98, 0xb5, 0x8a, 0x8c, 0xe2, 0x76, 0x0f, 0x1c, 0xaa, 0x0c, 0xdc, 0x02, 0xf0, 0xf8, 0x12, 0x21,
+	0xe1, 0x8c, 0xf0, 0x26, 0x80, 0xe3, 0x69, 0xed, 0xf7, 0x18, 0x73, 0x1e, 0x15, 0xd8, 0xab, 0xca,
+	0xf1, 0x54, 0x77, 0xc3, 0x08, 0x36, 0xf5, 0x1d, 0xdf, 0xb4, 0x7b, 0x05, 0x30, 0xd0, 0x3b, 0x05,
+	0x58, 0xce, 0x0d, 0x2f, 0x6b, 0x1e, 0x71, 0xed, 0xa8, 0x00, 0x63, 0x47, 0x2b, 0xc2, 0x63, 0x92,
+	0x4a, 0x87, 0x44, 0x5d, 0xce, 0x88, 0x3e, 0xb4, 0x59, 0x78, 0xc2, 0x26, 0xc2, 0xe2, 0xd4, 0x4b,
+	0xa3, 0x67, 0x64, 0x45, 0xc1, 0xa4, 0xc2, 0x89, 0x45, 0x3d, 0x4a, 0x5c, 0x59, 0x1a, 0x3c, 0xf0,
+	0xa4, 0x92, 0xd8, 0xc8, 0x8c, 0x54, 0x43, 0x87, 0x39, 0x52, 0x5d, 0x1a, 0x79, 0xf7, 0xb6, 0x3e,
+	0x10, 0x06, 0xe7, 0xc3, 0x41, 0xf8, 0xff, 0x1c, 0x13, 0x0b, 0xcc, 0x95, 0xd4, 0xf5, 0x99, 0x2f,
+	0x96, 0xfc, 0xbf, 0x23, 0x25, 0x6f, 0x00, 0x78, 0x2a, 0x3a, 0x45, 0xa6, 0x6d, 0xf5, 0x62, 0xe7,
+	0x05, 0x95, 0x40, 0xea, 0x6e, 0x76, 0xef, 0x41, 0x7d, 0x11, 0x37, 0x1e, 0x6d, 0x8f, 0x1b, 0x9c,
+	0x66, 0xc0, 0x11, 0xe2, 0xda, 0x66, 0xf0, 0xfc, 0x0e, 0x7b, 0xe6, 0x89, 0x0b, 0xe5, 0x6a, 0xf4,
+	0x36, 0xaf, 0xc6, 0x6f, 0xf3, 0xea, 0x7a, 0xfc, 0x36, 0xaf, 0xcf, 0x28, 0x28, 0x13, 0x11, 0x94,
+	0x78, 0x27, 0xba, 0x75, 0x5f, 0x07, 0xc1, 0xb8, 0x65, 0x07, 0xaa, 0x99, 0xa0, 0x7c, 0x03, 0xe0,
+	0x53, 0x0b, 0xd8, 0xb5, 0x88, 0xf3, 0x4f, 0x0a, 0x4d, 0xe6, 0x00, 0xdf, 0x15, 0xe0, 0x78, 0x1e,
+	0x6d, 0xde, 0x1b, 0xf8, 0xb3, 0x12, 0xa1, 0x70, 0x94, 0x89, 0x30, 0x78, 0x48, 0x89, 0x10, 0x95,
+	0xcd, 0x5f, 0x01, 0x9c, 0x4a, 0x5e, 0xe0, 0x6b, 0x12, 0x73, 0x49, 0xdd, 0xe6, 0x15, 0x77, 0x23,
+	0x9c, 0x4b, 0x3c, 0x4e, 0xb6, 0x29, 0xf3, 0x45, 0xbe, 0x15, 0x65, 0xe6, 0x92, 0x2e, 0x05, 0x64,
+	0x8c, 0xc7, 0x12, 0xd5, 0x88, 0xd6, 0xe1, 0xb1, 0x70, 0x68, 0x53, 0x5d, 0xe8, 0xd9, 0xbe, 0x47,
+	0xda, 0xb1, 0xc8, 0x51, 0x68, 0x04, 0x19, 0x91, 0x31, 0x6d, 0x11, 0x0e, 0x6f, 0x12, 0xda, 0xdc,
+	0x8c, 0x12, 0x6a, 0xa8, 0x7e, 0xee, 0xa7, 0xb6, 0x3e, 0x61, 0x71, 0x12, 0xcc, 0x53, 0xae, 0x19,
+	0x2d, 0xa5, 0x20, 0xbb, 0x16, 0x90, 0xa1, 0x36, 0xa3, 0x5f, 0x00, 0xfc, 0x8f, 0x3a, 0x3b, 0x65,
+	0x6e, 0x8f, 0x7f, 0x68, 0x8e, 0xea, 0x59, 0xae, 0xbd, 0x0a, 0x87, 0x93, 0xd7, 0xc8, 0xe1, 0xcd,
+	0x44, 0xca, 0x66, 0x7d, 0xe5, 0xcb, 0xbd, 0x0a, 0xb8, 0xbb, 0x57, 0x01, 0xf7, 0xf6, 0x2a, 0xe0,
+	0xc7, 0xbd, 0x0a, 0xb8, 0xf5, 0xa0, 0x32, 0x70, 0xef, 0x41, 0x65, 0xe0, 0xfb, 0x07, 0x95, 0x81,
+	0x57, 0xce, 0x3f, 0xd6, 0xe4, 0x6e, 0xfe, 0x8f, 0xc6, 0xd0, 0x43, 0x63, 0x38, 0x4c, 0xc2, 0x8b,
+	0xbf, 0x0f, 0x00, 0x92, 0xa8, 0x94, 0xe0, 0x8c, 0x14, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -932,14 +1278,14 @@ func (this *MsgWithdrawDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
+func (this *MsgWithdrawAllDelegatorRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgWithdrawValidatorCommission)
+	that1, ok := that.(*MsgWithdrawAllDelegatorRewards)
 	if !ok {
-		that2, ok := that.(MsgWithdrawValidatorCommission)
+		that2, ok := that.(MsgWithdrawAllDelegatorRewards)
 		if ok {
 			that1 = &that2
 		} else {
@@ -951,19 +1297,22 @@ func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if this.WithdrawCommission != that1.WithdrawCommission {
 		return false
 	}
 	return true
 }
-func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
+func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFundCommunityPool)
+	that1, ok := that.(*MsgWithdrawValidatorCommission)
 	if !ok {
-		that2, ok := that.(MsgFundCommunityPool)
+		that2, ok := that.(MsgWithdrawValidatorCommission)
 		if ok {
 			that1 = &that2
 		} else {
@@ -975,27 +1324,19 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
-	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
-		}
-	}
-	if !bytes.Equal(this.Depositor, that1.Depositor) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *MsgWithdrawTokenizeShareRecordReward) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordReward)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordReward)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1007,28 +1348,19 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CommunityTax.Equal(that1.CommunityTax) {
-		return false
-	}
-	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
-		return false
-	}
-	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
-		return false
-	}
-	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
 		return false
 	}
 	return true
 }
-func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
+func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorHistoricalRewards)
+	that1, ok := that.(*MsgFundCommunityPool)
 	if !ok {
-		that2, ok := that.(ValidatorHistoricalRewards)
+		that2, ok := that.(MsgFundCommunityPool)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1040,11 +1372,109 @@ func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
+	if len(this.Amount) != len(that1.Amount) {
 		return false
 	}
-	for i := range this.CumulativeRewardRatio {
-		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Depositor, that1.Depositor) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoRestake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoRestake)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
+		return false
+	}
+	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
+		return false
+	}
+	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
+		return false
+	}
+	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
+		return false
+	}
+	if this.MaxAutoRestakesPerBlock != that1.MaxAutoRestakesPerBlock {
+		return false
+	}
+	return true
+}
+func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorHistoricalRewards)
+	if !ok {
+		that2, ok := that.(ValidatorHistoricalRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
+		return false
+	}
+	for i := range this.CumulativeRewardRatio {
+		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
 			return false
 		}
 	}
@@ -1266,6 +1696,112 @@ func (this *CommunityPoolSpendProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CommunityPoolContinuousFundProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolContinuousFundProposal)
+	if !ok {
+		that2, ok := that.(CommunityPoolContinuousFundProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if len(this.AmountPerBlock) != len(that1.AmountPerBlock) {
+		return false
+	}
+	for i := range this.AmountPerBlock {
+		if !this.AmountPerBlock[i].Equal(&that1.AmountPerBlock[i]) {
+			return false
+		}
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
+func (this *CancelCommunityPoolContinuousFundProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelCommunityPoolContinuousFundProposal)
+	if !ok {
+		that2, ok := that.(CancelCommunityPoolContinuousFundProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	return true
+}
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if len(this.AmountPerBlock) != len(that1.AmountPerBlock) {
+		return false
+	}
+	for i := range this.AmountPerBlock {
+		if !this.AmountPerBlock[i].Equal(&that1.AmountPerBlock[i]) {
+			return false
+		}
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1402,6 +1938,46 @@ func (m *MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawCommission {
+		i--
+		if m.WithdrawCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1432,6 +2008,36 @@ func (m *MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1476,7 +2082,7 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1486,33 +2092,85 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WithdrawAddrEnabled {
+	if m.Enabled {
 		i--
-		if m.WithdrawAddrEnabled {
+		if m.Enabled {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	{
-		size := m.BonusProposerReward.Size()
-		i -= size
-		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAutoRestakesPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoRestakesPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WithdrawAddrEnabled {
+		i--
+		if m.WithdrawAddrEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BonusProposerReward.Size()
+		i -= size
+		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
@@ -1867,6 +2525,168 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *CommunityPoolContinuousFundProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolContinuousFundProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolContinuousFundProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AmountPerBlock) > 0 {
+		for iNdEx := len(m.AmountPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelCommunityPoolContinuousFundProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelCommunityPoolContinuousFundProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelCommunityPoolContinuousFundProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AmountPerBlock) > 0 {
+		for iNdEx := len(m.AmountPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1999,6 +2819,22 @@ func (m *MsgWithdrawDelegatorReward) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.WithdrawCommission {
+		n += 2
+	}
+	return n
+}
+
 func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2012,6 +2848,19 @@ func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgFundCommunityPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2031,6 +2880,26 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2046,6 +2915,9 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.MaxAutoRestakesPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoRestakesPerBlock))
+	}
 	return n
 }
 
@@ -2186,30 +3058,101 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
-func (m *DelegatorStartingInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
-	}
-	l = m.Stake.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovDistribution(uint64(m.Height))
-	}
-	return n
-}
-
-func (m *DelegationDelegatorReward) Size() (n int) {
+func (m *CommunityPoolContinuousFundProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.AmountPerBlock) > 0 {
+		for _, e := range m.AmountPerBlock {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CancelCommunityPoolContinuousFundProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.AmountPerBlock) > 0 {
+		for _, e := range m.AmountPerBlock {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
@@ -2470,7 +3413,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2493,15 +3436,15 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2528,11 +3471,31 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCommission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawCommission = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2557,7 +3520,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2580,17 +3543,17 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2600,29 +3563,82 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2649,9 +3665,9 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2678,7 +3694,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,17 +3717,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2721,31 +3737,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2755,93 +3771,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.WithdrawAddrEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2853,7 +3815,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2876,17 +3838,17 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2896,31 +3858,65 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.ReferenceCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2930,11 +3926,12 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2959,7 +3956,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2982,17 +3979,17 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3002,31 +3999,99 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
 			}
-			m.Period = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3036,7 +4101,27 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoRestakesPerBlock", wireType)
+			}
+			m.MaxAutoRestakesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoRestakesPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3065,7 +4150,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3088,15 +4173,15 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3123,11 +4208,30 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3152,7 +4256,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3175,10 +4279,10 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3215,17 +4319,210 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDistribution
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDistribution
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3704,6 +5001,529 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityPoolContinuousFundProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolContinuousFundProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolContinuousFundProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerBlock = append(m.AmountPerBlock, types.Coin{})
+			if err := m.AmountPerBlock[len(m.AmountPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelCommunityPoolContinuousFundProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelCommunityPoolContinuousFundProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelCommunityPoolContinuousFundProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerBlock = append(m.AmountPerBlock, types.Coin{})
+			if err := m.AmountPerBlock[len(m.AmountPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,8 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrNoTokenizeShareRecords  = sdkerrors.Register(ModuleName, 14, "no tokenize share records to withdraw rewards from")
+	ErrInvalidProposalEndTime  = sdkerrors.Register(ModuleName, 15, "invalid community pool continuous fund proposal end time")
+	ErrContinuousFundExists    = sdkerrors.Register(ModuleName, 16, "continuous fund already exists for recipient")
+	ErrNoContinuousFund        = sdkerrors.Register(ModuleName, 17, "no continuous fund for recipient")
 )
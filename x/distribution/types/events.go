@@ -6,11 +6,24 @@ const (
 	EventTypeRewards            = "rewards"
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawRewards    = "withdraw_rewards"
+	EventTypeWithdrawAllRewards = "withdraw_all_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
+
+	EventTypeContinuousFund        = "continuous_fund"
+	EventTypeCancelContinuousFund  = "cancel_continuous_fund"
+	EventTypeContinuousFundPayment = "continuous_fund_payment"
+	EventTypeContinuousFundExpired = "continuous_fund_expired"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyCommission      = "commission"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyEndTime         = "end_time"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoRestakes []DelegatorAutoRestake, continuousFunds []ContinuousFund,
 ) GenesisState {
 
 	return GenesisState{
@@ -21,6 +24,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakes:                    autoRestakes,
+		ContinuousFunds:                 continuousFunds,
 	}
 }
 
@@ -37,6 +42,8 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakes:                    []DelegatorAutoRestake{},
+		ContinuousFunds:                 []ContinuousFund{},
 	}
 }
 
@@ -45,5 +52,23 @@ func ValidateGenesis(gs GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, ar := range gs.AutoRestakes {
+		if ar.DelegatorAddress.Empty() {
+			return ErrEmptyDelegatorAddr
+		}
+		if ar.ValidatorAddress.Empty() {
+			return ErrEmptyValidatorAddr
+		}
+	}
+	recipients := make(map[string]bool, len(gs.ContinuousFunds))
+	for _, cf := range gs.ContinuousFunds {
+		if err := cf.Validate(); err != nil {
+			return err
+		}
+		if recipients[cf.Recipient.String()] {
+			return fmt.Errorf("duplicate continuous fund for recipient %s", cf.Recipient)
+		}
+		recipients[cf.Recipient.String()] = true
+	}
 	return gs.FeePool.ValidateGenesis()
 }
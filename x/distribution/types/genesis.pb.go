@@ -81,6 +81,60 @@ func (m *DelegatorWithdrawInfo) GetWithdrawAddress() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+// DelegatorAutoRestake defines a delegation whose rewards are automatically
+// restaked, used for import/export via genesis json.
+type DelegatorAutoRestake struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *DelegatorAutoRestake) Reset()         { *m = DelegatorAutoRestake{} }
+func (m *DelegatorAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAutoRestake) ProtoMessage()    {}
+func (*DelegatorAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a45655a6b48d269, []int{1}
+}
+func (m *DelegatorAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAutoRestake.Merge(m, src)
+}
+func (m *DelegatorAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorAutoRestake proto.InternalMessageInfo
+
+func (m *DelegatorAutoRestake) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *DelegatorAutoRestake) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
 // ValidatorOutstandingRewardsRecord
 // used for import/export via genesis json
 type ValidatorOutstandingRewardsRecord struct {
@@ -92,7 +146,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{1}
+	return fileDescriptor_7a45655a6b48d269, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +200,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{2}
+	return fileDescriptor_7a45655a6b48d269, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +255,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{3}
+	return fileDescriptor_7a45655a6b48d269, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +316,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{4}
+	return fileDescriptor_7a45655a6b48d269, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +371,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{5}
+	return fileDescriptor_7a45655a6b48d269, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +434,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{6}
+	return fileDescriptor_7a45655a6b48d269, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,13 +503,15 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord                `protobuf:"bytes,8,rep,name=validator_current_rewards,json=validatorCurrentRewards,proto3,casttype=ValidatorCurrentRewardsRecord" json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord                  `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3,casttype=DelegatorStartingInfoRecord" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord                    `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3,casttype=ValidatorSlashEventRecord" json:"validator_slash_events" yaml:"validator_slash_events"`
+	AutoRestakes                    []DelegatorAutoRestake                         `protobuf:"bytes,11,rep,name=auto_restakes,json=autoRestakes,proto3" json:"auto_restakes" yaml:"auto_restakes"`
+	ContinuousFunds                 []ContinuousFund                               `protobuf:"bytes,12,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds" yaml:"continuous_funds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a45655a6b48d269, []int{7}
+	return fileDescriptor_7a45655a6b48d269, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,8 +610,23 @@ func (m *GenesisState) GetValidatorSlashEvents() []ValidatorSlashEventRecord {
 	return nil
 }
 
+func (m *GenesisState) GetAutoRestakes() []DelegatorAutoRestake {
+	if m != nil {
+		return m.AutoRestakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContinuousFunds() []ContinuousFund {
+	if m != nil {
+		return m.ContinuousFunds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegatorAutoRestake)(nil), "cosmos.distribution.DelegatorAutoRestake")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.ValidatorHistoricalRewardsRecord")
@@ -568,75 +639,81 @@ func init() {
 func init() { proto.RegisterFile("cosmos/distribution/genesis.proto", fileDescriptor_7a45655a6b48d269) }
 
 var fileDescriptor_7a45655a6b48d269 = []byte{
-	// 1085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x6e, 0x5a, 0xa7, 0x4c, 0x5c, 0xd2, 0x6e, 0xd2, 0xc4, 0x75, 0x5b, 0x6f, 0xb2, 0x20,
-	0x14, 0x51, 0xba, 0xa6, 0x29, 0x45, 0x02, 0x71, 0x20, 0x9b, 0xd2, 0x52, 0x09, 0x48, 0xd8, 0xa0,
-	0x82, 0xe0, 0x60, 0x6d, 0x76, 0x27, 0xf6, 0xa8, 0xeb, 0x1d, 0x6b, 0x67, 0xec, 0x10, 0x38, 0x70,
-	0xe3, 0xc4, 0x01, 0x09, 0x89, 0x23, 0x47, 0x54, 0x71, 0x42, 0x08, 0x04, 0xfc, 0x07, 0x39, 0xf6,
-	0xc8, 0xc9, 0x45, 0xc9, 0x7f, 0x90, 0x63, 0x4e, 0x68, 0x3e, 0xf6, 0xcb, 0x5e, 0xaf, 0xdd, 0x1e,
-	0x68, 0x6e, 0xde, 0xdd, 0x37, 0xef, 0xf7, 0x31, 0x6f, 0xde, 0x3c, 0x83, 0x15, 0x17, 0x93, 0x36,
-	0x26, 0x75, 0x0f, 0x11, 0x1a, 0xa2, 0x9d, 0x2e, 0x45, 0x38, 0xa8, 0x37, 0x61, 0x00, 0x09, 0x22,
-	0x66, 0x27, 0xc4, 0x14, 0x6b, 0xf3, 0x22, 0xc4, 0x4c, 0x87, 0x54, 0x17, 0x9a, 0xb8, 0x89, 0xf9,
-	0xf7, 0x3a, 0xfb, 0x25, 0x42, 0xab, 0x32, 0xb4, 0x2e, 0x57, 0x88, 0x97, 0xaf, 0xe4, 0x41, 0xa4,
-	0x1f, 0x44, 0x9c, 0xf1, 0x9d, 0x0a, 0x2e, 0xdd, 0x81, 0x3e, 0x6c, 0x3a, 0x14, 0x87, 0x9f, 0x22,
-	0xda, 0xf2, 0x42, 0x67, 0xef, 0x7e, 0xb0, 0x8b, 0xb5, 0xaf, 0xc0, 0x45, 0x2f, 0xfa, 0xd0, 0x70,
-	0x3c, 0x2f, 0x84, 0x84, 0x54, 0x94, 0x65, 0x65, 0xb5, 0x6c, 0x7d, 0x78, 0xdc, 0xd7, 0x2b, 0xfb,
-	0x4e, 0xdb, 0x7f, 0xdb, 0x18, 0x0a, 0x31, 0x4e, 0xfa, 0xfa, 0x8d, 0x26, 0xa2, 0xad, 0xee, 0x8e,
-	0xe9, 0xe2, 0x76, 0x3d, 0x43, 0xee, 0x06, 0xf1, 0x1e, 0xd6, 0xe9, 0x7e, 0x07, 0x12, 0x73, 0xdd,
-	0x75, 0xd7, 0xc5, 0x0a, 0xfb, 0x42, 0x9c, 0x44, 0xbe, 0xd1, 0xf6, 0xc0, 0x85, 0x3d, 0xc9, 0x25,
-	0x86, 0x56, 0x39, 0xf4, 0x07, 0xc7, 0x7d, 0x7d, 0x49, 0x40, 0x0f, 0x46, 0x3c, 0x03, 0xf2, 0x5c,
-	0x94, 0x43, 0xbe, 0x30, 0xfe, 0x50, 0xc1, 0xca, 0x03, 0xc7, 0x47, 0x1e, 0x63, 0xb3, 0xd9, 0xa5,
-	0x84, 0x3a, 0x81, 0x87, 0x82, 0xa6, 0x0d, 0xf7, 0x9c, 0xd0, 0x23, 0x36, 0x74, 0x71, 0xe8, 0x31,
-	0x6b, 0x7a, 0x51, 0xd0, 0x68, 0x6b, 0x86, 0x42, 0x26, 0x25, 0xf8, 0xc0, 0xf1, 0x63, 0x6b, 0xe2,
-	0x24, 0x91, 0x35, 0x3f, 0x28, 0x60, 0x1e, 0x27, 0xc4, 0x1a, 0xa1, 0x60, 0x56, 0x51, 0x97, 0xa7,
-	0x57, 0x67, 0xd7, 0xe6, 0x4c, 0x59, 0x05, 0x77, 0xa0, 0xbb, 0x81, 0x51, 0x60, 0x7d, 0x7c, 0xd0,
-	0xd7, 0xa7, 0x8e, 0xfb, 0x7a, 0x55, 0x70, 0xca, 0x59, 0x69, 0xfc, 0xf2, 0x44, 0xbf, 0x3e, 0x01,
-	0x2b, 0x99, 0x91, 0xd8, 0x1a, 0x1e, 0xf2, 0xc5, 0xf8, 0x4b, 0x05, 0x2f, 0xc7, 0xbe, 0xad, 0xbb,
-	0x6e, 0xb7, 0xdd, 0xf5, 0x1d, 0x0a, 0xbd, 0x0d, 0xdc, 0x6e, 0x23, 0x42, 0x10, 0x0e, 0x4e, 0x87,
-	0x75, 0xb3, 0x4e, 0xc2, 0x8d, 0x57, 0xd4, 0xec, 0xda, 0x2d, 0x33, 0xe7, 0xa8, 0x99, 0xc5, 0x62,
-	0xac, 0x77, 0xa4, 0xad, 0x9a, 0xe0, 0x9b, 0xca, 0xca, 0x98, 0xd6, 0xc6, 0x58, 0x91, 0x66, 0x61,
-	0xfc, 0xa9, 0x82, 0xe5, 0x38, 0xfe, 0x7d, 0x44, 0x28, 0x0e, 0x91, 0xeb, 0xf8, 0xa7, 0xa7, 0xe2,
-	0x16, 0x41, 0xa9, 0x03, 0x43, 0x84, 0x85, 0x61, 0x67, 0x6c, 0xf9, 0xa4, 0x7d, 0x03, 0x66, 0xa2,
-	0xe2, 0x9b, 0xe6, 0x4e, 0xd6, 0x8b, 0x9d, 0x1c, 0xd2, 0x66, 0xbd, 0x21, 0x5d, 0x7c, 0x51, 0xd0,
-	0x8f, 0x0a, 0xf2, 0xa4, 0xaf, 0x57, 0x0b, 0x1c, 0x89, 0x50, 0x8d, 0x1f, 0x55, 0x70, 0x2d, 0x8e,
-	0xdb, 0xe8, 0x86, 0x21, 0x0c, 0xe8, 0xe9, 0xb1, 0x6d, 0x3f, 0xb1, 0x47, 0x14, 0xda, 0x6b, 0xc5,
-	0xf6, 0x64, 0x05, 0x58, 0x37, 0x47, 0x7a, 0xb3, 0x34, 0x4a, 0x73, 0x6c, 0xcc, 0x4f, 0xd3, 0xe0,
-	0x4a, 0xdc, 0xd4, 0xb7, 0xa9, 0x13, 0x52, 0x14, 0x34, 0x59, 0x53, 0x4f, 0x6c, 0x79, 0x6e, 0xad,
-	0x3d, 0x77, 0x4b, 0xd4, 0xff, 0x67, 0x4b, 0xbe, 0x55, 0xc0, 0x79, 0x22, 0xed, 0x68, 0xa0, 0x60,
-	0x17, 0xcb, 0xc2, 0x7d, 0x35, 0x77, 0x67, 0x72, 0x1d, 0xb4, 0x6e, 0xcb, 0x7d, 0x59, 0x10, 0x44,
-	0x33, 0xe9, 0x18, 0xc9, 0x4b, 0xf9, 0xc6, 0x97, 0x49, 0xea, 0xc9, 0xf8, 0x5d, 0x05, 0x97, 0xe3,
-	0x5d, 0xdc, 0xf6, 0x1d, 0xd2, 0x7a, 0xaf, 0xc7, 0x37, 0xf2, 0x34, 0x1c, 0xf6, 0x16, 0x44, 0xcd,
-	0x16, 0x8d, 0x0e, 0xbb, 0x78, 0x4a, 0x35, 0x81, 0xe9, 0x4c, 0x13, 0x78, 0x08, 0xce, 0x42, 0x46,
-	0xbd, 0x72, 0x86, 0x3b, 0xb9, 0x5a, 0x5c, 0xe3, 0x89, 0x54, 0xeb, 0xba, 0xf4, 0xb1, 0x2c, 0xd4,
-	0xf0, 0x24, 0x4c, 0xc1, 0x7c, 0x9e, 0x2f, 0x02, 0xc3, 0x38, 0x2a, 0x83, 0xf2, 0x3d, 0x31, 0x26,
-	0x6d, 0x53, 0x87, 0x42, 0xed, 0x13, 0x50, 0xea, 0x38, 0xa1, 0xd3, 0x16, 0xf6, 0xcc, 0xae, 0x5d,
-	0xc9, 0x85, 0xdf, 0xe2, 0x21, 0x96, 0x2e, 0x11, 0xcf, 0x0b, 0x44, 0xb1, 0x90, 0x41, 0x96, 0x44,
-	0x80, 0x2d, 0x73, 0x69, 0x5f, 0x80, 0x73, 0xbb, 0x10, 0x36, 0x3a, 0x18, 0xfb, 0xf2, 0xe8, 0x5e,
-	0xcd, 0xcd, 0x7b, 0x17, 0xc2, 0x2d, 0x8c, 0x7d, 0xcb, 0x90, 0x89, 0xe7, 0x44, 0xe2, 0x68, 0x2d,
-	0x4b, 0x3d, 0x23, 0x63, 0xec, 0x99, 0x5d, 0xf1, 0x43, 0xfb, 0x59, 0x01, 0x95, 0xe4, 0x64, 0xc5,
-	0x33, 0x0c, 0x2b, 0x1f, 0xd6, 0x47, 0xa7, 0xc7, 0x97, 0x63, 0x7a, 0x4a, 0xb3, 0xde, 0x95, 0xd8,
-	0xfa, 0xe0, 0x99, 0xcd, 0x66, 0xce, 0x56, 0x66, 0x3a, 0x83, 0xbd, 0xe8, 0xe5, 0xbd, 0x26, 0xda,
-	0xd7, 0xe0, 0x62, 0x27, 0x84, 0x3d, 0x84, 0xbb, 0xa4, 0xd1, 0x09, 0x71, 0x07, 0x13, 0x18, 0xf2,
-	0x5d, 0x2e, 0x5b, 0x1f, 0x25, 0x55, 0x38, 0x14, 0xc2, 0x90, 0xcc, 0x09, 0xaa, 0x70, 0x03, 0x07,
-	0x24, 0x2e, 0xc3, 0x28, 0xcb, 0x96, 0x4c, 0xa2, 0xfd, 0x3a, 0x62, 0xca, 0x39, 0xcb, 0x0d, 0x7a,
-	0xb3, 0xb8, 0xca, 0x46, 0xcd, 0x6d, 0xd6, 0xbd, 0xf1, 0xc3, 0xd0, 0x49, 0x5f, 0x1f, 0x3f, 0x00,
-	0xe6, 0x8d, 0x40, 0xda, 0x13, 0x05, 0xac, 0xa4, 0xce, 0x64, 0x72, 0xc3, 0x37, 0xdc, 0xf8, 0xe6,
-	0x27, 0x95, 0x12, 0x17, 0xf0, 0xd6, 0x33, 0xcc, 0x1c, 0x52, 0xc3, 0x67, 0x52, 0xc3, 0xea, 0x50,
-	0x17, 0xc8, 0x47, 0x64, 0x8a, 0x26, 0x1a, 0xcd, 0x6c, 0xbd, 0x57, 0x18, 0x45, 0xb4, 0x03, 0x05,
-	0x5c, 0x4d, 0xf0, 0x5a, 0xf1, 0xc5, 0x1c, 0xef, 0xce, 0x0c, 0x17, 0x77, 0xfb, 0x29, 0xc7, 0x00,
-	0x29, 0x6c, 0x53, 0x0a, 0x7b, 0x69, 0x50, 0xd8, 0x30, 0x10, 0xd3, 0x34, 0x76, 0x66, 0xb2, 0xab,
-	0xbd, 0x91, 0x11, 0xda, 0xdf, 0x0a, 0xb8, 0x9c, 0x20, 0xb8, 0xe2, 0x1e, 0x8d, 0x75, 0x9c, 0xe3,
-	0x3a, 0xd6, 0x9e, 0xe6, 0xbe, 0x96, 0x22, 0xee, 0x4b, 0x11, 0xcb, 0x83, 0x22, 0x06, 0x20, 0x98,
-	0x82, 0xe2, 0xd9, 0xc5, 0x5e, 0xea, 0xe5, 0x7f, 0xd6, 0x7e, 0xcb, 0x74, 0x90, 0xcc, 0x05, 0x44,
-	0x2a, 0x2f, 0x70, 0xea, 0xaf, 0x4f, 0x7e, 0xa1, 0x49, 0xe2, 0x77, 0x47, 0xf5, 0x91, 0x6c, 0x7e,
-	0xc6, 0xbb, 0x68, 0xb4, 0x48, 0x75, 0x93, 0xf4, 0x47, 0xa2, 0x3d, 0x52, 0xc0, 0x62, 0xe2, 0x06,
-	0x61, 0xad, 0xbd, 0xc1, 0x9b, 0x3a, 0xa9, 0x00, 0x4e, 0xd9, 0x9c, 0xf4, 0xe6, 0x90, 0x84, 0x2d,
-	0x49, 0xf8, 0xda, 0xa0, 0xd3, 0xe9, 0xdc, 0x8c, 0xee, 0xe8, 0x8b, 0xd6, 0x5e, 0xe8, 0x0d, 0x7f,
-	0x22, 0xd6, 0xe6, 0xa3, 0xc3, 0x9a, 0x72, 0x70, 0x58, 0x53, 0x1e, 0x1f, 0xd6, 0x94, 0x7f, 0x0f,
-	0x6b, 0xca, 0xf7, 0x47, 0xb5, 0xa9, 0xc7, 0x47, 0xb5, 0xa9, 0x7f, 0x8e, 0x6a, 0x53, 0x9f, 0xdf,
-	0x2c, 0xec, 0x6d, 0x5f, 0x66, 0xff, 0x70, 0xf3, 0x56, 0xb7, 0x53, 0xe2, 0x7f, 0xb5, 0x6f, 0xfd,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0x48, 0x83, 0xa5, 0x19, 0xf7, 0x0f, 0x00, 0x00,
+	// 1178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x9d, 0x36, 0x29, 0x93, 0x6d, 0x93, 0x4c, 0xd2, 0xc4, 0x4d, 0xd3, 0x75, 0xe2, 0x22,
+	0x14, 0x28, 0xdd, 0xa5, 0x29, 0x45, 0x02, 0x71, 0x20, 0x4e, 0x49, 0xa9, 0x04, 0x24, 0x38, 0xa8,
+	0x20, 0x38, 0xac, 0x1c, 0x7b, 0xb2, 0x6b, 0xc5, 0xeb, 0x59, 0x79, 0xc6, 0x1b, 0x52, 0x0e, 0xdc,
+	0x38, 0x21, 0x84, 0x84, 0xc4, 0x91, 0x23, 0xaa, 0x38, 0x21, 0x04, 0x02, 0xae, 0x9c, 0x72, 0xec,
+	0x91, 0xd3, 0x16, 0x25, 0xff, 0x41, 0x8e, 0x39, 0x21, 0xcf, 0x8c, 0xbf, 0x76, 0xbd, 0xde, 0x6d,
+	0x0f, 0x10, 0x89, 0xdb, 0xda, 0x7e, 0x7e, 0xbf, 0x8f, 0x79, 0xf3, 0xe6, 0xad, 0xc1, 0xb2, 0x85,
+	0x49, 0x13, 0x93, 0xaa, 0xed, 0x10, 0xea, 0x3b, 0x3b, 0x01, 0x75, 0xb0, 0x57, 0xad, 0x23, 0x0f,
+	0x11, 0x87, 0x54, 0x5a, 0x3e, 0xa6, 0x18, 0xce, 0xf0, 0x90, 0x4a, 0x3a, 0x64, 0x61, 0xb6, 0x8e,
+	0xeb, 0x98, 0x3d, 0xaf, 0x86, 0xbf, 0x78, 0xe8, 0x82, 0x08, 0xad, 0x8a, 0x37, 0xf8, 0xcd, 0x17,
+	0xf2, 0x20, 0xd2, 0x17, 0x3c, 0x4e, 0xfb, 0x4a, 0x06, 0x97, 0xef, 0x22, 0x17, 0xd5, 0x4d, 0x8a,
+	0xfd, 0x8f, 0x1c, 0xda, 0xb0, 0x7d, 0x73, 0xff, 0xbe, 0xb7, 0x8b, 0xe1, 0x43, 0x30, 0x6d, 0x47,
+	0x0f, 0x6a, 0xa6, 0x6d, 0xfb, 0x88, 0x10, 0x45, 0x5a, 0x92, 0x56, 0x4a, 0xfa, 0x7b, 0x27, 0x1d,
+	0x55, 0x39, 0x30, 0x9b, 0xee, 0x1b, 0x5a, 0x4f, 0x88, 0x76, 0xda, 0x51, 0x6f, 0xd6, 0x1d, 0xda,
+	0x08, 0x76, 0x2a, 0x16, 0x6e, 0x56, 0x33, 0xe4, 0x6e, 0x12, 0x7b, 0xaf, 0x4a, 0x0f, 0x5a, 0x88,
+	0x54, 0xd6, 0x2c, 0x6b, 0x8d, 0xbf, 0x61, 0x4c, 0xc5, 0x49, 0xc4, 0x1d, 0xb8, 0x0f, 0xa6, 0xf6,
+	0x05, 0x97, 0x18, 0x5a, 0x66, 0xd0, 0xef, 0x9e, 0x74, 0xd4, 0x79, 0x0e, 0xdd, 0x1d, 0xf1, 0x0c,
+	0xc8, 0x93, 0x51, 0x0e, 0x71, 0x43, 0xfb, 0x5a, 0x06, 0xb3, 0xb1, 0x1d, 0x6b, 0x01, 0xc5, 0x06,
+	0x22, 0xd4, 0xdc, 0x43, 0xff, 0xa9, 0x1b, 0x0f, 0xc1, 0x74, 0xdb, 0x74, 0x1d, 0x3b, 0x83, 0x2d,
+	0x77, 0x63, 0xf7, 0x84, 0x0c, 0x8b, 0xfd, 0xc0, 0x74, 0x63, 0xec, 0x38, 0x49, 0x64, 0xc8, 0xaf,
+	0x32, 0x58, 0x7e, 0x10, 0xdd, 0xdc, 0x0c, 0x28, 0xa1, 0xa6, 0x67, 0x3b, 0x5e, 0xdd, 0x40, 0xfb,
+	0xa6, 0x6f, 0x13, 0x03, 0x59, 0xd8, 0xb7, 0xf3, 0x19, 0x4a, 0xff, 0x0a, 0x43, 0xf8, 0xad, 0x04,
+	0x66, 0x70, 0x42, 0xac, 0xe6, 0x73, 0x66, 0x8a, 0xbc, 0x34, 0xba, 0x32, 0xb1, 0x3a, 0x59, 0x11,
+	0xdb, 0xe2, 0x2e, 0xb2, 0xd6, 0xb1, 0xe3, 0xe9, 0x1f, 0x1c, 0x76, 0xd4, 0x91, 0x93, 0x8e, 0xba,
+	0xc0, 0x39, 0xe5, 0xbc, 0xa9, 0xfd, 0xf8, 0x44, 0xbd, 0x31, 0x04, 0x2b, 0x91, 0x91, 0x18, 0x10,
+	0xf7, 0xf8, 0xa2, 0xfd, 0x2e, 0x83, 0xe7, 0x63, 0xdf, 0xd6, 0x2c, 0x2b, 0x68, 0x06, 0xae, 0x49,
+	0x91, 0xbd, 0x8e, 0x9b, 0x4d, 0x87, 0x10, 0x07, 0x7b, 0x67, 0xc3, 0xba, 0x09, 0x33, 0xe1, 0xc6,
+	0x6a, 0x6a, 0x62, 0xf5, 0x76, 0x25, 0xa7, 0xf7, 0x54, 0x8a, 0xc5, 0xe8, 0x6f, 0x0a, 0x5b, 0x21,
+	0xe7, 0x9b, 0xca, 0x1a, 0x32, 0x2d, 0x0f, 0xb0, 0x22, 0xcd, 0x42, 0xfb, 0x4d, 0x06, 0x4b, 0x71,
+	0xfc, 0x3b, 0x0e, 0xa1, 0xd8, 0x77, 0x2c, 0xd3, 0x3d, 0x3b, 0x15, 0x37, 0x07, 0xc6, 0x5a, 0xc8,
+	0x77, 0x30, 0x37, 0xec, 0x9c, 0x21, 0xae, 0xe0, 0x17, 0x60, 0x3c, 0x2a, 0xbe, 0x51, 0xe6, 0x64,
+	0xb5, 0xd8, 0xc9, 0x1e, 0x6d, 0xfa, 0xab, 0xc2, 0xc5, 0x4b, 0x9c, 0x7e, 0x54, 0x90, 0xa7, 0x1d,
+	0x75, 0xa1, 0xc0, 0x91, 0x08, 0x55, 0xfb, 0x4e, 0x06, 0xd7, 0xe2, 0xb8, 0xf5, 0xc0, 0xf7, 0x91,
+	0x47, 0xcf, 0x8e, 0x6d, 0x07, 0x89, 0x3d, 0xbc, 0xd0, 0x5e, 0x2e, 0xb6, 0x27, 0x2b, 0x40, 0xbf,
+	0xd5, 0xd7, 0x9b, 0xf9, 0x7e, 0x9a, 0x63, 0x63, 0xbe, 0x1f, 0x05, 0x57, 0xe3, 0xb6, 0xbe, 0x4d,
+	0x4d, 0x9f, 0x3a, 0x5e, 0x3d, 0x3c, 0xe5, 0x12, 0x5b, 0xfe, 0x8f, 0xdd, 0x1d, 0x7e, 0x29, 0x81,
+	0x8b, 0x44, 0xd8, 0x51, 0x73, 0xbc, 0x5d, 0x2c, 0x0a, 0xf7, 0xa5, 0xdc, 0x95, 0xc9, 0x75, 0x50,
+	0xbf, 0x23, 0xd6, 0x65, 0x96, 0x13, 0xcd, 0xa4, 0x0b, 0x49, 0x5e, 0xce, 0x37, 0xbe, 0x44, 0x52,
+	0x57, 0xda, 0x2f, 0x32, 0xb8, 0x12, 0xaf, 0xe2, 0xb6, 0x6b, 0x92, 0xc6, 0xdb, 0x6d, 0xb6, 0x90,
+	0x67, 0x61, 0xb3, 0x37, 0x90, 0x53, 0x6f, 0xd0, 0x68, 0xb3, 0xf3, 0xab, 0x54, 0x13, 0x18, 0xcd,
+	0x34, 0x81, 0x3d, 0x70, 0x1e, 0x85, 0xd4, 0x95, 0x73, 0xcc, 0xc9, 0x95, 0xe2, 0x1a, 0x4f, 0xa4,
+	0xea, 0x37, 0x84, 0x8f, 0x25, 0xae, 0x86, 0x25, 0x09, 0x15, 0xcc, 0xe4, 0xf9, 0xc2, 0x31, 0xb4,
+	0x3f, 0x2f, 0x81, 0xd2, 0x3d, 0x3e, 0x37, 0x6e, 0x53, 0x93, 0x22, 0xf8, 0x21, 0x18, 0x6b, 0x99,
+	0xbe, 0xd9, 0xe4, 0xf6, 0x4c, 0xac, 0x5e, 0xcd, 0x85, 0xdf, 0x62, 0x21, 0xba, 0x2a, 0x10, 0x2f,
+	0x72, 0x44, 0xfe, 0x62, 0x08, 0x39, 0xc6, 0x03, 0x0c, 0x91, 0x0b, 0x7e, 0x0a, 0x2e, 0xec, 0x22,
+	0x54, 0x6b, 0x61, 0xec, 0x8a, 0xad, 0xbb, 0x98, 0x9b, 0x77, 0x03, 0xa1, 0x2d, 0x8c, 0x5d, 0x5d,
+	0x13, 0x89, 0x27, 0x79, 0xe2, 0xe8, 0xdd, 0x30, 0xf5, 0xb8, 0x88, 0x31, 0xc6, 0x77, 0xf9, 0x0f,
+	0xf8, 0x83, 0x04, 0x94, 0x64, 0x67, 0xc5, 0x43, 0x5d, 0x58, 0x3e, 0x61, 0x1f, 0x1d, 0x1d, 0x5c,
+	0x8e, 0xe9, 0xb1, 0x55, 0x7f, 0x4b, 0x60, 0xab, 0xdd, 0x7b, 0x36, 0x9b, 0x39, 0x5b, 0x99, 0xe9,
+	0x0c, 0xc6, 0x9c, 0x9d, 0x77, 0x9b, 0xc0, 0xcf, 0xc1, 0x74, 0xcb, 0x47, 0x6d, 0x07, 0x07, 0xa4,
+	0xd6, 0xf2, 0x71, 0x0b, 0x13, 0xe4, 0xb3, 0x55, 0x2e, 0xe9, 0xef, 0x27, 0x55, 0xd8, 0x13, 0x12,
+	0x22, 0x55, 0x86, 0xa8, 0xc2, 0x75, 0xec, 0x91, 0xb8, 0x0c, 0xa3, 0x2c, 0x5b, 0x22, 0x09, 0xfc,
+	0xa9, 0xcf, 0x94, 0x73, 0x9e, 0x19, 0xf4, 0x5a, 0x71, 0x95, 0xf5, 0x9b, 0xdb, 0xf4, 0x7b, 0x83,
+	0x87, 0xa1, 0xd3, 0x8e, 0x3a, 0x78, 0x00, 0xcc, 0x1b, 0x81, 0xe0, 0x13, 0x09, 0x2c, 0xa7, 0xf6,
+	0x64, 0x72, 0xc2, 0xd7, 0xac, 0xf8, 0xe4, 0x27, 0xca, 0x18, 0x13, 0xf0, 0xfa, 0x33, 0xcc, 0x1c,
+	0x42, 0xc3, 0xc7, 0x42, 0xc3, 0x4a, 0x4f, 0x17, 0xc8, 0x47, 0x0c, 0x15, 0x0d, 0x35, 0x9a, 0x19,
+	0x6a, 0xbb, 0x30, 0x8a, 0xc0, 0x43, 0x09, 0x2c, 0x26, 0x78, 0x8d, 0xf8, 0x60, 0x8e, 0x57, 0x67,
+	0x9c, 0x89, 0xbb, 0xf3, 0x94, 0x63, 0x80, 0x10, 0xb6, 0x29, 0x84, 0x5d, 0xef, 0x16, 0xd6, 0x0b,
+	0x14, 0x6a, 0x1a, 0x38, 0x33, 0x19, 0x0b, 0xed, 0xbe, 0x11, 0xf0, 0x0f, 0x09, 0x5c, 0x49, 0x10,
+	0x2c, 0x7e, 0x8e, 0xc6, 0x3a, 0x2e, 0x30, 0x1d, 0xab, 0x4f, 0x73, 0x5e, 0x0b, 0x11, 0xf7, 0x85,
+	0x88, 0xa5, 0x6e, 0x11, 0x5d, 0x10, 0xa1, 0x82, 0xe2, 0xd9, 0xc5, 0x98, 0x6f, 0xe7, 0x3f, 0x86,
+	0x3f, 0x67, 0x3a, 0x48, 0xe6, 0x00, 0x22, 0xca, 0x73, 0x8c, 0xfa, 0x2b, 0xc3, 0x1f, 0x68, 0x82,
+	0xf8, 0x46, 0xbf, 0x3e, 0x92, 0xcd, 0x1f, 0xf2, 0x2e, 0x1a, 0x2d, 0x52, 0xdd, 0x24, 0xfd, 0x90,
+	0xc0, 0x47, 0x12, 0x98, 0x4b, 0xdc, 0x20, 0x61, 0x6b, 0xaf, 0xb1, 0xa6, 0x4e, 0x14, 0xc0, 0x28,
+	0x57, 0x86, 0x3d, 0x39, 0x04, 0x61, 0x5d, 0x10, 0xbe, 0xd6, 0xed, 0x74, 0x3a, 0x77, 0x48, 0xb7,
+	0xff, 0x41, 0x6b, 0xcc, 0xb6, 0x7b, 0x1f, 0x11, 0xe8, 0x82, 0x8b, 0x66, 0x40, 0x71, 0xcd, 0xe7,
+	0xff, 0x85, 0x89, 0x32, 0xc1, 0x08, 0xbe, 0x58, 0xec, 0x69, 0xea, 0xdf, 0xb3, 0xbe, 0x98, 0x9d,
+	0x11, 0x32, 0xd9, 0x34, 0xa3, 0x64, 0x26, 0xa1, 0x04, 0x62, 0x30, 0x65, 0x61, 0x8f, 0x3a, 0x5e,
+	0x10, 0x76, 0xd1, 0xdd, 0xc0, 0xb3, 0x89, 0x52, 0x62, 0x80, 0xd7, 0x73, 0x01, 0xd7, 0xe3, 0xe0,
+	0x8d, 0xc0, 0xb3, 0xe3, 0x43, 0x4d, 0x7c, 0x24, 0xe8, 0x4e, 0xa5, 0x19, 0x93, 0x56, 0xe6, 0x05,
+	0xa2, 0x6f, 0x3e, 0x3a, 0x2a, 0x4b, 0x87, 0x47, 0x65, 0xe9, 0xf1, 0x51, 0x59, 0xfa, 0xfb, 0xa8,
+	0x2c, 0x7d, 0x73, 0x5c, 0x1e, 0x79, 0x7c, 0x5c, 0x1e, 0xf9, 0xeb, 0xb8, 0x3c, 0xf2, 0xc9, 0xad,
+	0xc2, 0xd6, 0xfd, 0x59, 0xf6, 0x03, 0x0b, 0xeb, 0xe4, 0x3b, 0x63, 0xec, 0xd3, 0xca, 0xed, 0x7f,
+	0x06, 0x00, 0xb4, 0xed, 0xde, 0x0c, 0xe7, 0x11, 0x00, 0x00,
 }
 
 func (this *DelegatorWithdrawInfo) Equal(that interface{}) bool {
@@ -666,6 +743,33 @@ func (this *DelegatorWithdrawInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegatorAutoRestake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorAutoRestake)
+	if !ok {
+		that2, ok := that.(DelegatorAutoRestake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	return true
+}
 func (this *ValidatorOutstandingRewardsRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -929,6 +1033,22 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AutoRestakes) != len(that1.AutoRestakes) {
+		return false
+	}
+	for i := range this.AutoRestakes {
+		if !this.AutoRestakes[i].Equal(&that1.AutoRestakes[i]) {
+			return false
+		}
+	}
+	if len(this.ContinuousFunds) != len(that1.ContinuousFunds) {
+		return false
+	}
+	for i := range this.ContinuousFunds {
+		if !this.ContinuousFunds[i].Equal(&that1.ContinuousFunds[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -968,6 +1088,43 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1254,6 +1411,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoRestakes) > 0 {
+		for iNdEx := len(m.AutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1410,6 +1595,23 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1573,6 +1775,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoRestakes) > 0 {
+		for _, e := range m.AutoRestakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1703,6 +1917,127 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2882,6 +3217,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakes = append(m.AutoRestakes, DelegatorAutoRestake{})
+			if err := m.AutoRestakes[len(m.AutoRestakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
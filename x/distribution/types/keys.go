@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,19 +28,25 @@ const (
 //
 // - 0x01: sdk.ConsAddress
 //
-// - 0x02<valAddr_Bytes>: ValidatorOutstandingRewards
+// - 0x02<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorOutstandingRewards
 //
-// - 0x03<accAddr_Bytes>: sdk.AccAddress
+// - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: sdk.AccAddress
 //
-// - 0x04<valAddr_Bytes><accAddr_Bytes>: DelegatorStartingInfo
+// - 0x04<valAddrLen (1 Byte)><valAddr_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>: DelegatorStartingInfo
 //
-// - 0x05<valAddr_Bytes><period_Bytes>: ValidatorHistoricalRewards
+// - 0x05<valAddrLen (1 Byte)><valAddr_Bytes><period_Bytes>: ValidatorHistoricalRewards
 //
-// - 0x06<valAddr_Bytes>: ValidatorCurrentRewards
+// - 0x06<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentRewards
 //
-// - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
+// - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentRewards
 //
-// - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+// - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x0A: DelegatorAutoRestake key (auto-restake cursor)
+//
+// - 0x0B<accAddrLen (1 Byte)><accAddr_Bytes>: ContinuousFund
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,35 +58,27 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoRestakePrefix           = []byte{0x09} // key for delegations whose rewards are auto-restaked
+	AutoRestakeCursorKey                 = []byte{0x0A} // key for the last auto-restaked delegation
+	ContinuousFundPrefix                 = []byte{0x0B} // key for continuous funds paid from the community pool
 )
 
 // gets an address from a validator's outstanding rewards key
 func GetValidatorOutstandingRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
-	addr := key[1:]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
-	return sdk.ValAddress(addr)
+	return sdk.ValAddress(parseSingleAddressKey(key))
 }
 
 // gets an address from a delegator's withdraw info key
 func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
-	addr := key[1:]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
-	return sdk.AccAddress(addr)
+	return sdk.AccAddress(parseSingleAddressKey(key))
 }
 
 // gets the addresses from a delegator starting info key
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
-	addr := key[1 : 1+sdk.AddrLen]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
+	addr, rest := address.ParseLengthPrefixed(key[1:])
 	valAddr = sdk.ValAddress(addr)
-	addr = key[1+sdk.AddrLen:]
-	if len(addr) != sdk.AddrLen {
+	addr, rest = address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
 		panic("unexpected key length")
 	}
 	delAddr = sdk.AccAddress(addr)
@@ -88,12 +87,8 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delA
 
 // gets the address & period from a validator's historical rewards key
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
-	addr := key[1 : 1+sdk.AddrLen]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
+	addr, b := address.ParseLengthPrefixed(key[1:])
 	valAddr = sdk.ValAddress(addr)
-	b := key[1+sdk.AddrLen:]
 	if len(b) != 8 {
 		panic("unexpected key length")
 	}
@@ -103,85 +98,99 @@ func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddr
 
 // gets the address from a validator's current rewards key
 func GetValidatorCurrentRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
-	addr := key[1:]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
-	return sdk.ValAddress(addr)
+	return sdk.ValAddress(parseSingleAddressKey(key))
 }
 
 // gets the address from a validator's accumulated commission key
 func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddress) {
-	addr := key[1:]
-	if len(addr) != sdk.AddrLen {
+	return sdk.ValAddress(parseSingleAddressKey(key))
+}
+
+// gets the addresses from a delegator auto-restake key
+func GetDelegatorAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr, rest := address.ParseLengthPrefixed(key[1:])
+	delAddr = sdk.AccAddress(addr)
+	addr, rest = address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
 		panic("unexpected key length")
 	}
-	return sdk.ValAddress(addr)
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
+// gets the recipient address from a continuous fund key
+func GetContinuousFundRecipient(key []byte) (recipient sdk.AccAddress) {
+	return sdk.AccAddress(parseSingleAddressKey(key))
 }
 
 // gets the height from a validator's slash event key
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
-	addr := key[1 : 1+sdk.AddrLen]
-	if len(addr) != sdk.AddrLen {
+	addr, b := address.ParseLengthPrefixed(key[1:])
+	valAddr = sdk.ValAddress(addr)
+	if len(b) < 8 {
 		panic("unexpected key length")
 	}
-	valAddr = sdk.ValAddress(addr)
-	startB := 1 + sdk.AddrLen
-	b := key[startB : startB+8] // the next 8 bytes represent the height
-	height = binary.BigEndian.Uint64(b)
+	height = binary.BigEndian.Uint64(b[:8]) // the next 8 bytes represent the height
 	return
 }
 
+// parseSingleAddressKey returns the address of a key made of a prefix byte
+// and a length prefixed address.
+func parseSingleAddressKey(key []byte) []byte {
+	addr, rest := address.ParseLengthPrefixed(key[1:])
+	if len(rest) != 0 {
+		panic("unexpected key length")
+	}
+	return addr
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
-	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
+	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
 }
 
 // gets the key for a delegator's withdraw addr
 func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
-	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
+	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
-	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
+	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
 }
 
 // gets the prefix key for a validator's historical rewards
 func GetValidatorHistoricalRewardsPrefix(v sdk.ValAddress) []byte {
-	return append(ValidatorHistoricalRewardsPrefix, v.Bytes()...)
+	return append(ValidatorHistoricalRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
 // gets the key for a validator's historical rewards
 func GetValidatorHistoricalRewardsKey(v sdk.ValAddress, k uint64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, k)
-	return append(append(ValidatorHistoricalRewardsPrefix, v.Bytes()...), b...)
+	return append(GetValidatorHistoricalRewardsPrefix(v), b...)
 }
 
 // gets the key for a validator's current rewards
 func GetValidatorCurrentRewardsKey(v sdk.ValAddress) []byte {
-	return append(ValidatorCurrentRewardsPrefix, v.Bytes()...)
+	return append(ValidatorCurrentRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
 // gets the key for a validator's current commission
 func GetValidatorAccumulatedCommissionKey(v sdk.ValAddress) []byte {
-	return append(ValidatorAccumulatedCommissionPrefix, v.Bytes()...)
+	return append(ValidatorAccumulatedCommissionPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
 // gets the prefix key for a validator's slash fractions
 func GetValidatorSlashEventPrefix(v sdk.ValAddress) []byte {
-	return append(ValidatorSlashEventPrefix, v.Bytes()...)
+	return append(ValidatorSlashEventPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
 // gets the prefix key for a validator's slash fraction (ValidatorSlashEventPrefix + height)
 func GetValidatorSlashEventKeyPrefix(v sdk.ValAddress, height uint64) []byte {
 	heightBz := make([]byte, 8)
 	binary.BigEndian.PutUint64(heightBz, height)
-	return append(
-		ValidatorSlashEventPrefix,
-		append(v.Bytes(), heightBz...)...,
-	)
+	return append(GetValidatorSlashEventPrefix(v), heightBz...)
 }
 
 // gets the key for a validator's slash fraction
@@ -191,3 +200,18 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for a delegator's auto-restaked delegations
+func GetDelegatorAutoRestakePrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoRestakePrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// gets the key for an auto-restaked delegation
+func GetDelegatorAutoRestakeKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoRestakePrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// gets the key for the continuous fund of a recipient
+func GetContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestKeysRoundTrip(t *testing.T) {
+	addrs := [][]byte{
+		sdk.AccAddress("addr1_______________"),
+		address.Module(ModuleName, []byte("key")),
+	}
+
+	for _, bz := range addrs {
+		valAddr := sdk.ValAddress(bz)
+		delAddr := sdk.AccAddress(bz)
+
+		require.Equal(t, valAddr, GetValidatorOutstandingRewardsAddress(GetValidatorOutstandingRewardsKey(valAddr)))
+		require.Equal(t, delAddr, GetDelegatorWithdrawInfoAddress(GetDelegatorWithdrawAddrKey(delAddr)))
+		require.Equal(t, valAddr, GetValidatorCurrentRewardsAddress(GetValidatorCurrentRewardsKey(valAddr)))
+		require.Equal(t, valAddr, GetValidatorAccumulatedCommissionAddress(GetValidatorAccumulatedCommissionKey(valAddr)))
+
+		v, d := GetDelegatorStartingInfoAddresses(GetDelegatorStartingInfoKey(valAddr, delAddr))
+		require.Equal(t, valAddr, v)
+		require.Equal(t, delAddr, d)
+
+		v, period := GetValidatorHistoricalRewardsAddressPeriod(GetValidatorHistoricalRewardsKey(valAddr, 7))
+		require.Equal(t, valAddr, v)
+		require.Equal(t, uint64(7), period)
+
+		v, height := GetValidatorSlashEventAddressHeight(GetValidatorSlashEventKey(valAddr, 10, 3))
+		require.Equal(t, valAddr, v)
+		require.Equal(t, uint64(10), height)
+	}
+
+	require.Panics(t, func() {
+		GetValidatorCurrentRewardsAddress(append(GetValidatorCurrentRewardsKey(sdk.ValAddress(addrs[0])), 0x01))
+	})
+}
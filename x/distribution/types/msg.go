@@ -1,4 +1,4 @@
-//nolint
+// nolint
 package types
 
 import (
@@ -8,10 +8,13 @@ import (
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress                = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward           = "withdraw_delegator_reward"
+	TypeMsgWithdrawAllDelegatorRewards       = "withdraw_all_delegator_rewards"
+	TypeMsgWithdrawValidatorCommission       = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool                 = "fund_community_pool"
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgSetAutoRestake                    = "set_auto_restake"
 )
 
 // Verify interface at compile time
@@ -82,6 +85,43 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of a delegator, and the
+// commission of the validator it operates if withdrawCommission is set.
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress, withdrawCommission bool) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr,
+		WithdrawCommission: withdrawCommission,
+	}
+}
+
+// Route returns the MsgWithdrawAllDelegatorRewards message route.
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawAllDelegatorRewards message type.
+func (msg MsgWithdrawAllDelegatorRewards) Type() string { return TypeMsgWithdrawAllDelegatorRewards }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawAllDelegatorRewards
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawAllDelegatorRewards message validation.
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
@@ -149,3 +189,82 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward for the owner of tokenize share records.
+func NewMsgWithdrawTokenizeShareRecordReward(owner sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: owner,
+	}
+}
+
+// Route returns the MsgWithdrawTokenizeShareRecordReward message route.
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawTokenizeShareRecordReward message type.
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawTokenizeShareRecordReward message validation.
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if msg.OwnerAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OwnerAddress.String())
+	}
+
+	return nil
+}
+
+// NewMsgSetAutoRestake returns a new MsgSetAutoRestake enabling or disabling
+// the automatic restaking of the rewards of a delegation.
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoRestake message route.
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoRestake message type.
+func (msg MsgSetAutoRestake) Type() string { return TypeMsgSetAutoRestake }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoRestake message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoRestake message validation.
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
@@ -93,3 +93,46 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoRestake
+func TestMsgSetAutoRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestake(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr      sdk.AccAddress
+		withdrawCommission bool
+		expectPass         bool
+	}{
+		{delAddr1, false, true},
+		{delAddr1, true, true},
+		{emptyDelAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr, tc.withdrawCommission)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,14 +12,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolContinuousFund defines the type for a CommunityPoolContinuousFundProposal
+	ProposalTypeCommunityPoolContinuousFund = "CommunityPoolContinuousFund"
+	// ProposalTypeCancelCommunityPoolContinuousFund defines the type for a CancelCommunityPoolContinuousFundProposal
+	ProposalTypeCancelCommunityPoolContinuousFund = "CancelCommunityPoolContinuousFund"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the community pool proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &CommunityPoolContinuousFundProposal{}
+	_ govtypes.Content = &CancelCommunityPoolContinuousFundProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolContinuousFund)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolContinuousFundProposal{}, "cosmos-sdk/CommunityPoolContinuousFundProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolContinuousFund)
+	govtypes.RegisterProposalTypeCodec(&CancelCommunityPoolContinuousFundProposal{}, "cosmos-sdk/CancelCommunityPoolContinuousFundProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spned proposal.
@@ -65,3 +78,101 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewCommunityPoolContinuousFundProposal creates a new community pool continuous fund proposal.
+func NewCommunityPoolContinuousFundProposal(
+	title, description string, recipient sdk.AccAddress, amountPerBlock sdk.Coins, endTime time.Time,
+) *CommunityPoolContinuousFundProposal {
+	return &CommunityPoolContinuousFundProposal{title, description, recipient, amountPerBlock, endTime}
+}
+
+// GetTitle returns the title of a community pool continuous fund proposal.
+func (cfp *CommunityPoolContinuousFundProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a community pool continuous fund proposal.
+func (cfp *CommunityPoolContinuousFundProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a community pool continuous fund proposal.
+func (cfp *CommunityPoolContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool continuous fund proposal.
+func (cfp *CommunityPoolContinuousFundProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolContinuousFund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp *CommunityPoolContinuousFundProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cfp)
+	if err != nil {
+		return err
+	}
+	if !cfp.AmountPerBlock.IsValid() || cfp.AmountPerBlock.IsZero() {
+		return ErrInvalidProposalAmount
+	}
+	if cfp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if cfp.EndTime.IsZero() {
+		return ErrInvalidProposalEndTime
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cfp CommunityPoolContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Continuous Fund Proposal:
+  Title:            %s
+  Description:      %s
+  Recipient:        %s
+  Amount Per Block: %s
+  End Time:         %s
+`, cfp.Title, cfp.Description, cfp.Recipient, cfp.AmountPerBlock, cfp.EndTime))
+	return b.String()
+}
+
+// NewCancelCommunityPoolContinuousFundProposal creates a new proposal to cancel a community pool continuous fund.
+func NewCancelCommunityPoolContinuousFundProposal(
+	title, description string, recipient sdk.AccAddress,
+) *CancelCommunityPoolContinuousFundProposal {
+	return &CancelCommunityPoolContinuousFundProposal{title, description, recipient}
+}
+
+// GetTitle returns the title of a continuous fund cancellation proposal.
+func (ccp *CancelCommunityPoolContinuousFundProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a continuous fund cancellation proposal.
+func (ccp *CancelCommunityPoolContinuousFundProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a continuous fund cancellation proposal.
+func (ccp *CancelCommunityPoolContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a continuous fund cancellation proposal.
+func (ccp *CancelCommunityPoolContinuousFundProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolContinuousFund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *CancelCommunityPoolContinuousFundProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(ccp)
+	if err != nil {
+		return err
+	}
+	if ccp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ccp CancelCommunityPoolContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Continuous Fund Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+`, ccp.Title, ccp.Description, ccp.Recipient))
+	return b.String()
+}
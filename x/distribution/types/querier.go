@@ -15,6 +15,9 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryDelegatorAutoRestakes       = "delegator_auto_restakes"
+	QueryContinuousFund              = "continuous_fund"
+	QueryContinuousFunds             = "continuous_funds"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -71,7 +74,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/delegator_auto_restakes'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -92,3 +96,13 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/continuous_fund'
+type QueryContinuousFundParams struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// NewQueryContinuousFundParams creates a new instance of QueryContinuousFundParams.
+func NewQueryContinuousFundParams(recipient sdk.AccAddress) QueryContinuousFundParams {
+	return QueryContinuousFundParams{Recipient: recipient}
+}
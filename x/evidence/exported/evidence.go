@@ -34,6 +34,15 @@ type ValidatorEvidence interface {
 	GetTotalPower() int64
 }
 
+// ReportedEvidence extends Evidence interface to define contract for evidence
+// whose reporter is rewarded from the tokens slashed for the infraction
+type ReportedEvidence interface {
+	Evidence
+
+	// The address reporting the infraction, if any
+	GetReporter() sdk.AccAddress
+}
+
 // MsgSubmitEvidence defines the specific interface a concrete message must
 // implement in order to process submitted evidence. The concrete MsgSubmitEvidence
 // must be defined at the application-level.
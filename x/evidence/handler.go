@@ -1,6 +1,8 @@
 package evidence
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -42,3 +44,29 @@ func handleMsgSubmitEvidence(ctx sdk.Context, k keeper.Keeper, msg exported.MsgS
 		Events: ctx.EventManager().ABCIEvents(),
 	}, nil
 }
+
+// NewLightClientAttackHandler returns an Evidence Handler for LightClientAttack
+// evidence, to be registered under the RouteLightClientAttack route.
+func NewLightClientAttackHandler(k keeper.Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.LightClientAttack)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		return k.HandleLightClientAttack(ctx, evidence)
+	}
+}
+
+// NewAmnesiaHandler returns an Evidence Handler for Amnesia evidence, to be
+// registered under the RouteAmnesia route.
+func NewAmnesiaHandler(k keeper.Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.Amnesia)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		return k.HandleAmnesia(ctx, evidence)
+	}
+}
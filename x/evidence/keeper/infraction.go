@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
@@ -75,7 +79,7 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 	}
 
 	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+	if k.isTombstoned(ctx, consAddr, validator) {
 		logger.Info(
 			"ignored equivocation; validator already tombstoned",
 			"validator", consAddr,
@@ -111,6 +115,37 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 		evidence.GetValidatorPower(), distributionHeight,
 	)
 
+	k.tombstone(ctx, consAddr, validator)
+}
+
+// rotatedConsAddr returns the current consensus address of a validator if it
+// has rotated its consensus pubkey since it used the given consensus address.
+func (k Keeper) rotatedConsAddr(
+	ctx sdk.Context, consAddr sdk.ConsAddress, validator stakingexported.ValidatorI,
+) (sdk.ConsAddress, bool) {
+	currConsAddr := validator.GetConsAddr()
+	rotated := !currConsAddr.Equals(consAddr) && k.slashingKeeper.HasValidatorSigningInfo(ctx, currConsAddr)
+
+	return currConsAddr, rotated
+}
+
+// isTombstoned returns true if a validator is tombstoned under the given
+// consensus address or, if it has rotated its consensus pubkey since the
+// infraction, under its current consensus address.
+func (k Keeper) isTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress, validator stakingexported.ValidatorI) bool {
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return true
+	}
+
+	currConsAddr, rotated := k.rotatedConsAddr(ctx, consAddr, validator)
+	return rotated && k.slashingKeeper.IsTombstoned(ctx, currConsAddr)
+}
+
+// tombstone jails a validator which committed an infraction with the given
+// consensus address forever and tombstones it. The validator may have rotated
+// its consensus pubkey since the infraction, in which case both the key used
+// for the infraction and its current key are tombstoned.
+func (k Keeper) tombstone(ctx sdk.Context, consAddr sdk.ConsAddress, validator stakingexported.ValidatorI) {
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
@@ -119,4 +154,263 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
+
+	if currConsAddr, rotated := k.rotatedConsAddr(ctx, consAddr, validator); rotated {
+		k.slashingKeeper.JailUntil(ctx, currConsAddr, types.DoubleSignJailEndTime)
+		k.slashingKeeper.Tombstone(ctx, currConsAddr)
+	}
+}
+
+// isEvidenceTooOld returns true if evidence of an infraction at the given
+// height and time is too old to be handled, i.e. if both its age in time and
+// in number of blocks are greater than the allowed consensus parameters.
+func (k Keeper) isEvidenceTooOld(ctx sdk.Context, infractionHeight int64, infractionTime time.Time) bool {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Evidence == nil {
+		return false
+	}
+
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - infractionHeight
+
+	return ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks
+}
+
+// HandleLightClientAttack implements a light client attack evidence handler.
+// The evidence is a header conflicting with the canonical chain at the same
+// height, which could be used to fool a light client, along with precommits
+// for it. Every validator which signed a precommit for the conflicting header
+// is slashed, jailed and tombstoned, the reporter of the evidence being paid
+// the ReporterRewardFraction of the slashed tokens.
+//
+// Since honest validators may precommit a block in a round which does not
+// commit, the conflicting header is only considered as an attack if it differs
+// from the canonical header in a field derived from the application state at
+// its height, which no honest validator would precommit (a lunatic attack).
+// The canonical header and the voting power of the validators at the height of
+// the attack are given by the historical info of the staking module, so that
+// attacks older than the HistoricalEntries kept cannot be handled.
+//
+// The evidence is considered invalid if:
+// - the conflicting header is for another chain or a height not committed yet
+// - the historical info does not exist at the height of the conflicting header
+// - the conflicting header does not conflict with the canonical header
+// - a precommit is not for the conflicting header or has an invalid signature
+// - a precommit is not signed by a validator of the validator set at the
+// height of the conflicting header
+// - the evidence is too old
+// - no validator can be punished, i.e. they are all unbonded or tombstoned
+func (k Keeper) HandleLightClientAttack(ctx sdk.Context, evidence *types.LightClientAttack) error {
+	logger := k.Logger(ctx)
+	header := evidence.GetConflictingHeader()
+	infractionHeight := header.Height
+
+	if header.ChainID != ctx.ChainID() {
+		return fmt.Errorf("conflicting header is for chain %s, expected %s", header.ChainID, ctx.ChainID())
+	}
+	if infractionHeight >= ctx.BlockHeight() {
+		return fmt.Errorf("conflicting header height %d is not committed yet", infractionHeight)
+	}
+
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return fmt.Errorf("no historical info at height %d", infractionHeight)
+	}
+
+	canonical := histInfo.Header
+	if bytes.Equal(header.ValidatorsHash, canonical.ValidatorsHash) &&
+		bytes.Equal(header.NextValidatorsHash, canonical.NextValidatorsHash) &&
+		bytes.Equal(header.ConsensusHash, canonical.ConsensusHash) &&
+		bytes.Equal(header.AppHash, canonical.AppHash) &&
+		bytes.Equal(header.LastResultsHash, canonical.LastResultsHash) {
+		return fmt.Errorf("conflicting header does not conflict with the canonical header at height %d", infractionHeight)
+	}
+
+	// the age of the evidence is the one of the canonical header, the time of
+	// the conflicting header being chosen by the attackers
+	if k.isEvidenceTooOld(ctx, infractionHeight, canonical.Time) {
+		return fmt.Errorf("light client attack at height %d is too old", infractionHeight)
+	}
+
+	headerHash := header.Hash()
+	powers := make([]int64, len(evidence.Votes))
+	for i, vote := range evidence.Votes {
+		if !bytes.Equal(vote.BlockHash, headerHash) {
+			return fmt.Errorf("vote of %s is not for the conflicting header", vote.ValidatorAddress)
+		}
+
+		pubkey, err := k.slashingKeeper.GetPubkey(ctx, vote.ValidatorAddress.Bytes())
+		if err != nil {
+			return err
+		}
+		if err := vote.ToTendermint().Verify(header.ChainID, pubkey); err != nil {
+			return fmt.Errorf("invalid vote of %s: %w", vote.ValidatorAddress, err)
+		}
+
+		found := false
+		for _, val := range histInfo.Valset {
+			if val.GetConsAddr().Equals(vote.ValidatorAddress) {
+				powers[i], found = val.GetConsensusPower(), true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s is not a validator at height %d", vote.ValidatorAddress, infractionHeight)
+		}
+	}
+
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	punished := 0
+	for i, vote := range evidence.Votes {
+		consAddr := vote.ValidatorAddress
+
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+		if validator == nil || validator.IsUnbonded() || !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+			continue
+		}
+		if k.isTombstoned(ctx, consAddr, validator) {
+			logger.Info(
+				"ignored light client attack; validator already tombstoned",
+				"validator", consAddr,
+				"infraction_height", infractionHeight,
+			)
+			continue
+		}
+
+		logger.Info(
+			"confirmed light client attack",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"reporter", evidence.Reporter,
+		)
+
+		k.slashingKeeper.SlashWithReward(
+			ctx,
+			consAddr,
+			k.slashingKeeper.SlashFractionDoubleSign(ctx),
+			powers[i], distributionHeight,
+			slashingtypes.AttributeValueLightClientAttack, evidence.Reporter,
+		)
+		k.tombstone(ctx, consAddr, validator)
+		punished++
+	}
+
+	if punished == 0 {
+		return fmt.Errorf("no validator to punish for the light client attack at height %d", infractionHeight)
+	}
+
+	return nil
+}
+
+// HandleAmnesia implements an amnesia evidence handler. The evidence is a
+// precommit of a validator for a block and a vote of the same validator at the
+// same height for a different block in a later round. The validator is
+// slashed and jailed for DowntimeJailDuration, the reporter of the evidence
+// being paid the ReporterRewardFraction of the slashed tokens. As the
+// validator may have been allowed to change its vote by a proof of lock change
+// which cannot be verified by the application, the validator is not
+// tombstoned, and is punished at most once per height.
+//
+// The evidence is considered invalid if:
+// - a vote has an invalid signature
+// - the historical info does not exist at the height of the votes
+// - the validator is not in the validator set at the height of the votes
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - the signing info does not exist
+// - the validator is tombstoned
+// - the validator has already been punished for amnesia at the same height
+func (k Keeper) HandleAmnesia(ctx sdk.Context, evidence *types.Amnesia) error {
+	logger := k.Logger(ctx)
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+
+	pubkey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return err
+	}
+	for _, vote := range []types.Vote{evidence.VoteA, evidence.VoteB} {
+		if err := vote.ToTendermint().Verify(ctx.ChainID(), pubkey); err != nil {
+			return fmt.Errorf("invalid vote of %s: %w", consAddr, err)
+		}
+	}
+
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return fmt.Errorf("no historical info at height %d", infractionHeight)
+	}
+
+	power := int64(0)
+	for _, val := range histInfo.Valset {
+		if val.GetConsAddr().Equals(consAddr) {
+			power = val.GetConsensusPower()
+			break
+		}
+	}
+	if power == 0 {
+		return fmt.Errorf("%s is not a validator at height %d", consAddr, infractionHeight)
+	}
+
+	if k.isEvidenceTooOld(ctx, infractionHeight, evidence.GetTime()) {
+		return fmt.Errorf("amnesia at height %d is too old", infractionHeight)
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", consAddr)
+	}
+	if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return fmt.Errorf("no signing info for validator %s", consAddr)
+	}
+	if k.isTombstoned(ctx, consAddr, validator) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.AmnesiaKey(consAddr, infractionHeight)
+	if store.Has(key) {
+		return fmt.Errorf("validator %s already punished for amnesia at height %d", consAddr, infractionHeight)
+	}
+	store.Set(key, []byte{})
+
+	logger.Info(
+		"confirmed amnesia",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"reporter", evidence.Reporter,
+	)
+
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	k.slashingKeeper.SlashWithReward(
+		ctx,
+		consAddr,
+		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		power, distributionHeight,
+		slashingtypes.AttributeValueAmnesia, evidence.Reporter,
+	)
+
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	// an existing jail period is not shortened
+	jailAddrs := []sdk.ConsAddress{consAddr}
+	if currConsAddr, rotated := k.rotatedConsAddr(ctx, consAddr, validator); rotated {
+		jailAddrs = append(jailAddrs, currConsAddr)
+	}
+
+	jailedUntil := ctx.BlockHeader().Time.Add(k.slashingKeeper.DowntimeJailDuration(ctx))
+	for _, addr := range jailAddrs {
+		if signInfo, _ := k.slashingKeeper.GetValidatorSigningInfo(ctx, addr); signInfo.JailedUntil.Before(jailedUntil) {
+			k.slashingKeeper.JailUntil(ctx, addr, jailedUntil)
+		}
+	}
+
+	return nil
 }
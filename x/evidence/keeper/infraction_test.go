@@ -8,7 +8,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) *stakingtypes.MsgCreateValidator {
@@ -85,6 +89,50 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.NotNil(res)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_RotatedConsPubKey() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	selfDelegation := sdk.TokensFromConsensusPower(power)
+	operatorAddr, oldPk, newPk := valAddresses[0], pubkeys[0], pubkeys[1]
+
+	// create validator
+	res, err := staking.NewHandler(suite.app.StakingKeeper)(ctx, newTestMsgCreateValidator(operatorAddr, oldPk, selfDelegation))
+	suite.NoError(err)
+	suite.NotNil(res)
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus pubkey of the validator
+	res, err = staking.NewHandler(suite.app.StakingKeeper)(ctx, stakingtypes.NewMsgRotateConsPubKey(operatorAddr, newPk))
+	suite.NoError(err)
+	suite.NotNil(res)
+
+	// double sign with the old key
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(oldPk.Address()),
+	}
+	suite.app.EvidenceKeeper.HandleDoubleSign(ctx, evidence)
+
+	// should be slashed, jailed and tombstoned under both keys
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(oldPk.Address())))
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPk.Address())))
+
+	// require we cannot unjail
+	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(suite.app.StakingKeeper.UnbondingTime(ctx)))
+	suite.Error(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
@@ -124,3 +172,164 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func newTestVote(
+	privKey ed25519.PrivKeyEd25519, chainID string, voteType tmtypes.SignedMsgType, height int64, round int, blockHash []byte,
+) types.Vote {
+	vote := &tmtypes.Vote{
+		Type:   voteType,
+		Height: height,
+		Round:  round,
+		BlockID: tmtypes.BlockID{
+			Hash:        blockHash,
+			PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(blockHash)},
+		},
+		Timestamp:        time.Unix(0, 0).UTC(),
+		ValidatorAddress: privKey.PubKey().Address(),
+	}
+
+	sig, err := privKey.Sign(vote.SignBytes(chainID))
+	if err != nil {
+		panic(err)
+	}
+	vote.Signature = sig
+
+	return types.NewVote(vote)
+}
+
+// createValidators creates a bonded validator of the given power for each of
+// the given consensus keys, and records the historical info at the current
+// height with the given header.
+func (suite *KeeperTestSuite) createValidators(ctx sdk.Context, header abci.Header, power int64, privKeys ...ed25519.PrivKeyEd25519) {
+	suite.populateValidators(ctx)
+
+	selfDelegation := sdk.TokensFromConsensusPower(power)
+	for i, privKey := range privKeys {
+		res, err := staking.NewHandler(suite.app.StakingKeeper)(ctx, newTestMsgCreateValidator(valAddresses[i], privKey.PubKey(), selfDelegation))
+		suite.NoError(err)
+		suite.NotNil(res)
+	}
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	for _, privKey := range privKeys {
+		suite.app.SlashingKeeper.HandleValidatorSignature(ctx, privKey.PubKey().Address(), power, true)
+	}
+
+	suite.app.StakingKeeper.SetHistoricalInfo(
+		ctx, ctx.BlockHeight(), stakingtypes.NewHistoricalInfo(header, suite.app.StakingKeeper.GetLastValidators(ctx)),
+	)
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithChainID("test-chain").WithBlockTime(time.Unix(100, 0))
+	privKeys := []ed25519.PrivKeyEd25519{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	reporter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	canonical := abci.Header{
+		ChainID:        ctx.ChainID(),
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		ValidatorsHash: tmhash.Sum([]byte("validators")),
+		AppHash:        tmhash.Sum([]byte("app")),
+	}
+	power := int64(100)
+	suite.createValidators(ctx, canonical, power, privKeys...)
+	ctx = ctx.WithBlockHeight(2)
+
+	operatorAddr, consAddr := valAddresses[0], sdk.ConsAddress(privKeys[0].PubKey().Address())
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	// a header with the canonical state does not conflict
+	evidence := &types.LightClientAttack{ConflictingHeader: canonical, Reporter: reporter}
+	evidence.Votes = []types.Vote{
+		newTestVote(privKeys[0], ctx.ChainID(), tmtypes.PrecommitType, 1, 0, evidence.GetConflictingHeader().Hash()),
+	}
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	// a header with another application state conflicts
+	lunatic := canonical
+	lunatic.AppHash = tmhash.Sum([]byte("lunatic"))
+	evidence = &types.LightClientAttack{ConflictingHeader: lunatic, Reporter: reporter}
+	headerHash := evidence.GetConflictingHeader().Hash()
+
+	// votes must be for the conflicting header
+	evidence.Votes = []types.Vote{
+		newTestVote(privKeys[0], ctx.ChainID(), tmtypes.PrecommitType, 1, 0, tmhash.Sum([]byte("block"))),
+	}
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	// votes must be signed by their validator
+	vote := newTestVote(privKeys[1], ctx.ChainID(), tmtypes.PrecommitType, 1, 0, headerHash)
+	vote.ValidatorAddress = consAddr
+	evidence.Votes = []types.Vote{vote}
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	evidence.Votes = []types.Vote{
+		newTestVote(privKeys[0], ctx.ChainID(), tmtypes.PrecommitType, 1, 0, headerHash),
+	}
+	suite.NoError(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	// the signer should be slashed, jailed and tombstoned
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.LT(oldTokens))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// the other validator should not be punished
+	suite.False(suite.app.StakingKeeper.Validator(ctx, valAddresses[1]).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(privKeys[1].PubKey().Address())))
+
+	// the reporter should be paid its reward from the slashed tokens
+	expectedReward := oldTokens.Sub(newTokens).ToDec().Mul(suite.app.SlashingKeeper.ReporterRewardFraction(ctx)).TruncateInt()
+	suite.True(expectedReward.IsPositive())
+	suite.Equal(expectedReward, suite.app.BankKeeper.GetBalance(ctx, reporter, sdk.DefaultBondDenom).Amount)
+
+	// no validator left to punish
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+	suite.Equal(newTokens, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+}
+
+func (suite *KeeperTestSuite) TestHandleAmnesia() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithChainID("test-chain").WithBlockTime(time.Unix(100, 0))
+	privKey := ed25519.GenPrivKey()
+	reporter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	power := int64(100)
+	suite.createValidators(ctx, abci.Header{ChainID: ctx.ChainID(), Height: 1, Time: ctx.BlockTime()}, power, privKey)
+	ctx = ctx.WithBlockHeight(2)
+
+	operatorAddr, consAddr := valAddresses[0], sdk.ConsAddress(privKey.PubKey().Address())
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	evidence := &types.Amnesia{
+		VoteA:    newTestVote(privKey, ctx.ChainID(), tmtypes.PrecommitType, 1, 0, tmhash.Sum([]byte("block a"))),
+		VoteB:    newTestVote(privKey, ctx.ChainID(), tmtypes.PrevoteType, 1, 1, tmhash.Sum([]byte("block b"))),
+		Reporter: reporter,
+	}
+
+	// votes must be signed for the chain
+	suite.Error(suite.app.EvidenceKeeper.HandleAmnesia(ctx.WithChainID("other-chain"), evidence))
+
+	suite.NoError(suite.app.EvidenceKeeper.HandleAmnesia(ctx, evidence))
+
+	// the validator should be slashed and jailed, but not tombstoned
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.LT(oldTokens))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	signInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(suite.app.SlashingKeeper.DowntimeJailDuration(ctx)), signInfo.JailedUntil)
+
+	// the reporter should be paid its reward from the slashed tokens
+	expectedReward := oldTokens.Sub(newTokens).ToDec().Mul(suite.app.SlashingKeeper.ReporterRewardFraction(ctx)).TruncateInt()
+	suite.True(expectedReward.IsPositive())
+	suite.Equal(expectedReward, suite.app.BankKeeper.GetBalance(ctx, reporter, sdk.DefaultBondDenom).Amount)
+
+	// the validator is punished at most once per height
+	evidence.VoteB = newTestVote(privKey, ctx.ChainID(), tmtypes.PrevoteType, 1, 2, tmhash.Sum([]byte("block c")))
+	suite.Error(suite.app.EvidenceKeeper.HandleAmnesia(ctx, evidence))
+	suite.Equal(newTokens, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+}
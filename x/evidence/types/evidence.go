@@ -1,12 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +18,19 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
+	RouteAmnesia           = "amnesia"
+	TypeAmnesia            = "amnesia"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence         = &Equivocation{}
+	_ exported.ReportedEvidence = &LightClientAttack{}
+	_ exported.ReportedEvidence = &Amnesia{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -90,3 +101,168 @@ func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) exported.Evidence {
 		Time:             dupVote.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.ConflictingHeader.Height < 1 {
+		return fmt.Errorf("invalid light client attack header height: %d", e.ConflictingHeader.Height)
+	}
+	if len(e.ConflictingHeader.ValidatorsHash) == 0 {
+		return fmt.Errorf("invalid light client attack header: missing validators hash")
+	}
+	if len(e.Votes) == 0 {
+		return fmt.Errorf("invalid light client attack: no votes")
+	}
+
+	signers := make(map[string]bool, len(e.Votes))
+	for _, vote := range e.Votes {
+		if err := vote.ValidateBasic(); err != nil {
+			return err
+		}
+		if !vote.IsPrecommit() {
+			return fmt.Errorf("invalid light client attack vote type: %d", vote.Type)
+		}
+		if vote.Height != e.ConflictingHeader.Height {
+			return fmt.Errorf(
+				"invalid light client attack vote height: %d, expected %d", vote.Height, e.ConflictingHeader.Height,
+			)
+		}
+		if signers[vote.ValidatorAddress.String()] {
+			return fmt.Errorf("duplicate light client attack vote from %s", vote.ValidatorAddress)
+		}
+		signers[vote.ValidatorAddress.String()] = true
+	}
+
+	return nil
+}
+
+// GetHeight returns the height of the conflicting header of the
+// LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.ConflictingHeader.Height
+}
+
+// GetReporter returns the address reporting the LightClientAttack.
+func (e LightClientAttack) GetReporter() sdk.AccAddress {
+	return e.Reporter
+}
+
+// GetConflictingHeader returns the Tendermint header the votes of the
+// LightClientAttack were signed for, which its hash can be computed from.
+func (e LightClientAttack) GetConflictingHeader() *tmtypes.Header {
+	h := e.ConflictingHeader
+
+	return &tmtypes.Header{
+		Version: version.Consensus{
+			Block: version.Protocol(h.Version.Block),
+			App:   version.Protocol(h.Version.App),
+		},
+		ChainID: h.ChainID,
+		Height:  h.Height,
+		Time:    h.Time,
+		LastBlockID: tmtypes.BlockID{
+			Hash: h.LastBlockId.Hash,
+			PartsHeader: tmtypes.PartSetHeader{
+				Total: int(h.LastBlockId.PartsHeader.Total),
+				Hash:  h.LastBlockId.PartsHeader.Hash,
+			},
+		},
+		LastCommitHash:     h.LastCommitHash,
+		DataHash:           h.DataHash,
+		ValidatorsHash:     h.ValidatorsHash,
+		NextValidatorsHash: h.NextValidatorsHash,
+		ConsensusHash:      h.ConsensusHash,
+		AppHash:            h.AppHash,
+		LastResultsHash:    h.LastResultsHash,
+		EvidenceHash:       h.EvidenceHash,
+		ProposerAddress:    h.ProposerAddress,
+	}
+}
+
+// Route returns the Evidence Handler route for an Amnesia type.
+func (e *Amnesia) Route() string { return RouteAmnesia }
+
+// Type returns the Evidence Handler type for an Amnesia type.
+func (e *Amnesia) Type() string { return TypeAmnesia }
+
+func (e *Amnesia) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an Amnesia object.
+func (e *Amnesia) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an Amnesia
+// object. VoteA must be a precommit for a block and VoteB a vote of the same
+// validator at the same height for a different block in a later round.
+func (e *Amnesia) ValidateBasic() error {
+	if err := e.VoteA.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := e.VoteB.ValidateBasic(); err != nil {
+		return err
+	}
+	if !e.VoteA.IsPrecommit() {
+		return fmt.Errorf("invalid amnesia first vote type: %d", e.VoteA.Type)
+	}
+	if e.VoteA.Height != e.VoteB.Height {
+		return fmt.Errorf("invalid amnesia votes heights: %d and %d", e.VoteA.Height, e.VoteB.Height)
+	}
+	if !e.VoteA.ValidatorAddress.Equals(e.VoteB.ValidatorAddress) {
+		return fmt.Errorf(
+			"invalid amnesia votes validators: %s and %s", e.VoteA.ValidatorAddress, e.VoteB.ValidatorAddress,
+		)
+	}
+	if e.VoteA.Round >= e.VoteB.Round {
+		return fmt.Errorf("invalid amnesia votes rounds: %d and %d", e.VoteA.Round, e.VoteB.Round)
+	}
+	if len(e.VoteA.BlockHash) == 0 || len(e.VoteB.BlockHash) == 0 {
+		return fmt.Errorf("invalid amnesia votes: nil block")
+	}
+	if bytes.Equal(e.VoteA.BlockHash, e.VoteB.BlockHash) {
+		return fmt.Errorf("invalid amnesia votes: same block %X", e.VoteA.BlockHash)
+	}
+
+	return nil
+}
+
+// GetHeight returns the height of the votes of the Amnesia.
+func (e Amnesia) GetHeight() int64 {
+	return e.VoteA.Height
+}
+
+// GetConsensusAddress returns the consensus address of the validator which
+// signed the votes of the Amnesia.
+func (e Amnesia) GetConsensusAddress() sdk.ConsAddress {
+	return e.VoteA.ValidatorAddress
+}
+
+// GetTime returns the time of the first vote of the Amnesia.
+func (e Amnesia) GetTime() time.Time {
+	return e.VoteA.Timestamp
+}
+
+// GetReporter returns the address reporting the Amnesia.
+func (e Amnesia) GetReporter() sdk.AccAddress {
+	return e.Reporter
+}
@@ -13,6 +13,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -112,41 +113,199 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Vote defines a Tendermint consensus vote signed by a validator, as carried by
+// the LightClientAttack and Amnesia evidence.
+type Vote struct {
+	Type             int32                                          `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Height           int64                                          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round            int32                                          `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash        []byte                                         `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty" yaml:"block_hash"`
+	PartSetTotal     int32                                          `protobuf:"varint,5,opt,name=part_set_total,json=partSetTotal,proto3" json:"part_set_total,omitempty" yaml:"part_set_total"`
+	PartSetHash      []byte                                         `protobuf:"bytes,6,opt,name=part_set_hash,json=partSetHash,proto3" json:"part_set_hash,omitempty" yaml:"part_set_hash"`
+	Timestamp        time.Time                                      `protobuf:"bytes,7,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	ValidatorIndex   int32                                          `protobuf:"varint,9,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" yaml:"validator_index"`
+	Signature        []byte                                         `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2cafccc38cf08ce, []int{2}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+// LightClientAttack implements the Evidence interface and defines evidence of
+// validators signing a header conflicting with the canonical chain at the same
+// height, which could be used to fool a light client.
+type LightClientAttack struct {
+	ConflictingHeader types1.Header `protobuf:"bytes,1,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header" yaml:"conflicting_header"`
+	// precommits for the conflicting header
+	Votes    []Vote                                        `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=reporter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"reporter,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2cafccc38cf08ce, []int{3}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// Amnesia implements the Evidence interface and defines evidence of a validator
+// voting for a block at a later round than it precommitted a different block,
+// without having seen the proof of lock change allowing it to do so.
+type Amnesia struct {
+	VoteA    Vote                                          `protobuf:"bytes,1,opt,name=vote_a,json=voteA,proto3" json:"vote_a" yaml:"vote_a"`
+	VoteB    Vote                                          `protobuf:"bytes,2,opt,name=vote_b,json=voteB,proto3" json:"vote_b" yaml:"vote_b"`
+	Reporter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=reporter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"reporter,omitempty"`
+}
+
+func (m *Amnesia) Reset()      { *m = Amnesia{} }
+func (*Amnesia) ProtoMessage() {}
+func (*Amnesia) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2cafccc38cf08ce, []int{4}
+}
+func (m *Amnesia) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Amnesia) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Amnesia.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Amnesia) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amnesia.Merge(m, src)
+}
+func (m *Amnesia) XXX_Size() int {
+	return m.Size()
+}
+func (m *Amnesia) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amnesia.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amnesia proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.MsgSubmitEvidence")
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.Equivocation")
+	proto.RegisterType((*Vote)(nil), "cosmos.evidence.Vote")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.LightClientAttack")
+	proto.RegisterType((*Amnesia)(nil), "cosmos.evidence.Amnesia")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/evidence.proto", fileDescriptor_a2cafccc38cf08ce) }
 
 var fileDescriptor_a2cafccc38cf08ce = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0xeb, 0xd3, 0x40,
-	0x18, 0xce, 0xf9, 0xab, 0x3f, 0xe2, 0x59, 0xd0, 0x5f, 0x28, 0x12, 0x3b, 0xdc, 0x95, 0x4c, 0x5d,
-	0x7a, 0x41, 0x5d, 0xa4, 0x83, 0xd0, 0x48, 0x27, 0x51, 0x21, 0x3a, 0xb9, 0x94, 0xfc, 0x39, 0xd3,
-	0x60, 0x93, 0x8b, 0xb9, 0x4b, 0x35, 0xf8, 0x05, 0x1c, 0x3b, 0x3a, 0x76, 0x14, 0x67, 0x3f, 0x44,
-	0x71, 0xea, 0xe8, 0x14, 0x25, 0xfd, 0x06, 0x8e, 0x05, 0x41, 0x7a, 0xb9, 0xb4, 0x60, 0x41, 0x9c,
-	0x72, 0xef, 0xfb, 0x3e, 0xf7, 0x3c, 0xcf, 0xfb, 0xe4, 0x20, 0x0a, 0x18, 0x4f, 0x18, 0xb7, 0xe9,
-	0x32, 0x0e, 0x69, 0x1a, 0xd0, 0xe3, 0x81, 0x64, 0x39, 0x13, 0xcc, 0xb8, 0xd5, 0xcc, 0x49, 0xdb,
-	0xee, 0xf7, 0x22, 0x16, 0x31, 0x39, 0xb3, 0x0f, 0xa7, 0x06, 0xd6, 0xc7, 0x11, 0x63, 0xd1, 0x82,
-	0xda, 0xb2, 0xf2, 0x8b, 0xd7, 0xb6, 0x88, 0x13, 0xca, 0x85, 0x97, 0x64, 0x0a, 0x70, 0xf7, 0x6f,
-	0x80, 0x97, 0x96, 0xed, 0xa8, 0x91, 0x98, 0x35, 0xa4, 0x4a, 0x4f, 0x16, 0xd6, 0x17, 0x00, 0xaf,
-	0x9e, 0xf2, 0xe8, 0x45, 0xe1, 0x27, 0xb1, 0x98, 0x2a, 0x0b, 0xc6, 0x73, 0x78, 0x83, 0xcb, 0x8e,
-	0xa0, 0xb9, 0x09, 0x06, 0x60, 0xd8, 0x75, 0xee, 0xed, 0x2b, 0x3c, 0x8a, 0x62, 0x31, 0x2f, 0x7c,
-	0x12, 0xb0, 0x44, 0xb1, 0xa8, 0xcf, 0x88, 0x87, 0x6f, 0x6c, 0x51, 0x66, 0x94, 0x93, 0x49, 0x10,
-	0x4c, 0xc2, 0x30, 0xa7, 0x9c, 0xbb, 0x27, 0x0e, 0xe3, 0x11, 0xd4, 0xdb, 0xfd, 0xcc, 0x6b, 0x03,
-	0x30, 0xbc, 0x79, 0xbf, 0x47, 0x1a, 0xbf, 0xa4, 0xf5, 0x4b, 0x26, 0x69, 0xe9, 0x74, 0xbf, 0x7d,
-	0x1d, 0xe9, 0xad, 0x0d, 0xf7, 0x78, 0x67, 0xdc, 0xf9, 0xb8, 0xc6, 0x9a, 0xf5, 0x1b, 0xc0, 0xee,
-	0xf4, 0x6d, 0x11, 0x2f, 0x59, 0xe0, 0x89, 0x98, 0xa5, 0xc6, 0x1d, 0x78, 0x39, 0xa7, 0x71, 0x34,
-	0x17, 0xd2, 0xe4, 0x85, 0xab, 0x2a, 0xe3, 0x21, 0xec, 0x1c, 0xe2, 0x51, 0x52, 0xfd, 0x33, 0xa9,
-	0x97, 0x6d, 0x76, 0x8e, 0xbe, 0xa9, 0xb0, 0xb6, 0xfa, 0x81, 0x81, 0x2b, 0x6f, 0x18, 0x3d, 0x78,
-	0x3d, 0x63, 0xef, 0x68, 0x6e, 0x5e, 0x48, 0xc2, 0xa6, 0x30, 0x3e, 0xc0, 0xab, 0x80, 0xa5, 0x9c,
-	0xa6, 0xbc, 0xe0, 0x33, 0xaf, 0x59, 0xcf, 0xec, 0xc8, 0x5c, 0x9e, 0xfd, 0xaa, 0xb0, 0x59, 0x7a,
-	0xc9, 0x62, 0x6c, 0x9d, 0x41, 0xac, 0x7d, 0x85, 0xc9, 0x7f, 0x64, 0xf6, 0x98, 0xa5, 0xbc, 0x0d,
-	0xed, 0xf6, 0x91, 0x45, 0x75, 0xc6, 0xfa, 0x61, 0xf7, 0x4f, 0x6b, 0xac, 0x39, 0x4f, 0x3e, 0xd7,
-	0x08, 0x6c, 0x6a, 0x04, 0xb6, 0x35, 0x02, 0x3f, 0x6b, 0x04, 0x56, 0x3b, 0xa4, 0x6d, 0x77, 0x48,
-	0xfb, 0xbe, 0x43, 0xda, 0xab, 0x7f, 0xff, 0x9d, 0xf7, 0xa7, 0x07, 0x28, 0x45, 0xfd, 0x4b, 0x99,
-	0xc6, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x46, 0x6e, 0x6c, 0xb7, 0xa0, 0x02, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xfa, 0x6c, 0xc7, 0x1e, 0x3b, 0x09, 0x1e, 0xd9, 0xd1, 0x9e, 0x05, 0xbb, 0x97, 0xad,
+	0xae, 0xb9, 0xb5, 0x72, 0x50, 0xa0, 0x13, 0x02, 0x79, 0xad, 0x48, 0x41, 0x10, 0x90, 0x36, 0x11,
+	0x05, 0x8d, 0x35, 0xbb, 0x3b, 0x59, 0x8f, 0xce, 0x3b, 0x63, 0x76, 0xc6, 0x26, 0x16, 0xff, 0x00,
+	0xe5, 0x95, 0x94, 0x74, 0x20, 0x6a, 0xfe, 0x88, 0x88, 0x2a, 0x25, 0xd5, 0x82, 0x7c, 0x2d, 0x95,
+	0xcb, 0x48, 0x48, 0x68, 0x7e, 0x78, 0x7d, 0xf1, 0x09, 0x44, 0x4e, 0x4a, 0x73, 0x37, 0xf3, 0x7e,
+	0x7c, 0xdf, 0xfb, 0xde, 0xdb, 0x37, 0x06, 0x4e, 0xcc, 0x78, 0xc6, 0xf8, 0x10, 0x2f, 0x49, 0x82,
+	0x69, 0x8c, 0xcb, 0x83, 0x3f, 0xcf, 0x99, 0x60, 0xf0, 0xae, 0xf6, 0xfb, 0x5b, 0xf3, 0xa0, 0x97,
+	0xb2, 0x94, 0x29, 0xdf, 0x50, 0x9e, 0x74, 0xd8, 0xc0, 0x4d, 0x19, 0x4b, 0x67, 0x78, 0xa8, 0x6e,
+	0xd1, 0xe2, 0xd9, 0x50, 0x90, 0x0c, 0x73, 0x81, 0xb2, 0xb9, 0x09, 0x38, 0xdc, 0x0f, 0x40, 0x74,
+	0xb5, 0x75, 0x69, 0x8a, 0x89, 0x06, 0x35, 0x7c, 0xda, 0x75, 0x5f, 0x60, 0x9a, 0xe0, 0x3c, 0x23,
+	0x54, 0x0c, 0x51, 0x14, 0x93, 0xa1, 0x58, 0xcd, 0x31, 0xd7, 0x7f, 0x75, 0x88, 0xf7, 0x8b, 0x05,
+	0xba, 0x8f, 0x79, 0xfa, 0x64, 0x11, 0x65, 0x44, 0x3c, 0x34, 0x55, 0xc2, 0x2f, 0x41, 0x8b, 0x2b,
+	0x8b, 0xc0, 0xb9, 0x6d, 0x1d, 0x59, 0xc7, 0x9d, 0xe0, 0xc1, 0xab, 0xc2, 0x3d, 0x49, 0x89, 0x98,
+	0x2e, 0x22, 0x3f, 0x66, 0x99, 0x21, 0x32, 0xff, 0x4e, 0x78, 0x72, 0x6e, 0x60, 0x47, 0x71, 0x3c,
+	0x4a, 0x92, 0x1c, 0x73, 0x1e, 0xee, 0x30, 0xe0, 0xc7, 0xa0, 0xb9, 0x6d, 0x81, 0x5d, 0x3d, 0xb2,
+	0x8e, 0xdb, 0xa7, 0x3d, 0x5f, 0x4b, 0xf2, 0xb7, 0x92, 0xfc, 0x11, 0x5d, 0x05, 0x9d, 0xdf, 0x7e,
+	0x3d, 0x69, 0x6e, 0xcb, 0x08, 0xcb, 0x9c, 0xb3, 0xda, 0xf7, 0x3f, 0xba, 0x15, 0xef, 0x6f, 0x0b,
+	0x74, 0x1e, 0x7e, 0xb3, 0x20, 0x4b, 0x16, 0x23, 0x41, 0x18, 0x85, 0xf7, 0x40, 0x63, 0x8a, 0x49,
+	0x3a, 0x15, 0xaa, 0xc8, 0x83, 0xd0, 0xdc, 0xe0, 0x87, 0xa0, 0x26, 0x3b, 0x68, 0xa8, 0x06, 0xd7,
+	0xa8, 0x9e, 0x6e, 0xdb, 0x1b, 0x34, 0x5f, 0x14, 0x6e, 0xe5, 0xe2, 0x0f, 0xd7, 0x0a, 0x55, 0x06,
+	0xec, 0x81, 0xfa, 0x9c, 0x7d, 0x8b, 0x73, 0xfb, 0x40, 0x01, 0xea, 0x0b, 0xfc, 0x0e, 0x74, 0x63,
+	0x46, 0x39, 0xa6, 0x7c, 0xc1, 0x27, 0x48, 0xcb, 0xb3, 0x6b, 0xaa, 0x2f, 0x5f, 0x6c, 0x0a, 0xd7,
+	0x5e, 0xa1, 0x6c, 0x76, 0xe6, 0x5d, 0x0b, 0xf1, 0x5e, 0x15, 0xae, 0xff, 0x3f, 0x7a, 0x36, 0x66,
+	0x94, 0x6f, 0x9b, 0xf6, 0x4e, 0x89, 0x62, 0x2c, 0x67, 0x4d, 0xa9, 0xfd, 0x07, 0xa9, 0xff, 0xa7,
+	0x1a, 0xa8, 0x7d, 0xc5, 0x04, 0x86, 0x10, 0xd4, 0x64, 0xa6, 0x52, 0x5d, 0x0f, 0xd5, 0xf9, 0x4a,
+	0x2f, 0xaa, 0xaf, 0xf5, 0xa2, 0x07, 0xea, 0x39, 0x5b, 0xd0, 0x44, 0x29, 0xaa, 0x87, 0xfa, 0x02,
+	0x3f, 0x00, 0x20, 0x9a, 0xb1, 0xf8, 0x7c, 0x32, 0x45, 0x7c, 0x6a, 0xa4, 0xf4, 0x37, 0x85, 0xdb,
+	0xd5, 0x52, 0x76, 0x3e, 0x2f, 0x6c, 0xa9, 0xcb, 0x23, 0xc4, 0xa7, 0xf0, 0x13, 0x70, 0x67, 0x8e,
+	0x72, 0x31, 0xe1, 0x58, 0x4c, 0x04, 0x13, 0x68, 0x66, 0xd7, 0x25, 0x68, 0x70, 0xb8, 0x29, 0xdc,
+	0xbe, 0xce, 0x7c, 0xdd, 0xef, 0x85, 0x1d, 0x69, 0x78, 0x82, 0xc5, 0x53, 0x79, 0x85, 0x1f, 0x81,
+	0xdb, 0x65, 0x80, 0x62, 0x6e, 0x28, 0x66, 0x7b, 0x53, 0xb8, 0xbd, 0xbd, 0x7c, 0x4d, 0xde, 0x36,
+	0xe9, 0x8a, 0x3e, 0x00, 0xad, 0x72, 0x31, 0xec, 0x5b, 0x6f, 0x30, 0xdb, 0x5d, 0x9a, 0x1c, 0xe5,
+	0x12, 0xcd, 0x48, 0x82, 0x04, 0xcb, 0xcb, 0x51, 0x36, 0xf7, 0x47, 0x79, 0x2d, 0xe4, 0x46, 0xa3,
+	0x2c, 0x51, 0x8c, 0x05, 0x8e, 0xc1, 0xdd, 0x1d, 0x32, 0xa1, 0x09, 0x7e, 0x6e, 0xb7, 0x54, 0x03,
+	0x07, 0x9b, 0xc2, 0xbd, 0xb7, 0x4f, 0xad, 0x02, 0xbc, 0xf0, 0x4e, 0x69, 0xf9, 0x54, 0x1a, 0xe0,
+	0xbb, 0xa0, 0xc5, 0x49, 0x4a, 0x91, 0x58, 0xe4, 0xd8, 0x06, 0xb2, 0xf2, 0x70, 0x67, 0x30, 0x9b,
+	0x72, 0x51, 0x05, 0xdd, 0xcf, 0xe5, 0xf8, 0xc7, 0x33, 0x82, 0xa9, 0x18, 0x09, 0x81, 0xe2, 0x73,
+	0xc8, 0x00, 0x8c, 0x19, 0x7d, 0x36, 0x23, 0xb1, 0x20, 0x34, 0x9d, 0x4c, 0x31, 0x4a, 0xcc, 0x7e,
+	0xb7, 0x4f, 0xdf, 0xf3, 0x77, 0x8f, 0x85, 0x2f, 0x1f, 0x0b, 0x5f, 0x0b, 0x7a, 0xa4, 0x82, 0x82,
+	0xfb, 0xb2, 0x97, 0x9b, 0xc2, 0x3d, 0x2c, 0x3f, 0xf5, 0x3d, 0x18, 0x2f, 0xec, 0x5e, 0x31, 0xea,
+	0x2c, 0xf8, 0x00, 0xd4, 0x97, 0x4c, 0x60, 0x6e, 0x57, 0x8f, 0x0e, 0x8e, 0xdb, 0xa7, 0x7d, 0x7f,
+	0xef, 0x39, 0xf4, 0xe5, 0xd7, 0x1c, 0xd4, 0x24, 0x76, 0xa8, 0x23, 0xe1, 0x63, 0xd0, 0xcc, 0xf1,
+	0x9c, 0xe5, 0xc2, 0xec, 0xe0, 0x8d, 0x5e, 0x9e, 0x12, 0xe2, 0xca, 0xf2, 0xfc, 0x65, 0x81, 0x5b,
+	0xa3, 0x8c, 0x62, 0x4e, 0x10, 0x1c, 0x83, 0x86, 0x64, 0x9b, 0x20, 0x23, 0xfe, 0x5f, 0x0a, 0xeb,
+	0x1b, 0xd1, 0xb7, 0xcd, 0x64, 0x54, 0x8a, 0xa7, 0x2b, 0x1d, 0x95, 0x20, 0x91, 0x5d, 0x7d, 0x53,
+	0x90, 0xc8, 0x80, 0x04, 0x6f, 0x4d, 0x6e, 0xf0, 0xd9, 0xcf, 0x6b, 0xc7, 0x7a, 0xb1, 0x76, 0xac,
+	0x97, 0x6b, 0xc7, 0xfa, 0x73, 0xed, 0x58, 0x17, 0x97, 0x4e, 0xe5, 0xe5, 0xa5, 0x53, 0xf9, 0xfd,
+	0xd2, 0xa9, 0x7c, 0xfd, 0xdf, 0x04, 0xcf, 0x77, 0xbf, 0x67, 0x8a, 0x2b, 0x6a, 0xa8, 0xed, 0x7a,
+	0xff, 0x9f, 0x01, 0x00, 0xa3, 0xf4, 0x74, 0xd7, 0xef, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidence) Equal(that interface{}) bool {
@@ -209,6 +368,122 @@ func (this *Equivocation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Vote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Vote)
+	if !ok {
+		that2, ok := that.(Vote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if this.PartSetTotal != that1.PartSetTotal {
+		return false
+	}
+	if !bytes.Equal(this.PartSetHash, that1.PartSetHash) {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.ValidatorIndex != that1.ValidatorIndex {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *LightClientAttack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LightClientAttack)
+	if !ok {
+		that2, ok := that.(LightClientAttack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ConflictingHeader.Equal(&that1.ConflictingHeader) {
+		return false
+	}
+	if len(this.Votes) != len(that1.Votes) {
+		return false
+	}
+	for i := range this.Votes {
+		if !this.Votes[i].Equal(&that1.Votes[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Reporter, that1.Reporter) {
+		return false
+	}
+	return true
+}
+func (this *Amnesia) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Amnesia)
+	if !ok {
+		that2, ok := that.(Amnesia)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VoteA.Equal(&that1.VoteA) {
+		return false
+	}
+	if !this.VoteB.Equal(&that1.VoteB) {
+		return false
+	}
+	if !bytes.Equal(this.Reporter, that1.Reporter) {
+		return false
+	}
+	return true
+}
 func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,128 +508,994 @@ func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		{
 			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Equivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Equivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PartSetHash) > 0 {
+		i -= len(m.PartSetHash)
+		copy(dAtA[i:], m.PartSetHash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.PartSetHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PartSetTotal != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.PartSetTotal))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Amnesia) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amnesia) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Amnesia) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *Equivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEvidence(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.PartSetTotal != 0 {
+		n += 1 + sovEvidence(uint64(m.PartSetTotal))
+	}
+	l = len(m.PartSetHash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorIndex))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConflictingHeader.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *Amnesia) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VoteA.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = m.VoteB.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types.Any{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Equivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Equivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Equivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = append(m.ConsensusAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusAddress == nil {
+				m.ConsensusAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetTotal", wireType)
+			}
+			m.PartSetTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartSetTotal |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartSetHash = append(m.PartSetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartSetHash == nil {
+				m.PartSetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintEvidence(dAtA, i, uint64(size))
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Equivocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Equivocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsensusAddress) > 0 {
-		i -= len(m.ConsensusAddress)
-		copy(dAtA[i:], m.ConsensusAddress)
-		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Power != 0 {
-		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintEvidence(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvidence(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovEvidence(uint64(l))
-	}
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovEvidence(uint64(l))
 	}
-	return n
-}
 
-func (m *Equivocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovEvidence(uint64(m.Height))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovEvidence(uint64(l))
-	if m.Power != 0 {
-		n += 1 + sovEvidence(uint64(m.Power))
-	}
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovEvidence(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvidence(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvidence(x uint64) (n int) {
-	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -377,17 +1518,17 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvidence
@@ -397,29 +1538,28 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvidence
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvidence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -446,13 +1586,45 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &types.Any{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -477,7 +1649,7 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Equivocation) Unmarshal(dAtA []byte) error {
+func (m *Amnesia) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -500,17 +1672,17 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Equivocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Amnesia: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Equivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Amnesia: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvidence
@@ -520,14 +1692,28 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -554,32 +1740,13 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvidence
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -606,9 +1773,9 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = append(m.ConsensusAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsensusAddress == nil {
-				m.ConsensusAddress = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -54,3 +58,137 @@ func TestEquivocationValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func newTestVote(privKey ed25519.PrivKeyEd25519, voteType tmtypes.SignedMsgType, height int64, round int, blockHash []byte) types.Vote {
+	vote := &tmtypes.Vote{
+		Type:   voteType,
+		Height: height,
+		Round:  round,
+		BlockID: tmtypes.BlockID{
+			Hash:        blockHash,
+			PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(blockHash)},
+		},
+		Timestamp:        time.Unix(0, 0).UTC(),
+		ValidatorAddress: privKey.PubKey().Address(),
+	}
+
+	sig, err := privKey.Sign(vote.SignBytes("test-chain"))
+	if err != nil {
+		panic(err)
+	}
+	vote.Signature = sig
+
+	return types.NewVote(vote)
+}
+
+func TestVoteToTendermint(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	vote := newTestVote(privKey, tmtypes.PrecommitType, 100, 1, tmhash.Sum([]byte("block")))
+
+	require.NoError(t, vote.ValidateBasic())
+	require.True(t, vote.IsPrecommit())
+	require.NoError(t, vote.ToTendermint().Verify("test-chain", privKey.PubKey()))
+	require.Error(t, vote.ToTendermint().Verify("other-chain", privKey.PubKey()))
+}
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	privKeys := []ed25519.PrivKeyEd25519{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	header := abci.Header{ChainID: "test-chain", Height: 100, ValidatorsHash: tmhash.Sum([]byte("validators"))}
+	blockHash := tmhash.Sum([]byte("block"))
+
+	precommit := func(i int, height int64) types.Vote {
+		return newTestVote(privKeys[i], tmtypes.PrecommitType, height, 0, blockHash)
+	}
+
+	testCases := []struct {
+		name      string
+		header    abci.Header
+		votes     []types.Vote
+		expectErr bool
+	}{
+		{"valid", header, []types.Vote{precommit(0, 100), precommit(1, 100)}, false},
+		{"invalid height", abci.Header{ValidatorsHash: header.ValidatorsHash}, []types.Vote{precommit(0, 100)}, true},
+		{"missing validators hash", abci.Header{Height: 100}, []types.Vote{precommit(0, 100)}, true},
+		{"no votes", header, nil, true},
+		{"prevote", header, []types.Vote{newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 0, blockHash)}, true},
+		{"vote height", header, []types.Vote{precommit(0, 99)}, true},
+		{"duplicate vote", header, []types.Vote{precommit(0, 100), precommit(0, 100)}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := types.LightClientAttack{ConflictingHeader: tc.header, Votes: tc.votes}
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestAmnesiaValidateBasic(t *testing.T) {
+	privKeys := []ed25519.PrivKeyEd25519{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	blockA, blockB := tmhash.Sum([]byte("block a")), tmhash.Sum([]byte("block b"))
+
+	testCases := []struct {
+		name      string
+		voteA     types.Vote
+		voteB     types.Vote
+		expectErr bool
+	}{
+		{
+			"valid prevote",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 1, blockB),
+			false,
+		},
+		{
+			"valid precommit",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 2, blockB),
+			false,
+		},
+		{
+			"first vote prevote",
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 1, blockB),
+			true,
+		},
+		{
+			"different heights",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 101, 1, blockB),
+			true,
+		},
+		{
+			"different validators",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[1], tmtypes.PrevoteType, 100, 1, blockB),
+			true,
+		},
+		{
+			"same round",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 1, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 1, blockB),
+			true,
+		},
+		{
+			"same block",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 1, blockA),
+			true,
+		},
+		{
+			"nil block",
+			newTestVote(privKeys[0], tmtypes.PrecommitType, 100, 0, blockA),
+			newTestVote(privKeys[0], tmtypes.PrevoteType, 100, 1, nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := types.Amnesia{VoteA: tc.voteA, VoteB: tc.voteB}
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type (
@@ -14,6 +16,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+		GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -22,9 +25,12 @@ type (
 		GetPubkey(sdk.Context, crypto.Address) (crypto.PubKey, error)
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
+		GetValidatorSigningInfo(sdk.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
+		SlashWithReward(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64, string, sdk.AccAddress)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
+		DowntimeJailDuration(sdk.Context) time.Duration
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -17,4 +21,11 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixAmnesia  = []byte{0x01}
 )
+
+// AmnesiaKey returns the key of the record of the amnesia of a validator at
+// the given height: 0x01 | ConsAddress | BigEndian(height)
+func AmnesiaKey(consAddr sdk.ConsAddress, height int64) []byte {
+	return append(append(KeyPrefixAmnesia, consAddr.Bytes()...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
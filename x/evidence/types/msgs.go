@@ -55,6 +55,14 @@ func (m MsgSubmitEvidence) ValidateBasic() error {
 		return err
 	}
 
+	// the reporter is rewarded from the slashed tokens, so that only it may
+	// submit the evidence
+	if rep, ok := evi.(exported.ReportedEvidence); ok {
+		if reporter := rep.GetReporter(); !reporter.Empty() && !reporter.Equals(m.Submitter) {
+			return sdkerrors.Wrapf(ErrInvalidEvidence, "reporter %s is not the submitter", reporter)
+		}
+	}
+
 	return nil
 }
 
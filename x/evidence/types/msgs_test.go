@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func testMsgSubmitEvidence(t *testing.T, e exported.Evidence, s sdk.AccAddress) exported.MsgSubmitEvidence {
@@ -47,6 +49,24 @@ func TestMsgSubmitEvidence(t *testing.T) {
 			submitter,
 			false,
 		},
+		{
+			testMsgSubmitEvidence(t, &types.Amnesia{
+				VoteA:    newTestVote(pk, tmtypes.PrecommitType, 10, 0, tmhash.Sum([]byte("block a"))),
+				VoteB:    newTestVote(pk, tmtypes.PrevoteType, 10, 1, tmhash.Sum([]byte("block b"))),
+				Reporter: submitter,
+			}, submitter),
+			submitter,
+			false,
+		},
+		{
+			testMsgSubmitEvidence(t, &types.Amnesia{
+				VoteA:    newTestVote(pk, tmtypes.PrecommitType, 10, 0, tmhash.Sum([]byte("block a"))),
+				VoteB:    newTestVote(pk, tmtypes.PrevoteType, 10, 1, tmhash.Sum([]byte("block b"))),
+				Reporter: sdk.AccAddress("reporter"),
+			}, submitter),
+			submitter,
+			true,
+		},
 	}
 
 	for i, tc := range testCases {
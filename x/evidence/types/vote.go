@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// NewVote creates a Vote from a Tendermint vote.
+func NewVote(vote *tmtypes.Vote) Vote {
+	return Vote{
+		Type:             int32(vote.Type),
+		Height:           vote.Height,
+		Round:            int32(vote.Round),
+		BlockHash:        vote.BlockID.Hash,
+		PartSetTotal:     int32(vote.BlockID.PartsHeader.Total),
+		PartSetHash:      vote.BlockID.PartsHeader.Hash,
+		Timestamp:        vote.Timestamp,
+		ValidatorAddress: vote.ValidatorAddress.Bytes(),
+		ValidatorIndex:   int32(vote.ValidatorIndex),
+		Signature:        vote.Signature,
+	}
+}
+
+// ToTendermint returns the Tendermint vote the Vote was created from, which
+// its signature can be verified against.
+func (v Vote) ToTendermint() *tmtypes.Vote {
+	return &tmtypes.Vote{
+		Type:   tmtypes.SignedMsgType(v.Type),
+		Height: v.Height,
+		Round:  int(v.Round),
+		BlockID: tmtypes.BlockID{
+			Hash: v.BlockHash,
+			PartsHeader: tmtypes.PartSetHeader{
+				Total: int(v.PartSetTotal),
+				Hash:  v.PartSetHash,
+			},
+		},
+		Timestamp:        v.Timestamp,
+		ValidatorAddress: v.ValidatorAddress.Bytes(),
+		ValidatorIndex:   int(v.ValidatorIndex),
+		Signature:        v.Signature,
+	}
+}
+
+// ValidateBasic performs basic stateless validation checks on a Vote.
+func (v Vote) ValidateBasic() error {
+	if v.Height < 1 {
+		return fmt.Errorf("invalid vote height: %d", v.Height)
+	}
+	if v.Timestamp.IsZero() {
+		return fmt.Errorf("invalid vote timestamp: %s", v.Timestamp)
+	}
+	if err := v.ToTendermint().ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid vote: %w", err)
+	}
+
+	return nil
+}
+
+// IsPrecommit returns true if the Vote is a precommit.
+func (v Vote) IsPrecommit() bool {
+	return tmtypes.SignedMsgType(v.Type) == tmtypes.PrecommitType
+}
@@ -33,7 +33,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinimumDeposit(proposal.IsExpedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -46,20 +46,54 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which does not pass is converted to a regular
+		// proposal. It keeps its deposits and votes, and voting goes on until
+		// the end of the regular voting period.
+		if proposal.IsExpedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal",
+					proposal.ProposalID, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
 			keeper.RefundDeposits(ctx, proposal.ProposalID)
 		}
+		keeper.DeleteVotes(ctx, proposal.ProposalID)
 
 		if passes {
 			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// on the proposal content. If the handler fails, or spends the
+			// deposits of the other proposals held in escrow, no state
+			// mutation is written and the error message is logged.
 			err := handler(cacheCtx, proposal.GetContent())
+			if err == nil {
+				err = keeper.ValidateEscrowedDeposits(cacheCtx)
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -32,6 +32,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		types.ContentFromProposalType("test", "test", types.ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0],
+		false,
 	)
 	require.NoError(t, err)
 
@@ -84,6 +85,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		types.ContentFromProposalType("test", "test", types.ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0],
+		false,
 	)
 	require.NoError(t, err)
 
@@ -107,6 +109,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		types.ContentFromProposalType("test2", "test2", types.ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0],
+		false,
 	)
 	require.NoError(t, err)
 
@@ -164,6 +167,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		types.ContentFromProposalType("test2", "test2", types.ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0],
+		false,
 	)
 	require.NoError(t, err)
 
@@ -216,7 +220,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))}
-	newProposalMsg, err := types.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0])
+	newProposalMsg, err := types.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0], false)
 	require.NoError(t, err)
 
 	res, err := govHandler(ctx, newProposalMsg)
@@ -282,7 +286,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -334,7 +338,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -358,3 +362,92 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		noVote    bool // the second validator votes No instead of Yes
+		expStatus types.ProposalStatus
+	}{
+		{"passes the expedited threshold", false, types.StatusPassed},
+		{"converted to a regular proposal", true, types.StatusPassed},
+	}
+
+	for _, tc := range testCases {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{})
+		addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+
+		handler := gov.NewHandler(app.GovKeeper)
+		stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+		createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}, []int64{6, 4})
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		depositParams := app.GovKeeper.GetDepositParams(ctx)
+		votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+		// the minimum deposit of regular proposals is not enough for an expedited one
+		msg, err := types.NewMsgSubmitProposal(TestProposal, depositParams.MinDeposit, addrs[0], true)
+		require.NoError(t, err, tc.name)
+		res, err := handler(ctx, msg)
+		require.NoError(t, err, tc.name)
+
+		proposalID := types.GetProposalIDFromBytes(res.Data)
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok, tc.name)
+		require.True(t, proposal.IsExpedited, tc.name)
+		require.Equal(t, types.StatusDepositPeriod, proposal.Status, tc.name)
+
+		_, err = handler(ctx, types.NewMsgDeposit(addrs[1], proposalID, depositParams.MinExpeditedDeposit.Sub(depositParams.MinDeposit)))
+		require.NoError(t, err, tc.name)
+
+		proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok, tc.name)
+		require.Equal(t, types.StatusVotingPeriod, proposal.Status, tc.name)
+		require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime, tc.name)
+
+		secondOption := types.OptionYes
+		if tc.noVote {
+			secondOption = types.OptionNo
+		}
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes), tc.name)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], secondOption), tc.name)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = proposal.VotingEndTime
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok, tc.name)
+
+		if tc.noVote {
+			// 60% of Yes votes do not pass the expedited threshold: the proposal
+			// goes on as a regular proposal, keeping its deposits and votes
+			require.False(t, proposal.IsExpedited, tc.name)
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status, tc.name)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime, tc.name)
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposalID), 2, tc.name)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposalID), 2, tc.name)
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid(), tc.name)
+			activeQueue.Close()
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok, tc.name)
+		}
+
+		require.Equal(t, tc.expStatus, proposal.Status, tc.name)
+		require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposalID), tc.name)
+		require.Empty(t, app.GovKeeper.GetVotes(ctx, proposalID), tc.name)
+	}
+}
@@ -7,7 +7,11 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -42,3 +46,41 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseExecMsgsProposal reads an ExecMsgsProposal and its deposit from a JSON
+// file, the messages being encoded in protobuf JSON along with their @type.
+func parseExecMsgsProposal(registry codectypes.InterfaceRegistry, proposalFile string) (*types.ExecMsgsProposal, sdk.Coins, error) {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var proposal execMsgsProposal
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return nil, nil, err
+	}
+
+	cdc := codec.NewProtoCodec(registry)
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, bz := range proposal.Messages {
+		var any codectypes.Any
+		if err := cdc.UnmarshalJSON(bz, &any); err != nil {
+			return nil, nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+		if err := registry.UnpackAny(&any, &msgs[i]); err != nil {
+			return nil, nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	deposit, err := sdk.ParseCoins(proposal.Deposit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := types.NewExecMsgsProposal(proposal.Title, proposal.Description, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return content, deposit, nil
+}
@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -70,3 +76,57 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseExecMsgsProposal(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	recipient := sdk.AccAddress("recipient")
+	okJSON, cleanup1 := testutil.WriteToNewTempFile(t, fmt.Sprintf(`
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.MsgSend",
+      "from_address": "%s",
+      "to_address": "%s",
+      "amount": [{"denom": "test", "amount": "10"}]
+    }
+  ],
+  "deposit": "1000test"
+}
+`, govAddr, recipient))
+	t.Cleanup(cleanup1)
+
+	unknownMsgJSON, cleanup2 := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "messages": [{"@type": "/cosmos.unknown.MsgUnknown"}],
+  "deposit": "1000test"
+}
+`)
+	t.Cleanup(cleanup2)
+
+	// nonexistent json
+	_, _, err := parseExecMsgsProposal(registry, "fileDoesNotExist")
+	require.Error(t, err)
+
+	// unknown message
+	_, _, err = parseExecMsgsProposal(registry, unknownMsgJSON.Name())
+	require.Error(t, err)
+
+	// ok json
+	content, deposit, err := parseExecMsgsProposal(registry, okJSON.Name())
+	require.NoError(t, err)
+	require.Equal(t, "Test Proposal", content.GetTitle())
+	require.Equal(t, "My awesome proposal", content.GetDescription())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 1000)), deposit)
+	require.NoError(t, content.ValidateBasic())
+
+	msgs, err := content.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("test", 10)))}, msgs)
+}
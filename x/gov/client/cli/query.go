@@ -38,6 +38,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryRepresentative(),
+		GetCmdQueryRepresentatives(),
+		GetCmdQueryVotingPowerDelegation(),
 	)
 
 	return govQueryCmd
@@ -686,3 +689,141 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryRepresentative implements the query governance representative command.
+func GetCmdQueryRepresentative() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "representative [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a governance representative and its voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a governance representative along with the voting power of the
+delegators which assigned their voting power to it, including its own.
+
+Example:
+$ %s query gov representative cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Representative(
+				context.Background(),
+				&types.QueryRepresentativeRequest{Representative: representative},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.GetRepresentative())
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRepresentatives implements the query governance representatives command.
+func GetCmdQueryRepresentatives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "representatives",
+		Args:  cobra.NoArgs,
+		Short: "Query all governance representatives and their voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all governance representatives along with their voting power.
+
+Example:
+$ %[1]s query gov representatives
+$ %[1]s query gov representatives --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Representatives(
+				context.Background(),
+				&types.QueryRepresentativesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.GetRepresentatives())
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "representatives")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotingPowerDelegation implements the query voting power delegation command.
+func GetCmdQueryVotingPowerDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the representative a delegator assigned its governance voting power to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the representative a delegator assigned its governance voting power to.
+
+Example:
+$ %s query gov voting-power-delegation cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPowerDelegation(
+				context.Background(),
+				&types.QueryVotingPowerDelegationRequest{Delegator: delegator},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.GetVotingPowerDelegation())
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +23,7 @@ const (
 	FlagDescription  = "description"
 	FlagProposalType = "type"
 	FlagDeposit      = "deposit"
+	FlagExpedited    = "expedited"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
@@ -35,6 +37,13 @@ type proposal struct {
 	Deposit     string
 }
 
+type execMsgsProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+	Deposit     string            `json:"deposit"`
+}
+
 // ProposalFlags defines the core required fields of a proposal. It is used to
 // verify that these values are not provided in conjunction with a JSON proposal
 // file.
@@ -60,6 +69,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	}
 
 	cmdSubmitProp := NewCmdSubmitProposal()
+	cmdSubmitProp.AddCommand(NewCmdSubmitExecMsgsProposal())
 	for _, propCmd := range propCmds {
 		cmdSubmitProp.AddCommand(propCmd)
 	}
@@ -67,7 +77,12 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
+		NewCmdRegisterRepresentative(),
+		NewCmdUnregisterRepresentative(),
+		NewCmdDelegateVotingPower(),
+		NewCmdUndelegateVotingPower(),
 	)
 
 	return govTxCmd
@@ -97,6 +112,9 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+An expedited proposal, with a shorter voting period but a higher minimum deposit and
+threshold, is submitted with the --expedited flag.
 `,
 				version.AppName, version.AppName,
 			),
@@ -118,9 +136,14 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return err
 			}
 
+			isExpedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
-			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress())
+			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress(), isExpedited)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -138,6 +161,77 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitExecMsgsProposal implements submitting a proposal executing
+// messages signed by the governance module account.
+func NewCmdSubmitExecMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages signed by the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit, executing a list of
+messages once it passes. The messages must be signed by the governance module account
+only, and are executed atomically: if one of them fails, none is applied and the
+proposal fails. The messages are given in protobuf JSON along with their type URL.
+
+Example:
+$ %s tx gov submit-proposal exec-msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Funding",
+  "description": "Fund the community pool from the governance account",
+  "messages": [
+    {
+      "@type": "/cosmos.distribution.MsgFundCommunityPool",
+      "amount": [{"denom": "stake", "amount": "10000"}],
+      "depositor": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+    }
+  ],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content, deposit, err := parseExecMsgsProposal(clientCtx.InterfaceRegistry, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			isExpedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress(), isExpedited)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -250,3 +344,224 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options with weights summing to 1",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+across the yes/no/no_with_veto/abstain options with weights summing to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Get voting address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRegisterRepresentative implements registering the sender as a
+// governance representative transaction command.
+func NewCmdRegisterRepresentative() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-representative",
+		Args:  cobra.NoArgs,
+		Short: "Register as a governance representative delegators can assign their voting power to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register the sender as a governance representative. Delegators can then
+assign their governance voting power to it, independently of the validators they
+bonded to.
+
+Example:
+$ %s tx gov register-representative --description="Governance expert" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterRepresentative(clientCtx.GetFromAddress(), description)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDescription, "", "The description of the representative")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUnregisterRepresentative implements unregistering the sender as a
+// governance representative transaction command.
+func NewCmdUnregisterRepresentative() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-representative",
+		Args:  cobra.NoArgs,
+		Short: "Unregister as a governance representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unregister the sender as a governance representative. The voting power
+delegators assigned to it goes back to them.
+
+Example:
+$ %s tx gov unregister-representative --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnregisterRepresentative(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateVotingPower implements assigning the governance voting power
+// of the sender to a representative transaction command.
+func NewCmdDelegateVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-voting-power [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Assign your governance voting power to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Assign the governance voting power of the sender to a registered
+representative, replacing any previous assignment. The representative votes with it
+on the proposals the sender does not vote on directly.
+
+Example:
+$ %s tx gov delegate-voting-power cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVotingPower(clientCtx.GetFromAddress(), representative)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateVotingPower implements taking back the governance voting
+// power the sender assigned to a representative transaction command.
+func NewCmdUndelegateVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-voting-power",
+		Args:  cobra.NoArgs,
+		Short: "Take back the governance voting power you assigned to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Take back the governance voting power the sender assigned to a
+representative. It then goes to the validators the sender bonded to again.
+
+Example:
+$ %s tx gov undelegate-voting-power --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegateVotingPower(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
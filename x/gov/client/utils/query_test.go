@@ -66,6 +66,10 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -104,6 +108,18 @@ func TestGetPaginatedVotes(t *testing.T) {
 				types.NewVote(0, acc2, types.OptionYes),
 				types.NewVote(0, acc2, types.OptionYes)},
 		},
+		{
+			description: "WeightedVote",
+			page:        1,
+			limit:       2,
+			txs: []authtypes.StdTx{
+				{Msgs: acc1Msgs[:1]},
+				{Msgs: []sdk.Msg{types.NewMsgVoteWeighted(acc2, 0, weightedOptions)}},
+			},
+			votes: []types.Vote{
+				types.NewVote(0, acc1, types.OptionYes),
+				types.NewWeightedVote(0, acc2, weightedOptions)},
+		},
 		{
 			description: "IncompleteSearchTx",
 			page:        1,
@@ -28,9 +28,21 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	}
 
 	for _, vote := range data.Votes {
+		// votes exported before weighted votes only carry a single option
+		if len(vote.Options) == 0 {
+			vote = types.NewVote(vote.ProposalID, vote.Voter, vote.Option)
+		}
 		k.SetVote(ctx, vote)
 	}
 
+	for _, rep := range data.Representatives {
+		k.SetRepresentative(ctx, rep)
+	}
+
+	for _, del := range data.VotingPowerDelegations {
+		k.SetVotingPowerDelegation(ctx, del)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
@@ -70,12 +82,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	}
 
 	return types.GenesisState{
-		StartingProposalID: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		DepositParams:      depositParams,
-		VotingParams:       votingParams,
-		TallyParams:        tallyParams,
+		StartingProposalID:     startingProposalID,
+		Deposits:               proposalsDeposits,
+		Votes:                  proposalsVotes,
+		Proposals:              proposals,
+		DepositParams:          depositParams,
+		VotingParams:           votingParams,
+		TallyParams:            tallyParams,
+		Representatives:        k.GetRepresentatives(ctx),
+		VotingPowerDelegations: k.GetVotingPowerDelegations(ctx),
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -31,11 +32,11 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalID
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalID
 
@@ -50,6 +51,10 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	// Register a representative with a delegator
+	require.NoError(t, app.GovKeeper.RegisterRepresentative(ctx, addrs[0], "description"))
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[1], addrs[0]))
+
 	authGenState := auth.ExportGenesis(ctx, app.AccountKeeper)
 	bankGenState := app.BankKeeper.ExportGenesis(ctx)
 
@@ -96,6 +101,16 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	require.Equal(t, app.GovKeeper.GetRepresentatives(ctx), app2.GovKeeper.GetRepresentatives(ctx2))
+	require.Equal(t, app.GovKeeper.GetVotingPowerDelegations(ctx), app2.GovKeeper.GetVotingPowerDelegations(ctx2))
+
+	var delegators []sdk.AccAddress
+	app2.GovKeeper.IterateRepresentativeDelegators(ctx2, addrs[0], func(delegator sdk.AccAddress) bool {
+		delegators = append(delegators, delegator)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addrs[1]}, delegators)
+
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -122,10 +137,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
@@ -28,6 +28,21 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 		case *types.MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case *types.MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case *types.MsgRegisterRepresentative:
+			return handleMsgRegisterRepresentative(ctx, keeper, msg)
+
+		case *types.MsgUnregisterRepresentative:
+			return handleMsgUnregisterRepresentative(ctx, keeper, msg)
+
+		case *types.MsgDelegateVotingPower:
+			return handleMsgDelegateVotingPower(ctx, keeper, msg)
+
+		case *types.MsgUndelegateVotingPower:
+			return handleMsgUndelegateVotingPower(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -35,7 +50,7 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSubmitProposalI) (*sdk.Result, error) {
-	proposal, err := keeper.SubmitProposal(ctx, msg.GetContent())
+	proposal, err := keeper.SubmitProposal(ctx, msg.GetContent(), msg.GetIsExpedited())
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +70,11 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper keeper.Keeper, msg types.Ms
 		),
 	)
 
-	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()))
+	submitEvent := sdk.NewEvent(
+		types.EventTypeSubmitProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()),
+		sdk.NewAttribute(types.AttributeKeyIsExpedited, strconv.FormatBool(msg.GetIsExpedited())),
+	)
 	if votingStarted {
 		submitEvent = submitEvent.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalID)),
@@ -128,3 +147,113 @@ func handleMsgVote(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgVote) (*
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgVoteWeighted) (*sdk.Result, error) {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "vote"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.Itoa(int(msg.ProposalID))),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgRegisterRepresentative(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgRegisterRepresentative) (*sdk.Result, error) {
+	if err := keeper.RegisterRepresentative(ctx, msg.Address, msg.Description); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterRepresentative,
+			sdk.NewAttribute(types.AttributeKeyRepresentative, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgUnregisterRepresentative(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgUnregisterRepresentative) (*sdk.Result, error) {
+	if err := keeper.UnregisterRepresentative(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnregisterRepresentative,
+			sdk.NewAttribute(types.AttributeKeyRepresentative, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgDelegateVotingPower(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgDelegateVotingPower) (*sdk.Result, error) {
+	if err := keeper.DelegateVotingPower(ctx, msg.Delegator, msg.Representative); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateVotingPower,
+			sdk.NewAttribute(types.AttributeKeyRepresentative, msg.Representative.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgUndelegateVotingPower(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgUndelegateVotingPower) (*sdk.Result, error) {
+	del, found := keeper.GetVotingPowerDelegation(ctx, msg.Delegator)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoVotingPowerDelegation, msg.Delegator.String())
+	}
+
+	if err := keeper.UndelegateVotingPower(ctx, msg.Delegator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegateVotingPower,
+			sdk.NewAttribute(types.AttributeKeyRepresentative, del.Representative.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
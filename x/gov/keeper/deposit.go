@@ -126,7 +126,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinimumDeposit(proposal.IsExpedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -154,6 +154,24 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// ValidateEscrowedDeposits returns an error if the balance of the governance
+// module account doesn't cover the deposits it holds in escrow, which are
+// refunded or burned once their proposal is finalized.
+func (keeper Keeper) ValidateEscrowedDeposits(ctx sdk.Context) error {
+	var deposits sdk.Coins
+	keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = deposits.Add(deposit.Amount...)
+		return false
+	})
+
+	balance := keeper.bankKeeper.GetAllBalances(ctx, keeper.authKeeper.GetModuleAddress(types.ModuleName))
+	if !balance.IsAllGTE(deposits) {
+		return sdkerrors.Wrapf(types.ErrEscrowedDepositsSpent, "balance %s is below the deposits %s", balance, deposits)
+	}
+
+	return nil
+}
+
 // RefundDeposits refunds and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -129,6 +129,7 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 		if err := q.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
 			return err
 		}
+		populateLegacyOptions(&vote)
 
 		votes = append(votes, vote)
 		return nil
@@ -259,3 +260,75 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// Representative returns a governance representative and its voting power
+func (q Keeper) Representative(c context.Context, req *types.QueryRepresentativeRequest) (*types.QueryRepresentativeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Representative.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty representative address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rep, found := q.GetRepresentative(ctx, req.Representative)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "representative %s doesn't exist", req.Representative)
+	}
+
+	votingPower := q.GetRepresentativeVotingPower(ctx, rep.Address)
+	return &types.QueryRepresentativeResponse{Representative: types.NewRepresentativeVotingPower(rep, votingPower)}, nil
+}
+
+// Representatives returns all the governance representatives and their voting power
+func (q Keeper) Representatives(c context.Context, req *types.QueryRepresentativesRequest) (*types.QueryRepresentativesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var reps []types.RepresentativeVotingPower
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := q.bondedValidators(ctx)
+
+	store := ctx.KVStore(q.storeKey)
+	repStore := prefix.NewStore(store, types.RepresentativesKeyPrefix)
+
+	pageRes, err := query.Paginate(repStore, req.Pagination, func(key []byte, value []byte) error {
+		var rep types.Representative
+		if err := q.cdc.UnmarshalBinaryBare(value, &rep); err != nil {
+			return err
+		}
+
+		votingPower := q.representativeVotingPower(ctx, validators, rep.Address)
+		reps = append(reps, types.NewRepresentativeVotingPower(rep, votingPower))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRepresentativesResponse{Representatives: reps, Pagination: pageRes}, nil
+}
+
+// VotingPowerDelegation returns the representative a delegator assigned its voting power to
+func (q Keeper) VotingPowerDelegation(c context.Context, req *types.QueryVotingPowerDelegationRequest) (*types.QueryVotingPowerDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	del, found := q.GetVotingPowerDelegation(ctx, req.Delegator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "delegator %s has not delegated its voting power", req.Delegator)
+	}
+
+	return &types.QueryVotingPowerDelegationResponse{VotingPowerDelegation: del}, nil
+}
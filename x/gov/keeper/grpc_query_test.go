@@ -50,7 +50,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -106,7 +106,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -274,7 +274,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -379,7 +379,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -395,12 +395,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 				app.GovKeeper.SetProposal(ctx, proposal)
 
 				votes = []types.Vote{
-					{proposal.ProposalID, addrs[0], types.OptionAbstain},
-					{proposal.ProposalID, addrs[1], types.OptionYes},
+					types.NewVote(proposal.ProposalID, addrs[0], types.OptionAbstain),
+					types.NewWeightedVote(proposal.ProposalID, addrs[1], types.WeightedVoteOptions{
+						types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+						types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+					}),
 				}
 
 				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalID, votes[0].Voter, votes[0].Option))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalID, votes[1].Voter, votes[1].Option))
+				suite.Require().NoError(app.GovKeeper.AddWeightedVote(ctx, proposal.ProposalID, votes[1].Voter, votes[1].Options))
 
 				req = &types.QueryVotesRequest{
 					ProposalId: proposal.ProposalID,
@@ -457,7 +460,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -468,7 +471,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
 					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -567,7 +570,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -656,7 +659,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -748,7 +751,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -813,3 +816,115 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryRepresentative() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	var (
+		req    *types.QueryRepresentativeRequest
+		expRes *types.QueryRepresentativeResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryRepresentativeRequest{}
+			},
+			false,
+		},
+		{
+			"non existed representative",
+			func() {
+				req = &types.QueryRepresentativeRequest{Representative: addrs[0]}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				suite.Require().NoError(app.GovKeeper.RegisterRepresentative(ctx, addrs[0], "description"))
+				suite.Require().NoError(app.GovKeeper.DelegateVotingPower(ctx, addrs[1], addrs[0]))
+
+				req = &types.QueryRepresentativeRequest{Representative: addrs[0]}
+
+				// the accounts have not bonded any tokens
+				expRes = &types.QueryRepresentativeResponse{
+					Representative: types.NewRepresentativeVotingPower(
+						types.NewRepresentative(addrs[0], "description"), sdk.ZeroDec(),
+					),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			rep, err := queryClient.Representative(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.String(), rep.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(rep)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryRepresentatives() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	res, err := queryClient.Representatives(gocontext.Background(), &types.QueryRepresentativesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.GetRepresentatives())
+
+	for _, addr := range addrs {
+		suite.Require().NoError(app.GovKeeper.RegisterRepresentative(ctx, addr, "description"))
+	}
+
+	res, err = queryClient.Representatives(gocontext.Background(), &types.QueryRepresentativesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.GetRepresentatives(), 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.Representatives(gocontext.Background(), &types.QueryRepresentativesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.GetRepresentatives(), 1)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryVotingPowerDelegation() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	_, err := queryClient.VotingPowerDelegation(gocontext.Background(), &types.QueryVotingPowerDelegationRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryVotingPowerDelegationRequest{Delegator: addrs[1]}
+	_, err = queryClient.VotingPowerDelegation(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.GovKeeper.RegisterRepresentative(ctx, addrs[0], "description"))
+	suite.Require().NoError(app.GovKeeper.DelegateVotingPower(ctx, addrs[1], addrs[0]))
+
+	res, err := queryClient.VotingPowerDelegation(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewVotingPowerDelegation(addrs[1], addrs[0]), res.GetVotingPowerDelegation())
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MigrateParams sets the expedited proposal params, which are missing from the
+// governance params of the chains created before expedited proposals. It is
+// meant to be called once from an x/upgrade handler.
+func (keeper Keeper) MigrateParams(ctx sdk.Context) {
+	depositParams := keeper.GetDepositParams(ctx)
+	if depositParams.MinExpeditedDeposit.Empty() {
+		depositParams.MinExpeditedDeposit = types.DefaultDepositParams().MinExpeditedDeposit
+
+		// the expedited deposit is in the denoms of the minimum deposit, scaled
+		// as the default params
+		if !depositParams.MinDeposit.Empty() {
+			depositParams.MinExpeditedDeposit = sdk.Coins{}
+			for _, coin := range depositParams.MinDeposit {
+				amount := coin.Amount.Mul(types.DefaultMinExpeditedDepositTokens).Quo(types.DefaultMinDepositTokens)
+				depositParams.MinExpeditedDeposit = append(depositParams.MinExpeditedDeposit, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		keeper.SetDepositParams(ctx, depositParams)
+	}
+
+	votingParams := keeper.GetVotingParams(ctx)
+	if votingParams.ExpeditedVotingPeriod == 0 {
+		// the expedited voting period must be shorter than the voting period
+		votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+		if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+			votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+		}
+		keeper.SetVotingParams(ctx, votingParams)
+	}
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	if tallyParams.ExpeditedThreshold.IsNil() || tallyParams.ExpeditedThreshold.IsZero() {
+		// the expedited threshold must be greater than the threshold
+		tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+		if !tallyParams.ExpeditedThreshold.GT(tallyParams.Threshold) {
+			tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+		}
+		keeper.SetTallyParams(ctx, tallyParams)
+	}
+}
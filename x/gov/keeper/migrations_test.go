@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// write the params without the expedited proposal params
+	paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	setLegacyParams := func(key []byte, params interface{}, removed string) {
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(app.Codec().MustMarshalJSON(params), &fields))
+		delete(fields, removed)
+		bz, err := json.Marshal(fields)
+		require.NoError(t, err)
+		paramsStore.Set(key, bz)
+	}
+
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 3))
+	setLegacyParams(types.ParamStoreKeyDepositParams, types.NewDepositParams(minDeposit, types.DefaultPeriod, nil), "min_expedited_deposit")
+
+	// the default expedited voting period and threshold would be invalid
+	setLegacyParams(types.ParamStoreKeyVotingParams, types.NewVotingParams(12*time.Hour, 0), "expedited_voting_period")
+	tallyParams := types.NewTallyParams(types.DefaultQuorum, sdk.NewDecWithPrec(7, 1), types.DefaultVeto, sdk.Dec{})
+	setLegacyParams(types.ParamStoreKeyTallyParams, tallyParams, "expedited_threshold")
+
+	app.GovKeeper.MigrateParams(ctx)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("photon", 15)), app.GovKeeper.GetDepositParams(ctx).MinExpeditedDeposit)
+	require.Equal(t, 6*time.Hour, app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(85, 2), app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold)
+
+	// the params already set are kept
+	votingParams := types.NewVotingParams(types.DefaultPeriod, time.Hour)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	app.GovKeeper.MigrateParams(ctx)
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+}
@@ -19,7 +19,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -34,7 +34,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -77,7 +77,7 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -93,7 +93,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now(), false)
 			require.NoError(t, err)
 
 			p.Status = s
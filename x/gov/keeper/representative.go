@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// RegisterRepresentative registers an account as a governance representative
+func (keeper Keeper) RegisterRepresentative(ctx sdk.Context, address sdk.AccAddress, description string) error {
+	if _, found := keeper.GetRepresentative(ctx, address); found {
+		return sdkerrors.Wrap(types.ErrRepresentativeExists, address.String())
+	}
+
+	keeper.SetRepresentative(ctx, types.NewRepresentative(address, description))
+	return nil
+}
+
+// UnregisterRepresentative unregisters a governance representative. The
+// voting power delegated to it goes back to the delegators.
+func (keeper Keeper) UnregisterRepresentative(ctx sdk.Context, address sdk.AccAddress) error {
+	if _, found := keeper.GetRepresentative(ctx, address); !found {
+		return sdkerrors.Wrap(types.ErrUnknownRepresentative, address.String())
+	}
+
+	var delegators []sdk.AccAddress
+	keeper.IterateRepresentativeDelegators(ctx, address, func(delegator sdk.AccAddress) bool {
+		delegators = append(delegators, delegator)
+		return false
+	})
+	for _, delegator := range delegators {
+		keeper.deleteVotingPowerDelegation(ctx, delegator, address)
+	}
+
+	ctx.KVStore(keeper.storeKey).Delete(types.RepresentativeKey(address))
+	return nil
+}
+
+// DelegateVotingPower assigns the governance voting power of a delegator to a
+// representative, replacing any previous assignment
+func (keeper Keeper) DelegateVotingPower(ctx sdk.Context, delegator, representative sdk.AccAddress) error {
+	if delegator.Equals(representative) {
+		return types.ErrSelfVotingPowerDelegation
+	}
+	if _, found := keeper.GetRepresentative(ctx, representative); !found {
+		return sdkerrors.Wrap(types.ErrUnknownRepresentative, representative.String())
+	}
+
+	if del, found := keeper.GetVotingPowerDelegation(ctx, delegator); found {
+		keeper.deleteVotingPowerDelegation(ctx, del.Delegator, del.Representative)
+	}
+
+	keeper.SetVotingPowerDelegation(ctx, types.NewVotingPowerDelegation(delegator, representative))
+	return nil
+}
+
+// UndelegateVotingPower takes back the governance voting power a delegator
+// assigned to a representative
+func (keeper Keeper) UndelegateVotingPower(ctx sdk.Context, delegator sdk.AccAddress) error {
+	del, found := keeper.GetVotingPowerDelegation(ctx, delegator)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoVotingPowerDelegation, delegator.String())
+	}
+
+	keeper.deleteVotingPowerDelegation(ctx, del.Delegator, del.Representative)
+	return nil
+}
+
+// GetRepresentative gets a governance representative from the store
+func (keeper Keeper) GetRepresentative(ctx sdk.Context, address sdk.AccAddress) (rep types.Representative, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.RepresentativeKey(address))
+	if bz == nil {
+		return rep, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &rep)
+	return rep, true
+}
+
+// SetRepresentative sets a governance representative to the gov store
+func (keeper Keeper) SetRepresentative(ctx sdk.Context, rep types.Representative) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryBare(&rep)
+	store.Set(types.RepresentativeKey(rep.Address), bz)
+}
+
+// GetRepresentatives returns all the governance representatives from the store
+func (keeper Keeper) GetRepresentatives(ctx sdk.Context) (reps types.Representatives) {
+	keeper.IterateRepresentatives(ctx, func(rep types.Representative) bool {
+		reps = append(reps, rep)
+		return false
+	})
+	return
+}
+
+// IterateRepresentatives iterates over all the governance representatives and
+// performs a callback function
+func (keeper Keeper) IterateRepresentatives(ctx sdk.Context, cb func(rep types.Representative) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RepresentativesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rep types.Representative
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rep)
+
+		if cb(rep) {
+			break
+		}
+	}
+}
+
+// GetVotingPowerDelegation gets the voting power delegation of a delegator
+// from the store
+func (keeper Keeper) GetVotingPowerDelegation(ctx sdk.Context, delegator sdk.AccAddress) (del types.VotingPowerDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VotingPowerDelegationKey(delegator))
+	if bz == nil {
+		return del, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &del)
+	return del, true
+}
+
+// SetVotingPowerDelegation sets a voting power delegation to the gov store,
+// along with its entry in the index by representative
+func (keeper Keeper) SetVotingPowerDelegation(ctx sdk.Context, del types.VotingPowerDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryBare(&del)
+	store.Set(types.VotingPowerDelegationKey(del.Delegator), bz)
+	store.Set(types.VotingPowerDelegationByRepresentativeKey(del.Representative, del.Delegator), []byte{})
+}
+
+// GetVotingPowerDelegations returns all the voting power delegations from the store
+func (keeper Keeper) GetVotingPowerDelegations(ctx sdk.Context) (dels types.VotingPowerDelegations) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotingPowerDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var del types.VotingPowerDelegation
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &del)
+		dels = append(dels, del)
+	}
+	return
+}
+
+// IterateRepresentativeDelegators iterates over the delegators which assigned
+// their voting power to a representative and performs a callback function
+func (keeper Keeper) IterateRepresentativeDelegators(ctx sdk.Context, representative sdk.AccAddress, cb func(delegator sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotingPowerDelegationsByRepresentativeKey(representative))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, delegator := types.SplitKeyVotingPowerDelegationByRepresentative(iterator.Key())
+
+		if cb(delegator) {
+			break
+		}
+	}
+}
+
+// GetRepresentativeVotingPower returns the voting power of a representative:
+// the bonded tokens of its own delegations and of the delegations of the
+// delegators which assigned their voting power to it
+func (keeper Keeper) GetRepresentativeVotingPower(ctx sdk.Context, representative sdk.AccAddress) sdk.Dec {
+	return keeper.representativeVotingPower(ctx, keeper.bondedValidators(ctx), representative)
+}
+
+func (keeper Keeper) representativeVotingPower(
+	ctx sdk.Context, validators map[string]types.ValidatorGovInfo, representative sdk.AccAddress,
+) sdk.Dec {
+	votingPower := keeper.delegatorVotingPower(ctx, validators, representative)
+	keeper.IterateRepresentativeDelegators(ctx, representative, func(delegator sdk.AccAddress) bool {
+		votingPower = votingPower.Add(keeper.delegatorVotingPower(ctx, validators, delegator))
+		return false
+	})
+	return votingPower
+}
+
+// delegatorVotingPower returns the bonded tokens of the delegations of a
+// delegator to the given validators
+func (keeper Keeper) delegatorVotingPower(
+	ctx sdk.Context, validators map[string]types.ValidatorGovInfo, delegator sdk.AccAddress,
+) sdk.Dec {
+	votingPower := sdk.ZeroDec()
+	keeper.sk.IterateDelegations(ctx, delegator, func(index int64, delegation exported.DelegationI) (stop bool) {
+		if val, ok := validators[delegation.GetValidatorAddr().String()]; ok {
+			votingPower = votingPower.Add(delegation.GetShares().Quo(val.DelegatorShares).MulInt(val.BondedTokens))
+		}
+		return false
+	})
+	return votingPower
+}
+
+// bondedValidators returns the bonded validators by operator address
+func (keeper Keeper) bondedValidators(ctx sdk.Context) map[string]types.ValidatorGovInfo {
+	validators := make(map[string]types.ValidatorGovInfo)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			nil,
+		)
+
+		return false
+	})
+	return validators
+}
+
+func (keeper Keeper) deleteVotingPowerDelegation(ctx sdk.Context, delegator, representative sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VotingPowerDelegationKey(delegator))
+	store.Delete(types.VotingPowerDelegationByRepresentativeKey(representative, delegator))
+}
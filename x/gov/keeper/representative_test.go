@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestRepresentatives(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+
+	// Test registration
+	require.NoError(t, app.GovKeeper.RegisterRepresentative(ctx, addrs[0], "first"))
+	require.True(t, types.ErrRepresentativeExists.Is(app.GovKeeper.RegisterRepresentative(ctx, addrs[0], "first")))
+	require.NoError(t, app.GovKeeper.RegisterRepresentative(ctx, addrs[1], "second"))
+
+	rep, found := app.GovKeeper.GetRepresentative(ctx, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewRepresentative(addrs[0], "first"), rep)
+	require.Len(t, app.GovKeeper.GetRepresentatives(ctx), 2)
+
+	// Test voting power delegation
+	require.True(t, types.ErrUnknownRepresentative.Is(app.GovKeeper.DelegateVotingPower(ctx, addrs[2], addrs[3])))
+	require.True(t, types.ErrSelfVotingPowerDelegation.Is(app.GovKeeper.DelegateVotingPower(ctx, addrs[0], addrs[0])))
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[2], addrs[0]))
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[3], addrs[0]))
+
+	del, found := app.GovKeeper.GetVotingPowerDelegation(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, types.NewVotingPowerDelegation(addrs[2], addrs[0]), del)
+
+	// Test change of representative
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[2], addrs[1]))
+	del, found = app.GovKeeper.GetVotingPowerDelegation(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, addrs[1], del.Representative)
+
+	var delegators []sdk.AccAddress
+	app.GovKeeper.IterateRepresentativeDelegators(ctx, addrs[0], func(delegator sdk.AccAddress) bool {
+		delegators = append(delegators, delegator)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addrs[3]}, delegators)
+
+	// Test undelegation
+	require.NoError(t, app.GovKeeper.UndelegateVotingPower(ctx, addrs[2]))
+	_, found = app.GovKeeper.GetVotingPowerDelegation(ctx, addrs[2])
+	require.False(t, found)
+	require.True(t, types.ErrNoVotingPowerDelegation.Is(app.GovKeeper.UndelegateVotingPower(ctx, addrs[2])))
+
+	// Test unregistration, which removes the voting power delegations
+	require.NoError(t, app.GovKeeper.UnregisterRepresentative(ctx, addrs[0]))
+	_, found = app.GovKeeper.GetRepresentative(ctx, addrs[0])
+	require.False(t, found)
+	_, found = app.GovKeeper.GetVotingPowerDelegation(ctx, addrs[3])
+	require.False(t, found)
+	require.True(t, types.ErrUnknownRepresentative.Is(app.GovKeeper.UnregisterRepresentative(ctx, addrs[0])))
+	require.Empty(t, app.GovKeeper.GetVotingPowerDelegations(ctx))
+}
+
+func TestGetRepresentativeVotingPower(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs, valAddrs := createValidators(ctx, app, []int64{10, 10, 10})
+
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], sdk.TokensFromConsensusPower(5), sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.GovKeeper.RegisterRepresentative(ctx, addrs[4], "description"))
+	require.True(t, app.GovKeeper.GetRepresentativeVotingPower(ctx, addrs[4]).IsZero())
+
+	// the voting power of the delegators adds up to the one of the representative
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[3], addrs[4]))
+	require.NoError(t, app.GovKeeper.DelegateVotingPower(ctx, addrs[1], addrs[4]))
+	votingPower := app.GovKeeper.GetRepresentativeVotingPower(ctx, addrs[4])
+	require.Equal(t, sdk.TokensFromConsensusPower(15), votingPower.RoundInt())
+}
@@ -18,29 +18,27 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			types.OptionEmpty,
-		)
+	currValidators := keeper.bondedValidators(ctx)
 
-		return false
-	})
+	// voters which voted directly, whose voting power is never delegated to a
+	// representative
+	voted := make(map[string]bool)
+	// votes of the representatives
+	var repVotes []types.Vote
 
 	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.Options
 			currValidators[valAddrStr] = val
 		}
 
+		voted[vote.Voter.String()] = true
+		if _, ok := keeper.GetRepresentative(ctx, vote.Voter); ok {
+			repVotes = append(repVotes, vote)
+		}
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
@@ -54,20 +52,52 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-				results[vote.Option] = results[vote.Option].Add(votingPower)
+				for _, option := range vote.Options {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 		return false
 	})
 
+	// tally the voting power the delegators which did not vote assigned to the
+	// representatives which voted, deducting it from their validators
+	for _, vote := range repVotes {
+		keeper.IterateRepresentativeDelegators(ctx, vote.Voter, func(delegator sdk.AccAddress) bool {
+			if voted[delegator.String()] {
+				return false
+			}
+
+			keeper.sk.IterateDelegations(ctx, delegator, func(index int64, delegation exported.DelegationI) (stop bool) {
+				valAddrStr := delegation.GetValidatorAddr().String()
+
+				if val, ok := currValidators[valAddrStr]; ok {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					currValidators[valAddrStr] = val
+
+					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+					votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+					for _, option := range vote.Options {
+						results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+					}
+					totalVotingPower = totalVotingPower.Add(votingPower)
+				}
+
+				return false
+			})
+
+			return false
+		})
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -75,7 +105,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
@@ -104,8 +136,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes. Expedited
+	// proposals use the higher expedited threshold.
+	threshold := tallyParams.GetThreshold(proposal.IsExpedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
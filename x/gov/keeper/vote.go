@@ -8,8 +8,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// AddVote adds a vote on a specific proposal
+// AddVote adds a vote on a specific proposal, giving the whole voting power of
+// the voter to a single option
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote on a specific proposal, splitting the voting
+// power of the voter across the given options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +25,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := options.Validate(); err != nil {
+		return err
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -63,6 +70,7 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	keeper.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	populateLegacyOptions(&vote)
 	return vote, true
 }
 
@@ -82,6 +90,7 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		populateLegacyOptions(&vote)
 
 		if cb(vote) {
 			break
@@ -98,6 +107,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		populateLegacyOptions(&vote)
 
 		if cb(vote) {
 			break
@@ -105,8 +115,20 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
-// deleteVote deletes a vote from a given proposalID and voter from the store
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+// DeleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(types.VoteKey(proposalID, voterAddr))
+
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		store.Delete(types.VoteKey(proposalID, vote.Voter))
+		return false
+	})
+}
+
+// populateLegacyOptions sets the weighted options of the votes stored before
+// votes could be split, which only hold their single option.
+func populateLegacyOptions(vote *types.Vote) {
+	if len(vote.Options) == 0 && vote.Option != types.OptionEmpty {
+		vote.Options = types.NewNonSplitVoteOption(vote.Option)
+	}
 }
@@ -18,7 +18,7 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -68,3 +68,43 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, votes[1].ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
 }
+
+func TestWeightedVotes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	require.Error(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[0], invalidOptions), "weights not summing to 1")
+
+	// Test split vote
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.NoError(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[0], options))
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, addrs[0], vote.Voter)
+	require.Equal(t, proposalID, vote.ProposalID)
+	require.Equal(t, options, vote.Options)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+
+	// Test change of a split vote to a single option
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionAbstain))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionAbstain), vote.Options)
+	require.Equal(t, types.OptionAbstain, vote.Option)
+}
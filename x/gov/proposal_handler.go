@@ -0,0 +1,64 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler creates a governance Handler for the proposals of the
+// governance module. Text proposals are handled by types.ProposalHandler while
+// the messages of ExecMsgsProposal are executed through the given router, which
+// is usually the router of the BaseApp.
+func NewProposalHandler(msgRouter sdk.Router) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		switch c := content.(type) {
+		case *types.ExecMsgsProposal:
+			return handleExecMsgsProposal(ctx, msgRouter, c)
+
+		default:
+			return types.ProposalHandler(ctx, content)
+		}
+	}
+}
+
+// handleExecMsgsProposal executes the messages of the proposal in order. The
+// proposal handler is executed with a cached context, so that no state change
+// is written if one of the messages fails.
+func handleExecMsgsProposal(ctx sdk.Context, msgRouter sdk.Router, p *types.ExecMsgsProposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid message %d", i)
+		}
+
+		handler := msgRouter.Route(ctx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		// the handlers return all the events of the EventManager they are
+		// given, so each message is executed with a new one before its events
+		// are merged into the one of the proposal execution
+		res, err := executeMsg(ctx.WithEventManager(sdk.NewEventManager()), handler, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
+
+// executeMsg executes a message with its handler, a panic being returned as an
+// error so that a message cannot halt the chain at the end of the block.
+func executeMsg(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer sdkerrors.Recover(&err)
+
+	return handler(ctx, msg)
+}
@@ -0,0 +1,211 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestExecMsgsProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// fund the governance account, which sends the coins of the proposals
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], govAddr, funds))
+
+	recipient := sdk.AccAddress("recipient")
+	sendMsgs := func(amounts ...int64) []sdk.Msg {
+		msgs := make([]sdk.Msg, len(amounts))
+		for i, amount := range amounts {
+			msgs[i] = banktypes.NewMsgSend(govAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		}
+		return msgs
+	}
+
+	// the messages are executed when the proposal is submitted to check that
+	// they can succeed
+	content, err := types.NewExecMsgsProposal("Test", "description", sendMsgs(60, 60))
+	require.NoError(t, err)
+	_, err = app.GovKeeper.SubmitProposal(ctx, content, false)
+	require.True(t, types.ErrInvalidProposalContent.Is(err))
+
+	testCases := []struct {
+		name      string
+		amounts   []int64
+		withdrawn sdk.Coins // removed from the governance account during the voting period
+		expStatus types.ProposalStatus
+		expFunds  sdk.Coins
+	}{
+		{
+			"last message fails", []int64{60, 40}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+			types.StatusFailed, sdk.Coins{},
+		},
+		{
+			"all messages succeed", []int64{60, 20}, sdk.Coins{},
+			types.StatusPassed, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80)),
+		},
+	}
+
+	for _, tc := range testCases {
+		content, err := types.NewExecMsgsProposal("Test", "description", sendMsgs(tc.amounts...))
+		require.NoError(t, err, tc.name)
+
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content, false)
+		require.NoError(t, err, tc.name)
+		app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.OptionYes), tc.name)
+
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, govAddr, addrs[1], tc.withdrawn), tc.name)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok, tc.name)
+		require.Equal(t, tc.expStatus, proposal.Status, tc.name)
+
+		// the messages are executed atomically
+		require.Equal(t, tc.expFunds, app.BankKeeper.GetAllBalances(ctx, recipient), tc.name)
+	}
+}
+
+func TestExecMsgsProposalEscrowedDeposits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], govAddr, funds))
+
+	// the deposits of a text proposal are held in escrow by the governance
+	// account, its deposit period ending after the voting period of the
+	// other proposals
+	textCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	textProposal, err := app.GovKeeper.SubmitProposal(textCtx, TestProposal, false)
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	_, err = app.GovKeeper.AddDeposit(textCtx, textProposal.ProposalID, addrs[1], deposit)
+	require.NoError(t, err)
+	require.Equal(t, funds.Add(deposit...), app.BankKeeper.GetAllBalances(ctx, govAddr))
+
+	recipient := sdk.AccAddress("recipient")
+	sendMsgs := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))}
+	}
+
+	// the messages cannot spend the deposits held in escrow
+	content, err := types.NewExecMsgsProposal("Test", "description", sendMsgs(120))
+	require.NoError(t, err)
+	_, err = app.GovKeeper.SubmitProposal(ctx, content, false)
+	require.True(t, types.ErrInvalidProposalContent.Is(err))
+
+	// both proposals can be executed without spending the deposits when
+	// submitted, but only the first one executed still can
+	var proposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		content, err := types.NewExecMsgsProposal("Test", "description", sendMsgs(60))
+		require.NoError(t, err)
+
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content, false)
+		require.NoError(t, err)
+		app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.OptionYes))
+		proposalIDs = append(proposalIDs, proposal.ProposalID)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	for i, expStatus := range []types.ProposalStatus{types.StatusPassed, types.StatusFailed} {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalIDs[i])
+		require.True(t, ok)
+		require.Equal(t, expStatus, proposal.Status)
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)), app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	// the deposits can still be refunded
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	require.NotPanics(t, func() { app.GovKeeper.RefundDeposits(ctx, textProposal.ProposalID) })
+	require.Equal(t, balance.Add(deposit...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), app.BankKeeper.GetAllBalances(ctx, govAddr))
+}
+
+func TestExecMsgsProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[0], govAddr, funds))
+
+	handler := app.GovKeeper.Router().GetRoute(types.RouterKey)
+
+	// text proposals are still handled
+	require.NoError(t, handler(ctx, TestProposal))
+
+	// the events of each message are emitted once
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	content, err := types.NewExecMsgsProposal("Test", "description", []sdk.Msg{
+		banktypes.NewMsgSend(govAddr, addrs[0], half),
+		banktypes.NewMsgSend(govAddr, addrs[0], half),
+	})
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, handler(ctx, content))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsZero())
+
+	var transfers int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == banktypes.EventTypeTransfer {
+			transfers++
+		}
+	}
+	require.Equal(t, 2, transfers)
+	require.Len(t, ctx.EventManager().Events(), 6)
+
+	// the events emitted before a message are not emitted again by handlers
+	// returning all the events of the EventManager of their context
+	router := baseapp.NewRouter().AddRoute(sdk.NewRoute(banktypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(banktypes.EventTypeTransfer))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, gov.NewProposalHandler(router)(ctx, content))
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	// messages must be valid
+	content, err = types.NewExecMsgsProposal("Test", "description", []sdk.Msg{
+		banktypes.NewMsgSend(govAddr, addrs[0], sdk.Coins{}),
+	})
+	require.NoError(t, err)
+	require.Error(t, handler(ctx, content))
+}
@@ -47,6 +47,23 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.RepresentativesKeyPrefix):
+			var repA, repB types.Representative
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &repA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &repB)
+			return fmt.Sprintf("%v\n%v", repA, repB)
+
+		case bytes.Equal(kvA.Key[:1], types.VotingPowerDelegationsKeyPrefix):
+			var delA, delB types.VotingPowerDelegation
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &delA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &delB)
+			return fmt.Sprintf("%v\n%v", delA, delB)
+
+		case bytes.Equal(kvA.Key[:1], types.VotingPowerDelegationsByRepresentativeKeyPrefix):
+			repA, delegatorA := types.SplitKeyVotingPowerDelegationByRepresentative(kvA.Key)
+			repB, delegatorB := types.SplitKeyVotingPowerDelegationByRepresentative(kvB.Key)
+			return fmt.Sprintf("%s -> %s\n%s -> %s", delegatorA, repA, delegatorB, repB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
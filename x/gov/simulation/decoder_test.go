@@ -19,6 +19,8 @@ import (
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delAddr1 = sdk.AccAddress(delPk1.Address())
+	delPk2   = ed25519.GenPrivKey().PubKey()
+	delAddr2 = sdk.AccAddress(delPk2.Address())
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -28,13 +30,15 @@ func TestDecodeStore(t *testing.T) {
 	endTime := time.Now().UTC()
 
 	content := types.ContentFromProposalType("test", "test", types.ProposalTypeText)
-	proposal, err := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposal, err := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour), false)
 	require.NoError(t, err)
 
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	rep := types.NewRepresentative(delAddr1, "test")
+	del := types.NewVotingPowerDelegation(delAddr2, delAddr1)
 
 	proposalBz, err := cdc.MarshalBinaryBare(&proposal)
 	require.NoError(t, err)
@@ -44,6 +48,9 @@ func TestDecodeStore(t *testing.T) {
 		kv.Pair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 		kv.Pair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryBare(&deposit)},
 		kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryBare(&vote)},
+		kv.Pair{Key: types.RepresentativeKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&rep)},
+		kv.Pair{Key: types.VotingPowerDelegationKey(delAddr2), Value: cdc.MustMarshalBinaryBare(&del)},
+		kv.Pair{Key: types.VotingPowerDelegationByRepresentativeKey(delAddr1, delAddr2), Value: []byte{}},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -55,6 +62,9 @@ func TestDecodeStore(t *testing.T) {
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"representatives", fmt.Sprintf("%v\n%v", rep, rep)},
+		{"voting power delegations", fmt.Sprintf("%v\n%v", del, del)},
+		{"voting power delegations by representative", fmt.Sprintf("%s -> %s\n%s -> %s", delAddr2, delAddr1, delAddr2, delAddr1)},
 		{"other", ""},
 	}
 
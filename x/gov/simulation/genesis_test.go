@@ -37,16 +37,20 @@ func TestRandomizedGenState(t *testing.T) {
 	var govGenesis types.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
-	dec1, _ := sdk.NewDecFromStr("0.361000000000000000")
-	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
-	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
+	dec1, _ := sdk.NewDecFromStr("0.375000000000000000")
+	dec2, _ := sdk.NewDecFromStr("0.478000000000000000")
+	dec3, _ := sdk.NewDecFromStr("0.324000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.711000000000000000")
 
 	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit.String())
 	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
-	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, "1118stake", govGenesis.DepositParams.MinExpeditedDeposit.String())
+	require.Equal(t, float64(167429), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, float64(31535), govGenesis.VotingParams.ExpeditedVotingPeriod.Seconds())
 	require.Equal(t, dec1, govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2, govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3, govGenesis.TallyParams.Veto)
+	require.Equal(t, dec4, govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalID)
 	require.Equal(t, types.Deposits(nil), govGenesis.Deposits)
 	require.Equal(t, types.Votes(nil), govGenesis.Votes)
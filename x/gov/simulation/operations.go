@@ -20,8 +20,12 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgVote         = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
+
+	OpWeightMsgRegisterRepresentative = "op_weight_msg_register_representative"
+	OpWeightMsgDelegateVotingPower    = "op_weight_msg_delegate_voting_power"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -31,8 +35,12 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit      int
+		weightMsgVote         int
+		weightMsgVoteWeighted int
+
+		weightMsgRegisterRepresentative int
+		weightMsgDelegateVotingPower    int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -47,6 +55,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = simappparams.DefaultWeightMsgVoteWeighted
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterRepresentative, &weightMsgRegisterRepresentative, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterRepresentative = simappparams.DefaultWeightMsgRegisterRepresentative
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateVotingPower, &weightMsgDelegateVotingPower, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateVotingPower = simappparams.DefaultWeightMsgDelegateVotingPower
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -74,6 +100,18 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteWeighted,
+			SimulateMsgVoteWeighted(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRegisterRepresentative,
+			SimulateMsgRegisterRepresentative(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateVotingPower,
+			SimulateMsgDelegateVotingPower(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -125,7 +163,9 @@ func SimulateMsgSubmitProposal(
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "unable to generate deposit"), nil, err
 		}
 
-		msg, err := types.NewMsgSubmitProposal(content, deposit, simAccount.Address)
+		isExpedited := r.Intn(10) == 0
+
+		msg, err := types.NewMsgSubmitProposal(content, deposit, simAccount.Address, isExpedited)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate a submit proposal msg"), nil, err
 		}
@@ -180,7 +220,7 @@ func SimulateMsgSubmitProposal(
 
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
-		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
+		votingPeriod := k.GetVotingParams(ctx).GetVotingPeriod(isExpedited)
 
 		fops := make([]simtypes.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
@@ -315,6 +355,151 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "unable to generate proposalID"), nil, nil
+		}
+
+		options := randomWeightedVotingOptions(r)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRegisterRepresentative generates a MsgRegisterRepresentative with random values.
+func SimulateMsgRegisterRepresentative(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if _, found := k.GetRepresentative(ctx, simAccount.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterRepresentative, "representative already registered"), nil, nil
+		}
+
+		msg := types.NewMsgRegisterRepresentative(simAccount.Address, simtypes.RandStringOfLength(r, 100))
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgDelegateVotingPower generates a MsgDelegateVotingPower with random values.
+func SimulateMsgDelegateVotingPower(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		reps := k.GetRepresentatives(ctx)
+		if len(reps) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateVotingPower, "no representative registered"), nil, nil
+		}
+
+		rep := reps[r.Intn(len(reps))]
+		if rep.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateVotingPower, "cannot delegate voting power to oneself"), nil, nil
+		}
+
+		msg := types.NewMsgDelegateVotingPower(simAccount.Address, rep.Address)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -393,3 +578,27 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// Pick random weighted voting options, splitting the voting power in percents
+// across a random subset of the options
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+	r.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+
+	var weightedOptions types.WeightedVoteOptions
+	remaining := int64(100)
+	for i, option := range options {
+		weight := remaining
+		if i < len(options)-1 {
+			weight = r.Int63n(remaining + 1)
+		}
+		if weight == 0 {
+			continue
+		}
+
+		weightedOptions = append(weightedOptions, types.NewWeightedVoteOption(option, sdk.NewDecWithPrec(weight, 2)))
+		remaining -= weight
+	}
+
+	return weightedOptions
+}
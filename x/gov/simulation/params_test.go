@@ -19,9 +19,9 @@ func TestParamChanges(t *testing.T) {
 		simValue    string
 		subspace    string
 	}{
-		{"gov/votingparams", "votingparams", "{\"voting_period\": \"82639000000000\"}", "gov"},
-		{"gov/depositparams", "depositparams", "{\"max_deposit_period\": \"47332000000000\"}", "gov"},
-		{"gov/tallyparams", "tallyparams", "{\"threshold\":\"0.509000000000000000\"}", "gov"},
+		{"gov/votingparams", "votingparams", "{\"voting_period\": \"54843000000000\", \"expedited_voting_period\": \"7511000000000\"}", "gov"},
+		{"gov/depositparams", "depositparams", "{\"max_deposit_period\": \"153577000000000\"}", "gov"},
+		{"gov/tallyparams", "tallyparams", "{\"expedited_threshold\":\"0.625000000000000000\",\"quorum\":\"0.429000000000000000\"}", "gov"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
@@ -14,19 +14,31 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgRegisterRepresentative{}, "cosmos-sdk/MsgRegisterRepresentative", nil)
+	cdc.RegisterConcrete(&MsgUnregisterRepresentative{}, "cosmos-sdk/MsgUnregisterRepresentative", nil)
+	cdc.RegisterConcrete(&MsgDelegateVotingPower{}, "cosmos-sdk/MsgDelegateVotingPower", nil)
+	cdc.RegisterConcrete(&MsgUndelegateVotingPower{}, "cosmos-sdk/MsgUndelegateVotingPower", nil)
 	cdc.RegisterConcrete(&TextProposal{}, "cosmos-sdk/TextProposal", nil)
+	cdc.RegisterConcrete(&ExecMsgsProposal{}, "cosmos-sdk/ExecMsgsProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgVoteWeighted{},
 		&MsgDeposit{},
+		&MsgRegisterRepresentative{},
+		&MsgUnregisterRepresentative{},
+		&MsgDelegateVotingPower{},
+		&MsgUndelegateVotingPower{},
 	)
 	registry.RegisterInterface(
 		"cosmos_sdk.gov.v1.Content",
 		(*Content)(nil),
 		&TextProposal{},
+		&ExecMsgsProposal{},
 	)
 }
 
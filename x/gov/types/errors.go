@@ -6,12 +6,17 @@ import (
 
 // x/gov module sentinel errors
 var (
-	ErrUnknownProposal         = sdkerrors.Register(ModuleName, 2, "unknown proposal")
-	ErrInactiveProposal        = sdkerrors.Register(ModuleName, 3, "inactive proposal")
-	ErrAlreadyActiveProposal   = sdkerrors.Register(ModuleName, 4, "proposal already active")
-	ErrInvalidProposalContent  = sdkerrors.Register(ModuleName, 5, "invalid proposal content")
-	ErrInvalidProposalType     = sdkerrors.Register(ModuleName, 6, "invalid proposal type")
-	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
-	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
-	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrUnknownProposal           = sdkerrors.Register(ModuleName, 2, "unknown proposal")
+	ErrInactiveProposal          = sdkerrors.Register(ModuleName, 3, "inactive proposal")
+	ErrAlreadyActiveProposal     = sdkerrors.Register(ModuleName, 4, "proposal already active")
+	ErrInvalidProposalContent    = sdkerrors.Register(ModuleName, 5, "invalid proposal content")
+	ErrInvalidProposalType       = sdkerrors.Register(ModuleName, 6, "invalid proposal type")
+	ErrInvalidVote               = sdkerrors.Register(ModuleName, 7, "invalid vote option")
+	ErrInvalidGenesis            = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
+	ErrNoProposalHandlerExists   = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrUnknownRepresentative     = sdkerrors.Register(ModuleName, 10, "unknown representative")
+	ErrRepresentativeExists      = sdkerrors.Register(ModuleName, 11, "representative already registered")
+	ErrSelfVotingPowerDelegation = sdkerrors.Register(ModuleName, 12, "cannot delegate voting power to oneself")
+	ErrNoVotingPowerDelegation   = sdkerrors.Register(ModuleName, 13, "no voting power delegation")
+	ErrEscrowedDepositsSpent     = sdkerrors.Register(ModuleName, 14, "deposits held in escrow cannot be spent")
 )
@@ -2,20 +2,27 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
+	EventTypeSubmitProposal           = "submit_proposal"
+	EventTypeProposalDeposit          = "proposal_deposit"
+	EventTypeProposalVote             = "proposal_vote"
+	EventTypeInactiveProposal         = "inactive_proposal"
+	EventTypeActiveProposal           = "active_proposal"
+	EventTypeRegisterRepresentative   = "register_representative"
+	EventTypeUnregisterRepresentative = "unregister_representative"
+	EventTypeDelegateVotingPower      = "delegate_voting_power"
+	EventTypeUndelegateVotingPower    = "undelegate_voting_power"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyProposalResult              = "proposal_result"
+	AttributeKeyOption                      = "option"
+	AttributeKeyProposalID                  = "proposal_id"
+	AttributeKeyVotingPeriodStart           = "voting_period_start"
+	AttributeKeyIsExpedited                 = "is_expedited"
+	AttributeKeyRepresentative              = "representative"
+	AttributeValueCategory                  = "governance"
+	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
+	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
+	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
+	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // converted to a regular proposal
+	AttributeKeyProposalType                = "proposal_type"
 )
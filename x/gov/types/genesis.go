@@ -34,7 +34,9 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		data.Representatives.Equal(other.Representatives) &&
+		data.VotingPowerDelegations.Equal(other.VotingPowerDelegations)
 }
 
 // Empty returns true if a GenesisState is empty
@@ -50,6 +52,12 @@ func ValidateGenesis(data GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || !expeditedThreshold.GT(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
 	veto := data.TallyParams.Veto
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -61,6 +69,56 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	minExpeditedDeposit := data.DepositParams.MinExpeditedDeposit
+	if !minExpeditedDeposit.IsValid() || !minExpeditedDeposit.IsAllGT(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the deposit amount, is %s",
+			minExpeditedDeposit.String())
+	}
+
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period, is %s",
+			expeditedVotingPeriod.String())
+	}
+
+	for _, vote := range data.Votes {
+		// votes exported before weighted votes only carry a single option
+		if len(vote.Options) == 0 {
+			continue
+		}
+		if err := vote.Options.Validate(); err != nil {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %w", vote.Voter, vote.ProposalID, err)
+		}
+	}
+
+	representatives := make(map[string]bool, len(data.Representatives))
+	for _, rep := range data.Representatives {
+		if rep.Address.Empty() {
+			return fmt.Errorf("governance representative address cannot be empty")
+		}
+		if representatives[rep.Address.String()] {
+			return fmt.Errorf("duplicate governance representative %s", rep.Address)
+		}
+		representatives[rep.Address.String()] = true
+	}
+
+	delegators := make(map[string]bool, len(data.VotingPowerDelegations))
+	for _, del := range data.VotingPowerDelegations {
+		if del.Delegator.Empty() {
+			return fmt.Errorf("voting power delegator address cannot be empty")
+		}
+		if delegators[del.Delegator.String()] {
+			return fmt.Errorf("duplicate voting power delegation of %s", del.Delegator)
+		}
+		if !representatives[del.Representative.String()] {
+			return fmt.Errorf("voting power of %s delegated to unknown representative %s", del.Delegator, del.Representative)
+		}
+		if del.Delegator.Equals(del.Representative) {
+			return fmt.Errorf("voting power of %s delegated to itself", del.Delegator)
+		}
+		delegators[del.Delegator.String()] = true
+	}
+
 	return nil
 }
 
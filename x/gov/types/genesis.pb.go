@@ -25,13 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64        `protobuf:"varint,1,opt,name=starting_proposal_id,json=startingProposalId,proto3" json:"starting_proposal_id,omitempty" yaml:"starting_proposal_id"`
-	Deposits           Deposits      `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
-	Votes              Votes         `protobuf:"bytes,3,rep,name=votes,proto3,castrepeated=Votes" json:"votes"`
-	Proposals          Proposals     `protobuf:"bytes,4,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
-	DepositParams      DepositParams `protobuf:"bytes,5,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params" yaml:"deposit_params"`
-	VotingParams       VotingParams  `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
-	TallyParams        TallyParams   `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	StartingProposalID     uint64                 `protobuf:"varint,1,opt,name=starting_proposal_id,json=startingProposalId,proto3" json:"starting_proposal_id,omitempty" yaml:"starting_proposal_id"`
+	Deposits               Deposits               `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Votes                  Votes                  `protobuf:"bytes,3,rep,name=votes,proto3,castrepeated=Votes" json:"votes"`
+	Proposals              Proposals              `protobuf:"bytes,4,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
+	DepositParams          DepositParams          `protobuf:"bytes,5,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params" yaml:"deposit_params"`
+	VotingParams           VotingParams           `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
+	TallyParams            TallyParams            `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	Representatives        Representatives        `protobuf:"bytes,8,rep,name=representatives,proto3,castrepeated=Representatives" json:"representatives"`
+	VotingPowerDelegations VotingPowerDelegations `protobuf:"bytes,9,rep,name=voting_power_delegations,json=votingPowerDelegations,proto3,castrepeated=VotingPowerDelegations" json:"voting_power_delegations" yaml:"voting_power_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +118,20 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetRepresentatives() Representatives {
+	if m != nil {
+		return m.Representatives
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerDelegations() VotingPowerDelegations {
+	if m != nil {
+		return m.VotingPowerDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.GenesisState")
 }
@@ -123,34 +139,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/genesis.proto", fileDescriptor_b3a5f07e3880dc71) }
 
 var fileDescriptor_b3a5f07e3880dc71 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0x94, 0x40,
-	0x14, 0xc7, 0xc1, 0xee, 0xd6, 0x76, 0x76, 0xd7, 0xd4, 0x29, 0x46, 0x6c, 0x15, 0x36, 0x9c, 0xb8,
-	0x08, 0x49, 0x4d, 0x2f, 0xde, 0x24, 0x35, 0xc6, 0x5b, 0x43, 0x8d, 0x26, 0x7a, 0x20, 0xb4, 0x90,
-	0x91, 0x08, 0x7d, 0x84, 0x37, 0x12, 0xf7, 0x5b, 0xf8, 0x39, 0xfc, 0x24, 0xbd, 0xd9, 0xa3, 0x27,
-	0x34, 0xec, 0x37, 0xe8, 0x27, 0x30, 0xcc, 0x0c, 0xed, 0xb4, 0xe9, 0x69, 0x97, 0xf7, 0xfe, 0xbf,
-	0x5f, 0xe6, 0xbd, 0x3c, 0x62, 0x9f, 0x01, 0x56, 0x80, 0x21, 0x83, 0x36, 0x64, 0xf9, 0x79, 0x8e,
-	0x05, 0x06, 0x75, 0x03, 0x1c, 0x28, 0x91, 0x9d, 0x80, 0x41, 0xbb, 0x67, 0x31, 0x60, 0x20, 0xca,
-	0xe1, 0xf0, 0x4f, 0x26, 0xf6, 0x2c, 0x9d, 0x85, 0x56, 0x56, 0xbd, 0xdf, 0x13, 0x32, 0x7f, 0x27,
-	0x4d, 0x27, 0x3c, 0xe5, 0x39, 0x65, 0xc4, 0x42, 0x9e, 0x36, 0xbc, 0x38, 0x67, 0x49, 0xdd, 0x40,
-	0x0d, 0x98, 0x96, 0x49, 0x91, 0xd9, 0xe6, 0xd2, 0xf4, 0x27, 0xd1, 0x61, 0xdf, 0xb9, 0xf4, 0x44,
-	0xf5, 0x8f, 0x55, 0xfb, 0xfd, 0xd1, 0x55, 0xe7, 0xee, 0xaf, 0xd2, 0xaa, 0x7c, 0xed, 0xdd, 0xc7,
-	0x7a, 0x31, 0xc5, 0xbb, 0x48, 0x46, 0xdf, 0x90, 0xad, 0x2c, 0xaf, 0x01, 0x0b, 0x8e, 0xf6, 0x83,
-	0xe5, 0x86, 0x3f, 0x3b, 0xd8, 0x0d, 0x6e, 0x86, 0x08, 0x8e, 0x64, 0x2f, 0xda, 0xb9, 0xe8, 0x5c,
-	0xe3, 0xd7, 0x5f, 0x77, 0x4b, 0x15, 0x30, 0xbe, 0xc6, 0xe8, 0x21, 0x99, 0xb6, 0xc0, 0x73, 0xb4,
-	0x37, 0x04, 0xbf, 0xa3, 0xf3, 0x1f, 0x81, 0xe7, 0xd1, 0x42, 0xc1, 0xd3, 0xe1, 0x0b, 0x63, 0x99,
-	0xa6, 0x6f, 0xc9, 0xf6, 0xf8, 0x3a, 0xb4, 0x27, 0x02, 0xb5, 0x74, 0x74, 0x7c, 0x64, 0xf4, 0x58,
-	0xe1, 0xdb, 0x63, 0x05, 0xe3, 0x1b, 0x92, 0x26, 0xe4, 0x91, 0x7a, 0x49, 0x52, 0xa7, 0x4d, 0x5a,
-	0xa1, 0x3d, 0x5d, 0x9a, 0xfe, 0xec, 0xe0, 0xd9, 0x3d, 0x63, 0x1c, 0x8b, 0x40, 0xf4, 0x62, 0x10,
-	0x5e, 0x75, 0xee, 0x13, 0xb9, 0xac, 0xdb, 0xb8, 0x17, 0x2f, 0x32, 0x3d, 0x4d, 0xbf, 0x90, 0x45,
-	0x0b, 0x72, 0x99, 0xd2, 0xbf, 0x29, 0xfc, 0xf6, 0x9d, 0x31, 0x87, 0xb5, 0x4a, 0xfd, 0x73, 0xa5,
-	0xb7, 0xa4, 0xfe, 0x16, 0xec, 0xc5, 0xf3, 0x56, 0xcb, 0xd2, 0x4f, 0x64, 0xce, 0xd3, 0xb2, 0x5c,
-	0x8d, 0xee, 0x87, 0xc2, 0xfd, 0x54, 0x77, 0x7f, 0x18, 0xfa, 0x4a, 0xbd, 0xaf, 0xd4, 0xbb, 0x52,
-	0xad, 0xa3, 0x5e, 0x3c, 0xe3, 0x5a, 0x32, 0xba, 0xe8, 0x1d, 0xf3, 0xb2, 0x77, 0xcc, 0x7f, 0xbd,
-	0x63, 0xfe, 0x5c, 0x3b, 0xc6, 0xe5, 0xda, 0x31, 0xfe, 0xac, 0x1d, 0xe3, 0xb3, 0xcf, 0x0a, 0xfe,
-	0xf5, 0xfb, 0x69, 0x70, 0x06, 0x55, 0xa8, 0x8e, 0x51, 0xfe, 0xbc, 0xc4, 0xec, 0x5b, 0xf8, 0x43,
-	0x5c, 0x26, 0x5f, 0xd5, 0x39, 0x9e, 0x6e, 0x8a, 0xe3, 0x7c, 0xf5, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0xd7, 0xec, 0x8f, 0xa2, 0xf0, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0xda, 0x86, 0x64, 0x92, 0xd0, 0x32, 0x0d, 0xed, 0x90, 0x82, 0x1d, 0xbc, 0xca,
+	0x86, 0x58, 0x2a, 0xea, 0x86, 0x5d, 0xad, 0x20, 0xc4, 0xae, 0x9a, 0x22, 0x90, 0x40, 0xc8, 0x72,
+	0xeb, 0x91, 0xb1, 0x70, 0x32, 0x96, 0xdf, 0x60, 0xc8, 0x2d, 0x38, 0x01, 0x07, 0xe8, 0x49, 0xba,
+	0xa3, 0x4b, 0x56, 0x29, 0x4a, 0x6e, 0xd0, 0x13, 0x20, 0xcf, 0x8c, 0x1b, 0x3b, 0xf2, 0xca, 0xf6,
+	0x7b, 0xff, 0xf7, 0x79, 0xde, 0x93, 0x06, 0x91, 0x4b, 0x0e, 0x53, 0x0e, 0x4e, 0xc8, 0x33, 0x27,
+	0x64, 0x33, 0x06, 0x11, 0x8c, 0x93, 0x94, 0x0b, 0x8e, 0x91, 0xea, 0x8c, 0x43, 0x9e, 0x0d, 0xfa,
+	0x21, 0x0f, 0xb9, 0x2c, 0x3b, 0xf9, 0x9b, 0x4a, 0x0c, 0xfa, 0x65, 0x96, 0x67, 0xaa, 0x6a, 0xff,
+	0x69, 0xa2, 0xee, 0x5b, 0x65, 0x3a, 0x17, 0xbe, 0x60, 0x38, 0x44, 0x7d, 0x10, 0x7e, 0x2a, 0xa2,
+	0x59, 0xe8, 0x25, 0x29, 0x4f, 0x38, 0xf8, 0xb1, 0x17, 0x05, 0xc4, 0x18, 0x1a, 0xa3, 0x6d, 0xf7,
+	0x64, 0xb9, 0xb0, 0xf0, 0xb9, 0xee, 0x9f, 0xe9, 0xf6, 0xbb, 0xc9, 0xdd, 0xc2, 0x3a, 0x9a, 0xfb,
+	0xd3, 0xf8, 0xb5, 0x5d, 0xc7, 0xda, 0x14, 0xc3, 0x26, 0x12, 0xe0, 0x53, 0xd4, 0x0a, 0x58, 0xc2,
+	0x21, 0x12, 0x40, 0x1e, 0x0c, 0xb7, 0x46, 0x9d, 0xe3, 0xfd, 0xf1, 0x7a, 0x88, 0xf1, 0x44, 0xf5,
+	0xdc, 0xbd, 0xeb, 0x85, 0xd5, 0xb8, 0xba, 0xb5, 0x5a, 0xba, 0x00, 0xf4, 0x1e, 0xc3, 0x27, 0x68,
+	0x27, 0xe3, 0x82, 0x01, 0xd9, 0x92, 0xfc, 0x5e, 0x99, 0xff, 0xc0, 0x05, 0x73, 0x7b, 0x1a, 0xde,
+	0xc9, 0xbf, 0x80, 0xaa, 0x34, 0x7e, 0x83, 0xda, 0xc5, 0xe9, 0x80, 0x6c, 0x4b, 0xb4, 0x5f, 0x46,
+	0x8b, 0x43, 0xba, 0x8f, 0x35, 0xde, 0x2e, 0x2a, 0x40, 0xd7, 0x24, 0xf6, 0xd0, 0x23, 0x7d, 0x12,
+	0x2f, 0xf1, 0x53, 0x7f, 0x0a, 0x64, 0x67, 0x68, 0x8c, 0x3a, 0xc7, 0x4f, 0x6b, 0xc6, 0x38, 0x93,
+	0x01, 0xf7, 0x79, 0x2e, 0xbc, 0x5b, 0x58, 0x4f, 0xd4, 0xb2, 0xaa, 0xb8, 0x4d, 0x7b, 0x41, 0x39,
+	0x8d, 0x3f, 0xa3, 0x5e, 0xc6, 0xd5, 0x32, 0x95, 0xbf, 0x29, 0xfd, 0x64, 0x63, 0xcc, 0x7c, 0xad,
+	0x4a, 0xff, 0x4c, 0xeb, 0xfb, 0x4a, 0x5f, 0x81, 0x6d, 0xda, 0xcd, 0x4a, 0x59, 0xfc, 0x11, 0x75,
+	0x85, 0x1f, 0xc7, 0xf3, 0xc2, 0xfd, 0x50, 0xba, 0x0f, 0xcb, 0xee, 0xf7, 0x79, 0x5f, 0xab, 0x8f,
+	0xb4, 0x7a, 0x5f, 0xa9, 0xcb, 0xa8, 0x4d, 0x3b, 0x62, 0x9d, 0xc4, 0x5f, 0xd0, 0x6e, 0xca, 0x92,
+	0x94, 0x01, 0x9b, 0x09, 0x5f, 0x44, 0x19, 0x03, 0xd2, 0x92, 0x3b, 0x1e, 0x94, 0xdd, 0xb4, 0x12,
+	0x71, 0x0f, 0xf5, 0xa6, 0x77, 0xab, 0x75, 0xa0, 0x9b, 0x2e, 0xfc, 0xdb, 0x40, 0xa4, 0x18, 0x8c,
+	0xff, 0x60, 0xa9, 0x17, 0xb0, 0x98, 0x85, 0xbe, 0x88, 0xf8, 0x0c, 0x48, 0x5b, 0xfe, 0xe8, 0x45,
+	0xcd, 0x82, 0xf2, 0xe8, 0xe4, 0x3e, 0xe9, 0x9e, 0xea, 0x71, 0xac, 0xea, 0xa6, 0x36, 0x85, 0xf6,
+	0xd5, 0xad, 0x75, 0x50, 0x6b, 0x00, 0x7a, 0x90, 0xd5, 0xd6, 0x5d, 0xf7, 0x7a, 0x69, 0x1a, 0x37,
+	0x4b, 0xd3, 0xf8, 0xb7, 0x34, 0x8d, 0x5f, 0x2b, 0xb3, 0x71, 0xb3, 0x32, 0x1b, 0x7f, 0x57, 0x66,
+	0xe3, 0xd3, 0x28, 0x8c, 0xc4, 0xd7, 0xef, 0x17, 0xe3, 0x4b, 0x3e, 0x75, 0xf4, 0x65, 0x54, 0x8f,
+	0x97, 0x10, 0x7c, 0x73, 0x7e, 0xca, 0x9b, 0x29, 0xe6, 0x09, 0x83, 0x8b, 0xa6, 0xbc, 0x9c, 0xaf,
+	0xfe, 0x0f, 0x00, 0xcd, 0x75, 0xcd, 0x93, 0xf0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -173,6 +194,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotingPowerDelegations) > 0 {
+		for iNdEx := len(m.VotingPowerDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Representatives) > 0 {
+		for iNdEx := len(m.Representatives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Representatives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +346,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Representatives) > 0 {
+		for _, e := range m.Representatives {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerDelegations) > 0 {
+		for _, e := range m.VotingPowerDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -555,6 +616,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Representatives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Representatives = append(m.Representatives, Representative{})
+			if err := m.Representatives[len(m.Representatives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerDelegations = append(m.VotingPowerDelegations, VotingPowerDelegation{})
+			if err := m.VotingPowerDelegations[len(m.VotingPowerDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
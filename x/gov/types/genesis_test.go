@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +22,95 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*GenesisState)
+		expErr   bool
+	}{
+		{"default", func(*GenesisState) {}, false},
+		{"expedited threshold not greater than the threshold", func(data *GenesisState) {
+			data.TallyParams.ExpeditedThreshold = data.TallyParams.Threshold
+		}, true},
+		{"expedited threshold too large", func(data *GenesisState) {
+			data.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1)
+		}, true},
+		{"missing expedited threshold", func(data *GenesisState) {
+			data.TallyParams.ExpeditedThreshold = sdk.Dec{}
+		}, true},
+		{"expedited deposit not greater than the deposit", func(data *GenesisState) {
+			data.DepositParams.MinExpeditedDeposit = data.DepositParams.MinDeposit
+		}, true},
+		{"expedited voting period not shorter than the voting period", func(data *GenesisState) {
+			data.VotingParams.ExpeditedVotingPeriod = data.VotingParams.VotingPeriod
+		}, true},
+		{"no expedited voting period", func(data *GenesisState) {
+			data.VotingParams.ExpeditedVotingPeriod = 0
+		}, true},
+	}
+	for _, tt := range tests {
+		data := DefaultGenesisState()
+		tt.malleate(&data)
+
+		if tt.expErr {
+			require.Error(t, ValidateGenesis(data), tt.name)
+		} else {
+			require.NoError(t, ValidateGenesis(data), tt.name)
+		}
+	}
+}
+
+func TestValidateGenesisRepresentatives(t *testing.T) {
+	rep1, rep2 := sdk.AccAddress("rep1"), sdk.AccAddress("rep2")
+	delegator := sdk.AccAddress("delegator")
+
+	tests := []struct {
+		name            string
+		representatives Representatives
+		delegations     VotingPowerDelegations
+		expErr          bool
+	}{
+		{
+			"valid",
+			Representatives{NewRepresentative(rep1, ""), NewRepresentative(rep2, "")},
+			VotingPowerDelegations{NewVotingPowerDelegation(delegator, rep1), NewVotingPowerDelegation(rep2, rep1)},
+			false,
+		},
+		{
+			"duplicate representative",
+			Representatives{NewRepresentative(rep1, ""), NewRepresentative(rep1, "")},
+			nil,
+			true,
+		},
+		{
+			"unknown representative",
+			Representatives{NewRepresentative(rep1, "")},
+			VotingPowerDelegations{NewVotingPowerDelegation(delegator, rep2)},
+			true,
+		},
+		{
+			"duplicate delegation",
+			Representatives{NewRepresentative(rep1, ""), NewRepresentative(rep2, "")},
+			VotingPowerDelegations{NewVotingPowerDelegation(delegator, rep1), NewVotingPowerDelegation(delegator, rep2)},
+			true,
+		},
+		{
+			"delegation to oneself",
+			Representatives{NewRepresentative(rep1, "")},
+			VotingPowerDelegations{NewVotingPowerDelegation(rep1, rep1)},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		data := DefaultGenesisState()
+		data.Representatives = tt.representatives
+		data.VotingPowerDelegations = tt.delegations
+
+		if tt.expErr {
+			require.Error(t, ValidateGenesis(data), tt.name)
+		} else {
+			require.NoError(t, ValidateGenesis(data), tt.name)
+		}
+	}
+}
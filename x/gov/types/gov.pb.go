@@ -115,6 +115,9 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// is_expedited defines whether the proposal is expedited, using the
+	// expedited governance params.
+	IsExpedited bool `protobuf:"varint,4,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -188,6 +191,46 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote split across several
+// options, with weights summing to 1
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{2}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -198,7 +241,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{2}
+	return fileDescriptor_67fb57f9a603bed5, []int{3}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +270,160 @@ func (m *MsgDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
 
+// MsgRegisterRepresentative defines a message to register an account as a
+// governance representative
+type MsgRegisterRepresentative struct {
+	Address     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *MsgRegisterRepresentative) Reset()      { *m = MsgRegisterRepresentative{} }
+func (*MsgRegisterRepresentative) ProtoMessage() {}
+func (*MsgRegisterRepresentative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{4}
+}
+func (m *MsgRegisterRepresentative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRepresentative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRepresentative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRepresentative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRepresentative.Merge(m, src)
+}
+func (m *MsgRegisterRepresentative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRepresentative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRepresentative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRepresentative proto.InternalMessageInfo
+
+// MsgUnregisterRepresentative defines a message to unregister a governance
+// representative, giving the voting power assigned to it back to the delegators
+type MsgUnregisterRepresentative struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+}
+
+func (m *MsgUnregisterRepresentative) Reset()      { *m = MsgUnregisterRepresentative{} }
+func (*MsgUnregisterRepresentative) ProtoMessage() {}
+func (*MsgUnregisterRepresentative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{5}
+}
+func (m *MsgUnregisterRepresentative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterRepresentative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterRepresentative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterRepresentative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterRepresentative.Merge(m, src)
+}
+func (m *MsgUnregisterRepresentative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterRepresentative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterRepresentative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterRepresentative proto.InternalMessageInfo
+
+// MsgDelegateVotingPower defines a message to assign the governance voting
+// power of a delegator to a representative
+type MsgDelegateVotingPower struct {
+	Delegator      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Representative github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=representative,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"representative,omitempty"`
+}
+
+func (m *MsgDelegateVotingPower) Reset()      { *m = MsgDelegateVotingPower{} }
+func (*MsgDelegateVotingPower) ProtoMessage() {}
+func (*MsgDelegateVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{6}
+}
+func (m *MsgDelegateVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotingPower.Merge(m, src)
+}
+func (m *MsgDelegateVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVotingPower proto.InternalMessageInfo
+
+// MsgUndelegateVotingPower defines a message to take back the governance
+// voting power a delegator assigned to a representative
+type MsgUndelegateVotingPower struct {
+	Delegator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+}
+
+func (m *MsgUndelegateVotingPower) Reset()      { *m = MsgUndelegateVotingPower{} }
+func (*MsgUndelegateVotingPower) ProtoMessage() {}
+func (*MsgUndelegateVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{7}
+}
+func (m *MsgUndelegateVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVotingPower.Merge(m, src)
+}
+func (m *MsgUndelegateVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVotingPower proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval
 type TextProposal struct {
@@ -237,7 +434,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{3}
+	return fileDescriptor_67fb57f9a603bed5, []int{8}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,6 +463,47 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// ExecMsgsProposal defines a proposal executing a list of messages signed by
+// the governance module account once it passes. The messages are executed
+// atomically: if one of them fails, none is applied and the proposal fails.
+type ExecMsgsProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Messages    []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ExecMsgsProposal) Reset()      { *m = ExecMsgsProposal{} }
+func (*ExecMsgsProposal) ProtoMessage() {}
+func (*ExecMsgsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{9}
+}
+func (m *ExecMsgsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecMsgsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecMsgsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecMsgsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecMsgsProposal.Merge(m, src)
+}
+func (m *ExecMsgsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecMsgsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecMsgsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecMsgsProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active proposal
 type Deposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
@@ -276,7 +514,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{4}
+	return fileDescriptor_67fb57f9a603bed5, []int{10}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,12 +554,16 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// is_expedited defines whether the proposal is expedited. An expedited
+	// proposal which does not pass at the end of its voting period is converted
+	// to a regular proposal.
+	IsExpedited bool `protobuf:"varint,10,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{5}
+	return fileDescriptor_67fb57f9a603bed5, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +603,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{6}
+	return fileDescriptor_67fb57f9a603bed5, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,17 +633,20 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	// option is only set for votes which are not split, and kept for clients
+	// unaware of weighted votes
+	Option  VoteOption          `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{7}
+	return fileDescriptor_67fb57f9a603bed5, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,18 +675,58 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// WeightedVoteOption defines the share of a vote given to a vote option
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{14}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
 	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit"`
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	MinExpeditedDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_expedited_deposit,json=minExpeditedDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_expedited_deposit,omitempty" yaml:"min_expedited_deposit"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{8}
+	return fileDescriptor_67fb57f9a603bed5, []int{15}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,12 +759,14 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{9}
+	return fileDescriptor_67fb57f9a603bed5, []int{16}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,12 +803,14 @@ type TallyParams struct {
 	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3.
 	Veto github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{10}
+	return fileDescriptor_67fb57f9a603bed5, []int{17}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,118 +839,230 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// Representative defines an account registered as a governance representative,
+// to which delegators can assign their voting power
+type Representative struct {
+	Address     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *Representative) Reset()      { *m = Representative{} }
+func (*Representative) ProtoMessage() {}
+func (*Representative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{18}
+}
+func (m *Representative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Representative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Representative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Representative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Representative.Merge(m, src)
+}
+func (m *Representative) XXX_Size() int {
+	return m.Size()
+}
+func (m *Representative) XXX_DiscardUnknown() {
+	xxx_messageInfo_Representative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Representative proto.InternalMessageInfo
+
+// VotingPowerDelegation defines the assignment of the governance voting power
+// of a delegator to a representative
+type VotingPowerDelegation struct {
+	Delegator      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Representative github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=representative,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"representative,omitempty"`
+}
+
+func (m *VotingPowerDelegation) Reset()      { *m = VotingPowerDelegation{} }
+func (*VotingPowerDelegation) ProtoMessage() {}
+func (*VotingPowerDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{19}
+}
+func (m *VotingPowerDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerDelegation.Merge(m, src)
+}
+func (m *VotingPowerDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerDelegation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.MsgSubmitProposal")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.gov.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.gov.MsgVoteWeighted")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.MsgDeposit")
+	proto.RegisterType((*MsgRegisterRepresentative)(nil), "cosmos.gov.MsgRegisterRepresentative")
+	proto.RegisterType((*MsgUnregisterRepresentative)(nil), "cosmos.gov.MsgUnregisterRepresentative")
+	proto.RegisterType((*MsgDelegateVotingPower)(nil), "cosmos.gov.MsgDelegateVotingPower")
+	proto.RegisterType((*MsgUndelegateVotingPower)(nil), "cosmos.gov.MsgUndelegateVotingPower")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.TextProposal")
+	proto.RegisterType((*ExecMsgsProposal)(nil), "cosmos.gov.ExecMsgsProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.Vote")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.WeightedVoteOption")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.TallyParams")
+	proto.RegisterType((*Representative)(nil), "cosmos.gov.Representative")
+	proto.RegisterType((*VotingPowerDelegation)(nil), "cosmos.gov.VotingPowerDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/gov.proto", fileDescriptor_67fb57f9a603bed5) }
 
 var fileDescriptor_67fb57f9a603bed5 = []byte{
-	// 1468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6b, 0x1b, 0xd7,
-	0x16, 0xd6, 0xc8, 0xbf, 0x8f, 0x64, 0x7b, 0x72, 0xed, 0x67, 0x2b, 0xf3, 0xde, 0x9b, 0x51, 0xf4,
-	0xc2, 0xc3, 0x84, 0x44, 0xce, 0x73, 0x56, 0x2f, 0x81, 0x47, 0x24, 0x6b, 0x92, 0x28, 0xc4, 0x92,
-	0x18, 0x4d, 0x14, 0x92, 0xb7, 0x18, 0xc6, 0xd2, 0x44, 0x9e, 0x56, 0x33, 0x57, 0xd5, 0x5c, 0xb9,
-	0x36, 0xd9, 0xb4, 0xbb, 0xa2, 0x42, 0x48, 0x77, 0xdd, 0x08, 0x0a, 0xcd, 0xa2, 0xed, 0xaa, 0x85,
-	0xfe, 0x11, 0xa6, 0x74, 0x11, 0x4a, 0x17, 0xa1, 0x0b, 0xa5, 0x71, 0xa0, 0x14, 0x2f, 0xba, 0xc8,
-	0xb2, 0x9b, 0x96, 0xb9, 0xf7, 0x8e, 0x35, 0x92, 0x4d, 0x1d, 0xf5, 0x07, 0x85, 0x2e, 0x02, 0xd2,
-	0xb9, 0xe7, 0xfb, 0xee, 0x39, 0x9f, 0xce, 0x77, 0x66, 0x62, 0x58, 0xac, 0x62, 0xcf, 0xc1, 0xde,
-	0x6a, 0x1d, 0x6f, 0xfb, 0xff, 0xd2, 0xcd, 0x16, 0x26, 0x18, 0x01, 0x8b, 0xa6, 0xeb, 0x78, 0x5b,
-	0x5a, 0xe0, 0x19, 0x3c, 0x44, 0x13, 0xa4, 0xc5, 0x3a, 0xae, 0x63, 0xfa, 0x71, 0xd5, 0xff, 0xc4,
-	0xa3, 0xa7, 0x59, 0x8e, 0xc1, 0x0e, 0x06, 0x00, 0x4a, 0x1d, 0xe3, 0x7a, 0xc3, 0x5a, 0xa5, 0xdf,
-	0x36, 0xdb, 0xf7, 0x57, 0x89, 0xed, 0x58, 0x1e, 0x31, 0x9d, 0x66, 0x80, 0x1d, 0x4e, 0x30, 0xdd,
-	0x5d, 0x7e, 0x24, 0x0f, 0x1f, 0xd5, 0xda, 0x2d, 0x93, 0xd8, 0xd8, 0x65, 0xe7, 0xa9, 0x8f, 0xa3,
-	0x70, 0x6a, 0xc3, 0xab, 0x97, 0xdb, 0x9b, 0x8e, 0x4d, 0x4a, 0x2d, 0xdc, 0xc4, 0x9e, 0xd9, 0x40,
-	0x57, 0x60, 0xaa, 0x8a, 0x5d, 0x62, 0xb9, 0x24, 0x21, 0x24, 0x85, 0x95, 0xd8, 0xda, 0x62, 0x9a,
-	0xf1, 0xa4, 0x03, 0x9e, 0x74, 0xc6, 0xdd, 0xcd, 0xc6, 0xbe, 0xf8, 0xfc, 0xc2, 0xd4, 0x3a, 0x4b,
-	0xd4, 0x02, 0x04, 0x7a, 0x5b, 0x80, 0x79, 0xdb, 0xb5, 0x89, 0x6d, 0x36, 0x8c, 0x9a, 0xd5, 0xc4,
-	0x9e, 0x4d, 0x12, 0xd1, 0xe4, 0xd8, 0x4a, 0x6c, 0x2d, 0x9e, 0xe6, 0x7d, 0xad, 0x63, 0xdb, 0xcd,
-	0xde, 0xdc, 0xeb, 0x29, 0x91, 0x97, 0x3d, 0x65, 0x69, 0xd7, 0x74, 0x1a, 0x97, 0x53, 0x43, 0x90,
-	0xd4, 0x27, 0xcf, 0x94, 0x95, 0xba, 0x4d, 0xb6, 0xda, 0x9b, 0xe9, 0x2a, 0x76, 0x56, 0x07, 0x94,
-	0xbc, 0xe0, 0xd5, 0x5e, 0x5f, 0x25, 0xbb, 0x4d, 0x8b, 0x51, 0x79, 0xda, 0x1c, 0x47, 0xe7, 0x18,
-	0x18, 0x6d, 0xc0, 0x74, 0x93, 0x36, 0x63, 0xb5, 0x12, 0x63, 0x49, 0x61, 0x25, 0x9e, 0xfd, 0xcf,
-	0x8f, 0x3d, 0xe5, 0xc2, 0x2b, 0xf0, 0x65, 0xaa, 0xd5, 0x4c, 0xad, 0xd6, 0xb2, 0x3c, 0x4f, 0x3b,
-	0xa4, 0xb8, 0x3c, 0xfe, 0xfd, 0x07, 0x8a, 0x90, 0xea, 0x09, 0x30, 0xb5, 0xe1, 0xd5, 0x2b, 0x98,
-	0x58, 0x48, 0x87, 0x58, 0x93, 0xab, 0x65, 0xd8, 0x35, 0xaa, 0xd2, 0x78, 0xf6, 0xd2, 0x7e, 0x4f,
-	0x81, 0x40, 0xc4, 0x7c, 0xee, 0xa0, 0xa7, 0x84, 0x93, 0x5e, 0xf6, 0x14, 0xc4, 0x5a, 0x0d, 0x05,
-	0x53, 0x1a, 0x04, 0xdf, 0xf2, 0x35, 0x74, 0x1d, 0x26, 0xb6, 0x31, 0xb1, 0x5a, 0x89, 0xe8, 0xaf,
-	0xad, 0x99, 0xe1, 0x51, 0x1a, 0x26, 0x71, 0xd3, 0xff, 0x99, 0x69, 0xf7, 0x73, 0x6b, 0x4b, 0xe9,
-	0xfe, 0x54, 0xa6, 0xfd, 0x06, 0x8a, 0xf4, 0x54, 0xe3, 0x59, 0xbc, 0xc1, 0xf7, 0xa2, 0x00, 0x1b,
-	0x5e, 0x3d, 0x10, 0xf1, 0x8f, 0xe9, 0xb1, 0x08, 0x33, 0xfc, 0x27, 0xc6, 0xbf, 0xa1, 0xcf, 0x3e,
-	0x07, 0xaa, 0xc0, 0xa4, 0xe9, 0xe0, 0xb6, 0x4b, 0x12, 0x63, 0xc7, 0x4c, 0xd9, 0x45, 0x7f, 0xca,
-	0x46, 0x9a, 0x25, 0xce, 0xc6, 0x35, 0xb9, 0x03, 0x71, 0xdd, 0xda, 0xe9, 0x5b, 0x63, 0x11, 0x26,
-	0x88, 0x4d, 0x1a, 0x16, 0x95, 0x63, 0x46, 0x63, 0x5f, 0x50, 0x12, 0x62, 0x35, 0xcb, 0xab, 0xb6,
-	0x6c, 0x26, 0x7a, 0x94, 0x9e, 0x85, 0x43, 0x97, 0xe7, 0x7d, 0xb6, 0xaf, 0xfa, 0x7e, 0x49, 0xfd,
-	0x24, 0xc0, 0x54, 0xa0, 0xb4, 0x7a, 0x9c, 0xd2, 0x67, 0x07, 0x95, 0xfe, 0xeb, 0x49, 0xfb, 0xdd,
-	0x24, 0x4c, 0x1f, 0xea, 0x9a, 0x3d, 0x4e, 0x82, 0x33, 0x47, 0x86, 0x2d, 0x4a, 0x67, 0x6c, 0x86,
-	0xaf, 0x8c, 0xa1, 0xfe, 0x43, 0x6b, 0x2b, 0x3a, 0xf2, 0xda, 0x2a, 0xc0, 0xa4, 0x47, 0x4c, 0xd2,
-	0xf6, 0xb8, 0x65, 0xa4, 0xb0, 0x65, 0x82, 0x1a, 0xca, 0x34, 0x23, 0x2b, 0xf5, 0xd7, 0xd6, 0x61,
-	0xd1, 0x0c, 0x9c, 0xd2, 0x38, 0x0b, 0xda, 0x02, 0x74, 0xdf, 0x76, 0xcd, 0x86, 0x41, 0xcc, 0x46,
-	0x63, 0xd7, 0x68, 0x59, 0x5e, 0xbb, 0x41, 0x12, 0xe3, 0xb4, 0xae, 0xe5, 0x30, 0xb7, 0xee, 0x9f,
-	0x6b, 0xf4, 0x38, 0x7b, 0x86, 0xef, 0xc4, 0xd3, 0x8c, 0xfc, 0x28, 0x41, 0x4a, 0x13, 0x69, 0x30,
-	0x04, 0x42, 0xff, 0x87, 0x98, 0x47, 0xf7, 0xb7, 0xe1, 0x3f, 0x18, 0x12, 0x13, 0xf4, 0x0a, 0xe9,
-	0x48, 0xeb, 0x7a, 0xf0, 0xd4, 0xc8, 0xca, 0xfc, 0x16, 0x3e, 0x4f, 0x21, 0x70, 0xea, 0xd1, 0x33,
-	0x45, 0xd0, 0x80, 0x45, 0x7c, 0x00, 0xb2, 0x41, 0xe4, 0xf3, 0x60, 0x58, 0x6e, 0x8d, 0xdd, 0x30,
-	0x79, 0xe2, 0x0d, 0xff, 0xe2, 0x37, 0x2c, 0xb3, 0x1b, 0x86, 0x19, 0xd8, 0x35, 0x73, 0x3c, 0xac,
-	0xba, 0x35, 0x7a, 0xd5, 0x03, 0x98, 0x25, 0x98, 0x84, 0x9e, 0x1a, 0x53, 0xc7, 0x0c, 0xdd, 0x0d,
-	0xce, 0xbc, 0xc8, 0x98, 0x07, 0x00, 0xa3, 0x3d, 0x33, 0xe2, 0x14, 0x1b, 0x58, 0xb0, 0x01, 0xa7,
-	0xb6, 0x31, 0xb1, 0xdd, 0xba, 0xff, 0x43, 0xb6, 0xb8, 0x94, 0xd3, 0x27, 0x36, 0x7a, 0x96, 0x97,
-	0x93, 0x60, 0xe5, 0x1c, 0xa1, 0x60, 0x9d, 0xce, 0xb3, 0x78, 0xd9, 0x0f, 0xd3, 0x56, 0xef, 0x03,
-	0x0f, 0xf5, 0x45, 0x9d, 0x39, 0xf1, 0xae, 0xd4, 0xe0, 0x03, 0x73, 0x88, 0x80, 0xdd, 0x34, 0xcb,
-	0xa2, 0x5c, 0x52, 0x6e, 0xb4, 0xbd, 0x28, 0xc4, 0xc2, 0x03, 0x73, 0x15, 0xc6, 0x76, 0x2d, 0x8f,
-	0x6d, 0xb0, 0x6c, 0xda, 0x67, 0xfd, 0xa6, 0xa7, 0xfc, 0xfb, 0x15, 0x84, 0xcb, 0xbb, 0x44, 0xf3,
-	0xa1, 0xe8, 0x06, 0x4c, 0x99, 0x9b, 0x1e, 0x31, 0x6d, 0xbe, 0xeb, 0x46, 0x66, 0x09, 0xe0, 0xe8,
-	0x7f, 0x10, 0x75, 0x31, 0xb5, 0xdc, 0xe8, 0x24, 0x51, 0x17, 0xa3, 0x3a, 0xc4, 0x5d, 0x6c, 0xbc,
-	0x69, 0x93, 0x2d, 0x63, 0xdb, 0x22, 0x98, 0x1a, 0x6c, 0x26, 0xab, 0x8e, 0xc6, 0xf4, 0xb2, 0xa7,
-	0x2c, 0x30, 0x51, 0xc3, 0x5c, 0x29, 0x0d, 0x5c, 0x7c, 0xc7, 0x26, 0x5b, 0x15, 0x8b, 0x60, 0x2e,
-	0xe5, 0x97, 0x02, 0x8c, 0xd3, 0x17, 0x80, 0xdf, 0x69, 0x65, 0xff, 0xc9, 0x4f, 0xfc, 0xcf, 0xa2,
-	0x30, 0xcb, 0x1d, 0x50, 0x32, 0x5b, 0xa6, 0xe3, 0xa1, 0x87, 0x02, 0xc4, 0x1c, 0xdb, 0x3d, 0xf4,
-	0xa0, 0x70, 0x8c, 0x07, 0x0d, 0x5f, 0xdd, 0x83, 0x9e, 0xf2, 0xb7, 0x50, 0xe2, 0x79, 0xec, 0xd8,
-	0xc4, 0x72, 0x9a, 0x64, 0xb7, 0xdf, 0x75, 0xe8, 0x78, 0x34, 0x6b, 0x82, 0x63, 0xbb, 0x81, 0x31,
-	0x1f, 0x0a, 0x80, 0x1c, 0x73, 0x27, 0x20, 0x32, 0x9a, 0x56, 0xcb, 0xc6, 0x35, 0xbe, 0xe0, 0x4f,
-	0x1f, 0xb1, 0x4b, 0x8e, 0xbf, 0xdf, 0xb2, 0x11, 0x38, 0xe8, 0x29, 0xff, 0x38, 0x0a, 0x1e, 0xa8,
-	0x95, 0xaf, 0xda, 0xa3, 0x59, 0xa9, 0xf7, 0x7d, 0x43, 0x89, 0x8e, 0xb9, 0x13, 0x28, 0xc4, 0xc2,
-	0xef, 0x0a, 0x10, 0xaf, 0x50, 0x97, 0x71, 0xc9, 0x1e, 0x00, 0x77, 0x5d, 0x50, 0x9b, 0x70, 0x52,
-	0x6d, 0x57, 0x78, 0x6d, 0xcb, 0x03, 0xb8, 0x81, 0xb2, 0x16, 0x07, 0x4c, 0x1e, 0xae, 0x28, 0xce,
-	0x62, 0xbc, 0x9a, 0xc7, 0x81, 0xb7, 0x79, 0x31, 0xf7, 0x60, 0xf2, 0x8d, 0x36, 0x6e, 0xb5, 0x1d,
-	0x5a, 0x45, 0x3c, 0x9b, 0x1d, 0xc1, 0x09, 0x39, 0xab, 0x7a, 0xd0, 0x53, 0x44, 0x86, 0xef, 0x57,
-	0xa3, 0x71, 0x46, 0x54, 0x85, 0x19, 0xb2, 0xd5, 0xb2, 0xbc, 0x2d, 0xdc, 0xa8, 0xf1, 0x81, 0x55,
-	0x47, 0xa6, 0x5f, 0x38, 0xa4, 0x08, 0xdd, 0xd0, 0xe7, 0x45, 0x3a, 0x8c, 0x53, 0x23, 0xb3, 0xd7,
-	0xf6, 0xab, 0x23, 0xf3, 0xcf, 0xf9, 0xe8, 0x10, 0x35, 0x65, 0x3b, 0xf7, 0x83, 0x00, 0xd0, 0x77,
-	0x01, 0x3a, 0x0f, 0xcb, 0x95, 0xa2, 0xae, 0x1a, 0xc5, 0x92, 0x9e, 0x2f, 0x16, 0x8c, 0xdb, 0x85,
-	0x72, 0x49, 0x5d, 0xcf, 0x5f, 0xcb, 0xab, 0x39, 0x31, 0x22, 0xcd, 0x77, 0xba, 0xc9, 0x18, 0x4b,
-	0x54, 0x7d, 0x0a, 0x94, 0x82, 0xf9, 0x70, 0xf6, 0x5d, 0xb5, 0x2c, 0x0a, 0xd2, 0x6c, 0xa7, 0x9b,
-	0x9c, 0x61, 0x59, 0x77, 0x2d, 0x0f, 0x9d, 0x83, 0x85, 0x70, 0x4e, 0x26, 0x5b, 0xd6, 0x33, 0xf9,
-	0x82, 0x18, 0x95, 0x4e, 0x75, 0xba, 0xc9, 0x59, 0x96, 0x97, 0xe1, 0x3b, 0x2f, 0x09, 0x73, 0xe1,
-	0xdc, 0x42, 0x51, 0x1c, 0x93, 0xe2, 0x9d, 0x6e, 0x72, 0x9a, 0xa5, 0x15, 0x30, 0x5a, 0x83, 0xc4,
-	0x60, 0x86, 0x71, 0x27, 0xaf, 0xdf, 0x30, 0x2a, 0xaa, 0x5e, 0x14, 0xc7, 0xa5, 0xc5, 0x4e, 0x37,
-	0x29, 0x06, 0xb9, 0xc1, 0x82, 0x92, 0xe2, 0xef, 0x7c, 0x28, 0x47, 0x3e, 0x7a, 0x2c, 0x47, 0x3e,
-	0x7d, 0x2c, 0x47, 0xce, 0x7d, 0x1d, 0x85, 0xb9, 0xc1, 0xb7, 0x16, 0x94, 0x86, 0xbf, 0x97, 0xb4,
-	0x62, 0xa9, 0x58, 0xce, 0xdc, 0x32, 0xca, 0x7a, 0x46, 0xbf, 0x5d, 0x1e, 0x6a, 0x9c, 0xb6, 0xc4,
-	0x92, 0x0b, 0xb6, 0xff, 0xbf, 0x40, 0x79, 0x38, 0x3f, 0xa7, 0x96, 0x8a, 0xe5, 0xbc, 0x6e, 0x94,
-	0x54, 0x2d, 0x5f, 0xcc, 0x89, 0x82, 0xb4, 0xdc, 0xe9, 0x26, 0x17, 0x18, 0x64, 0xc0, 0x25, 0xe8,
-	0xbf, 0xf0, 0xcf, 0x61, 0x70, 0xa5, 0xa8, 0xe7, 0x0b, 0xd7, 0x03, 0x6c, 0x54, 0x5a, 0xea, 0x74,
-	0x93, 0x88, 0x61, 0x2b, 0xa1, 0x91, 0x46, 0xe7, 0x61, 0x69, 0x18, 0x5a, 0xca, 0x94, 0xcb, 0x6a,
-	0x4e, 0x1c, 0x93, 0xc4, 0x4e, 0x37, 0x19, 0x67, 0x98, 0x92, 0xe9, 0x79, 0x56, 0x0d, 0x5d, 0x84,
-	0xc4, 0x70, 0xb6, 0xa6, 0xde, 0x54, 0xd7, 0x75, 0x35, 0x27, 0x8e, 0x4b, 0xa8, 0xd3, 0x4d, 0xce,
-	0xb1, 0x7c, 0xcd, 0x7a, 0xcd, 0xaa, 0x12, 0xeb, 0x58, 0xfe, 0x6b, 0x99, 0xfc, 0x2d, 0x35, 0x27,
-	0x4e, 0x84, 0xf9, 0xaf, 0x99, 0x76, 0xc3, 0xaa, 0x0d, 0xca, 0x9a, 0x2d, 0xec, 0x3d, 0x97, 0x23,
-	0x4f, 0x9f, 0xcb, 0x91, 0xb7, 0xf6, 0xe5, 0xc8, 0xde, 0xbe, 0x2c, 0x3c, 0xd9, 0x97, 0x85, 0x6f,
-	0xf7, 0x65, 0xe1, 0xd1, 0x0b, 0x39, 0xf2, 0xe4, 0x85, 0x1c, 0x79, 0xfa, 0x42, 0x8e, 0xdc, 0xfb,
-	0xe5, 0x4d, 0xb7, 0x43, 0xff, 0x62, 0x40, 0x67, 0x76, 0x73, 0x92, 0x2e, 0x87, 0x4b, 0x3f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0x9e, 0x45, 0x50, 0x4c, 0x10, 0x00, 0x00,
+	// 1857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0x1b, 0xd7,
+	0xf1, 0xe7, 0x92, 0xb2, 0x7e, 0x0c, 0x29, 0x89, 0x7e, 0x92, 0x25, 0x9a, 0x4e, 0xb8, 0xf4, 0x7e,
+	0x83, 0x2f, 0x04, 0xc3, 0xa6, 0x5c, 0xe5, 0x54, 0x07, 0x68, 0x43, 0x9a, 0xeb, 0x98, 0x69, 0x24,
+	0x12, 0x4b, 0x5a, 0x82, 0xd3, 0xc3, 0x62, 0xc5, 0x7d, 0x5e, 0x6d, 0x4b, 0xee, 0x63, 0xf6, 0x3d,
+	0xca, 0x12, 0x72, 0x49, 0x0f, 0x05, 0x0a, 0x02, 0x09, 0xdc, 0x5b, 0x2f, 0x04, 0x8a, 0x38, 0x40,
+	0x8b, 0x9c, 0x72, 0x68, 0xff, 0x07, 0x37, 0xa7, 0xa0, 0xe8, 0x21, 0xe8, 0x81, 0x6e, 0x64, 0x14,
+	0x68, 0x7d, 0xe8, 0xc1, 0xc7, 0x5e, 0x5a, 0xec, 0xbe, 0xb7, 0xe4, 0xf2, 0x47, 0x2a, 0x51, 0x49,
+	0xda, 0xb4, 0x07, 0x03, 0xda, 0x79, 0x33, 0x9f, 0x99, 0xf9, 0xbc, 0xd9, 0x99, 0x59, 0x1a, 0x56,
+	0xeb, 0x84, 0x36, 0x09, 0xdd, 0xb4, 0xc8, 0xa1, 0xf7, 0x2f, 0xd7, 0x72, 0x09, 0x23, 0x08, 0xb8,
+	0x34, 0x67, 0x91, 0xc3, 0xf4, 0x8a, 0xd0, 0x10, 0x22, 0x5f, 0x21, 0xbd, 0x6a, 0x11, 0x8b, 0xf8,
+	0x7f, 0x6e, 0x7a, 0x7f, 0x09, 0xe9, 0x65, 0xae, 0xa3, 0xf3, 0x83, 0x21, 0x03, 0xd9, 0x22, 0xc4,
+	0x6a, 0xe0, 0x4d, 0xff, 0x69, 0xbf, 0xfd, 0x60, 0x93, 0xd9, 0x4d, 0x4c, 0x99, 0xd1, 0x6c, 0x05,
+	0xb6, 0xa3, 0x0a, 0x86, 0x73, 0x2c, 0x8e, 0x32, 0xa3, 0x47, 0x66, 0xdb, 0x35, 0x98, 0x4d, 0x1c,
+	0x7e, 0xae, 0xfc, 0x39, 0x0a, 0x17, 0xb7, 0xa9, 0x55, 0x6d, 0xef, 0x37, 0x6d, 0x56, 0x71, 0x49,
+	0x8b, 0x50, 0xa3, 0x81, 0x5e, 0x83, 0xb9, 0x3a, 0x71, 0x18, 0x76, 0x58, 0x4a, 0xca, 0x4a, 0x1b,
+	0xf1, 0xad, 0xd5, 0x1c, 0xc7, 0xc9, 0x05, 0x38, 0xb9, 0xbc, 0x73, 0x5c, 0x88, 0x7f, 0xfa, 0x9b,
+	0x1b, 0x73, 0xb7, 0xb9, 0xa2, 0x16, 0x58, 0xa0, 0x9f, 0x48, 0xb0, 0x6c, 0x3b, 0x36, 0xb3, 0x8d,
+	0x86, 0x6e, 0xe2, 0x16, 0xa1, 0x36, 0x4b, 0x45, 0xb3, 0xb1, 0x8d, 0xf8, 0x56, 0x22, 0x27, 0xf2,
+	0xba, 0x4d, 0x6c, 0xa7, 0xf0, 0xe6, 0x93, 0x9e, 0x1c, 0x79, 0xd1, 0x93, 0xd7, 0x8e, 0x8d, 0x66,
+	0xe3, 0x96, 0x32, 0x62, 0xa2, 0x7c, 0xfc, 0x54, 0xde, 0xb0, 0x6c, 0x76, 0xd0, 0xde, 0xcf, 0xd5,
+	0x49, 0x73, 0x73, 0x88, 0xc9, 0x1b, 0xd4, 0xfc, 0xf1, 0x26, 0x3b, 0x6e, 0x61, 0x0e, 0x45, 0xb5,
+	0x25, 0x61, 0x5d, 0xe4, 0xc6, 0x68, 0x1b, 0xe6, 0x5b, 0x7e, 0x32, 0xd8, 0x4d, 0xc5, 0xb2, 0xd2,
+	0x46, 0xa2, 0xf0, 0x9d, 0xbf, 0xf7, 0xe4, 0x1b, 0x67, 0xc0, 0xcb, 0xd7, 0xeb, 0x79, 0xd3, 0x74,
+	0x31, 0xa5, 0x5a, 0x1f, 0x02, 0xdd, 0x82, 0x84, 0x4d, 0x75, 0x7c, 0xd4, 0xc2, 0xa6, 0xcd, 0xb0,
+	0x99, 0x9a, 0xc9, 0x4a, 0x1b, 0xf3, 0x85, 0xf5, 0x17, 0x3d, 0x79, 0x45, 0x04, 0x1f, 0x3a, 0x55,
+	0xb4, 0xb8, 0x4d, 0xd5, 0xe0, 0xe9, 0xd6, 0xcc, 0x5f, 0x7e, 0x29, 0x4b, 0x4a, 0x4f, 0x82, 0xb9,
+	0x6d, 0x6a, 0xed, 0x12, 0x86, 0x51, 0x0d, 0xe2, 0x2d, 0xc1, 0xb4, 0x6e, 0x9b, 0x3e, 0xc3, 0x33,
+	0x85, 0x57, 0x4f, 0x7a, 0x32, 0x04, 0x17, 0x50, 0x2a, 0x3e, 0xef, 0xc9, 0x61, 0xa5, 0x17, 0x3d,
+	0x19, 0x71, 0x4f, 0x21, 0xa1, 0xa2, 0x41, 0xf0, 0x54, 0x32, 0xd1, 0x1b, 0x70, 0xe1, 0x90, 0x30,
+	0xec, 0xa6, 0xa2, 0xe7, 0xcd, 0x97, 0xdb, 0xa3, 0x1c, 0xcc, 0x92, 0x96, 0x57, 0x22, 0x3e, 0x73,
+	0x4b, 0x5b, 0x6b, 0xb9, 0x41, 0x45, 0xe7, 0xbc, 0x04, 0xca, 0xfe, 0xa9, 0x26, 0xb4, 0x44, 0x82,
+	0xef, 0x47, 0x61, 0x59, 0x24, 0xb8, 0x87, 0x6d, 0xeb, 0x80, 0x61, 0xf3, 0xdb, 0x9e, 0xe8, 0x3d,
+	0x98, 0xe3, 0x29, 0xd0, 0x54, 0xcc, 0xaf, 0xcf, 0x4c, 0x38, 0xd3, 0x20, 0x8b, 0x41, 0xc6, 0x85,
+	0x2b, 0x5e, 0xc5, 0x7e, 0xfc, 0x54, 0x5e, 0x19, 0x3f, 0xa3, 0x5a, 0x80, 0x25, 0xf8, 0xf8, 0x79,
+	0x14, 0x60, 0x9b, 0x5a, 0x41, 0x41, 0x7e, 0x33, 0x54, 0x94, 0x61, 0x41, 0xbc, 0x2e, 0xe4, 0x2b,
+	0xd0, 0x31, 0xc0, 0x40, 0xbb, 0x30, 0x6b, 0x34, 0x49, 0xdb, 0x61, 0xa9, 0xd8, 0x84, 0x37, 0xf6,
+	0xa6, 0xc8, 0xff, 0xec, 0xef, 0xa5, 0x40, 0x13, 0x9c, 0x3c, 0x92, 0xe0, 0xf2, 0x36, 0xb5, 0x34,
+	0x6c, 0xd9, 0x94, 0x61, 0x57, 0xc3, 0x2d, 0x17, 0x53, 0xec, 0x30, 0x83, 0xd9, 0x87, 0x18, 0xfd,
+	0x00, 0xe6, 0x0c, 0x1e, 0x51, 0x4a, 0x3a, 0x6f, 0x2a, 0x01, 0x02, 0xca, 0x42, 0xdc, 0xc4, 0xb4,
+	0xee, 0xda, 0xbc, 0x92, 0x3d, 0x6e, 0x16, 0xb4, 0xb0, 0x48, 0x84, 0xd4, 0x82, 0x2b, 0xdb, 0xd4,
+	0xba, 0xe7, 0xb8, 0xdf, 0x7c, 0x4c, 0xc2, 0xe3, 0xa7, 0x12, 0xac, 0xf9, 0x85, 0xd1, 0xc0, 0x96,
+	0xc1, 0xf0, 0x2e, 0x61, 0xb6, 0x63, 0x55, 0xc8, 0x43, 0xec, 0xf2, 0xeb, 0xf4, 0xc5, 0xc4, 0x3d,
+	0xbf, 0xbf, 0x01, 0x06, 0xba, 0x0f, 0x4b, 0xee, 0x50, 0x42, 0xe7, 0x2f, 0x92, 0x11, 0x20, 0x91,
+	0xcc, 0x3b, 0x90, 0xf2, 0xe9, 0x33, 0xff, 0x0d, 0xd9, 0x08, 0x97, 0x7b, 0x90, 0xa8, 0xe1, 0xa3,
+	0xc1, 0xac, 0x5a, 0x85, 0x0b, 0xcc, 0x66, 0x0d, 0xec, 0xbb, 0x58, 0xd0, 0xf8, 0xc3, 0x19, 0xee,
+	0x7f, 0xd9, 0x43, 0xfb, 0xfd, 0x60, 0x80, 0x29, 0xef, 0x4b, 0x90, 0x54, 0x8f, 0x70, 0x7d, 0x9b,
+	0x5a, 0xf4, 0xab, 0xa2, 0xa3, 0x9b, 0x30, 0xdf, 0xc4, 0x94, 0x1a, 0x16, 0x0e, 0x9a, 0xcb, 0xc4,
+	0x11, 0xaa, 0xf5, 0xb5, 0xc6, 0xe3, 0xf9, 0x87, 0x04, 0x73, 0x41, 0xfb, 0x50, 0x27, 0xb5, 0x8f,
+	0x57, 0x86, 0xdb, 0xc7, 0xff, 0x5e, 0xbf, 0xf8, 0x70, 0x0e, 0xe6, 0xfb, 0x37, 0x51, 0x98, 0x44,
+	0xc1, 0xd5, 0xb1, 0x0e, 0x1a, 0xf5, 0x1b, 0xe7, 0x82, 0x18, 0xcb, 0x23, 0xf9, 0x87, 0xf6, 0x9a,
+	0xe8, 0xd4, 0x7b, 0xcd, 0x0e, 0xcc, 0x52, 0x66, 0xb0, 0x36, 0x15, 0x73, 0x31, 0x1d, 0x9e, 0x16,
+	0x41, 0x0c, 0x55, 0x5f, 0xa3, 0x90, 0x1e, 0xec, 0x35, 0xfd, 0xa0, 0xb9, 0xb1, 0xa2, 0x09, 0x14,
+	0x74, 0x00, 0xe8, 0x81, 0xed, 0x18, 0x0d, 0x9d, 0x19, 0x8d, 0xc6, 0xb1, 0xee, 0x62, 0xda, 0x6e,
+	0x30, 0x7f, 0xb5, 0x88, 0x6f, 0xad, 0x87, 0xb1, 0x6b, 0xde, 0xb9, 0xe6, 0x1f, 0x17, 0xae, 0x8a,
+	0xa5, 0xe9, 0x32, 0x07, 0x1f, 0x07, 0x50, 0xb4, 0xa4, 0x2f, 0x0c, 0x19, 0xa1, 0x1f, 0x42, 0x9c,
+	0xfa, 0x0b, 0x9e, 0xee, 0x6d, 0x8e, 0xa9, 0x0b, 0xbe, 0x8b, 0xf4, 0x58, 0xea, 0xb5, 0x60, 0xad,
+	0x2c, 0x64, 0x84, 0x17, 0x51, 0x4f, 0x21, 0x63, 0xe5, 0xd1, 0x53, 0x59, 0xd2, 0x80, 0x4b, 0x3c,
+	0x03, 0x64, 0x43, 0x52, 0xd4, 0x83, 0x8e, 0x1d, 0x93, 0x7b, 0x98, 0x3d, 0xd5, 0xc3, 0xff, 0x09,
+	0x0f, 0xeb, 0xdc, 0xc3, 0x28, 0x02, 0x77, 0xb3, 0x24, 0xc4, 0xaa, 0x63, 0xfa, 0xae, 0xde, 0x85,
+	0x45, 0x46, 0x58, 0x68, 0xad, 0x9c, 0x9b, 0x50, 0x74, 0x77, 0x05, 0xf2, 0x2a, 0x47, 0x1e, 0x32,
+	0x98, 0x6e, 0xa9, 0x4c, 0xf8, 0xb6, 0xc1, 0x2b, 0xd8, 0x80, 0x8b, 0x87, 0x7e, 0x77, 0xf3, 0x2e,
+	0xd2, 0x15, 0x54, 0xce, 0x9f, 0x9a, 0xe8, 0x2b, 0x22, 0x9c, 0x14, 0x0f, 0x67, 0x0c, 0x82, 0x67,
+	0xba, 0xcc, 0xe5, 0x55, 0x4f, 0xec, 0xa7, 0xfa, 0x00, 0x84, 0x68, 0x40, 0xea, 0xc2, 0xa9, 0xbe,
+	0x94, 0xe1, 0x8d, 0x7a, 0x04, 0x80, 0x7b, 0x5a, 0xe4, 0xd2, 0x80, 0xd2, 0xd1, 0xcd, 0x16, 0xa6,
+	0xde, 0x6c, 0x9f, 0x44, 0x21, 0x1e, 0x2e, 0xb6, 0xd7, 0x21, 0x76, 0x8c, 0xf9, 0xb8, 0x5c, 0x28,
+	0xe4, 0xbc, 0x88, 0xfe, 0xd8, 0x93, 0xff, 0xff, 0x0c, 0xa4, 0x97, 0x1c, 0xa6, 0x79, 0xa6, 0xe8,
+	0x2e, 0xcc, 0x19, 0xfb, 0x94, 0x19, 0xb6, 0xe8, 0xac, 0x53, 0xa3, 0x04, 0xe6, 0xe8, 0x7b, 0x10,
+	0x75, 0x48, 0x2a, 0x76, 0x2e, 0x90, 0xa8, 0x43, 0x90, 0x05, 0x09, 0x87, 0xe8, 0x0f, 0x6d, 0x76,
+	0xa0, 0x1f, 0x62, 0x46, 0xfc, 0x97, 0x73, 0xa1, 0xa0, 0x4e, 0x87, 0x34, 0xe0, 0x32, 0x8c, 0xa5,
+	0x68, 0xe0, 0x90, 0x3d, 0x9b, 0x1d, 0xec, 0x62, 0x46, 0x04, 0x95, 0x8f, 0xa3, 0x30, 0xe3, 0x7f,
+	0x21, 0x7c, 0x4d, 0xed, 0xfe, 0x3f, 0xf5, 0x49, 0x10, 0xde, 0xac, 0x67, 0xbe, 0xf6, 0xcd, 0xfa,
+	0xb1, 0x04, 0x68, 0x5c, 0x2d, 0x14, 0xa3, 0x74, 0xa6, 0x18, 0xf7, 0x60, 0xf6, 0xa1, 0x8f, 0x22,
+	0x8a, 0xec, 0xfb, 0x53, 0xdc, 0x6a, 0x11, 0xd7, 0x5f, 0xf4, 0xe4, 0x45, 0x4e, 0x3f, 0x47, 0x51,
+	0x34, 0x01, 0x27, 0xa2, 0xfc, 0x6b, 0x0c, 0x16, 0x45, 0xeb, 0xa8, 0x18, 0xae, 0xd1, 0xa4, 0xe8,
+	0x03, 0x09, 0xe2, 0x4d, 0xdb, 0xe9, 0x37, 0x2f, 0x69, 0x42, 0xf3, 0xd2, 0xbd, 0x20, 0x9e, 0xf7,
+	0xe4, 0x4b, 0x21, 0xc5, 0xeb, 0xa4, 0x69, 0x33, 0xdc, 0x6c, 0xb1, 0xe3, 0xc1, 0x95, 0x87, 0x8e,
+	0xa7, 0xeb, 0x69, 0xd0, 0xb4, 0x9d, 0xa0, 0xa3, 0x7d, 0x20, 0x01, 0x6a, 0x1a, 0x47, 0x01, 0x90,
+	0xde, 0xc2, 0xae, 0x4d, 0x4c, 0x31, 0x19, 0x2f, 0x8f, 0xf5, 0x99, 0xa2, 0xf8, 0xe5, 0x80, 0xd7,
+	0xff, 0xf3, 0x9e, 0xfc, 0xd2, 0xb8, 0xf1, 0x50, 0xac, 0x62, 0x46, 0x8d, 0x6b, 0x29, 0xbf, 0xf0,
+	0x3a, 0x51, 0xb2, 0x69, 0x1c, 0x05, 0x0c, 0xf9, 0x62, 0xf4, 0x5b, 0x09, 0xfc, 0xc4, 0xfb, 0x0d,
+	0xa7, 0xcf, 0xd5, 0xa4, 0xed, 0x82, 0x8a, 0x30, 0xe4, 0x89, 0x26, 0x43, 0x91, 0xbc, 0x34, 0x60,
+	0x6d, 0x4c, 0x71, 0x3a, 0xfe, 0x56, 0x9a, 0xb6, 0xd3, 0xef, 0x80, 0x22, 0x7a, 0xe5, 0x93, 0x28,
+	0x24, 0xc4, 0xe6, 0xcb, 0xaf, 0xfa, 0x5d, 0x10, 0x6d, 0x36, 0xe0, 0x54, 0x3a, 0x8d, 0xd3, 0xd7,
+	0x44, 0x32, 0xeb, 0x43, 0x76, 0x43, 0x49, 0xac, 0x0e, 0x75, 0xf5, 0x30, 0x93, 0x09, 0x2e, 0x13,
+	0x2c, 0x7e, 0x28, 0xc1, 0xfa, 0x20, 0xcb, 0xe1, 0x38, 0x4e, 0xbd, 0xdb, 0xb2, 0x88, 0xe3, 0xea,
+	0x97, 0x20, 0x0c, 0x45, 0x94, 0xe1, 0x11, 0x7d, 0x89, 0x2a, 0x8f, 0xed, 0x52, 0xff, 0x74, 0x37,
+	0x14, 0xa4, 0xf2, 0xab, 0x98, 0x98, 0x1a, 0x82, 0xb1, 0xb7, 0x61, 0xf6, 0x9d, 0x36, 0x71, 0xdb,
+	0x4d, 0xf1, 0xa5, 0x50, 0x98, 0xee, 0x6d, 0x7c, 0xde, 0x93, 0x93, 0xdc, 0x7e, 0x10, 0xa0, 0x26,
+	0x10, 0x51, 0x1d, 0x16, 0xd8, 0x81, 0x8b, 0xe9, 0x01, 0x69, 0x98, 0xa2, 0x15, 0xaa, 0x53, 0xc3,
+	0xaf, 0xf4, 0x21, 0x42, 0x1e, 0x06, 0xb8, 0xa8, 0x06, 0x33, 0xfe, 0x88, 0xe0, 0xbf, 0x36, 0xbd,
+	0x3e, 0x35, 0xfe, 0x92, 0x67, 0x1d, 0x82, 0xf6, 0xd1, 0xd0, 0x7b, 0x12, 0xac, 0x0c, 0xe8, 0x1d,
+	0x64, 0x31, 0xe3, 0x7b, 0x29, 0x4f, 0xed, 0xe5, 0xe5, 0x09, 0x60, 0x21, 0xa7, 0xa8, 0x7f, 0x5c,
+	0x0b, 0x4e, 0x95, 0x9f, 0x4a, 0xb0, 0xf4, 0x6d, 0xf8, 0x52, 0xff, 0x9d, 0x04, 0x97, 0x42, 0x9f,
+	0x97, 0xe2, 0xfb, 0xd9, 0xeb, 0xe4, 0xff, 0x75, 0x9f, 0xcd, 0xd7, 0xfe, 0x26, 0x01, 0x84, 0x46,
+	0xd7, 0x75, 0x58, 0xdf, 0x2d, 0xd7, 0x54, 0xbd, 0x5c, 0xa9, 0x95, 0xca, 0x3b, 0xfa, 0xbd, 0x9d,
+	0x6a, 0x45, 0xbd, 0x5d, 0xba, 0x53, 0x52, 0x8b, 0xc9, 0x48, 0x7a, 0xb9, 0xd3, 0xcd, 0xc6, 0xb9,
+	0xa2, 0xea, 0x5d, 0x12, 0x52, 0x60, 0x39, 0xac, 0x7d, 0x5f, 0xad, 0x26, 0xa5, 0xf4, 0x62, 0xa7,
+	0x9b, 0x5d, 0xe0, 0x5a, 0xf7, 0x31, 0x45, 0xd7, 0x60, 0x25, 0xac, 0x93, 0x2f, 0x54, 0x6b, 0xf9,
+	0xd2, 0x4e, 0x32, 0x9a, 0xbe, 0xd8, 0xe9, 0x66, 0x17, 0xb9, 0x5e, 0x5e, 0x2c, 0x49, 0x59, 0x58,
+	0x0a, 0xeb, 0xee, 0x94, 0x93, 0xb1, 0x74, 0xa2, 0xd3, 0xcd, 0xce, 0x73, 0xb5, 0x1d, 0x82, 0xb6,
+	0x20, 0x35, 0xac, 0xa1, 0xef, 0x95, 0x6a, 0x77, 0xf5, 0x5d, 0xb5, 0x56, 0x4e, 0xce, 0xa4, 0x57,
+	0x3b, 0xdd, 0x6c, 0x32, 0xd0, 0x0d, 0x36, 0x9a, 0x74, 0xe2, 0x67, 0x8f, 0x33, 0x91, 0x5f, 0x7f,
+	0x94, 0x89, 0x7c, 0xf2, 0x51, 0x26, 0x72, 0xed, 0x0f, 0x51, 0x58, 0x1a, 0xfe, 0x44, 0x42, 0x39,
+	0xb8, 0x52, 0xd1, 0xca, 0x95, 0x72, 0x35, 0xff, 0x96, 0x5e, 0xad, 0xe5, 0x6b, 0xf7, 0xaa, 0x23,
+	0x89, 0xfb, 0x29, 0x71, 0xe5, 0x1d, 0xdb, 0xfb, 0x4d, 0x3a, 0x33, 0xaa, 0x5f, 0x54, 0x2b, 0xe5,
+	0x6a, 0xa9, 0xa6, 0x57, 0x54, 0xad, 0x54, 0x2e, 0x26, 0xa5, 0xf4, 0x7a, 0xa7, 0x9b, 0x5d, 0xe1,
+	0x26, 0xc3, 0x93, 0xe5, 0xbb, 0xf0, 0xf2, 0xa8, 0xf1, 0x6e, 0xb9, 0x56, 0xda, 0x79, 0x23, 0xb0,
+	0x8d, 0xa6, 0xd7, 0x3a, 0xdd, 0x2c, 0xe2, 0xb6, 0xe1, 0x4e, 0x85, 0xae, 0xc3, 0xda, 0xa8, 0x69,
+	0x25, 0x5f, 0xad, 0xaa, 0xc5, 0x64, 0x2c, 0x9d, 0xec, 0x74, 0xb3, 0x09, 0x6e, 0x53, 0x31, 0x28,
+	0xc5, 0x26, 0xba, 0x09, 0xa9, 0x51, 0x6d, 0x4d, 0x7d, 0x53, 0xbd, 0x5d, 0x53, 0x8b, 0xc9, 0x99,
+	0x34, 0xea, 0x74, 0xb3, 0x4b, 0x5c, 0x5f, 0xc3, 0x3f, 0xc2, 0x75, 0x86, 0x27, 0xe2, 0xdf, 0xc9,
+	0x97, 0xde, 0x52, 0x8b, 0xc9, 0x0b, 0x61, 0xfc, 0x3b, 0x86, 0xdd, 0xc0, 0xe6, 0x30, 0xad, 0x85,
+	0x9d, 0x27, 0x5f, 0x64, 0x22, 0x9f, 0x7f, 0x91, 0x89, 0xbc, 0x77, 0x92, 0x89, 0x3c, 0x39, 0xc9,
+	0x48, 0x9f, 0x9d, 0x64, 0xa4, 0x3f, 0x9d, 0x64, 0xa4, 0x47, 0xcf, 0x32, 0x91, 0xcf, 0x9e, 0x65,
+	0x22, 0x9f, 0x3f, 0xcb, 0x44, 0xde, 0xfe, 0xd7, 0xd3, 0xed, 0xc8, 0xff, 0xff, 0x0b, 0xbf, 0x70,
+	0xf7, 0x67, 0xfd, 0x81, 0xf0, 0xea, 0x3f, 0x07, 0x00, 0xb2, 0xe6, 0x0a, 0xd5, 0xda, 0x18, 0x00,
+	0x00,
 }
 
 func (this *MsgSubmitProposal) Equal(that interface{}) bool {
@@ -697,6 +1098,9 @@ func (this *MsgSubmitProposal) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Proposer, that1.Proposer) {
 		return false
 	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *MsgVote) Equal(that interface{}) bool {
@@ -729,14 +1133,14 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgDeposit) Equal(that interface{}) bool {
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgDeposit)
+	that1, ok := that.(*MsgVoteWeighted)
 	if !ok {
-		that2, ok := that.(MsgDeposit)
+		that2, ok := that.(MsgVoteWeighted)
 		if ok {
 			that1 = &that2
 		} else {
@@ -751,27 +1155,27 @@ func (this *MsgDeposit) Equal(that interface{}) bool {
 	if this.ProposalID != that1.ProposalID {
 		return false
 	}
-	if !bytes.Equal(this.Depositor, that1.Depositor) {
+	if !bytes.Equal(this.Voter, that1.Voter) {
 		return false
 	}
-	if len(this.Amount) != len(that1.Amount) {
+	if len(this.Options) != len(that1.Options) {
 		return false
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *TextProposal) Equal(that interface{}) bool {
+func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TextProposal)
+	that1, ok := that.(*MsgDeposit)
 	if !ok {
-		that2, ok := that.(TextProposal)
+		that2, ok := that.(MsgDeposit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -783,22 +1187,30 @@ func (this *TextProposal) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Title != that1.Title {
+	if this.ProposalID != that1.ProposalID {
 		return false
 	}
-	if this.Description != that1.Description {
+	if !bytes.Equal(this.Depositor, that1.Depositor) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
 		return false
 	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *Deposit) Equal(that interface{}) bool {
+func (this *MsgRegisterRepresentative) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Deposit)
+	that1, ok := that.(*MsgRegisterRepresentative)
 	if !ok {
-		that2, ok := that.(Deposit)
+		that2, ok := that.(MsgRegisterRepresentative)
 		if ok {
 			that1 = &that2
 		} else {
@@ -810,30 +1222,22 @@ func (this *Deposit) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ProposalID != that1.ProposalID {
-		return false
-	}
-	if !bytes.Equal(this.Depositor, that1.Depositor) {
+	if !bytes.Equal(this.Address, that1.Address) {
 		return false
 	}
-	if len(this.Amount) != len(that1.Amount) {
+	if this.Description != that1.Description {
 		return false
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *Proposal) Equal(that interface{}) bool {
+func (this *MsgUnregisterRepresentative) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Proposal)
+	that1, ok := that.(*MsgUnregisterRepresentative)
 	if !ok {
-		that2, ok := that.(Proposal)
+		that2, ok := that.(MsgUnregisterRepresentative)
 		if ok {
 			that1 = &that2
 		} else {
@@ -845,7 +1249,179 @@ func (this *Proposal) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ProposalID != that1.ProposalID {
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	return true
+}
+func (this *MsgDelegateVotingPower) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDelegateVotingPower)
+	if !ok {
+		that2, ok := that.(MsgDelegateVotingPower)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Delegator, that1.Delegator) {
+		return false
+	}
+	if !bytes.Equal(this.Representative, that1.Representative) {
+		return false
+	}
+	return true
+}
+func (this *MsgUndelegateVotingPower) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUndelegateVotingPower)
+	if !ok {
+		that2, ok := that.(MsgUndelegateVotingPower)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Delegator, that1.Delegator) {
+		return false
+	}
+	return true
+}
+func (this *TextProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TextProposal)
+	if !ok {
+		that2, ok := that.(TextProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *ExecMsgsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecMsgsProposal)
+	if !ok {
+		that2, ok := that.(ExecMsgsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Deposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Deposit)
+	if !ok {
+		that2, ok := that.(Deposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Depositor, that1.Depositor) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Proposal)
+	if !ok {
+		that2, ok := that.(Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
 		return false
 	}
 	if !this.Content.Equal(that1.Content) {
@@ -877,6 +1453,9 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -940,6 +1519,95 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *Representative) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Representative)
+	if !ok {
+		that2, ok := that.(Representative)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *VotingPowerDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VotingPowerDelegation)
+	if !ok {
+		that2, ok := that.(VotingPowerDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Delegator, that1.Delegator) {
+		return false
+	}
+	if !bytes.Equal(this.Representative, that1.Representative) {
+		return false
+	}
 	return true
 }
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
@@ -962,6 +1630,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -1038,7 +1716,7 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1048,20 +1726,20 @@ func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1072,10 +1750,10 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1087,7 +1765,7 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1097,16 +1775,250 @@ func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRepresentative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRepresentative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRepresentative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterRepresentative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterRepresentative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterRepresentative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecMsgsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecMsgsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecMsgsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -1193,6 +2105,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
@@ -1357,6 +2279,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1377,6 +2313,44 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1397,6 +2371,20 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinExpeditedDeposit) > 0 {
+		for iNdEx := len(m.MinExpeditedDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinExpeditedDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
 	if err8 != nil {
 		return 0, err8
@@ -1442,13 +2430,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err9 != nil {
 		return 0, err9
 	}
 	i -= n9
 	i = encodeVarintGov(dAtA, i, uint64(n9))
 	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1473,6 +2469,16 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.Veto.Size()
 		i -= size
@@ -1506,6 +2512,80 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Representative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Representative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Representative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1537,6 +2617,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1559,6 +2642,28 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1581,6 +2686,66 @@ func (m *MsgDeposit) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterRepresentative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterRepresentative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1598,6 +2763,29 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *ExecMsgsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1652,6 +2840,9 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1688,6 +2879,26 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1705,6 +2916,12 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.MinExpeditedDeposit) > 0 {
+		for _, e := range m.MinExpeditedDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1716,6 +2933,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1731,6 +2950,42 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.Veto.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Representative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingPowerDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1873,6 +3128,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2022,7 +3297,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2045,10 +3320,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2072,7 +3347,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2099,14 +3374,14 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2133,8 +3408,8 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2162,7 +3437,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,17 +3460,17 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var stringLen uint64
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2205,29 +3480,50 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2237,23 +3533,25 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2279,7 +3577,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterRepresentative) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,17 +3600,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterRepresentative: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterRepresentative: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.ProposalID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2322,16 +3620,31 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2341,31 +3654,82 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterRepresentative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterRepresentative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterRepresentative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2375,24 +3739,24 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2419,7 +3783,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2442,17 +3806,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.ProposalID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2462,16 +3826,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2481,33 +3860,84 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types.Any{}
+			m.Representative = append(m.Representative[:0], dAtA[iNdEx:postIndex]...)
+			if m.Representative == nil {
+				m.Representative = []byte{}
 			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.Status = 0
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2517,16 +3947,906 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			var msglen int
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecMsgsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecMsgsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecMsgsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2536,30 +4856,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2569,30 +4890,84 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2602,30 +4977,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2635,31 +4996,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var msglen int
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2669,28 +5030,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2717,7 +5064,8 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2745,7 +5093,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2768,15 +5116,34 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2804,15 +5171,68 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2822,31 +5242,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2856,31 +5276,30 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExpeditedDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2890,23 +5309,23 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinExpeditedDeposit = append(m.MinExpeditedDeposit, types1.Coin{})
+			if err := m.MinExpeditedDeposit[len(m.MinExpeditedDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2934,7 +5353,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,17 +5376,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			m.ProposalID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2977,16 +5396,30 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2996,45 +5429,25 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3059,7 +5472,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3082,17 +5495,17 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3102,31 +5515,30 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3136,22 +5548,88 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Veto", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Veto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3179,7 +5657,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
+func (m *Representative) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,17 +5680,17 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Representative: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Representative: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3222,24 +5700,57 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +5776,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,15 +5799,15 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3323,46 +5834,14 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Veto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3389,8 +5868,9 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Veto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Representative = append(m.Representative[:0], dAtA[iNdEx:postIndex]...)
+			if m.Representative == nil {
+				m.Representative = []byte{}
 			}
 			iNdEx = postIndex
 		default:
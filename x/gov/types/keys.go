@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -36,6 +37,12 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30<representativeAddrLen (1 Byte)><representativeAddr_Bytes>: Representative
+//
+// - 0x31<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: VotingPowerDelegation
+//
+// - 0x32<representativeAddrLen (1 Byte)><representativeAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -45,6 +52,10 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	RepresentativesKeyPrefix                        = []byte{0x30}
+	VotingPowerDelegationsKeyPrefix                 = []byte{0x31}
+	VotingPowerDelegationsByRepresentativeKeyPrefix = []byte{0x32}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -106,6 +117,29 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// RepresentativeKey key of a specific representative from the store
+func RepresentativeKey(representativeAddr sdk.AccAddress) []byte {
+	return append(RepresentativesKeyPrefix, address.MustLengthPrefix(representativeAddr.Bytes())...)
+}
+
+// VotingPowerDelegationKey key of the voting power delegation of a delegator
+// from the store
+func VotingPowerDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(VotingPowerDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// VotingPowerDelegationsByRepresentativeKey gets the first part of the voting
+// power delegations index key based on the representative address
+func VotingPowerDelegationsByRepresentativeKey(representativeAddr sdk.AccAddress) []byte {
+	return append(VotingPowerDelegationsByRepresentativeKeyPrefix, address.MustLengthPrefix(representativeAddr.Bytes())...)
+}
+
+// VotingPowerDelegationByRepresentativeKey key of a specific voting power
+// delegation in the index by representative
+func VotingPowerDelegationByRepresentativeKey(representativeAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(VotingPowerDelegationsByRepresentativeKey(representativeAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -137,6 +171,18 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitKeyVotingPowerDelegationByRepresentative split the voting power
+// delegations index key and returns the representative and delegator addresses
+func SplitKeyVotingPowerDelegationByRepresentative(key []byte) (representativeAddr, delegatorAddr sdk.AccAddress) {
+	rep, rest := address.ParseLengthPrefixed(key[1:]) // remove prefix bytes
+	delegator, rest := address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
+		panic("unexpected key length")
+	}
+
+	return sdk.AccAddress(rep), sdk.AccAddress(delegator)
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
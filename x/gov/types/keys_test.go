@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -69,3 +70,21 @@ func TestVoteKeys(t *testing.T) {
 	key = VoteKey(5, addr2)
 	require.Panics(t, func() { SplitKeyVote(key) })
 }
+
+func TestVotingPowerDelegationKeys(t *testing.T) {
+	// addresses of any length are supported
+	rep, delegator := sdk.AccAddress("rep"), addr
+	key := VotingPowerDelegationByRepresentativeKey(rep, delegator)
+	repAddr, delegatorAddr := SplitKeyVotingPowerDelegationByRepresentative(key)
+	require.Equal(t, rep, repAddr)
+	require.Equal(t, delegator, delegatorAddr)
+
+	// the keys of a representative don't prefix the keys of a longer address
+	// starting with the same bytes
+	longerRep := sdk.AccAddress("representative")
+	require.False(t, bytes.HasPrefix(VotingPowerDelegationByRepresentativeKey(longerRep, delegator), VotingPowerDelegationsByRepresentativeKey(rep)))
+	require.False(t, bytes.HasPrefix(RepresentativeKey(longerRep), RepresentativeKey(rep)))
+
+	// invalid key
+	require.Panics(t, func() { SplitKeyVotingPowerDelegationByRepresentative(append(key, 0x00)) })
+}
@@ -16,13 +16,20 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+
+	TypeMsgRegisterRepresentative   = "register_representative"
+	TypeMsgUnregisterRepresentative = "unregister_representative"
+	TypeMsgDelegateVotingPower      = "delegate_voting_power"
+	TypeMsgUndelegateVotingPower    = "undelegate_voting_power"
 )
 
 var (
-	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
-	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
-	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_, _, _, _ sdk.Msg                       = &MsgRegisterRepresentative{}, &MsgUnregisterRepresentative{}, &MsgDelegateVotingPower{}, &MsgUndelegateVotingPower{}
+	_          MsgSubmitProposalI            = &MsgSubmitProposal{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
@@ -39,13 +46,17 @@ type MsgSubmitProposalI interface {
 
 	GetProposer() sdk.AccAddress
 	SetProposer(sdk.AccAddress)
+
+	GetIsExpedited() bool
+	SetIsExpedited(bool)
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
-func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) (*MsgSubmitProposal, error) {
+func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, isExpedited bool) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
+		IsExpedited:    isExpedited,
 	}
 	err := m.SetContent(content)
 	if err != nil {
@@ -58,6 +69,8 @@ func (m *MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return m.InitialDepo
 
 func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress { return m.Proposer }
 
+func (m *MsgSubmitProposal) GetIsExpedited() bool { return m.IsExpedited }
+
 func (m *MsgSubmitProposal) GetContent() Content {
 	content, ok := m.Content.GetCachedValue().(Content)
 	if !ok {
@@ -74,6 +87,10 @@ func (m *MsgSubmitProposal) SetProposer(address sdk.AccAddress) {
 	m.Proposer = address
 }
 
+func (m *MsgSubmitProposal) SetIsExpedited(isExpedited bool) {
+	m.IsExpedited = isExpedited
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -224,3 +241,202 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote split across several
+// options on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// NewMsgRegisterRepresentative creates a message to register an account as a
+// governance representative
+func NewMsgRegisterRepresentative(address sdk.AccAddress, description string) *MsgRegisterRepresentative {
+	return &MsgRegisterRepresentative{address, description}
+}
+
+// Route implements Msg
+func (msg MsgRegisterRepresentative) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRegisterRepresentative) Type() string { return TypeMsgRegisterRepresentative }
+
+// ValidateBasic implements Msg
+func (msg MsgRegisterRepresentative) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description too long; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgRegisterRepresentative) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRegisterRepresentative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRegisterRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// NewMsgUnregisterRepresentative creates a message to unregister a governance
+// representative
+func NewMsgUnregisterRepresentative(address sdk.AccAddress) *MsgUnregisterRepresentative {
+	return &MsgUnregisterRepresentative{address}
+}
+
+// Route implements Msg
+func (msg MsgUnregisterRepresentative) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUnregisterRepresentative) Type() string { return TypeMsgUnregisterRepresentative }
+
+// ValidateBasic implements Msg
+func (msg MsgUnregisterRepresentative) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgUnregisterRepresentative) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUnregisterRepresentative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnregisterRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// NewMsgDelegateVotingPower creates a message to assign the governance voting
+// power of a delegator to a representative
+func NewMsgDelegateVotingPower(delegator, representative sdk.AccAddress) *MsgDelegateVotingPower {
+	return &MsgDelegateVotingPower{delegator, representative}
+}
+
+// Route implements Msg
+func (msg MsgDelegateVotingPower) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDelegateVotingPower) Type() string { return TypeMsgDelegateVotingPower }
+
+// ValidateBasic implements Msg
+func (msg MsgDelegateVotingPower) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Delegator.String())
+	}
+	if msg.Representative.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Representative.String())
+	}
+	if msg.Delegator.Equals(msg.Representative) {
+		return ErrSelfVotingPowerDelegation
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgDelegateVotingPower) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDelegateVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDelegateVotingPower) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// NewMsgUndelegateVotingPower creates a message to take back the governance
+// voting power a delegator assigned to a representative
+func NewMsgUndelegateVotingPower(delegator sdk.AccAddress) *MsgUndelegateVotingPower {
+	return &MsgUndelegateVotingPower{delegator}
+}
+
+// Route implements Msg
+func (msg MsgUndelegateVotingPower) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUndelegateVotingPower) Type() string { return TypeMsgUndelegateVotingPower }
+
+// ValidateBasic implements Msg
+func (msg MsgUndelegateVotingPower) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Delegator.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgUndelegateVotingPower) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUndelegateVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUndelegateVotingPower) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
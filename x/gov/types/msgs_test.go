@@ -47,6 +47,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 			ContentFromProposalType(tc.title, tc.description, tc.proposalType),
 			tc.initialDeposit,
 			tc.proposerAddr,
+			false,
 		)
 
 		require.NoError(t, err)
@@ -118,9 +119,97 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+			NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(2, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.OneDec()),
+			NewWeightedVoteOption(OptionNo, sdk.ZeroDec()),
+		}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
+func TestMsgRegisterRepresentative(t *testing.T) {
+	tests := []struct {
+		address     sdk.AccAddress
+		description string
+		expectPass  bool
+	}{
+		{addrs[0], "description", true},
+		{addrs[0], "", true},
+		{sdk.AccAddress{}, "description", false},
+		{addrs[0], strings.Repeat("#", MaxDescriptionLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRegisterRepresentative(tc.address, tc.description)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgDelegateVotingPower(t *testing.T) {
+	tests := []struct {
+		delegator      sdk.AccAddress
+		representative sdk.AccAddress
+		expectPass     bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+		{addrs[0], addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgDelegateVotingPower(tc.delegator, tc.representative)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
-	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
+	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{}, false)
 	require.NoError(t, err)
 	var bz []byte
 	require.NotPanics(t, func() {
@@ -12,13 +12,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time, isExpedited bool) (Proposal, error) {
 	p := Proposal{
 		ProposalID:       id,
 		Status:           StatusDepositPeriod,
@@ -26,6 +27,7 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		IsExpedited:      isExpedited,
 	}
 
 	msg, ok := content.(proto.Message)
@@ -244,7 +246,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText     string = "Text"
+	ProposalTypeExecMsgs string = "ExecMsgs"
 )
 
 // Implements Content Interface
@@ -276,8 +279,115 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var (
+	_ Content                       = &ExecMsgsProposal{}
+	_ types.UnpackInterfacesMessage = ExecMsgsProposal{}
+)
+
+// NewExecMsgsProposal creates a proposal Content executing the given messages
+// once it passes. The messages must be signed by the governance module account.
+func NewExecMsgsProposal(title, description string, msgs []sdk.Msg) (*ExecMsgsProposal, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		m, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("can't proto marshal %T", msg)
+		}
+		any, err := types.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &ExecMsgsProposal{Title: title, Description: description, Messages: anys}, nil
+}
+
+// GetTitle returns the proposal title
+func (p *ExecMsgsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the proposal description
+func (p *ExecMsgsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the proposal router key
+func (p *ExecMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "ExecMsgs"
+func (p *ExecMsgsProposal) ProposalType() string { return ProposalTypeExecMsgs }
+
+// GetMsgs returns the messages executed by the proposal
+func (p *ExecMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, any := range p.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.Msg: %T", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ValidateBasic validates the content's title and description of the proposal
+// along with its messages, which must all be signed by the governance module
+// account only
+func (p *ExecMsgsProposal) ValidateBasic() error {
+	if err := ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal has no message to execute")
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, err.Error())
+	}
+
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d must only be signed by the governance module account %s", i, govAddr)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p ExecMsgsProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range p.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String implements Stringer interface
+func (p ExecMsgsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Exec Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, p.Title, p.Description))
+	for _, any := range p.Messages {
+		b.WriteString(fmt.Sprintf("    %s\n", any.GetCachedValue()))
+	}
+	return strings.TrimSpace(b.String())
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:     {},
+	ProposalTypeExecMsgs: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +25,29 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecMsgsProposal_ValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	otherAddr := sdk.AccAddress("other")
+
+	tests := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"valid", []sdk.Msg{NewMsgVote(govAddr, 1, OptionYes)}, false},
+		{"no messages", []sdk.Msg{}, true},
+		{"not signed by the governance account", []sdk.Msg{NewMsgVote(otherAddr, 1, OptionYes)}, true},
+		{"invalid message", []sdk.Msg{NewMsgVote(govAddr, 1, VoteOption(0x13))}, true},
+	}
+	for _, tt := range tests {
+		p, err := NewExecMsgsProposal("Title", "Description", tt.msgs)
+		require.NoError(t, err, tt.name)
+
+		if tt.expErr {
+			require.Error(t, p.ValidateBasic(), tt.name)
+		} else {
+			require.NoError(t, p.ValidateBasic(), tt.name)
+		}
+	}
+}
@@ -857,6 +857,334 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// RepresentativeVotingPower defines a governance representative along with the
+// voting power of the delegators which assigned their voting power to it,
+// including its own
+type RepresentativeVotingPower struct {
+	Representative Representative                         `protobuf:"bytes,1,opt,name=representative,proto3" json:"representative"`
+	VotingPower    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power" yaml:"voting_power"`
+}
+
+func (m *RepresentativeVotingPower) Reset()         { *m = RepresentativeVotingPower{} }
+func (m *RepresentativeVotingPower) String() string { return proto.CompactTextString(m) }
+func (*RepresentativeVotingPower) ProtoMessage()    {}
+func (*RepresentativeVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{16}
+}
+func (m *RepresentativeVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepresentativeVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepresentativeVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepresentativeVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepresentativeVotingPower.Merge(m, src)
+}
+func (m *RepresentativeVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepresentativeVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepresentativeVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepresentativeVotingPower proto.InternalMessageInfo
+
+func (m *RepresentativeVotingPower) GetRepresentative() Representative {
+	if m != nil {
+		return m.Representative
+	}
+	return Representative{}
+}
+
+// QueryRepresentativeRequest is the request type for the Query/Representative RPC method
+type QueryRepresentativeRequest struct {
+	// address of the representative
+	Representative github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=representative,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"representative,omitempty"`
+}
+
+func (m *QueryRepresentativeRequest) Reset()         { *m = QueryRepresentativeRequest{} }
+func (m *QueryRepresentativeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativeRequest) ProtoMessage()    {}
+func (*QueryRepresentativeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{17}
+}
+func (m *QueryRepresentativeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativeRequest.Merge(m, src)
+}
+func (m *QueryRepresentativeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativeRequest proto.InternalMessageInfo
+
+func (m *QueryRepresentativeRequest) GetRepresentative() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Representative
+	}
+	return nil
+}
+
+// QueryRepresentativeResponse is the response type for the Query/Representative RPC method
+type QueryRepresentativeResponse struct {
+	Representative RepresentativeVotingPower `protobuf:"bytes,1,opt,name=representative,proto3" json:"representative"`
+}
+
+func (m *QueryRepresentativeResponse) Reset()         { *m = QueryRepresentativeResponse{} }
+func (m *QueryRepresentativeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativeResponse) ProtoMessage()    {}
+func (*QueryRepresentativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{18}
+}
+func (m *QueryRepresentativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativeResponse.Merge(m, src)
+}
+func (m *QueryRepresentativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativeResponse proto.InternalMessageInfo
+
+func (m *QueryRepresentativeResponse) GetRepresentative() RepresentativeVotingPower {
+	if m != nil {
+		return m.Representative
+	}
+	return RepresentativeVotingPower{}
+}
+
+// QueryRepresentativesRequest is the request type for the Query/Representatives RPC method
+type QueryRepresentativesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRepresentativesRequest) Reset()         { *m = QueryRepresentativesRequest{} }
+func (m *QueryRepresentativesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativesRequest) ProtoMessage()    {}
+func (*QueryRepresentativesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{19}
+}
+func (m *QueryRepresentativesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativesRequest.Merge(m, src)
+}
+func (m *QueryRepresentativesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativesRequest proto.InternalMessageInfo
+
+func (m *QueryRepresentativesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRepresentativesResponse is the response type for the Query/Representatives RPC method
+type QueryRepresentativesResponse struct {
+	Representatives []RepresentativeVotingPower `protobuf:"bytes,1,rep,name=representatives,proto3" json:"representatives"`
+	Pagination      *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRepresentativesResponse) Reset()         { *m = QueryRepresentativesResponse{} }
+func (m *QueryRepresentativesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativesResponse) ProtoMessage()    {}
+func (*QueryRepresentativesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{20}
+}
+func (m *QueryRepresentativesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativesResponse.Merge(m, src)
+}
+func (m *QueryRepresentativesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativesResponse proto.InternalMessageInfo
+
+func (m *QueryRepresentativesResponse) GetRepresentatives() []RepresentativeVotingPower {
+	if m != nil {
+		return m.Representatives
+	}
+	return nil
+}
+
+func (m *QueryRepresentativesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVotingPowerDelegationRequest is the request type for the Query/VotingPowerDelegation RPC method
+type QueryVotingPowerDelegationRequest struct {
+	// address of the delegator
+	Delegator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+}
+
+func (m *QueryVotingPowerDelegationRequest) Reset()         { *m = QueryVotingPowerDelegationRequest{} }
+func (m *QueryVotingPowerDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerDelegationRequest) ProtoMessage()    {}
+func (*QueryVotingPowerDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{21}
+}
+func (m *QueryVotingPowerDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerDelegationRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerDelegationRequest) GetDelegator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+// QueryVotingPowerDelegationResponse is the response type for the Query/VotingPowerDelegation RPC method
+type QueryVotingPowerDelegationResponse struct {
+	VotingPowerDelegation VotingPowerDelegation `protobuf:"bytes,1,opt,name=voting_power_delegation,json=votingPowerDelegation,proto3" json:"voting_power_delegation"`
+}
+
+func (m *QueryVotingPowerDelegationResponse) Reset()         { *m = QueryVotingPowerDelegationResponse{} }
+func (m *QueryVotingPowerDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerDelegationResponse) ProtoMessage()    {}
+func (*QueryVotingPowerDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6efb1c1bc2595eda, []int{22}
+}
+func (m *QueryVotingPowerDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerDelegationResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerDelegationResponse) GetVotingPowerDelegation() VotingPowerDelegation {
+	if m != nil {
+		return m.VotingPowerDelegation
+	}
+	return VotingPowerDelegation{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.QueryProposalResponse")
@@ -874,63 +1202,86 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.QueryTallyResultResponse")
+	proto.RegisterType((*RepresentativeVotingPower)(nil), "cosmos.gov.RepresentativeVotingPower")
+	proto.RegisterType((*QueryRepresentativeRequest)(nil), "cosmos.gov.QueryRepresentativeRequest")
+	proto.RegisterType((*QueryRepresentativeResponse)(nil), "cosmos.gov.QueryRepresentativeResponse")
+	proto.RegisterType((*QueryRepresentativesRequest)(nil), "cosmos.gov.QueryRepresentativesRequest")
+	proto.RegisterType((*QueryRepresentativesResponse)(nil), "cosmos.gov.QueryRepresentativesResponse")
+	proto.RegisterType((*QueryVotingPowerDelegationRequest)(nil), "cosmos.gov.QueryVotingPowerDelegationRequest")
+	proto.RegisterType((*QueryVotingPowerDelegationResponse)(nil), "cosmos.gov.QueryVotingPowerDelegationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/query.proto", fileDescriptor_6efb1c1bc2595eda) }
 
 var fileDescriptor_6efb1c1bc2595eda = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x4f, 0xd3, 0x50,
-	0x14, 0x5e, 0xc7, 0x06, 0xdb, 0x19, 0x20, 0x5e, 0x10, 0x66, 0x23, 0xdb, 0xac, 0x3e, 0x10, 0x23,
-	0x5b, 0x84, 0xa0, 0xc2, 0x8b, 0x32, 0xfc, 0x19, 0x12, 0x81, 0x49, 0x7c, 0xe0, 0x85, 0x94, 0xb5,
-	0xa9, 0x8d, 0x63, 0xb7, 0xf4, 0xde, 0x2d, 0x2e, 0x31, 0xbe, 0x9a, 0x98, 0x68, 0xfc, 0x57, 0xfc,
-	0x2f, 0x78, 0xe4, 0xd1, 0x27, 0x62, 0xc0, 0xbf, 0xc2, 0x27, 0xd3, 0xfb, 0xa3, 0x6b, 0xd7, 0x75,
-	0x4e, 0x31, 0x3e, 0x31, 0xce, 0xfd, 0xce, 0x77, 0xbf, 0xf3, 0x9d, 0xd3, 0xd3, 0xc2, 0x6c, 0x1d,
-	0x93, 0x43, 0x4c, 0x2a, 0x16, 0x6e, 0x57, 0x8e, 0x5a, 0xa6, 0xdb, 0x29, 0x3b, 0x2e, 0xa6, 0x18,
-	0x01, 0x8f, 0x97, 0x2d, 0xdc, 0x56, 0xe7, 0x05, 0x86, 0x9d, 0x57, 0x1c, 0xdd, 0xb2, 0x9b, 0x3a,
-	0xb5, 0x71, 0x93, 0x43, 0xd5, 0x19, 0x0b, 0x5b, 0x98, 0xfd, 0xac, 0x78, 0xbf, 0x64, 0x34, 0x40,
-	0x6c, 0xe1, 0x36, 0x8f, 0x6a, 0xf7, 0x60, 0x66, 0xc7, 0x63, 0xd9, 0x76, 0xb1, 0x83, 0x89, 0xde,
-	0xa8, 0x99, 0x47, 0x2d, 0x93, 0x50, 0x54, 0x84, 0x9c, 0x23, 0x42, 0xfb, 0xb6, 0x91, 0x57, 0x4a,
-	0xca, 0x42, 0xaa, 0x06, 0x32, 0xf4, 0xdc, 0xd0, 0xb6, 0xe0, 0x4a, 0x4f, 0x22, 0x71, 0x70, 0x93,
-	0x98, 0xe8, 0x2e, 0x64, 0x24, 0x8c, 0xa5, 0xe5, 0x96, 0x66, 0xca, 0x5d, 0xed, 0x65, 0x89, 0xaf,
-	0xa6, 0x8e, 0x4f, 0x8b, 0x89, 0x9a, 0x8f, 0xd5, 0xbe, 0x26, 0x7b, 0x18, 0x89, 0xd4, 0xb2, 0x01,
-	0x97, 0x7c, 0x2d, 0x84, 0xea, 0xb4, 0x45, 0x18, 0xf1, 0xe4, 0x92, 0xda, 0x8f, 0xf8, 0x25, 0x43,
-	0xd4, 0x26, 0x9d, 0xd0, 0xff, 0xe8, 0x29, 0xa4, 0xdb, 0x98, 0x9a, 0x6e, 0x3e, 0x59, 0x52, 0x16,
-	0xc6, 0xab, 0x77, 0x7e, 0x9e, 0x16, 0x17, 0x2d, 0x9b, 0xbe, 0x6e, 0x1d, 0x94, 0xeb, 0xf8, 0xb0,
-	0x22, 0xcc, 0xe1, 0x7f, 0x16, 0x89, 0xf1, 0xa6, 0x42, 0x3b, 0x8e, 0x49, 0xca, 0xeb, 0xf5, 0xfa,
-	0xba, 0x61, 0xb8, 0x26, 0x21, 0x35, 0x9e, 0x8f, 0xb6, 0x20, 0x6b, 0x98, 0x0e, 0x26, 0x36, 0xc5,
-	0x6e, 0x7e, 0xe4, 0x6f, 0xc9, 0xba, 0x1c, 0x68, 0x15, 0xa0, 0xdb, 0xc2, 0x7c, 0x8a, 0x59, 0x76,
-	0x55, 0x56, 0xc6, 0x47, 0x60, 0x5b, 0xb7, 0x4c, 0xe1, 0x46, 0x2d, 0x00, 0xd6, 0x3e, 0x2b, 0x30,
-	0xdb, 0xeb, 0x99, 0x68, 0xc3, 0x7d, 0xc8, 0x4a, 0x07, 0x3c, 0xbb, 0x46, 0x7e, 0xd3, 0x87, 0x2e,
-	0x18, 0xad, 0x85, 0xf4, 0x24, 0x99, 0x1e, 0xb5, 0x9f, 0x1e, 0x7e, 0x53, 0x48, 0xd0, 0x3b, 0x98,
-	0x62, 0x7a, 0x5e, 0x61, 0x6a, 0x0e, 0x3b, 0x4a, 0xff, 0xac, 0x35, 0xda, 0x03, 0xb8, 0x1c, 0xb8,
-	0x5d, 0x18, 0x71, 0x0b, 0x52, 0xde, 0xa9, 0x98, 0xc5, 0xa9, 0xa0, 0x07, 0x1e, 0x4e, 0xd4, 0xcf,
-	0x30, 0x1a, 0x0e, 0x10, 0x90, 0xa1, 0xf5, 0xaf, 0xf6, 0x31, 0x6c, 0xc8, 0x06, 0xbe, 0x07, 0x14,
-	0xbc, 0x50, 0x48, 0xbe, 0xcd, 0x0d, 0x91, 0x7d, 0x8b, 0xd3, 0xcc, 0x41, 0x17, 0xea, 0xd7, 0x8a,
-	0xb8, 0x7f, 0x5b, 0x77, 0xf5, 0xc3, 0x50, 0xc5, 0x2c, 0xb0, 0xef, 0x39, 0xcd, 0x2a, 0xce, 0x7a,
-	0x69, 0x5e, 0x68, 0xb7, 0xe3, 0x98, 0xda, 0x0f, 0x05, 0xa6, 0x43, 0x79, 0x42, 0xf8, 0x06, 0x4c,
-	0xb4, 0x31, 0xb5, 0x9b, 0xd6, 0x3e, 0x07, 0x0b, 0xd3, 0xf3, 0x3d, 0x05, 0xd8, 0x4d, 0x8b, 0x27,
-	0x8a, 0x42, 0xc6, 0xdb, 0x81, 0x18, 0x7a, 0x02, 0x93, 0xe2, 0xe1, 0x90, 0x2c, 0x3d, 0x96, 0x7a,
-	0x2c, 0x8f, 0x38, 0x22, 0x44, 0x33, 0x61, 0x04, 0x83, 0xe8, 0x21, 0x8c, 0x53, 0xbd, 0xd1, 0xe8,
-	0x48, 0x96, 0x11, 0xc6, 0x32, 0x17, 0x64, 0xd9, 0xf5, 0xce, 0x43, 0x1c, 0x39, 0xda, 0x0d, 0x69,
-	0x1f, 0x64, 0x99, 0xe2, 0xb6, 0xa1, 0x27, 0x22, 0xb4, 0x23, 0x92, 0x17, 0xdf, 0x11, 0xda, 0xa6,
-	0x58, 0xd3, 0xbe, 0x10, 0x61, 0xf8, 0x32, 0x8c, 0x09, 0x90, 0xb0, 0x7a, 0xba, 0x8f, 0x49, 0xa2,
-	0x34, 0x89, 0xd4, 0xdc, 0x30, 0xd9, 0x7f, 0x19, 0xf4, 0x8f, 0x8a, 0xd8, 0xee, 0xdd, 0x4b, 0x45,
-	0x09, 0x2b, 0x90, 0x11, 0xc2, 0xe4, 0xbc, 0x0f, 0xa8, 0xc1, 0x87, 0x5e, 0x68, 0xea, 0xd7, 0x60,
-	0x8e, 0x69, 0x61, 0xed, 0xaf, 0x99, 0xa4, 0xd5, 0xa0, 0x7f, 0xf0, 0xde, 0xcb, 0x47, 0x73, 0xfd,
-	0x6e, 0xa4, 0xd9, 0xf8, 0x88, 0x5e, 0x44, 0x47, 0x8d, 0xe3, 0xe5, 0xe3, 0xcb, 0xb0, 0x4b, 0x9f,
-	0xd2, 0x90, 0x66, 0x8c, 0x68, 0x07, 0x32, 0x72, 0x2b, 0xa3, 0x52, 0x30, 0xb7, 0xdf, 0x1b, 0x5a,
-	0xbd, 0x3e, 0x00, 0xc1, 0xf5, 0x68, 0x09, 0xb4, 0x0b, 0x59, 0xff, 0xd5, 0x80, 0xe2, 0x33, 0xe4,
-	0x08, 0xa8, 0xda, 0x20, 0x88, 0xcf, 0xfa, 0x18, 0x52, 0xde, 0x1a, 0x42, 0xd7, 0x22, 0xe8, 0xc0,
-	0xde, 0x57, 0xe7, 0x63, 0x4e, 0x7d, 0x9a, 0x67, 0x90, 0x66, 0x7b, 0x0f, 0xf5, 0x47, 0xfa, 0xa2,
-	0x0a, 0x71, 0xc7, 0x3e, 0xd3, 0x26, 0x8c, 0x8a, 0x87, 0x3e, 0x8a, 0x0d, 0xad, 0x36, 0xb5, 0x18,
-	0x7b, 0xee, 0x93, 0xbd, 0x80, 0x31, 0x31, 0x74, 0x28, 0x8a, 0x0e, 0x6f, 0x02, 0xb5, 0x14, 0x0f,
-	0xf0, 0xf9, 0x76, 0x20, 0x23, 0x87, 0x1e, 0xc5, 0xe2, 0x49, 0x7c, 0x5b, 0x7b, 0x9f, 0x18, 0x2d,
-	0x81, 0xf6, 0x20, 0x17, 0x98, 0x27, 0x74, 0x23, 0x92, 0x13, 0x9d, 0x6c, 0xf5, 0xe6, 0x60, 0x90,
-	0xe4, 0xae, 0x56, 0x8f, 0xcf, 0x0a, 0xca, 0xc9, 0x59, 0x41, 0xf9, 0x7e, 0x56, 0x50, 0xbe, 0x9c,
-	0x17, 0x12, 0x27, 0xe7, 0x85, 0xc4, 0xb7, 0xf3, 0x42, 0x62, 0x6f, 0x61, 0xe0, 0xfa, 0x7a, 0xcb,
-	0xbe, 0x2c, 0xd9, 0x12, 0x3b, 0x18, 0x65, 0x1f, 0x97, 0xcb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0x13, 0x79, 0x06, 0xcd, 0x0a, 0x00, 0x00,
+	// 1072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x4e, 0x13, 0x3f, 0xa7, 0x4e, 0x99, 0x24, 0x8d, 0xbb, 0xb4, 0x76, 0x32, 0x40,
+	0x89, 0x10, 0xb1, 0x45, 0xaa, 0x02, 0xcd, 0x05, 0xea, 0xa6, 0x50, 0x54, 0x89, 0x26, 0xdb, 0x50,
+	0x41, 0x2f, 0xd6, 0xd6, 0x1e, 0xb6, 0x16, 0x8e, 0x67, 0xbb, 0xb3, 0xde, 0xd6, 0x12, 0xe2, 0x86,
+	0x90, 0x38, 0x20, 0xfe, 0x4a, 0xff, 0x45, 0x6f, 0xe4, 0xc0, 0x01, 0x71, 0x88, 0x50, 0xc2, 0x2f,
+	0xe0, 0xc8, 0x09, 0xed, 0xec, 0xcc, 0x78, 0x67, 0xbd, 0xeb, 0x38, 0x09, 0xea, 0x29, 0xce, 0xcc,
+	0xf7, 0xbe, 0xf7, 0xde, 0xf7, 0xde, 0x9b, 0x99, 0x85, 0xcb, 0x2d, 0xca, 0xf6, 0x29, 0xab, 0x3b,
+	0x34, 0xa8, 0x3f, 0xeb, 0x13, 0x6f, 0x50, 0x73, 0x3d, 0xea, 0x53, 0x04, 0xd1, 0x7a, 0xcd, 0xa1,
+	0x81, 0x79, 0x4d, 0x60, 0xf8, 0x7e, 0xdd, 0xb5, 0x9d, 0x4e, 0xcf, 0xf6, 0x3b, 0xb4, 0x17, 0x41,
+	0xcd, 0x25, 0x87, 0x3a, 0x94, 0xff, 0xac, 0x87, 0xbf, 0xe4, 0x6a, 0x8c, 0xd8, 0xa1, 0x41, 0xb4,
+	0x8a, 0x3f, 0x82, 0xa5, 0xdd, 0x90, 0x65, 0xc7, 0xa3, 0x2e, 0x65, 0x76, 0xd7, 0x22, 0xcf, 0xfa,
+	0x84, 0xf9, 0xa8, 0x0a, 0x45, 0x57, 0x2c, 0x35, 0x3b, 0xed, 0xb2, 0xb1, 0x6a, 0xac, 0xe7, 0x2d,
+	0x90, 0x4b, 0x5f, 0xb4, 0xf1, 0x03, 0x58, 0x4e, 0x18, 0x32, 0x97, 0xf6, 0x18, 0x41, 0x1f, 0xc2,
+	0x9c, 0x84, 0x71, 0xb3, 0xe2, 0xe6, 0x52, 0x6d, 0x18, 0x7b, 0x4d, 0xe2, 0x1b, 0xf9, 0x57, 0x87,
+	0xd5, 0x9c, 0xa5, 0xb0, 0xf8, 0xe5, 0x54, 0x82, 0x91, 0xc9, 0x58, 0xee, 0xc0, 0x82, 0x8a, 0x85,
+	0xf9, 0xb6, 0xdf, 0x67, 0x9c, 0xb8, 0xb4, 0x69, 0xa6, 0x11, 0x3f, 0xe4, 0x08, 0xab, 0xe4, 0x6a,
+	0xff, 0xa3, 0xcf, 0x61, 0x26, 0xa0, 0x3e, 0xf1, 0xca, 0x53, 0xab, 0xc6, 0xfa, 0x7c, 0xe3, 0x83,
+	0x7f, 0x0f, 0xab, 0x1b, 0x4e, 0xc7, 0x7f, 0xda, 0x7f, 0x52, 0x6b, 0xd1, 0xfd, 0xba, 0x10, 0x27,
+	0xfa, 0xb3, 0xc1, 0xda, 0xdf, 0xd5, 0xfd, 0x81, 0x4b, 0x58, 0xed, 0x76, 0xab, 0x75, 0xbb, 0xdd,
+	0xf6, 0x08, 0x63, 0x56, 0x64, 0x8f, 0x1e, 0x40, 0xa1, 0x4d, 0x5c, 0xca, 0x3a, 0x3e, 0xf5, 0xca,
+	0xd3, 0x67, 0x25, 0x1b, 0x72, 0xa0, 0x5b, 0x00, 0xc3, 0x12, 0x96, 0xf3, 0x5c, 0xb2, 0x2b, 0x32,
+	0xb3, 0xa8, 0x05, 0x76, 0x6c, 0x87, 0x08, 0x35, 0xac, 0x18, 0x18, 0xff, 0x62, 0xc0, 0xe5, 0xa4,
+	0x66, 0xa2, 0x0c, 0x1f, 0x43, 0x41, 0x2a, 0x10, 0xca, 0x35, 0x7d, 0x42, 0x1d, 0x86, 0x60, 0xb4,
+	0xa5, 0xc5, 0x33, 0xc5, 0xe3, 0x31, 0xd3, 0xe2, 0x89, 0x3c, 0x69, 0x01, 0x7d, 0x0f, 0x97, 0x78,
+	0x3c, 0x8f, 0xa8, 0x4f, 0x26, 0x6d, 0xa5, 0xff, 0xad, 0x34, 0xf8, 0x13, 0x78, 0x23, 0xe6, 0x5d,
+	0x08, 0xf1, 0x1e, 0xe4, 0xc3, 0x5d, 0xd1, 0x8b, 0x97, 0xe2, 0x1a, 0x84, 0x38, 0x91, 0x3f, 0xc7,
+	0x60, 0x1a, 0x23, 0x60, 0x13, 0xc7, 0x7f, 0x2b, 0x45, 0xb0, 0x09, 0x0b, 0xf8, 0x03, 0xa0, 0xb8,
+	0x43, 0x11, 0xf2, 0xfb, 0x91, 0x20, 0xb2, 0x6e, 0x59, 0x31, 0x47, 0xa0, 0x73, 0xd5, 0xeb, 0xa6,
+	0xf0, 0xbf, 0x63, 0x7b, 0xf6, 0xbe, 0x96, 0x31, 0x5f, 0x68, 0x86, 0x4a, 0xf3, 0x8c, 0x0b, 0x16,
+	0x44, 0x4b, 0x7b, 0x03, 0x97, 0xe0, 0xbf, 0x0d, 0x58, 0xd4, 0xec, 0x44, 0xe0, 0x77, 0xe0, 0x62,
+	0x40, 0xfd, 0x4e, 0xcf, 0x69, 0x46, 0x60, 0x21, 0x7a, 0x39, 0x91, 0x40, 0xa7, 0xe7, 0x44, 0x86,
+	0x22, 0x91, 0xf9, 0x20, 0xb6, 0x86, 0x3e, 0x83, 0x92, 0x18, 0x0e, 0xc9, 0x92, 0x90, 0x34, 0x64,
+	0xd9, 0x8e, 0x10, 0x1a, 0xcd, 0xc5, 0x76, 0x7c, 0x11, 0x7d, 0x0a, 0xf3, 0xbe, 0xdd, 0xed, 0x0e,
+	0x24, 0xcb, 0x34, 0x67, 0x59, 0x89, 0xb3, 0xec, 0x85, 0xfb, 0x1a, 0x47, 0xd1, 0x1f, 0x2e, 0xe1,
+	0x9f, 0x64, 0x9a, 0xc2, 0xdb, 0xc4, 0x1d, 0xa1, 0x9d, 0x11, 0x53, 0xe7, 0x3f, 0x23, 0xf0, 0x7d,
+	0x58, 0xd2, 0x03, 0x11, 0x82, 0xdf, 0x80, 0x59, 0x01, 0x12, 0x52, 0x2f, 0xa6, 0x88, 0x24, 0x52,
+	0x93, 0x48, 0xec, 0xe9, 0x64, 0xaf, 0xa5, 0xd1, 0x7f, 0x36, 0x60, 0x39, 0xe1, 0x54, 0xa4, 0x70,
+	0x13, 0xe6, 0x44, 0x60, 0xb2, 0xdf, 0xc7, 0xe4, 0xa0, 0xa0, 0xe7, 0xea, 0xfa, 0x2d, 0x58, 0xe1,
+	0xb1, 0xf0, 0xf2, 0x5b, 0x84, 0xf5, 0xbb, 0xfe, 0x29, 0xee, 0xbd, 0xf2, 0xa8, 0xad, 0xaa, 0xc6,
+	0x0c, 0x6f, 0x9f, 0xb2, 0x91, 0xd1, 0x6a, 0x11, 0x5e, 0x8e, 0x2f, 0xc7, 0xe2, 0xdf, 0x0c, 0xb8,
+	0x62, 0x11, 0xd7, 0x23, 0x8c, 0xf4, 0x7c, 0xdb, 0xef, 0x04, 0x44, 0x4c, 0x08, 0x7d, 0x4e, 0x3c,
+	0x74, 0x0f, 0x4a, 0x9e, 0xb6, 0x59, 0x36, 0xf4, 0x54, 0x43, 0x6e, 0xdd, 0x5c, 0xd0, 0x27, 0xec,
+	0xd0, 0x53, 0x98, 0x97, 0xb3, 0x19, 0x32, 0x73, 0xc9, 0x0a, 0x8d, 0xbb, 0x21, 0xf6, 0xcf, 0xc3,
+	0xea, 0xf5, 0x09, 0x5a, 0x73, 0x9b, 0xb4, 0xfe, 0x39, 0xac, 0x2e, 0x0e, 0xec, 0xfd, 0xee, 0x16,
+	0x8e, 0x73, 0x61, 0xab, 0x18, 0x0c, 0x63, 0xc6, 0xcf, 0xc1, 0xe4, 0x12, 0xe9, 0x61, 0x49, 0x85,
+	0xbf, 0x49, 0xcd, 0xe8, 0x4c, 0x03, 0x92, 0x20, 0xc2, 0x1e, 0xbc, 0x99, 0xea, 0x58, 0x94, 0xe7,
+	0x61, 0x86, 0x96, 0xef, 0x64, 0x6b, 0x19, 0x2b, 0x45, 0xba, 0xac, 0xf8, 0xeb, 0x54, 0x9f, 0x6a,
+	0xa6, 0xf4, 0x91, 0x31, 0x4e, 0x33, 0x32, 0x2f, 0x0d, 0xb8, 0x9a, 0x4e, 0x2d, 0xf2, 0xf9, 0x0a,
+	0x16, 0xf4, 0x60, 0xe4, 0x00, 0x9d, 0x2a, 0xa1, 0x24, 0xc7, 0xb9, 0x26, 0xcb, 0x87, 0x35, 0x79,
+	0x9f, 0x49, 0x37, 0xdb, 0xa4, 0x4b, 0x1c, 0xbe, 0x2b, 0x35, 0xe1, 0xa7, 0x23, 0x5f, 0xa4, 0xde,
+	0xd9, 0x8b, 0x3f, 0xe4, 0xc0, 0x3f, 0x1a, 0x80, 0xc7, 0xb9, 0x15, 0x7a, 0x35, 0x61, 0x25, 0xde,
+	0xb5, 0xcd, 0xb6, 0x82, 0x88, 0xc2, 0xac, 0xa5, 0xdc, 0x53, 0x3a, 0x97, 0xd0, 0x6c, 0x39, 0x48,
+	0xdb, 0xdc, 0xfc, 0x7d, 0x16, 0x66, 0x78, 0x1c, 0x68, 0x17, 0xe6, 0xe4, 0x03, 0x0b, 0xad, 0xc6,
+	0x59, 0xd3, 0x1e, 0xdb, 0xe6, 0xda, 0x18, 0x44, 0x14, 0x3b, 0xce, 0xa1, 0x3d, 0x28, 0xec, 0xa8,
+	0x37, 0x5a, 0xb6, 0x85, 0xec, 0x3c, 0x13, 0x8f, 0x83, 0x28, 0xd6, 0xbb, 0x90, 0x0f, 0x5f, 0x14,
+	0xe8, 0xea, 0x08, 0x3a, 0xf6, 0x84, 0x33, 0xaf, 0x65, 0xec, 0x2a, 0x9a, 0x7b, 0x30, 0xf3, 0x88,
+	0x3f, 0x46, 0xd2, 0x91, 0x2a, 0xa8, 0x4a, 0xd6, 0xb6, 0x62, 0xba, 0x0f, 0x17, 0xc4, 0xfd, 0x3d,
+	0x8a, 0xd5, 0x5e, 0x29, 0x66, 0x35, 0x73, 0x5f, 0x91, 0x7d, 0x09, 0xb3, 0xe2, 0xfe, 0x40, 0xa3,
+	0x68, 0xfd, 0x52, 0x37, 0x57, 0xb3, 0x01, 0x8a, 0x6f, 0x17, 0xe6, 0xb6, 0xe5, 0x05, 0x94, 0x89,
+	0x67, 0xd9, 0x65, 0x4d, 0x5e, 0x7e, 0x38, 0x87, 0x1e, 0x43, 0x31, 0x76, 0x35, 0xa0, 0xb7, 0x46,
+	0x6c, 0x46, 0x2f, 0x29, 0xf3, 0xed, 0xf1, 0x20, 0xc5, 0xdd, 0x82, 0x92, 0x3e, 0xfd, 0xe8, 0xfa,
+	0x88, 0x65, 0xea, 0x21, 0x6d, 0xbe, 0x7b, 0x22, 0x4e, 0x39, 0xf9, 0x16, 0x16, 0xac, 0xc4, 0x09,
+	0x72, 0x92, 0xb5, 0x52, 0x68, 0xfd, 0x64, 0xa0, 0xf2, 0xf3, 0x02, 0x96, 0x53, 0x47, 0x12, 0x6d,
+	0xa4, 0xf5, 0x54, 0xe6, 0xe9, 0x63, 0xd6, 0x26, 0x85, 0x4b, 0xcf, 0x8d, 0xc6, 0xab, 0xa3, 0x8a,
+	0x71, 0x70, 0x54, 0x31, 0xfe, 0x3a, 0xaa, 0x18, 0xbf, 0x1e, 0x57, 0x72, 0x07, 0xc7, 0x95, 0xdc,
+	0x1f, 0xc7, 0x95, 0xdc, 0xe3, 0xf5, 0xb1, 0x47, 0xd6, 0x0b, 0xfe, 0xad, 0xcd, 0x0f, 0xae, 0x27,
+	0x17, 0xf8, 0xe7, 0xf6, 0x8d, 0xff, 0x06, 0x00, 0x5c, 0x7c, 0x33, 0x34, 0xdf, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -961,6 +1312,13 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// Representative queries a governance representative and its voting power
+	Representative(ctx context.Context, in *QueryRepresentativeRequest, opts ...grpc.CallOption) (*QueryRepresentativeResponse, error)
+	// Representatives queries all governance representatives and their voting power
+	Representatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error)
+	// VotingPowerDelegation queries the representative a delegator assigned its
+	// voting power to
+	VotingPowerDelegation(ctx context.Context, in *QueryVotingPowerDelegationRequest, opts ...grpc.CallOption) (*QueryVotingPowerDelegationResponse, error)
 }
 
 type queryClient struct {
@@ -1043,6 +1401,33 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) Representative(ctx context.Context, in *QueryRepresentativeRequest, opts ...grpc.CallOption) (*QueryRepresentativeResponse, error) {
+	out := new(QueryRepresentativeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.Query/Representative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Representatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error) {
+	out := new(QueryRepresentativesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.Query/Representatives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPowerDelegation(ctx context.Context, in *QueryVotingPowerDelegationRequest, opts ...grpc.CallOption) (*QueryVotingPowerDelegationResponse, error) {
+	out := new(QueryVotingPowerDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.Query/VotingPowerDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID
@@ -1061,6 +1446,13 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// Representative queries a governance representative and its voting power
+	Representative(context.Context, *QueryRepresentativeRequest) (*QueryRepresentativeResponse, error)
+	// Representatives queries all governance representatives and their voting power
+	Representatives(context.Context, *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error)
+	// VotingPowerDelegation queries the representative a delegator assigned its
+	// voting power to
+	VotingPowerDelegation(context.Context, *QueryVotingPowerDelegationRequest) (*QueryVotingPowerDelegationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1091,6 +1483,15 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) Representative(ctx context.Context, req *QueryRepresentativeRequest) (*QueryRepresentativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Representative not implemented")
+}
+func (*UnimplementedQueryServer) Representatives(ctx context.Context, req *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Representatives not implemented")
+}
+func (*UnimplementedQueryServer) VotingPowerDelegation(ctx context.Context, req *QueryVotingPowerDelegationRequest) (*QueryVotingPowerDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerDelegation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1240,6 +1641,60 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Representative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Representative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.Query/Representative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Representative(ctx, req.(*QueryRepresentativeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Representatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Representatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.Query/Representatives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Representatives(ctx, req.(*QueryRepresentativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPowerDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.Query/VotingPowerDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerDelegation(ctx, req.(*QueryVotingPowerDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1276,6 +1731,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "Representative",
+			Handler:    _Query_Representative_Handler,
+		},
+		{
+			MethodName: "Representatives",
+			Handler:    _Query_Representatives_Handler,
+		},
+		{
+			MethodName: "VotingPowerDelegation",
+			Handler:    _Query_VotingPowerDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/query.proto",
@@ -1903,10 +2370,263 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *RepresentativeVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepresentativeVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepresentativeVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Representative.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Representative.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Representatives) > 0 {
+		for iNdEx := len(m.Representatives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Representatives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VotingPowerDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -2141,27 +2861,683 @@ func (m *QueryTallyResultRequest) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovQuery(uint64(m.ProposalId))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryTallyResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RepresentativeVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Representative.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRepresentativeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRepresentativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Representative.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRepresentativesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRepresentativesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Representatives) > 0 {
+		for _, e := range m.Representatives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPowerDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTallyResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Tally.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,17 +3560,17 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2204,11 +3580,25 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2233,7 +3623,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2256,15 +3646,34 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2291,7 +3700,10 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2319,7 +3731,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2342,36 +3754,17 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
-			}
-			m.ProposalStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2381,31 +3774,31 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2415,31 +3808,86 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2449,27 +3897,23 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParamsType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2495,7 +3939,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2518,15 +3962,48 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2553,14 +4030,13 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2587,10 +4063,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2618,7 +4091,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2641,10 +4114,10 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2668,7 +4141,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2695,9 +4168,9 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2724,7 +4197,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,15 +4220,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2782,7 +4255,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2810,7 +4283,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2833,10 +4306,10 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2918,7 +4391,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,15 +4414,15 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2976,8 +4449,8 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3041,7 +4514,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3064,17 +4537,17 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3084,24 +4557,11 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParamsType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3126,7 +4586,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3149,15 +4609,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3184,13 +4644,66 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepresentativeVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepresentativeVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepresentativeVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,15 +4730,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Representative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3235,22 +4748,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3278,7 +4792,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRepresentativeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,34 +4815,15 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRepresentativeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRepresentativeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3355,9 +4850,9 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Representative = append(m.Representative[:0], dAtA[iNdEx:postIndex]...)
+			if m.Representative == nil {
+				m.Representative = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3384,7 +4879,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRepresentativeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3407,15 +4902,15 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRepresentativeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRepresentativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3442,7 +4937,7 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Representative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3470,7 +4965,7 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRepresentativesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3493,32 +4988,13 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRepresentativesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRepresentativesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3578,7 +5054,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRepresentativesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3601,15 +5077,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRepresentativesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRepresentativesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Representatives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3636,8 +5112,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Representatives = append(m.Representatives, RepresentativeVotingPower{})
+			if err := m.Representatives[len(m.Representatives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3701,7 +5177,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3724,17 +5200,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.ProposalId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3744,11 +5220,26 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3773,7 +5264,7 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3796,15 +5287,15 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3831,7 +5322,7 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPowerDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
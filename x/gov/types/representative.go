@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRepresentative creates a new Representative instance
+func NewRepresentative(address sdk.AccAddress, description string) Representative {
+	return Representative{Address: address, Description: description}
+}
+
+func (r Representative) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// Representatives is a collection of Representative objects
+type Representatives []Representative
+
+// Equal returns true if two slices (order-dependant) of representatives are equal.
+func (r Representatives) Equal(other Representatives) bool {
+	if len(r) != len(other) {
+		return false
+	}
+
+	for i, rep := range r {
+		if !rep.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r Representatives) String() string {
+	if len(r) == 0 {
+		return "[]"
+	}
+	out := "Representatives:"
+	for _, rep := range r {
+		out += fmt.Sprintf("\n  %s: %s", rep.Address, rep.Description)
+	}
+	return out
+}
+
+// NewVotingPowerDelegation creates a new VotingPowerDelegation instance
+func NewVotingPowerDelegation(delegator, representative sdk.AccAddress) VotingPowerDelegation {
+	return VotingPowerDelegation{Delegator: delegator, Representative: representative}
+}
+
+func (d VotingPowerDelegation) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// VotingPowerDelegations is a collection of VotingPowerDelegation objects
+type VotingPowerDelegations []VotingPowerDelegation
+
+// Equal returns true if two slices (order-dependant) of voting power
+// delegations are equal.
+func (d VotingPowerDelegations) Equal(other VotingPowerDelegations) bool {
+	if len(d) != len(other) {
+		return false
+	}
+
+	for i, del := range d {
+		if !del.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d VotingPowerDelegations) String() string {
+	if len(d) == 0 {
+		return "[]"
+	}
+	out := "Voting power delegations:"
+	for _, del := range d {
+		out += fmt.Sprintf("\n  %s -> %s", del.Delegator, del.Representative)
+	}
+	return out
+}
+
+// NewRepresentativeVotingPower creates a new RepresentativeVotingPower instance
+func NewRepresentativeVotingPower(representative Representative, votingPower sdk.Dec) RepresentativeVotingPower {
+	return RepresentativeVotingPower{Representative: representative, VotingPower: votingPower}
+}
@@ -3,15 +3,29 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
+// NewVote creates a new Vote instance giving the whole voting power to a
+// single option
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return NewWeightedVote(proposalID, voter, NewNonSplitVoteOption(option))
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power
+// across the given options. The single option of the vote is only set if it
+// is not split.
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalID: proposalID, Voter: voter, Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option
+	}
+	return vote
 }
 
 func (v Vote) String() string {
@@ -43,7 +57,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -53,6 +67,89 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the weighted options of a vote giving the
+// whole voting power to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (w WeightedVoteOptions) String() string {
+	options := make([]string, len(w))
+	for i, option := range w {
+		options[i] = option.String()
+	}
+	return strings.Join(options, ",")
+}
+
+// Validate returns an error if an option is invalid or given more than once,
+// if a weight is not in (0, 1] or if the weights do not sum to 1.
+func (w WeightedVoteOptions) Validate() error {
+	if len(w) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote option")
+	}
+
+	seen := make(map[VoteOption]bool)
+	total := sdk.ZeroDec()
+	for _, option := range w {
+		if !ValidVoteOption(option.Option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.Option.String())
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidVote, "invalid weight for option %s: %s", option.Option, option.Weight)
+		}
+		if seen[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicate option %s", option.Option)
+		}
+		seen[option.Option] = true
+		total = total.Add(option.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s is not 1", total)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns the weighted vote options from a
+// string of comma separated option=weight pairs, e.g. "Yes=0.6,No=0.4". It
+// returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid weight: %w", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
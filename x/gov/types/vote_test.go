@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestVoteUnMarshalJSON(t *testing.T) {
@@ -34,3 +36,39 @@ func TestVoteUnMarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected WeightedVoteOptions
+		isError  bool
+	}{
+		{"Yes=1", NewNonSplitVoteOption(OptionYes), false},
+		{"Yes=0.6,No=0.4", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{"Abstain=0.5, NoWithVeto=0.5", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{"", nil, true},
+		{"Yes", nil, true},
+		{"misc=1", nil, true},
+		{"Yes=half", nil, true},
+	}
+	for _, tt := range tests {
+		options, err := WeightedVoteOptionsFromString(tt.str)
+		if tt.isError {
+			require.Error(t, err, tt.str)
+		} else {
+			require.NoError(t, err, tt.str)
+			require.Equal(t, tt.expected, options)
+
+			// the options are printed in the same format
+			parsed, err := WeightedVoteOptionsFromString(options.String())
+			require.NoError(t, err)
+			require.Equal(t, options, parsed)
+		}
+	}
+}
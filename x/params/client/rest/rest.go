@@ -35,7 +35,7 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 
 		content := proposal.NewParameterChangeProposal(req.Title, req.Description, req.Changes.ToParamChanges())
 
-		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
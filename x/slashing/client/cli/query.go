@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQueryDowntimeInfractions(),
 	)
 
 	return slashingQueryCmd
@@ -74,6 +76,86 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks of
+// a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query a validator's missed block bitmap and recent missed block heights",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the blocks missed by that validator in the signed blocks window:
+
+$ <appcli> query slashing missed-blocks cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress(pk.Address())}
+			res, err := queryClient.MissedBlocks(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDowntimeInfractions implements the command to query the downtime
+// infractions of a validator.
+func GetCmdQueryDowntimeInfractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-infractions [validator-conspub]",
+		Short: "Query a validator's downtime infractions within the lookback period",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime infractions escalating the penalties of that validator:
+
+$ <appcli> query slashing downtime-infractions cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDowntimeInfractionsRequest{ConsAddress: sdk.ConsAddress(pk.Address())}
+			res, err := queryClient.DowntimeInfractions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQuerySigningInfos implements the command to query signing infos.
 func GetCmdQuerySigningInfos() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,7 +22,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(
+		NewUnjailTxCmd(),
+		NewEnterMaintenanceTxCmd(),
+	)
 	return slashingTxCmd
 }
 
@@ -55,3 +60,42 @@ $ <appcli> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+func NewEnterMaintenanceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enter-maintenance [duration]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove validator from the active set for a planned maintenance window",
+		Long: `remove a validator from the active set for a planned maintenance window,
+without jailing it. The validator is let back into the active set once the
+window has ended:
+
+$ <appcli> tx slashing enter-maintenance 2h --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgEnterMaintenance(sdk.ValAddress(valAddr), duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,20 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		for _, missed := range array.MissedBlocks {
 			keeper.SetValidatorMissedBlockBitArray(ctx, address, missed.Index, missed.Missed)
 		}
+		for _, height := range array.MissedHeights {
+			keeper.SetValidatorMissedBlockHeight(ctx, address, height)
+		}
+	}
+
+	for _, maintenance := range data.Maintenances {
+		keeper.SetValidatorMaintenance(ctx, maintenance)
+		if maintenance.InMaintenance() {
+			keeper.InsertMaintenanceQueue(ctx, maintenance)
+		}
+	}
+
+	for _, infractions := range data.DowntimeInfractions {
+		keeper.SetValidatorDowntimeInfractions(ctx, infractions)
 	}
 
 	keeper.SetParams(ctx, data.Params)
@@ -55,12 +69,25 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data types.GenesisSta
 		localMissedBlocks := keeper.GetValidatorMissedBlocks(ctx, address)
 
 		missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
-			Address:      bechAddr,
-			MissedBlocks: localMissedBlocks,
+			Address:       bechAddr,
+			MissedBlocks:  localMissedBlocks,
+			MissedHeights: keeper.GetValidatorMissedBlockHeights(ctx, address),
 		})
 
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	maintenances := make([]types.ValidatorMaintenance, 0)
+	keeper.IterateValidatorMaintenances(ctx, func(maintenance types.ValidatorMaintenance) (stop bool) {
+		maintenances = append(maintenances, maintenance)
+		return false
+	})
+
+	downtimeInfractions := make([]types.ValidatorDowntimeInfractions, 0)
+	keeper.IterateValidatorDowntimeInfractions(ctx, func(infractions types.ValidatorDowntimeInfractions) (stop bool) {
+		downtimeInfractions = append(downtimeInfractions, infractions)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, maintenances, downtimeInfractions)
 }
@@ -1,6 +1,8 @@
 package slashing
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -16,6 +18,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
 
+		case *types.MsgEnterMaintenance:
+			return handleMsgEnterMaintenance(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -40,3 +45,27 @@ func handleMsgUnjail(ctx sdk.Context, msg *types.MsgUnjail, k keeper.Keeper) (*s
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+// Validators can submit a transaction to leave the active set for a planned
+// maintenance window without being jailed
+func handleMsgEnterMaintenance(ctx sdk.Context, msg *types.MsgEnterMaintenance, k keeper.Keeper) (*sdk.Result, error) {
+	endTime, err := k.EnterMaintenance(ctx, msg.ValidatorAddr, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStartMaintenance,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
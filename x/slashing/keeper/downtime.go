@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetValidatorDowntimeInfractions gets the downtime infraction history of a
+// validator
+func (k Keeper) GetValidatorDowntimeInfractions(ctx sdk.Context, consAddr sdk.ConsAddress) (infractions types.ValidatorDowntimeInfractions, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ValidatorDowntimeInfractionsKey(consAddr))
+	if bz == nil {
+		return infractions, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &infractions)
+
+	return infractions, true
+}
+
+// SetValidatorDowntimeInfractions sets the downtime infraction history of a
+// validator, or deletes it if it has no infraction
+func (k Keeper) SetValidatorDowntimeInfractions(ctx sdk.Context, infractions types.ValidatorDowntimeInfractions) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ValidatorDowntimeInfractionsKey(infractions.Address)
+
+	if len(infractions.Infractions) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&infractions)
+	store.Set(key, bz)
+}
+
+// IterateValidatorDowntimeInfractions iterates over the stored downtime
+// infraction histories
+func (k Keeper) IterateValidatorDowntimeInfractions(ctx sdk.Context,
+	handler func(infractions types.ValidatorDowntimeInfractions) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorDowntimeInfractionsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var infractions types.ValidatorDowntimeInfractions
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &infractions)
+		if handler(infractions) {
+			break
+		}
+	}
+}
+
+// GetRecentDowntimeInfractions returns the downtime infractions of a validator
+// within the downtime infraction lookback period, oldest first. Older
+// infractions have decayed and no longer escalate the penalties.
+func (k Keeper) GetRecentDowntimeInfractions(ctx sdk.Context, consAddr sdk.ConsAddress) []types.DowntimeInfraction {
+	recent := []types.DowntimeInfraction{}
+
+	infractions, found := k.GetValidatorDowntimeInfractions(ctx, consAddr)
+	if !found {
+		return recent
+	}
+
+	lookback := k.DowntimeInfractionLookback(ctx)
+	for _, infraction := range infractions.Infractions {
+		if infraction.Time.Add(lookback).After(ctx.BlockTime()) {
+			recent = append(recent, infraction)
+		}
+	}
+
+	return recent
+}
+
+// downtimePenalties returns the slash fraction and the jail duration of a new
+// downtime infraction of a validator. Both are multiplied by their multiplier
+// once per infraction of the validator within the lookback period. The slash
+// fraction is capped at the double sign slash fraction, and the jail duration
+// at MaxDowntimeJailDuration, unless the base penalties are already above them.
+func (k Keeper) downtimePenalties(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.Dec, time.Duration) {
+	previous := len(k.GetRecentDowntimeInfractions(ctx, consAddr))
+
+	baseSlashFraction := k.SlashFractionDowntime(ctx)
+	slashFraction := escalatePenalty(
+		baseSlashFraction, k.SlashFractionDowntimeMultiplier(ctx), previous,
+		sdk.MaxDec(baseSlashFraction, k.SlashFractionDoubleSign(ctx)),
+	)
+
+	baseJailDuration := sdk.NewDec(int64(k.DowntimeJailDuration(ctx)))
+	jailDuration := escalatePenalty(
+		baseJailDuration, k.DowntimeJailDurationMultiplier(ctx), previous,
+		sdk.MaxDec(baseJailDuration, sdk.NewDec(int64(types.MaxDowntimeJailDuration))),
+	)
+
+	return slashFraction, time.Duration(jailDuration.TruncateInt64())
+}
+
+// recordDowntimeInfraction adds a downtime infraction to the history of a
+// validator and drops its decayed infractions
+func (k Keeper) recordDowntimeInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, infraction types.DowntimeInfraction) {
+	infractions := append(k.GetRecentDowntimeInfractions(ctx, consAddr), infraction)
+	k.SetValidatorDowntimeInfractions(ctx, types.NewValidatorDowntimeInfractions(consAddr, infractions))
+}
+
+// escalatePenalty multiplies a penalty by the multiplier once per previous
+// infraction, up to the max penalty
+func escalatePenalty(penalty, multiplier sdk.Dec, previous int, max sdk.Dec) sdk.Dec {
+	for i := 0; i < previous && penalty.LT(max); i++ {
+		penalty = penalty.Mul(multiplier)
+	}
+
+	return sdk.MinDec(penalty, max)
+}
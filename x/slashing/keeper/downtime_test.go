@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestEscalatingDowntimePenalties(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0).UTC()})
+
+	params := keeper.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = 10 * time.Minute
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 2)
+	params.DowntimeInfractionLookback = 24 * time.Hour
+	params.DowntimeJailDurationMultiplier = sdk.NewDec(2)
+	params.SlashFractionDowntimeMultiplier = sdk.NewDec(3)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(100)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// miss blocks until the validator is jailed for downtime
+	missUntilJailed := func() {
+		for {
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), 100, false)
+			if app.StakingKeeper.Validator(ctx, addr).IsJailed() {
+				return
+			}
+		}
+	}
+
+	// the first infraction gets the base penalties
+	missUntilJailed()
+	infractions := app.SlashingKeeper.GetRecentDowntimeInfractions(ctx, consAddr)
+	require.Len(t, infractions, 1)
+	require.Equal(t, ctx.BlockHeight(), infractions[0].Height)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), infractions[0].SlashFraction)
+	require.Equal(t, 10*time.Minute, infractions[0].JailDuration)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), info.JailedUntil)
+
+	// a repeated infraction within the lookback period escalates both penalties
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	missUntilJailed()
+	infractions = app.SlashingKeeper.GetRecentDowntimeInfractions(ctx, consAddr)
+	require.Len(t, infractions, 2)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), infractions[1].SlashFraction)
+	require.Equal(t, 20*time.Minute, infractions[1].JailDuration)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(20*time.Minute), info.JailedUntil)
+
+	// infractions older than the lookback period decay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.DowntimeInfractionLookback))
+	require.Empty(t, app.SlashingKeeper.GetRecentDowntimeInfractions(ctx, consAddr))
+
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	missUntilJailed()
+	infractions = app.SlashingKeeper.GetRecentDowntimeInfractions(ctx, consAddr)
+	require.Len(t, infractions, 1)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), infractions[0].SlashFraction)
+	require.Equal(t, 10*time.Minute, infractions[0].JailDuration)
+
+	// the decayed infractions are dropped from the store
+	stored, found := app.SlashingKeeper.GetValidatorDowntimeInfractions(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewValidatorDowntimeInfractions(consAddr, infractions), stored)
+
+	// escalated penalties are capped by the double sign slash fraction and
+	// the max jail duration
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	repeated := make([]types.DowntimeInfraction, 40)
+	for i := range repeated {
+		repeated[i] = infractions[0]
+		repeated[i].Time = ctx.BlockTime()
+	}
+	app.SlashingKeeper.SetValidatorDowntimeInfractions(ctx, types.NewValidatorDowntimeInfractions(consAddr, repeated))
+
+	missUntilJailed()
+	infractions = app.SlashingKeeper.GetRecentDowntimeInfractions(ctx, consAddr)
+	require.Len(t, infractions, 41)
+	require.Equal(t, params.SlashFractionDoubleSign, infractions[40].SlashFraction)
+	require.Equal(t, types.MaxDowntimeJailDuration, infractions[40].JailDuration)
+}
@@ -67,3 +67,35 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasValidatorSigningInfo(ctx, req.ConsAddress) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	return k.getMissedBlocks(ctx, req.ConsAddress), nil
+}
+
+func (k Keeper) DowntimeInfractions(c context.Context, req *types.QueryDowntimeInfractionsRequest) (*types.QueryDowntimeInfractionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	infractions := k.GetRecentDowntimeInfractions(ctx, req.ConsAddress)
+
+	return &types.QueryDowntimeInfractionsResponse{Infractions: infractions}, nil
+}
@@ -105,6 +105,66 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+	ctx := suite.ctx.WithBlockHeight(1200)
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: nil})
+	suite.Error(err)
+
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress(sdk.AccAddress("unknown_____________"))})
+	suite.Error(err)
+
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, true)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 9, true)
+	for _, height := range []int64{100, 300, 1100} {
+		suite.app.SlashingKeeper.SetValidatorMissedBlockHeight(ctx, consAddr, height)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.SlashingKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: consAddr})
+	suite.NoError(err)
+	// the window of the test params is 1000 blocks
+	suite.Len(res.MissedBlockBitmap, 125)
+	suite.Equal(byte(0x04), res.MissedBlockBitmap[0])
+	suite.Equal(byte(0x02), res.MissedBlockBitmap[1])
+	// the height before the window is filtered out
+	suite.Equal([]int64{300, 1100}, res.MissedHeights)
+}
+
+func (suite *SlashingTestSuite) TestGRPCDowntimeInfractions() {
+	queryClient := suite.queryClient
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+
+	_, err := queryClient.DowntimeInfractions(gocontext.Background(), &types.QueryDowntimeInfractionsRequest{ConsAddress: nil})
+	suite.Error(err)
+
+	res, err := queryClient.DowntimeInfractions(gocontext.Background(), &types.QueryDowntimeInfractionsRequest{ConsAddress: consAddr})
+	suite.NoError(err)
+	suite.Empty(res.Infractions)
+
+	// the infraction older than the lookback period is not returned
+	now := time.Unix(0, 0).UTC().Add(keeper.TestParams().DowntimeInfractionLookback + time.Hour)
+	ctx := suite.ctx.WithBlockTime(now)
+	decayed := types.NewDowntimeInfraction(1, time.Unix(0, 0).UTC(), sdk.NewDecWithPrec(1, 2), time.Minute)
+	recent := types.NewDowntimeInfraction(2, now.Add(-time.Hour), sdk.NewDecWithPrec(1, 2), time.Minute)
+	suite.app.SlashingKeeper.SetValidatorDowntimeInfractions(ctx,
+		types.NewValidatorDowntimeInfractions(consAddr, []types.DowntimeInfraction{decayed, recent}))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.SlashingKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err = queryClient.DowntimeInfractions(gocontext.Background(), &types.QueryDowntimeInfractionsRequest{ConsAddress: consAddr})
+	suite.NoError(err)
+	suite.Equal([]types.DowntimeInfraction{recent}, res.Infractions)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -36,6 +36,30 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator rotates its consensus pubkey, add the address-pubkey
+// relation of the new key and carry the signing info and the downtime infraction
+// history of the old key over to it.
+// The old key keeps its signing info so that blocks signed with it are still
+// handled until the rotation completes.
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.AddPubkey(ctx, validator.GetConsPubKey())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	k.copyValidatorMissedBlockBitArray(ctx, oldConsAddr, newConsAddr)
+
+	if infractions, found := k.GetValidatorDowntimeInfractions(ctx, oldConsAddr); found {
+		infractions.Address = newConsAddr
+		k.SetValidatorDowntimeInfractions(ctx, infractions)
+	}
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -65,6 +89,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
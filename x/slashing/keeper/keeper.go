@@ -86,6 +86,30 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
 }
 
+// SlashWithReward attempts to slash a validator for an infraction of the given
+// reason, paying the ReporterRewardFraction of the slashed tokens to the
+// reporter of the infraction. The slash is delegated to the staking module to
+// make the necessary validator changes.
+func (k Keeper) SlashWithReward(
+	ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64,
+	reason string, reporter sdk.AccAddress,
+) {
+	reward := k.sk.SlashWithReward(
+		ctx, consAddr, distributionHeight, power, fraction, reporter, k.ReporterRewardFraction(ctx),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyReporter, reporter.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	)
+}
+
 // Jail attempts to jail a validator. The slash is delegated to the staking module
 // to make the necessary validator changes.
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
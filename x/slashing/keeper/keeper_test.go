@@ -318,3 +318,50 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that the signing info of a validator is carried over to its new
+// consensus pubkey while the old one keeps being handled
+func TestHandleValidatorSignatureAfterConsPubKeyRotation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, oldPk, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// miss a block with the old key
+	ctx = ctx.WithBlockHeight(1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), amt.Int64(), false)
+
+	res, err = sh(ctx, stakingtypes.NewMsgRotateConsPubKey(addr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	oldInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.True(t, found)
+	newInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.Equal(t, sdk.ConsAddress(newPk.Address()), newInfo.Address)
+	require.Equal(t, int64(1), newInfo.MissedBlocksCounter)
+	require.Equal(t, oldInfo.IndexOffset, newInfo.IndexOffset)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(newPk.Address()), 0))
+
+	_, err = app.SlashingKeeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+
+	// blocks are still signed with the old key until the rotation reaches Tendermint
+	ctx = ctx.WithBlockHeight(2)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), amt.Int64(), true)
+
+	oldInfo, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.True(t, found)
+	require.Equal(t, int64(2), oldInfo.IndexOffset)
+}
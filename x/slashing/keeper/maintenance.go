@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetValidatorMaintenance returns the ValidatorMaintenance of a validator
+func (k Keeper) GetValidatorMaintenance(ctx sdk.Context, valAddr sdk.ValAddress) (maintenance types.ValidatorMaintenance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorMaintenanceKey(valAddr))
+	if bz == nil {
+		return maintenance, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &maintenance)
+	return maintenance, true
+}
+
+// SetValidatorMaintenance sets the ValidatorMaintenance of a validator
+func (k Keeper) SetValidatorMaintenance(ctx sdk.Context, maintenance types.ValidatorMaintenance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&maintenance)
+	store.Set(types.ValidatorMaintenanceKey(maintenance.ValidatorAddress), bz)
+}
+
+// IterateValidatorMaintenances iterates over the stored ValidatorMaintenance
+func (k Keeper) IterateValidatorMaintenances(ctx sdk.Context,
+	handler func(maintenance types.ValidatorMaintenance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMaintenanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var maintenance types.ValidatorMaintenance
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &maintenance)
+		if handler(maintenance) {
+			break
+		}
+	}
+}
+
+// InsertMaintenanceQueue inserts the maintenance window of a validator into
+// the queue of maintenance windows by end time
+func (k Keeper) InsertMaintenanceQueue(ctx sdk.Context, maintenance types.ValidatorMaintenance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MaintenanceQueueKey(maintenance.EndTime, maintenance.ValidatorAddress), maintenance.ValidatorAddress)
+}
+
+// EnterMaintenance removes a bonded validator from the active set for the
+// given duration without jailing it, and returns the time the maintenance
+// window ends at. A validator can start at most MaxMaintenanceWindows windows
+// per MaintenanceEpoch, the epoch starting with the first window of the
+// validator once the previous epoch is over.
+func (k Keeper) EnterMaintenance(ctx sdk.Context, valAddr sdk.ValAddress, duration time.Duration) (time.Time, error) {
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return time.Time{}, types.ErrNoValidatorForAddress
+	}
+
+	if validator.IsJailed() {
+		return time.Time{}, types.ErrValidatorJailed
+	}
+
+	if validator.IsInMaintenance() {
+		return time.Time{}, types.ErrValidatorInMaintenance
+	}
+
+	if !validator.IsBonded() {
+		return time.Time{}, types.ErrValidatorNotBonded
+	}
+
+	if maxDuration := k.MaxMaintenanceDuration(ctx); duration > maxDuration {
+		return time.Time{}, sdkerrors.Wrapf(
+			types.ErrInvalidMaintenanceDuration, "got %s, expected at most %s", duration, maxDuration,
+		)
+	}
+
+	blockTime := ctx.BlockHeader().Time
+
+	maintenance, found := k.GetValidatorMaintenance(ctx, valAddr)
+	if !found || !blockTime.Before(maintenance.EpochStartTime.Add(k.MaintenanceEpoch(ctx))) {
+		maintenance = types.NewValidatorMaintenance(valAddr, time.Time{}, blockTime, 0)
+	}
+
+	if maintenance.Windows >= k.MaxMaintenanceWindows(ctx) {
+		return time.Time{}, types.ErrMaxMaintenanceWindows
+	}
+
+	maintenance.EndTime = blockTime.Add(duration)
+	maintenance.Windows++
+
+	k.SetValidatorMaintenance(ctx, maintenance)
+	k.InsertMaintenanceQueue(ctx, maintenance)
+	k.sk.StartMaintenance(ctx, validator.GetConsAddr())
+
+	return maintenance.EndTime, nil
+}
+
+// EndMatureMaintenances lets the validators whose maintenance window has
+// ended back into the active set
+func (k Keeper) EndMatureMaintenances(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+
+	iter := store.Iterator(
+		types.MaintenanceQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.MaintenanceQueueTimeKey(blockTime)),
+	)
+	defer iter.Close()
+
+	var (
+		keys     [][]byte
+		valAddrs []sdk.ValAddress
+	)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		valAddrs = append(valAddrs, sdk.ValAddress(iter.Value()))
+	}
+
+	for i, valAddr := range valAddrs {
+		store.Delete(keys[i])
+
+		maintenance, found := k.GetValidatorMaintenance(ctx, valAddr)
+		if !found {
+			panic("validator maintenance in queue not found")
+		}
+
+		maintenance.EndTime = time.Time{}
+		k.SetValidatorMaintenance(ctx, maintenance)
+
+		// the validator may have been removed during its maintenance window
+		validator := k.sk.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsInMaintenance() {
+			continue
+		}
+
+		k.sk.EndMaintenance(ctx, validator.GetConsAddr())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEndMaintenance,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestEnterMaintenance(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0).UTC()})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// an unbonded validator cannot enter maintenance
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(100)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.Equal(t, types.ErrValidatorNotBonded, err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the window cannot exceed the maximum maintenance duration
+	_, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, app.SlashingKeeper.MaxMaintenanceDuration(ctx)+time.Second)
+	require.True(t, types.ErrInvalidMaintenanceDuration.Is(err), err)
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), 100, false)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	endTime, err := app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), endTime)
+
+	_, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.Equal(t, types.ErrValidatorInMaintenance, err)
+
+	// the validator leaves the active set without being jailed
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	validator, found := app.StakingKeeper.GetValidator(ctx, addr)
+	require.True(t, found)
+	require.True(t, validator.InMaintenance)
+	require.False(t, validator.Jailed)
+	require.Equal(t, sdk.Unbonding, validator.Status)
+
+	// missed blocks are not counted during the maintenance window
+	ctx = ctx.WithBlockHeight(1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), 100, false)
+	newInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, info, newInfo)
+
+	// the validator is not let back before the end of the window
+	ctx = ctx.WithBlockTime(endTime.Add(-time.Second))
+	app.SlashingKeeper.EndMatureMaintenances(ctx)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addr)
+	require.True(t, validator.InMaintenance)
+
+	ctx = ctx.WithBlockTime(endTime)
+	app.SlashingKeeper.EndMatureMaintenances(ctx)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addr)
+	require.False(t, validator.InMaintenance)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	maintenance, found := app.SlashingKeeper.GetValidatorMaintenance(ctx, addr)
+	require.True(t, found)
+	require.False(t, maintenance.InMaintenance())
+	require.Equal(t, uint32(1), maintenance.Windows)
+
+	// the validator cannot start more windows than allowed per epoch
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	endTime, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(endTime)
+	app.SlashingKeeper.EndMatureMaintenances(ctx)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.Equal(t, types.ErrMaxMaintenanceWindows, err)
+
+	// a new epoch starts once the previous one is over
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC().Add(app.SlashingKeeper.MaintenanceEpoch(ctx)))
+	_, err = app.SlashingKeeper.EnterMaintenance(ctx, addr, time.Hour)
+	require.NoError(t, err)
+
+	maintenance, found = app.SlashingKeeper.GetValidatorMaintenance(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), maintenance.EpochStartTime)
+	require.Equal(t, uint32(1), maintenance.Windows)
+}
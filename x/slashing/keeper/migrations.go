@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateMissedBlockBitArrays moves the missed blocks stored with one key per
+// signed blocks window index to the chunked missed block bitmaps, and deletes
+// the per-index keys. It is meant to be called from the upgrade handler of the
+// upgrade introducing the bitmaps. The heights of the blocks missed before the
+// upgrade are not known, so they are not recorded.
+func (k Keeper) MigrateMissedBlockBitArrays(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKeyPrefix)
+
+	var keys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+
+		var missed gogotypes.BoolValue
+		k.cdc.MustUnmarshalBinaryBare(values[i], &missed)
+		if !missed.Value {
+			continue
+		}
+
+		addr := sdk.ConsAddress(key[1 : 1+sdk.AddrLen])
+		index := int64(binary.LittleEndian.Uint64(key[1+sdk.AddrLen:]))
+		k.SetValidatorMissedBlockBitArray(ctx, addr, index, true)
+	}
+}
+
+// MigrateParams sets the params added since the previous release, which don't
+// exist in the params store of the chains created before it. It is meant to be
+// called once from an x/upgrade handler.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	if !k.paramspace.Has(ctx, types.KeyMaxMaintenanceDuration) {
+		k.paramspace.Set(ctx, types.KeyMaxMaintenanceDuration, types.DefaultMaxMaintenanceDuration)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeyMaintenanceEpoch) {
+		k.paramspace.Set(ctx, types.KeyMaintenanceEpoch, types.DefaultMaintenanceEpoch)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeyMaxMaintenanceWindows) {
+		k.paramspace.Set(ctx, types.KeyMaxMaintenanceWindows, types.DefaultMaxMaintenanceWindows)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeyDowntimeInfractionLookback) {
+		k.paramspace.Set(ctx, types.KeyDowntimeInfractionLookback, types.DefaultDowntimeInfractionLookback)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeyDowntimeJailDurationMultiplier) {
+		k.paramspace.Set(ctx, types.KeyDowntimeJailDurationMultiplier, types.DefaultDowntimeJailDurationMultiplier)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeySlashFractionDowntimeMultiplier) {
+		k.paramspace.Set(ctx, types.KeySlashFractionDowntimeMultiplier, types.DefaultSlashFractionDowntimeMultiplier)
+	}
+
+	if !k.paramspace.Has(ctx, types.KeyReporterRewardFraction) {
+		k.paramspace.Set(ctx, types.KeyReporterRewardFraction, types.DefaultReporterRewardFraction)
+	}
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateMissedBlockBitArrays(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(200))
+	consAddr1, consAddr2 := sdk.ConsAddress(addrDels[0]), sdk.ConsAddress(addrDels[1])
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	setLegacy := func(addr sdk.ConsAddress, index int64, missed bool) {
+		bz := app.AppCodec().MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
+		store.Set(types.ValidatorMissedBlockBitArrayKey(addr, index), bz)
+	}
+
+	setLegacy(consAddr1, 0, true)
+	setLegacy(consAddr1, 1, false)
+	setLegacy(consAddr1, 50, true)
+	setLegacy(consAddr2, 7, true)
+
+	app.SlashingKeeper.MigrateMissedBlockBitArrays(ctx)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKeyPrefix)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	require.Equal(t, []types.MissedBlock{
+		types.NewMissedBlock(0, true),
+		types.NewMissedBlock(50, true),
+	}, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr1))
+	require.Equal(t, []types.MissedBlock{
+		types.NewMissedBlock(7, true),
+	}, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr2))
+}
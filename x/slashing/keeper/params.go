@@ -42,6 +42,53 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// MaxMaintenanceDuration - maximum duration of a maintenance window
+func (k Keeper) MaxMaintenanceDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxMaintenanceDuration, &res)
+	return
+}
+
+// MaintenanceEpoch - duration over which the maintenance windows of a
+// validator are limited
+func (k Keeper) MaintenanceEpoch(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaintenanceEpoch, &res)
+	return
+}
+
+// MaxMaintenanceWindows - maximum maintenance windows per maintenance epoch
+func (k Keeper) MaxMaintenanceWindows(ctx sdk.Context) (res uint32) {
+	k.paramspace.Get(ctx, types.KeyMaxMaintenanceWindows, &res)
+	return
+}
+
+// DowntimeInfractionLookback - period over which previous downtime infractions
+// escalate the penalties of a new one
+func (k Keeper) DowntimeInfractionLookback(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeInfractionLookback, &res)
+	return
+}
+
+// DowntimeJailDurationMultiplier - jail duration escalation factor per
+// previous downtime infraction
+func (k Keeper) DowntimeJailDurationMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationMultiplier, &res)
+	return
+}
+
+// SlashFractionDowntimeMultiplier - slash fraction escalation factor per
+// previous downtime infraction
+func (k Keeper) SlashFractionDowntimeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeMultiplier, &res)
+	return
+}
+
+// ReporterRewardFraction - fraction of the tokens slashed for evidence
+// submitted by a reporter which is paid to the reporter
+func (k Keeper) ReporterRewardFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyReporterRewardFraction, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
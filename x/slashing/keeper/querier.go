@@ -23,6 +23,12 @@ func NewQuerier(k Keeper, legacyQuerierCdc codec.JSONMarshaler) sdk.Querier {
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k, legacyQuerierCdc)
 
+		case types.QueryMissedBlocks:
+			return queryMissedBlocks(ctx, req, k, legacyQuerierCdc)
+
+		case types.QueryDowntimeInfractions:
+			return queryDowntimeInfractions(ctx, req, k, legacyQuerierCdc)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -90,3 +96,41 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQ
 
 	return res, nil
 }
+
+func queryMissedBlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryMissedBlocksRequest
+
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if !k.HasValidatorSigningInfo(ctx, params.ConsAddress) {
+		return nil, sdkerrors.Wrap(types.ErrNoSigningInfoFound, params.ConsAddress.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.getMissedBlocks(ctx, params.ConsAddress))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDowntimeInfractions(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryDowntimeInfractionsRequest
+
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	infractions := k.GetRecentDowntimeInfractions(ctx, params.ConsAddress)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, infractions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
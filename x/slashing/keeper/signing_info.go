@@ -3,8 +3,6 @@ package keeper
 import (
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -54,38 +52,71 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	}
 }
 
+// getValidatorMissedBlockBitmapChunk gets a chunk of the missed block bitmap of
+// a validator, or nil if it has no missed block
+func (k Keeper) getValidatorMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.ValidatorMissedBlockBitmapKey(address, chunkIndex))
+}
+
+// setValidatorMissedBlockBitmapChunk sets a chunk of the missed block bitmap of
+// a validator. Chunks without any missed block are deleted, so that only the
+// parts of the signed blocks window with missed blocks take up state.
+func (k Keeper) setValidatorMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64, chunk []byte) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ValidatorMissedBlockBitmapKey(address, chunkIndex)
+
+	for _, b := range chunk {
+		if b != 0 {
+			store.Set(key, chunk)
+			return
+		}
+	}
+
+	store.Delete(key)
+}
+
 // GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-	var missed gogotypes.BoolValue
-	if bz == nil {
-		// lazy: treat empty key as not missed
+	chunk := k.getValidatorMissedBlockBitmapChunk(ctx, address, index/types.MissedBlockBitmapChunkSize)
+	if chunk == nil {
+		// lazy: treat empty chunk as not missed
 		return false
 	}
-	k.cdc.MustUnmarshalBinaryBare(bz, &missed)
 
-	return missed.Value
+	bit := index % types.MissedBlockBitmapChunkSize
+	return chunk[bit/8]&(1<<uint(bit%8)) != 0
 }
 
-// IterateValidatorMissedBlockBitArray iterates over the signed blocks window
-// and performs a callback function
+// IterateValidatorMissedBlockBitArray iterates over the missed blocks of the
+// signed blocks window and performs a callback function
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed gogotypes.BoolValue
-		bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
+	window := k.SignedBlocksWindow(ctx)
+	prefix := types.ValidatorMissedBlockBitmapPrefixKey(address)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
 
-		k.cdc.MustUnmarshalBinaryBare(bz, &missed)
-		if handler(index, missed.Value) {
-			break
+	for ; iter.Valid(); iter.Next() {
+		chunkIndex := int64(sdk.BigEndianToUint64(iter.Key()[len(prefix):]))
+		chunk := iter.Value()
+
+		for bit := int64(0); bit < types.MissedBlockBitmapChunkSize; bit++ {
+			index := chunkIndex*types.MissedBlockBitmapChunkSize + bit
+			// bits past the window are left over from a larger window
+			if index >= window {
+				return
+			}
+
+			if chunk[bit/8]&(1<<uint(bit%8)) == 0 {
+				continue
+			}
+
+			if handler(index, true) {
+				return
+			}
 		}
 	}
 }
@@ -101,6 +132,84 @@ func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddres
 	return missedBlocks
 }
 
+// GetValidatorMissedBlockBitmap returns the missed block bitmap of the signed
+// blocks window of a validator, where the bit of each window index is set if
+// the validator missed the block at this index
+func (k Keeper) GetValidatorMissedBlockBitmap(ctx sdk.Context, address sdk.ConsAddress) []byte {
+	bitmap := make([]byte, (k.SignedBlocksWindow(ctx)+7)/8)
+	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
+		bitmap[index/8] |= 1 << uint(index%8)
+		return false
+	})
+
+	return bitmap
+}
+
+// getMissedBlocks returns the missed block bitmap of a validator along with the
+// heights of the blocks it missed in the last signed blocks window
+func (k Keeper) getMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) *types.QueryMissedBlocksResponse {
+	minHeight := ctx.BlockHeight() - k.SignedBlocksWindow(ctx)
+
+	heights := []int64{}
+	for _, height := range k.GetValidatorMissedBlockHeights(ctx, address) {
+		if height > minHeight {
+			heights = append(heights, height)
+		}
+	}
+
+	return &types.QueryMissedBlocksResponse{
+		MissedBlockBitmap: k.GetValidatorMissedBlockBitmap(ctx, address),
+		MissedHeights:     heights,
+	}
+}
+
+// SetValidatorMissedBlockHeight records the height of a block missed by a
+// validator
+func (k Keeper) SetValidatorMissedBlockHeight(ctx sdk.Context, address sdk.ConsAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorMissedBlockHeightKey(address, height), []byte{})
+}
+
+// GetValidatorMissedBlockHeights returns the recorded heights of the blocks
+// missed by a validator, in ascending order
+func (k Keeper) GetValidatorMissedBlockHeights(ctx sdk.Context, address sdk.ConsAddress) []int64 {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ValidatorMissedBlockHeightPrefixKey(address)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	heights := []int64{}
+	for ; iter.Valid(); iter.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(iter.Key()[len(prefix):])))
+	}
+
+	return heights
+}
+
+// pruneValidatorMissedBlockHeights deletes the heights of the blocks missed by
+// a validator up to the given height
+func (k Keeper) pruneValidatorMissedBlockHeights(ctx sdk.Context, address sdk.ConsAddress, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.ValidatorMissedBlockHeightPrefixKey(address),
+		types.ValidatorMissedBlockHeightKey(address, height+1),
+	)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // JailUntil attempts to set a validator's JailedUntil attribute in its signing
 // info. It will panic if the signing info does not exist for the validator.
 func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
@@ -142,17 +251,65 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
 // missed a block in the current window
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.ValidatorMissedBlockBitArrayKey(address, index), bz)
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	chunk := make([]byte, types.MissedBlockBitmapChunkSize/8)
+	copy(chunk, k.getValidatorMissedBlockBitmapChunk(ctx, address, chunkIndex))
+
+	bit := index % types.MissedBlockBitmapChunkSize
+	if missed {
+		chunk[bit/8] |= 1 << uint(bit%8)
+	} else {
+		chunk[bit/8] &^= 1 << uint(bit%8)
+	}
+
+	k.setValidatorMissedBlockBitmapChunk(ctx, address, chunkIndex, chunk)
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
-func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
+// copyValidatorMissedBlockBitArray copies the missed block bitmap and the
+// missed block heights of a validator to another consensus address.
+func (k Keeper) copyValidatorMissedBlockBitArray(ctx sdk.Context, from, to sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(address))
+
+	prefix := types.ValidatorMissedBlockBitmapPrefixKey(from)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
 	defer iter.Close()
+
+	chunks := make(map[int64][]byte)
+	var chunkIndexes []int64
 	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+		chunkIndex := int64(sdk.BigEndianToUint64(iter.Key()[len(prefix):]))
+		chunks[chunkIndex] = iter.Value()
+		chunkIndexes = append(chunkIndexes, chunkIndex)
+	}
+
+	for _, chunkIndex := range chunkIndexes {
+		k.setValidatorMissedBlockBitmapChunk(ctx, to, chunkIndex, chunks[chunkIndex])
+	}
+
+	for _, height := range k.GetValidatorMissedBlockHeights(ctx, from) {
+		k.SetValidatorMissedBlockHeight(ctx, to, height)
+	}
+}
+
+// clearValidatorMissedBlockBitArray deletes the missed block bitmap and the
+// missed block heights of a validator
+func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{
+		types.ValidatorMissedBlockBitmapPrefixKey(address),
+		types.ValidatorMissedBlockHeightPrefixKey(address),
+	} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
 	}
 }
@@ -49,6 +49,51 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	require.True(t, missed) // now should be missed
 }
 
+func TestValidatorMissedBlockBitmapChunks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	consAddr := sdk.ConsAddress(addrDels[0])
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 3000
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	indexes := []int64{0, 1023, 1024, 2999}
+	for _, index := range indexes {
+		app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+
+	for _, index := range indexes {
+		require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, index))
+	}
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+	require.Equal(t, []types.MissedBlock{
+		types.NewMissedBlock(0, true),
+		types.NewMissedBlock(1023, true),
+		types.NewMissedBlock(1024, true),
+		types.NewMissedBlock(2999, true),
+	}, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr))
+
+	bitmap := app.SlashingKeeper.GetValidatorMissedBlockBitmap(ctx, consAddr)
+	require.Len(t, bitmap, 375)
+	require.Equal(t, byte(0x01), bitmap[0])
+	require.Equal(t, byte(0x80), bitmap[127])
+	require.Equal(t, byte(0x01), bitmap[128])
+	require.Equal(t, byte(0x80), bitmap[374])
+
+	// a chunk without missed blocks is deleted
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1024, false)
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1024))
+	require.False(t, store.Has(types.ValidatorMissedBlockBitmapKey(consAddr, 1)))
+
+	// missed blocks past a smaller window are ignored
+	params.SignedBlocksWindow = 1024
+	app.SlashingKeeper.SetParams(ctx, params)
+	require.Len(t, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr), 2)
+}
+
 func TestTombstoned(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
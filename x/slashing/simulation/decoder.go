@@ -7,6 +7,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -22,18 +23,35 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &infoB)
 			return fmt.Sprintf("%v\n%v", infoA, infoB)
 
-		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitArrayKeyPrefix):
-			var missedA, missedB gogotypes.BoolValue
-			cdc.MustUnmarshalBinaryBare(kvA.Value, &missedA)
-			cdc.MustUnmarshalBinaryBare(kvB.Value, &missedB)
-			return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA.Value, missedB.Value)
-
 		case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKeyPrefix):
 			var pubKeyA, pubKeyB gogotypes.StringValue
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &pubKeyA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &pubKeyB)
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA.Value, pubKeyB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMaintenanceKeyPrefix):
+			var maintenanceA, maintenanceB types.ValidatorMaintenance
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &maintenanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &maintenanceB)
+			return fmt.Sprintf("%v\n%v", maintenanceA, maintenanceB)
+
+		case bytes.Equal(kvA.Key[:1], types.MaintenanceQueueKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitmapKeyPrefix):
+			return fmt.Sprintf("bitmapA: %X\nbitmapB: %X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockHeightKeyPrefix):
+			heightA := sdk.BigEndianToUint64(kvA.Key[1+sdk.AddrLen:])
+			heightB := sdk.BigEndianToUint64(kvB.Key[1+sdk.AddrLen:])
+			return fmt.Sprintf("heightA: %d\nheightB: %d", heightA, heightB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorDowntimeInfractionsKeyPrefix):
+			var infractionsA, infractionsB types.ValidatorDowntimeInfractions
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &infractionsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &infractionsB)
+			return fmt.Sprintf("%v\n%v", infractionsA, infractionsB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -30,11 +30,12 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, delPk1)
-	missed := gogotypes.BoolValue{Value: true}
+	bitmap := []byte{0x40}
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryBare(&info)},
-		kv.Pair{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryBare(&missed)},
+		kv.Pair{Key: types.ValidatorMissedBlockBitmapKey(consAddr1, 0), Value: bitmap},
+		kv.Pair{Key: types.ValidatorMissedBlockHeightKey(consAddr1, 6), Value: []byte{}},
 		kv.Pair{Key: types.AddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: bechPK})},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -44,7 +45,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
-		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value)},
+		{"ValidatorMissedBlockBitmap", fmt.Sprintf("bitmapA: %X\nbitmapB: %X", bitmap, bitmap)},
+		{"ValidatorMissedBlockHeight", "heightA: 6\nheightB: 6"},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
 		{"other", ""},
 	}
@@ -22,6 +22,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +52,16 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeJailDurationMultiplier randomized DowntimeJailDurationMultiplier
+func GenDowntimeJailDurationMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(21)), 1))
+}
+
+// GenSlashFractionDowntimeMultiplier randomized SlashFractionDowntimeMultiplier
+func GenSlashFractionDowntimeMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(11)), 1))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,12 +94,30 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeJailDurationMultiplier(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenSlashFractionDowntimeMultiplier(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		types.DefaultMaxMaintenanceDuration, types.DefaultMaintenanceEpoch, types.DefaultMaxMaintenanceWindows,
+		types.DefaultDowntimeInfractionLookback, downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier,
+		types.DefaultReporterRewardFraction,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(
+		params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.ValidatorMaintenance{},
+		[]types.ValidatorDowntimeInfractions{},
+	)
 
 	fmt.Printf("Selected randomly generated slashing parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, slashingGenesis.Params))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(slashingGenesis)
@@ -3,6 +3,7 @@ package simulation
 import (
 	"errors"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,7 +19,8 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgUnjail = "op_weight_msg_unjail"
+	OpWeightMsgUnjail           = "op_weight_msg_unjail"
+	OpWeightMsgEnterMaintenance = "op_weight_msg_enter_maintenance"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -34,11 +36,22 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgEnterMaintenance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgEnterMaintenance, &weightMsgEnterMaintenance, nil,
+		func(_ *rand.Rand) {
+			weightMsgEnterMaintenance = simappparams.DefaultWeightMsgEnterMaintenance
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgUnjail,
 			SimulateMsgUnjail(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgEnterMaintenance,
+			SimulateMsgEnterMaintenance(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -132,3 +145,71 @@ func SimulateMsgUnjail(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Kee
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgEnterMaintenance generates a MsgEnterMaintenance with random values
+// nolint: interfacer
+func SimulateMsgEnterMaintenance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "validator is not ok"), nil, nil // skip
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "unable to find account"), nil, nil // skip
+		}
+
+		if validator.IsJailed() || validator.IsInMaintenance() || !validator.IsBonded() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "validator is not bonded or in the active set"), nil, nil // skip
+		}
+
+		maxDuration := k.MaxMaintenanceDuration(ctx)
+		if maxDuration <= 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "maintenance is disabled"), nil, nil // skip
+		}
+
+		maintenance, found := k.GetValidatorMaintenance(ctx, validator.GetOperator())
+		if found && ctx.BlockHeader().Time.Before(maintenance.EpochStartTime.Add(k.MaintenanceEpoch(ctx))) &&
+			maintenance.Windows >= k.MaxMaintenanceWindows(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "no maintenance window left in the epoch"), nil, nil // skip
+		}
+
+		account := ak.GetAccount(ctx, sdk.AccAddress(validator.GetOperator()))
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnterMaintenance, "unable to generate fees"), nil, err
+		}
+
+		duration := time.Duration(simtypes.RandIntBetween(r, 1, int(maxDuration/time.Second)+1)) * time.Second
+		msg := types.NewMsgEnterMaintenance(validator.GetOperator(), duration)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
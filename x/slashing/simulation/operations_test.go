@@ -35,7 +35,10 @@ func TestWeightedOperations(t *testing.T) {
 		weight     int
 		opMsgRoute string
 		opMsgName  string
-	}{{simappparams.DefaultWeightMsgUnjail, types.ModuleName, types.TypeMsgUnjail}}
+	}{
+		{simappparams.DefaultWeightMsgUnjail, types.ModuleName, types.TypeMsgUnjail},
+		{simappparams.DefaultWeightMsgEnterMaintenance, types.ModuleName, types.TypeMsgEnterMaintenance},
+	}
 
 	weightesOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.SlashingKeeper, app.StakingKeeper)
 	for i, w := range weightesOps {
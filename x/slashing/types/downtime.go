@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDowntimeInfraction creates a new DowntimeInfraction instance
+func NewDowntimeInfraction(
+	height int64, infractionTime time.Time, slashFraction sdk.Dec, jailDuration time.Duration,
+) DowntimeInfraction {
+
+	return DowntimeInfraction{
+		Height:        height,
+		Time:          infractionTime,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// NewValidatorDowntimeInfractions creates a new ValidatorDowntimeInfractions
+// instance
+func NewValidatorDowntimeInfractions(
+	consAddr sdk.ConsAddress, infractions []DowntimeInfraction,
+) ValidatorDowntimeInfractions {
+
+	return ValidatorDowntimeInfractions{
+		Address:     consAddr,
+		Infractions: infractions,
+	}
+}
@@ -13,4 +13,8 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 6, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
+	ErrValidatorNotBonded           = sdkerrors.Register(ModuleName, 9, "validator not bonded; cannot enter maintenance")
+	ErrValidatorInMaintenance       = sdkerrors.Register(ModuleName, 10, "validator already in maintenance")
+	ErrInvalidMaintenanceDuration   = sdkerrors.Register(ModuleName, 11, "invalid maintenance duration")
+	ErrMaxMaintenanceWindows        = sdkerrors.Register(ModuleName, 12, "too many maintenance windows in the current maintenance epoch")
 )
@@ -6,14 +6,23 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeStartMaintenance = "start_maintenance"
+	EventTypeEndMaintenance   = "end_maintenance"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyEndTime      = "end_time"
+	AttributeKeyReporter     = "reporter"
+	AttributeKeyReward       = "reward"
 
-	AttributeValueDoubleSign       = "double_sign"
-	AttributeValueMissingSignature = "missing_signature"
-	AttributeValueCategory         = ModuleName
+	AttributeValueDoubleSign        = "double_sign"
+	AttributeValueMissingSignature  = "missing_signature"
+	AttributeValueLightClientAttack = "light_client_attack"
+	AttributeValueAmnesia           = "amnesia"
+	AttributeValueCategory          = ModuleName
 )
@@ -10,12 +10,15 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	maintenances []ValidatorMaintenance, downtimeInfractions []ValidatorDowntimeInfractions,
 ) GenesisState {
 
 	return GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:              params,
+		SigningInfos:        signingInfos,
+		MissedBlocks:        missedBlocks,
+		Maintenances:        maintenances,
+		DowntimeInfractions: downtimeInfractions,
 	}
 }
 
@@ -30,9 +33,11 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:              DefaultParams(),
+		SigningInfos:        []SigningInfo{},
+		MissedBlocks:        []ValidatorMissedBlocks{},
+		Maintenances:        []ValidatorMaintenance{},
+		DowntimeInfractions: []ValidatorDowntimeInfractions{},
 	}
 }
 
@@ -63,5 +68,41 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateMaxMaintenanceDuration(data.Params.MaxMaintenanceDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceEpoch(data.Params.MaintenanceEpoch); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeInfractionLookback(data.Params.DowntimeInfractionLookback); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailDurationMultiplier(data.Params.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDowntimeMultiplier(data.Params.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateReporterRewardFraction(data.Params.ReporterRewardFraction); err != nil {
+		return err
+	}
+
+	for _, maintenance := range data.Maintenances {
+		if maintenance.ValidatorAddress.Empty() {
+			return fmt.Errorf("validator maintenance has an empty validator address")
+		}
+	}
+
+	for _, infractions := range data.DowntimeInfractions {
+		if infractions.Address.Empty() {
+			return fmt.Errorf("validator downtime infractions have an empty address")
+		}
+	}
+
 	return nil
 }
@@ -25,9 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - all slashing state that must be provided at genesis
 type GenesisState struct {
-	Params       Params                  `protobuf:"bytes,1,opt,name=params,proto3,casttype=Params" json:"params"`
-	SigningInfos []SigningInfo           `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
-	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	Params              Params                         `protobuf:"bytes,1,opt,name=params,proto3,casttype=Params" json:"params"`
+	SigningInfos        []SigningInfo                  `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks        []ValidatorMissedBlocks        `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	Maintenances        []ValidatorMaintenance         `protobuf:"bytes,4,rep,name=maintenances,proto3" json:"maintenances"`
+	DowntimeInfractions []ValidatorDowntimeInfractions `protobuf:"bytes,5,rep,name=downtime_infractions,json=downtimeInfractions,proto3" json:"downtime_infractions" yaml:"downtime_infractions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,20 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetMaintenances() []ValidatorMaintenance {
+	if m != nil {
+		return m.Maintenances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDowntimeInfractions() []ValidatorDowntimeInfractions {
+	if m != nil {
+		return m.DowntimeInfractions
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address
 type SigningInfo struct {
 	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -141,6 +157,9 @@ func (m *SigningInfo) GetValidatorSigningInfo() ValidatorSigningInfo {
 type ValidatorMissedBlocks struct {
 	Address      string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	MissedBlocks []MissedBlock `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// missed_heights are the recorded heights of the blocks missed by the
+	// validator
+	MissedHeights []int64 `protobuf:"varint,3,rep,packed,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty" yaml:"missed_heights"`
 }
 
 func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
@@ -190,6 +209,13 @@ func (m *ValidatorMissedBlocks) GetMissedBlocks() []MissedBlock {
 	return nil
 }
 
+func (m *ValidatorMissedBlocks) GetMissedHeights() []int64 {
+	if m != nil {
+		return m.MissedHeights
+	}
+	return nil
+}
+
 // MissedBlock contains height and missed status as boolean
 type MissedBlock struct {
 	Index  int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -253,34 +279,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/slashing/genesis.proto", fileDescriptor_4742afabdd32b41b) }
 
 var fileDescriptor_4742afabdd32b41b = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xb4, 0x18, 0x75, 0xd2, 0x55, 0x18, 0xe2, 0x1a, 0x96, 0xdd, 0x74, 0x09, 0xac, 0xec,
-	0xa5, 0x09, 0xd4, 0x9b, 0x1e, 0x84, 0x5c, 0x8a, 0x07, 0x41, 0x52, 0xf0, 0xe0, 0x25, 0x4c, 0x9b,
-	0x34, 0x1d, 0x9a, 0x64, 0x4a, 0xbe, 0x58, 0xda, 0xab, 0xbf, 0x40, 0xbc, 0xfa, 0x17, 0xfc, 0x21,
-	0x3d, 0xf6, 0xe8, 0xa9, 0x48, 0xfb, 0x0f, 0x3c, 0x7a, 0x92, 0xce, 0x24, 0x34, 0x4d, 0x5b, 0x61,
-	0x4f, 0x9d, 0x8f, 0xf7, 0xbe, 0xf7, 0x5e, 0x5f, 0x3e, 0x7c, 0x33, 0xe4, 0x90, 0x70, 0x70, 0x20,
-	0xa6, 0x30, 0x66, 0x69, 0xe4, 0x44, 0x61, 0x1a, 0x02, 0x03, 0x7b, 0x9a, 0xf1, 0x9c, 0x93, 0xe7,
-	0x12, 0xb6, 0x4b, 0xf8, 0x4a, 0x8f, 0x78, 0xc4, 0x05, 0xe6, 0xec, 0x5e, 0x92, 0x76, 0x65, 0xd6,
-	0x55, 0xca, 0x87, 0xc4, 0xad, 0x1f, 0x0d, 0xdc, 0xea, 0x49, 0xe1, 0x7e, 0x4e, 0xf3, 0x90, 0xbc,
-	0xc3, 0xea, 0x94, 0x66, 0x34, 0x01, 0x03, 0xdd, 0xa2, 0x7b, 0xad, 0xfb, 0xd2, 0xae, 0x19, 0xd9,
-	0x1f, 0x05, 0xec, 0x3e, 0x5b, 0xae, 0xdb, 0xca, 0xdf, 0x75, 0x5b, 0x95, 0xb3, 0x57, 0xac, 0x11,
-	0x1f, 0x5f, 0x00, 0x8b, 0x52, 0x96, 0x46, 0x3e, 0x4b, 0x47, 0x1c, 0x8c, 0xc6, 0x6d, 0xf3, 0x5e,
-	0xeb, 0x5e, 0x1f, 0xe9, 0xf4, 0x25, 0xeb, 0x7d, 0x3a, 0xe2, 0xee, 0xf5, 0x4e, 0xec, 0xcf, 0xba,
-	0xad, 0x2f, 0x68, 0x12, 0xbf, 0xb1, 0x0e, 0x04, 0x2c, 0xaf, 0x05, 0x7b, 0x2a, 0x10, 0x86, 0x2f,
-	0x12, 0x06, 0x10, 0x06, 0xfe, 0x20, 0xe6, 0xc3, 0x09, 0x18, 0x4d, 0x61, 0xf0, 0xea, 0xc8, 0xe0,
-	0x13, 0x8d, 0x59, 0x40, 0x73, 0x9e, 0x7d, 0x10, 0x74, 0x57, 0xb0, 0xeb, 0x56, 0x07, 0x52, 0x96,
-	0xd7, 0x4a, 0x2a, 0x5c, 0xeb, 0x27, 0xc2, 0x5a, 0x25, 0x26, 0x31, 0xf0, 0x63, 0x1a, 0x04, 0x59,
-	0x08, 0xb2, 0x9d, 0xa7, 0x5e, 0x39, 0x92, 0xaf, 0x08, 0x5f, 0xce, 0x4a, 0x3f, 0xbf, 0x9a, 0xdf,
-	0x68, 0x88, 0x1e, 0xef, 0xce, 0xc7, 0xab, 0x16, 0x71, 0x57, 0xa4, 0xbb, 0x91, 0xe9, 0x4e, 0x4b,
-	0x5a, 0x9e, 0x3e, 0x3b, 0xb1, 0x6c, 0x7d, 0x47, 0xf8, 0xc5, 0xc9, 0x3f, 0xfd, 0x9f, 0xe0, 0x7e,
-	0xbd, 0xcd, 0x73, 0x9f, 0xab, 0xa2, 0xf7, 0xa0, 0x0e, 0xdf, 0x62, 0xad, 0xb2, 0x4a, 0x74, 0xfc,
-	0x88, 0xa5, 0x41, 0x38, 0x17, 0x39, 0x9a, 0x9e, 0x1c, 0xc8, 0x25, 0x56, 0xe5, 0x92, 0x68, 0xeb,
-	0x89, 0x57, 0x4c, 0x6e, 0x6f, 0xb9, 0x31, 0xd1, 0x6a, 0x63, 0xa2, 0xdf, 0x1b, 0x13, 0x7d, 0xdb,
-	0x9a, 0xca, 0x6a, 0x6b, 0x2a, 0xbf, 0xb6, 0xa6, 0xf2, 0xb9, 0x13, 0xb1, 0x7c, 0xfc, 0x65, 0x60,
-	0x0f, 0x79, 0xe2, 0x14, 0x37, 0x2e, 0x7f, 0x3a, 0x10, 0x4c, 0x9c, 0xf9, 0xfe, 0xe0, 0xf3, 0xc5,
-	0x34, 0x84, 0x81, 0x2a, 0xce, 0xfd, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x5e, 0xb8,
-	0x08, 0x56, 0x03, 0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0xd3, 0x3e,
+	0x14, 0xaf, 0xd7, 0xad, 0xdf, 0x2f, 0x6e, 0x37, 0x24, 0x93, 0x8d, 0x30, 0xb6, 0xb4, 0x0a, 0x1a,
+	0xda, 0xa5, 0x89, 0x34, 0x6e, 0x70, 0x00, 0x45, 0x48, 0x63, 0x07, 0x04, 0xf2, 0x24, 0x0e, 0x5c,
+	0x22, 0x37, 0xf1, 0x52, 0x6b, 0x8d, 0x5d, 0xc5, 0x66, 0x6c, 0x57, 0x24, 0xee, 0xfc, 0x21, 0xfc,
+	0x21, 0x3b, 0xee, 0xc0, 0x81, 0x53, 0x85, 0xda, 0xff, 0x60, 0xe2, 0xc4, 0x09, 0xd5, 0x76, 0xd5,
+	0xf4, 0xc7, 0x2a, 0x71, 0x8a, 0x9f, 0xde, 0xe7, 0xc7, 0x7b, 0x7e, 0x2f, 0x86, 0xfb, 0x89, 0x90,
+	0xb9, 0x90, 0xa1, 0xec, 0x11, 0xd9, 0x65, 0x3c, 0x0b, 0x33, 0xca, 0xa9, 0x64, 0x32, 0xe8, 0x17,
+	0x42, 0x09, 0x74, 0xdf, 0xa4, 0x83, 0x49, 0x7a, 0xd7, 0xc9, 0x44, 0x26, 0x74, 0x2e, 0x1c, 0x9f,
+	0x0c, 0x6c, 0xd7, 0x9b, 0x57, 0x99, 0x1c, 0x4c, 0xde, 0xff, 0x5d, 0x85, 0x8d, 0x63, 0x23, 0x7c,
+	0xaa, 0x88, 0xa2, 0xe8, 0x25, 0xac, 0xf5, 0x49, 0x41, 0x72, 0xe9, 0x82, 0x16, 0x38, 0xac, 0x1f,
+	0x3d, 0x0c, 0xe6, 0x8c, 0x82, 0xf7, 0x3a, 0x1d, 0x6d, 0x5d, 0x0f, 0x9a, 0x95, 0x3f, 0x83, 0x66,
+	0xcd, 0xc4, 0xd8, 0xd2, 0x50, 0x0c, 0x37, 0x25, 0xcb, 0x38, 0xe3, 0x59, 0xcc, 0xf8, 0x99, 0x90,
+	0xee, 0x5a, 0xab, 0x7a, 0x58, 0x3f, 0xda, 0x5b, 0xd0, 0x39, 0x35, 0xa8, 0x13, 0x7e, 0x26, 0xa2,
+	0xbd, 0xb1, 0xd8, 0xed, 0xa0, 0xe9, 0x5c, 0x91, 0xbc, 0xf7, 0xdc, 0x9f, 0x11, 0xf0, 0x71, 0x43,
+	0x4e, 0xa1, 0x12, 0x31, 0xb8, 0x99, 0x33, 0x29, 0x69, 0x1a, 0x77, 0x7a, 0x22, 0x39, 0x97, 0x6e,
+	0x55, 0x1b, 0x3c, 0x5d, 0x30, 0xf8, 0x40, 0x7a, 0x2c, 0x25, 0x4a, 0x14, 0x6f, 0x35, 0x3c, 0xd2,
+	0xe8, 0x79, 0xab, 0x19, 0x29, 0x1f, 0x37, 0xf2, 0x12, 0x16, 0xbd, 0x83, 0x8d, 0x9c, 0x30, 0xae,
+	0x28, 0x27, 0x3c, 0xa1, 0xd2, 0x5d, 0xd7, 0x4e, 0x07, 0x2b, 0x9c, 0xa6, 0xe8, 0x68, 0x7d, 0x6c,
+	0x84, 0x67, 0x04, 0xd0, 0x57, 0x00, 0x9d, 0x54, 0x7c, 0xe6, 0x8a, 0xe5, 0x74, 0xdc, 0x5d, 0x41,
+	0x12, 0xc5, 0x04, 0x97, 0xee, 0x86, 0x56, 0x6e, 0xdf, 0xad, 0xfc, 0xda, 0xb2, 0x4e, 0xa6, 0xa4,
+	0xe8, 0x89, 0x6d, 0xe5, 0xb1, 0x69, 0x65, 0x99, 0xb0, 0x8f, 0x1f, 0xa4, 0x8b, 0x4c, 0xff, 0x3b,
+	0x80, 0xf5, 0xd2, 0xfd, 0x23, 0x17, 0xfe, 0x47, 0xd2, 0xb4, 0xa0, 0xd2, 0x8c, 0xfd, 0x1e, 0x9e,
+	0x84, 0xe8, 0x0b, 0x80, 0x3b, 0x17, 0x93, 0x22, 0xe2, 0xf2, 0x60, 0xdc, 0xb5, 0x16, 0x58, 0x7d,
+	0x1b, 0xe5, 0x09, 0x1f, 0xd8, 0x5a, 0xf7, 0x4d, 0xad, 0xcb, 0x25, 0x7d, 0xec, 0x5c, 0x2c, 0x21,
+	0xfb, 0x3f, 0x00, 0xdc, 0x5e, 0x3a, 0xcd, 0x15, 0x85, 0xc7, 0xf3, 0x6b, 0x72, 0xd7, 0x1e, 0x96,
+	0xf4, 0xfe, 0x69, 0x39, 0x5e, 0xc1, 0x2d, 0x9b, 0xef, 0x52, 0x96, 0x75, 0x95, 0x59, 0xc4, 0x6a,
+	0xf4, 0xe8, 0x76, 0xd0, 0xdc, 0x9e, 0xe1, 0xdb, 0xbc, 0x8f, 0x6d, 0x45, 0x6f, 0x6c, 0xfc, 0x02,
+	0xd6, 0x4b, 0xe6, 0xc8, 0x81, 0x1b, 0x8c, 0xa7, 0xf4, 0x52, 0x77, 0x52, 0xc5, 0x26, 0x40, 0x3b,
+	0xb0, 0x66, 0x58, 0xfa, 0xbe, 0xff, 0xc7, 0x36, 0x8a, 0x8e, 0xaf, 0x87, 0x1e, 0xb8, 0x19, 0x7a,
+	0xe0, 0xd7, 0xd0, 0x03, 0xdf, 0x46, 0x5e, 0xe5, 0x66, 0xe4, 0x55, 0x7e, 0x8e, 0xbc, 0xca, 0xc7,
+	0x76, 0xc6, 0x54, 0xf7, 0x53, 0x27, 0x48, 0x44, 0x1e, 0xda, 0xdf, 0xdf, 0x7c, 0xda, 0x32, 0x3d,
+	0x0f, 0x2f, 0xa7, 0x6f, 0x81, 0xba, 0xea, 0x53, 0xd9, 0xa9, 0xe9, 0x97, 0xe0, 0xd9, 0xdf, 0x01,
+	0x00, 0x94, 0x4a, 0x09, 0xc3, 0x71, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -303,6 +335,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeInfractions) > 0 {
+		for iNdEx := len(m.DowntimeInfractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeInfractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Maintenances) > 0 {
+		for iNdEx := len(m.Maintenances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Maintenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -404,6 +464,25 @@ func (m *ValidatorMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MissedHeights) > 0 {
+		dAtA4 := make([]byte, len(m.MissedHeights)*10)
+		var j3 int
+		for _, num1 := range m.MissedHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -497,6 +576,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Maintenances) > 0 {
+		for _, e := range m.Maintenances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DowntimeInfractions) > 0 {
+		for _, e := range m.DowntimeInfractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -531,6 +622,13 @@ func (m *ValidatorMissedBlocks) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MissedHeights) > 0 {
+		l = 0
+		for _, e := range m.MissedHeights {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -685,6 +783,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintenances = append(m.Maintenances, ValidatorMaintenance{})
+			if err := m.Maintenances[len(m.Maintenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeInfractions = append(m.DowntimeInfractions, ValidatorDowntimeInfractions{})
+			if err := m.DowntimeInfractions[len(m.DowntimeInfractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -922,6 +1088,82 @@ func (m *ValidatorMissedBlocks) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedHeights = append(m.MissedHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedHeights) == 0 {
+					m.MissedHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedHeights = append(m.MissedHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +19,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize is the number of signed blocks window indexes
+	// stored in a single chunk of a missed block bitmap
+	MissedBlockBitmapChunkSize = 1024
 )
 
 // Keys for slashing store
@@ -25,13 +30,28 @@ const (
 //
 // - 0x01<consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddress_Bytes><period_Bytes>: bool
+// - 0x02<consAddress_Bytes><period_Bytes>: bool (legacy, see MigrateMissedBlockBitArrays)
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<valAddress_Bytes>: ValidatorMaintenance
+//
+// - 0x05<endTime_Bytes><valAddress_Bytes>: sdk.ValAddress
+//
+// - 0x06<consAddress_Bytes><chunkIndex_Bytes>: []byte
+//
+// - 0x07<consAddress_Bytes><height_Bytes>: []byte{}
+//
+// - 0x08<consAddress_Bytes>: ValidatorDowntimeInfractions
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorMaintenanceKeyPrefix         = []byte{0x04} // Prefix for validator maintenance
+	MaintenanceQueueKeyPrefix             = []byte{0x05} // Prefix for the queue of maintenance windows by end time
+	ValidatorMissedBlockBitmapKeyPrefix   = []byte{0x06} // Prefix for missed block bitmap chunks
+	ValidatorMissedBlockHeightKeyPrefix   = []byte{0x07} // Prefix for the heights of missed blocks
+	ValidatorDowntimeInfractionsKeyPrefix = []byte{0x08} // Prefix for the downtime infraction history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -41,11 +61,7 @@ func ValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 
 // ValidatorSigningInfoAddress - extract the address from a validator signing info key
 func ValidatorSigningInfoAddress(key []byte) (v sdk.ConsAddress) {
-	addr := key[1:]
-	if len(addr) != sdk.AddrLen {
-		panic("unexpected key length")
-	}
-	return sdk.ConsAddress(addr)
+	return sdk.ConsAddress(key[1:])
 }
 
 // ValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address)
@@ -53,14 +69,59 @@ func ValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorMissedBlockBitArrayKeyPrefix, v.Bytes()...)
 }
 
-// ValidatorMissedBlockBitArrayKey - stored by *Consensus* address (not operator address)
+// ValidatorMissedBlockBitArrayKey - stored by *Consensus* address (not operator address).
+// Missed blocks used to be stored with one key per signed blocks window index,
+// these keys are only read by the migration to missed block bitmaps.
 func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(i))
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// ValidatorMissedBlockBitmapPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKeyPrefix, v.Bytes()...)
+}
+
+// ValidatorMissedBlockBitmapKey gets the key of the chunk of the missed block
+// bitmap holding the given signed blocks window chunk index
+func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
+	return append(ValidatorMissedBlockBitmapPrefixKey(v), sdk.Uint64ToBigEndian(uint64(chunkIndex))...)
+}
+
+// ValidatorMissedBlockHeightPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorMissedBlockHeightPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockHeightKeyPrefix, v.Bytes()...)
+}
+
+// ValidatorMissedBlockHeightKey gets the key of a block missed by a validator
+func ValidatorMissedBlockHeightKey(v sdk.ConsAddress, height int64) []byte {
+	return append(ValidatorMissedBlockHeightPrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address...)
 }
+
+// ValidatorMaintenanceKey - stored by *Operator* address
+func ValidatorMaintenanceKey(v sdk.ValAddress) []byte {
+	return append(ValidatorMaintenanceKeyPrefix, v.Bytes()...)
+}
+
+// MaintenanceQueueTimeKey gets the prefix of the maintenance windows ending at
+// the given time
+func MaintenanceQueueTimeKey(endTime time.Time) []byte {
+	return append(MaintenanceQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// MaintenanceQueueKey gets the key of the maintenance window of a validator in
+// the queue
+func MaintenanceQueueKey(endTime time.Time, v sdk.ValAddress) []byte {
+	return append(MaintenanceQueueTimeKey(endTime), v.Bytes()...)
+}
+
+// ValidatorDowntimeInfractionsKey - stored by *Consensus* address (not operator address)
+func ValidatorDowntimeInfractionsKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorDowntimeInfractionsKeyPrefix, v.Bytes()...)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorMaintenance creates a new ValidatorMaintenance instance
+func NewValidatorMaintenance(
+	valAddr sdk.ValAddress, endTime, epochStartTime time.Time, windows uint32,
+) ValidatorMaintenance {
+
+	return ValidatorMaintenance{
+		ValidatorAddress: valAddr,
+		EndTime:          endTime,
+		EpochStartTime:   epochStartTime,
+		Windows:          windows,
+	}
+}
+
+// InMaintenance returns true if the validator is in a maintenance window
+func (m ValidatorMaintenance) InMaintenance() bool {
+	return !m.EndTime.IsZero()
+}
+
+// String implements the stringer interface for ValidatorMaintenance
+func (m ValidatorMaintenance) String() string {
+	return fmt.Sprintf(`Validator Maintenance:
+  Validator Address: %s
+  End Time:          %v
+  Epoch Start Time:  %v
+  Windows:           %d`,
+		m.ValidatorAddress, m.EndTime, m.EpochStartTime, m.Windows)
+}
@@ -1,16 +1,22 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail           = "unjail"
+	TypeMsgEnterMaintenance = "enter_maintenance"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgEnterMaintenance{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 func NewMsgUnjail(validatorAddr sdk.ValAddress) *MsgUnjail {
@@ -39,3 +45,36 @@ func (msg MsgUnjail) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgEnterMaintenance creates a new MsgEnterMaintenance instance
+func NewMsgEnterMaintenance(validatorAddr sdk.ValAddress, duration time.Duration) *MsgEnterMaintenance {
+	return &MsgEnterMaintenance{
+		ValidatorAddr: validatorAddr,
+		Duration:      duration,
+	}
+}
+
+func (msg MsgEnterMaintenance) Route() string { return RouterKey }
+func (msg MsgEnterMaintenance) Type() string  { return TypeMsgEnterMaintenance }
+func (msg MsgEnterMaintenance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgEnterMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgEnterMaintenance) ValidateBasic() error {
+	if msg.ValidatorAddr.Empty() {
+		return ErrBadValidatorAddr
+	}
+
+	if msg.Duration <= 0 {
+		return ErrInvalidMaintenanceDuration
+	}
+
+	return nil
+}
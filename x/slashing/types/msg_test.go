@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,3 +19,18 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgEnterMaintenance(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+
+	msg := NewMsgEnterMaintenance(sdk.ValAddress(addr), time.Hour)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgEnterMaintenance","value":{"address":"cosmosvaloper1v93xxeqhg9nn6","duration":"3600000000000"}}`,
+		string(msg.GetSignBytes()),
+	)
+
+	require.Error(t, NewMsgEnterMaintenance(sdk.ValAddress(addr), 0).ValidateBasic())
+	require.Error(t, NewMsgEnterMaintenance(sdk.ValAddress{}, time.Hour).ValidateBasic())
+}
@@ -12,12 +12,29 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultMaxMaintenanceDuration = 4 * time.Hour
+	DefaultMaintenanceEpoch       = 30 * 24 * time.Hour
+	DefaultMaxMaintenanceWindows  = uint32(2)
+
+	DefaultDowntimeInfractionLookback = 30 * 24 * time.Hour
+
+	// MaxDowntimeJailDuration caps the escalated downtime jail duration.
+	MaxDowntimeJailDuration = 365 * 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	DefaultDowntimeJailDurationMultiplier  = sdk.OneDec()
+	DefaultSlashFractionDowntimeMultiplier = sdk.OneDec()
+
+	// MaxDowntimePenaltyMultiplier caps the downtime penalty multipliers.
+	MaxDowntimePenaltyMultiplier = sdk.NewDec(10)
+
+	DefaultReporterRewardFraction = sdk.NewDecWithPrec(5, 2)
 )
 
 // Parameter store keys
@@ -27,6 +44,15 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyMaxMaintenanceDuration  = []byte("MaxMaintenanceDuration")
+	KeyMaintenanceEpoch        = []byte("MaintenanceEpoch")
+	KeyMaxMaintenanceWindows   = []byte("MaxMaintenanceWindows")
+
+	KeyDowntimeInfractionLookback      = []byte("DowntimeInfractionLookback")
+	KeyDowntimeJailDurationMultiplier  = []byte("DowntimeJailDurationMultiplier")
+	KeySlashFractionDowntimeMultiplier = []byte("SlashFractionDowntimeMultiplier")
+
+	KeyReporterRewardFraction = []byte("ReporterRewardFraction")
 )
 
 // ParamKeyTable for slashing module
@@ -38,6 +64,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	maxMaintenanceDuration, maintenanceEpoch time.Duration, maxMaintenanceWindows uint32,
+	downtimeInfractionLookback time.Duration, downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier sdk.Dec,
+	reporterRewardFraction sdk.Dec,
 ) Params {
 
 	return Params{
@@ -46,6 +75,15 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		MaxMaintenanceDuration:  maxMaintenanceDuration,
+		MaintenanceEpoch:        maintenanceEpoch,
+		MaxMaintenanceWindows:   maxMaintenanceWindows,
+
+		DowntimeInfractionLookback:      downtimeInfractionLookback,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+
+		ReporterRewardFraction: reporterRewardFraction,
 	}
 }
 
@@ -57,6 +95,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyMaxMaintenanceDuration, &p.MaxMaintenanceDuration, validateMaxMaintenanceDuration),
+		paramtypes.NewParamSetPair(KeyMaintenanceEpoch, &p.MaintenanceEpoch, validateMaintenanceEpoch),
+		paramtypes.NewParamSetPair(KeyMaxMaintenanceWindows, &p.MaxMaintenanceWindows, validateMaxMaintenanceWindows),
+		paramtypes.NewParamSetPair(KeyDowntimeInfractionLookback, &p.DowntimeInfractionLookback, validateDowntimeInfractionLookback),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDurationMultiplier, &p.DowntimeJailDurationMultiplier, validateDowntimeJailDurationMultiplier),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeMultiplier, &p.SlashFractionDowntimeMultiplier, validateSlashFractionDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyReporterRewardFraction, &p.ReporterRewardFraction, validateReporterRewardFraction),
 	}
 }
 
@@ -65,6 +110,9 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultMaxMaintenanceDuration, DefaultMaintenanceEpoch, DefaultMaxMaintenanceWindows,
+		DefaultDowntimeInfractionLookback, DefaultDowntimeJailDurationMultiplier, DefaultSlashFractionDowntimeMultiplier,
+		DefaultReporterRewardFraction,
 	)
 }
 
@@ -141,3 +189,99 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxMaintenanceDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max maintenance duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaintenanceEpoch(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("maintenance epoch must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxMaintenanceWindows(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDowntimeInfractionLookback(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime infraction lookback cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail duration multiplier must be at least one: %s", v)
+	}
+	if v.GT(MaxDowntimePenaltyMultiplier) {
+		return fmt.Errorf("downtime jail duration multiplier too large: %s, maximum %s", v, MaxDowntimePenaltyMultiplier)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash fraction multiplier must be at least one: %s", v)
+	}
+	if v.GT(MaxDowntimePenaltyMultiplier) {
+		return fmt.Errorf("downtime slash fraction multiplier too large: %s, maximum %s", v, MaxDowntimePenaltyMultiplier)
+	}
+
+	return nil
+}
+
+func validateReporterRewardFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reporter reward fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reporter reward fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -7,6 +7,9 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryMissedBlocks = "missedBlocks"
+
+	QueryDowntimeInfractions = "downtimeInfractions"
 )
 
 // QuerySigningInfosParams defines the params for the following queries:
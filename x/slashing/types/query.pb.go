@@ -303,6 +303,202 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC method
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query missed blocks of
+	ConsAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12bf00fd6c136588, []int{6}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return nil
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks RPC method
+type QueryMissedBlocksResponse struct {
+	// missed_block_bitmap is the bitmap of the signed blocks window, the bit of
+	// each window index is set if the validator missed the block at this index
+	MissedBlockBitmap []byte `protobuf:"bytes,1,opt,name=missed_block_bitmap,json=missedBlockBitmap,proto3" json:"missed_block_bitmap,omitempty"`
+	// missed_heights are the heights of the blocks missed by the validator in
+	// the last signed blocks window
+	MissedHeights []int64 `protobuf:"varint,2,rep,packed,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12bf00fd6c136588, []int{7}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetMissedBlockBitmap() []byte {
+	if m != nil {
+		return m.MissedBlockBitmap
+	}
+	return nil
+}
+
+func (m *QueryMissedBlocksResponse) GetMissedHeights() []int64 {
+	if m != nil {
+		return m.MissedHeights
+	}
+	return nil
+}
+
+// QueryDowntimeInfractionsRequest is the request type for the Query/DowntimeInfractions RPC method
+type QueryDowntimeInfractionsRequest struct {
+	// cons_address is the address to query downtime infractions of
+	ConsAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"cons_address,omitempty"`
+}
+
+func (m *QueryDowntimeInfractionsRequest) Reset()         { *m = QueryDowntimeInfractionsRequest{} }
+func (m *QueryDowntimeInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfractionsRequest) ProtoMessage()    {}
+func (*QueryDowntimeInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12bf00fd6c136588, []int{8}
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfractionsRequest.Merge(m, src)
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfractionsRequest) GetConsAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return nil
+}
+
+// QueryDowntimeInfractionsResponse is the response type for the Query/DowntimeInfractions RPC method
+type QueryDowntimeInfractionsResponse struct {
+	// infractions are the downtime infractions of the validator within the
+	// lookback period, oldest first
+	Infractions []DowntimeInfraction `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+}
+
+func (m *QueryDowntimeInfractionsResponse) Reset()         { *m = QueryDowntimeInfractionsResponse{} }
+func (m *QueryDowntimeInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfractionsResponse) ProtoMessage()    {}
+func (*QueryDowntimeInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12bf00fd6c136588, []int{9}
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfractionsResponse.Merge(m, src)
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfractionsResponse) GetInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.QueryParamsResponse")
@@ -310,41 +506,54 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "cosmos.slashing.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "cosmos.slashing.QueryMissedBlocksResponse")
+	proto.RegisterType((*QueryDowntimeInfractionsRequest)(nil), "cosmos.slashing.QueryDowntimeInfractionsRequest")
+	proto.RegisterType((*QueryDowntimeInfractionsResponse)(nil), "cosmos.slashing.QueryDowntimeInfractionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/query.proto", fileDescriptor_12bf00fd6c136588) }
 
 var fileDescriptor_12bf00fd6c136588 = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x8f, 0x23, 0xc5, 0x24, 0x02, 0xb4, 0x9c, 0x74, 0x77, 0x46, 0xf8, 0x90, 0x01, 0xe9,
-	0x40, 0x3a, 0x5b, 0x0a, 0xa2, 0x80, 0x06, 0x11, 0x0a, 0x84, 0x44, 0x71, 0x18, 0x99, 0x82, 0x26,
-	0xda, 0xd8, 0xce, 0x66, 0x85, 0xb3, 0xeb, 0x78, 0x9d, 0x88, 0xfc, 0x05, 0x25, 0x3f, 0xc1, 0x7f,
-	0xa4, 0x4c, 0x49, 0x15, 0xa1, 0xe4, 0x2f, 0xa8, 0x50, 0x76, 0xd7, 0x89, 0x13, 0x47, 0x21, 0x57,
-	0x79, 0x3d, 0xf3, 0x66, 0xde, 0x7b, 0x3b, 0xb3, 0xf0, 0x20, 0xe4, 0xa2, 0xcf, 0x85, 0x27, 0x12,
-	0x2c, 0x7a, 0x94, 0x11, 0x6f, 0x30, 0x8c, 0xb3, 0xb1, 0x9b, 0x66, 0x3c, 0xe7, 0xe8, 0xae, 0x4a,
-	0xba, 0x45, 0xd2, 0x7a, 0xa8, 0xd1, 0x12, 0xe4, 0xa5, 0x98, 0x50, 0x86, 0x73, 0xca, 0x99, 0xc2,
-	0x5b, 0x27, 0x84, 0x13, 0x2e, 0x8f, 0xde, 0xf2, 0xa4, 0xa3, 0xf6, 0x36, 0x45, 0x71, 0x50, 0x79,
-	0xe7, 0x04, 0xd0, 0xa7, 0x65, 0xbf, 0x6b, 0x9c, 0xe1, 0xbe, 0xf0, 0xe3, 0xc1, 0x30, 0x16, 0xb9,
-	0xf3, 0x11, 0xee, 0x6f, 0x44, 0x45, 0xca, 0x99, 0x88, 0xd1, 0x4b, 0xa8, 0xa5, 0x32, 0x72, 0x66,
-	0x3e, 0x32, 0x2f, 0xeb, 0xcd, 0x53, 0x77, 0x4b, 0xa3, 0xab, 0x0a, 0x5a, 0xc7, 0x93, 0xd9, 0x85,
-	0xe1, 0x6b, 0xb0, 0x93, 0xc2, 0xa9, 0xec, 0xf6, 0x99, 0x12, 0x46, 0x19, 0xf9, 0xc0, 0xba, 0x5c,
-	0x13, 0xa1, 0x00, 0x1a, 0x21, 0x67, 0xa2, 0x8d, 0xa3, 0x28, 0x8b, 0x85, 0xea, 0xdb, 0x68, 0x35,
-	0xff, 0xce, 0x2e, 0x5c, 0x42, 0xf3, 0xde, 0xb0, 0xe3, 0x86, 0xbc, 0xef, 0x69, 0x0f, 0xea, 0x73,
-	0x25, 0xa2, 0x6f, 0x5e, 0x3e, 0x4e, 0x63, 0xe1, 0xbe, 0xe3, 0x4c, 0xbc, 0x55, 0x95, 0x7e, 0x3d,
-	0x5c, 0xff, 0x38, 0x03, 0x38, 0xab, 0x32, 0x6a, 0x13, 0x01, 0xdc, 0x1b, 0xe1, 0xa4, 0x2d, 0x54,
-	0xaa, 0x4d, 0x59, 0x97, 0x6b, 0x3b, 0x4f, 0x2b, 0x76, 0xbe, 0xe0, 0x84, 0x46, 0x38, 0xe7, 0x59,
-	0xa9, 0x91, 0x36, 0x77, 0x67, 0x84, 0x93, 0x52, 0xd4, 0x09, 0xaa, 0x94, 0xc5, 0x75, 0xa2, 0x57,
-	0x00, 0xeb, 0x71, 0x69, 0xb2, 0xf3, 0x82, 0x4c, 0xcd, 0xfc, 0x1a, 0x93, 0x58, 0xc3, 0xfd, 0x12,
-	0xd8, 0xf9, 0x69, 0xc2, 0xf9, 0x8e, 0xbe, 0xda, 0xcb, 0x1b, 0x38, 0xd6, 0xfa, 0x6f, 0xdd, 0x54,
-	0xbf, 0x2c, 0x44, 0xaf, 0x37, 0x94, 0x1d, 0x49, 0x65, 0xd6, 0x2e, 0x65, 0x8a, 0xb0, 0x2c, 0xad,
-	0xf9, 0xeb, 0x08, 0x6e, 0x4b, 0x69, 0x28, 0x80, 0x9a, 0x1a, 0x3c, 0x7a, 0x5c, 0x91, 0x50, 0xdd,
-	0x2e, 0xeb, 0xc9, 0x7e, 0x90, 0xa2, 0x72, 0x0c, 0x14, 0x41, 0xbd, 0xa4, 0x1b, 0x5d, 0xee, 0x2e,
-	0xab, 0x6e, 0x95, 0xf5, 0xec, 0x00, 0xe4, 0x8a, 0x85, 0x40, 0xa3, 0x7c, 0xb7, 0xe8, 0xff, 0xc5,
-	0x2b, 0x23, 0xcf, 0x0f, 0x81, 0x16, 0x44, 0xad, 0xf7, 0x93, 0xb9, 0x6d, 0x4e, 0xe7, 0xb6, 0xf9,
-	0x67, 0x6e, 0x9b, 0x3f, 0x16, 0xb6, 0x31, 0x5d, 0xd8, 0xc6, 0xef, 0x85, 0x6d, 0x7c, 0xbd, 0xda,
-	0xbb, 0xeb, 0xdf, 0xd7, 0x8f, 0x57, 0xae, 0x7d, 0xa7, 0x26, 0x9f, 0xee, 0x8b, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x59, 0xd0, 0x27, 0x68, 0x3f, 0x04, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x9b, 0x90, 0xe1, 0x12, 0x0a, 0x5c, 0x2a, 0x35, 0x31, 0xc2, 0x89, 0x0c, 0x95, 0x42,
+	0xa5, 0xda, 0x10, 0xc4, 0x00, 0x0b, 0x22, 0x20, 0x41, 0x05, 0x48, 0xc5, 0x28, 0x0c, 0x2c, 0xd1,
+	0xc5, 0x76, 0x9c, 0x53, 0xe3, 0x3b, 0xc7, 0xe7, 0x94, 0x56, 0xe2, 0x47, 0x30, 0x30, 0xf0, 0x93,
+	0x3a, 0x76, 0x64, 0xaa, 0x50, 0xf2, 0x2f, 0x98, 0x50, 0xee, 0xce, 0x89, 0x53, 0x3b, 0x6d, 0x3a,
+	0x74, 0xca, 0xe5, 0xbd, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0x3e, 0xdb, 0xe0, 0xbe, 0x4d, 0x99, 0x4f,
+	0x99, 0xc9, 0x86, 0x88, 0x0d, 0x30, 0xf1, 0xcc, 0xd1, 0xd8, 0x0d, 0x4f, 0x8c, 0x20, 0xa4, 0x11,
+	0x85, 0x77, 0x44, 0xd2, 0x88, 0x93, 0xea, 0x03, 0x89, 0xe6, 0x20, 0x33, 0x40, 0x1e, 0x26, 0x28,
+	0xc2, 0x94, 0x08, 0xbc, 0xba, 0xe5, 0x51, 0x8f, 0xf2, 0xa3, 0x39, 0x3b, 0xc9, 0xa8, 0x76, 0x91,
+	0x22, 0x3e, 0x88, 0xbc, 0xbe, 0x05, 0xe0, 0xe7, 0x59, 0xbf, 0x03, 0x14, 0x22, 0x9f, 0x59, 0xee,
+	0x68, 0xec, 0xb2, 0x48, 0xff, 0x08, 0x2a, 0x4b, 0x51, 0x16, 0x50, 0xc2, 0x5c, 0xf8, 0x1c, 0x14,
+	0x03, 0x1e, 0xa9, 0x2a, 0x0d, 0xa5, 0x59, 0x6a, 0x6d, 0x1b, 0x17, 0x66, 0x34, 0x44, 0x41, 0xbb,
+	0x70, 0x7a, 0x5e, 0xcf, 0x59, 0x12, 0xac, 0x07, 0x60, 0x9b, 0x77, 0xfb, 0x82, 0x3d, 0x82, 0x89,
+	0xb7, 0x4f, 0xfa, 0x54, 0x12, 0xc1, 0x0e, 0x28, 0xdb, 0x94, 0xb0, 0x2e, 0x72, 0x9c, 0xd0, 0x65,
+	0xa2, 0x6f, 0xb9, 0xdd, 0xfa, 0x77, 0x5e, 0x37, 0x3c, 0x1c, 0x0d, 0xc6, 0x3d, 0xc3, 0xa6, 0xbe,
+	0x29, 0x35, 0x88, 0x9f, 0x3d, 0xe6, 0x1c, 0x9a, 0xd1, 0x49, 0xe0, 0x32, 0xe3, 0x0d, 0x25, 0xec,
+	0xb5, 0xa8, 0xb4, 0x4a, 0xf6, 0xe2, 0x8f, 0x3e, 0x02, 0xd5, 0x34, 0xa3, 0x14, 0xd1, 0x01, 0x77,
+	0x8f, 0xd0, 0xb0, 0xcb, 0x44, 0xaa, 0x8b, 0x49, 0x9f, 0x4a, 0x39, 0x3b, 0x29, 0x39, 0x5f, 0xd1,
+	0x10, 0x3b, 0x28, 0xa2, 0x61, 0xa2, 0x91, 0x14, 0xb7, 0x79, 0x84, 0x86, 0x89, 0xa8, 0xde, 0x49,
+	0x53, 0xc6, 0xeb, 0x84, 0x2f, 0x00, 0x58, 0x5c, 0x97, 0x24, 0xab, 0xc5, 0x64, 0xe2, 0xce, 0x0f,
+	0x90, 0xe7, 0x4a, 0xb8, 0x95, 0x00, 0xeb, 0xbf, 0x15, 0x50, 0xcb, 0xe8, 0x2b, 0xb5, 0xbc, 0x02,
+	0x05, 0x39, 0x7f, 0xfe, 0xba, 0xf3, 0xf3, 0x42, 0xf8, 0x72, 0x69, 0xb2, 0x0d, 0x3e, 0x99, 0x9a,
+	0x35, 0x99, 0x20, 0x5c, 0x1a, 0x2d, 0x5e, 0xf2, 0x27, 0xcc, 0x98, 0xeb, 0xb4, 0x87, 0xd4, 0x3e,
+	0x64, 0x37, 0x7c, 0xaf, 0x21, 0xa8, 0x65, 0x50, 0xca, 0x65, 0x18, 0xa0, 0xe2, 0xf3, 0x78, 0xb7,
+	0x37, 0x4b, 0x74, 0x7b, 0x38, 0xf2, 0x51, 0x20, 0xa8, 0xad, 0x7b, 0xfe, 0xa2, 0xa4, 0xcd, 0x13,
+	0x70, 0x07, 0x6c, 0x4a, 0xfc, 0xc0, 0xc5, 0xde, 0x20, 0x62, 0xd5, 0x8d, 0x46, 0xbe, 0x99, 0xb7,
+	0x6e, 0x8b, 0xe8, 0x7b, 0x11, 0xd4, 0x8f, 0x41, 0x9d, 0x73, 0xbe, 0xa5, 0xdf, 0x49, 0x84, 0x7d,
+	0x77, 0x9f, 0xf4, 0x43, 0x64, 0xcf, 0x36, 0x70, 0xd3, 0x6a, 0x29, 0x68, 0xac, 0x66, 0x96, 0xa2,
+	0x3f, 0x80, 0x12, 0x5e, 0x84, 0xa5, 0x11, 0x1e, 0xa6, 0x8c, 0x90, 0x6e, 0x21, 0x6d, 0x90, 0xac,
+	0x6e, 0xfd, 0x2a, 0x80, 0x5b, 0x9c, 0x11, 0x76, 0x40, 0x51, 0x3c, 0xca, 0x30, 0xdd, 0x2b, 0xfd,
+	0xbe, 0x50, 0x1f, 0x5d, 0x0e, 0x12, 0xb3, 0xea, 0x39, 0xe8, 0x80, 0x52, 0xc2, 0x89, 0xb0, 0x99,
+	0x5d, 0x96, 0x7e, 0x4f, 0xa8, 0x8f, 0xd7, 0x40, 0xce, 0x59, 0x3c, 0x50, 0x4e, 0x24, 0x18, 0xbc,
+	0xba, 0x78, 0x2e, 0x64, 0x77, 0x1d, 0x68, 0x92, 0x28, 0xe9, 0xc4, 0x55, 0x44, 0x19, 0x0f, 0x88,
+	0xba, 0xbb, 0x0e, 0x74, 0x4e, 0xf4, 0x03, 0x54, 0x32, 0x4c, 0x00, 0x9f, 0x64, 0x37, 0x59, 0xed,
+	0x54, 0xf5, 0xe9, 0x35, 0x2a, 0x62, 0xf6, 0xf6, 0xbb, 0xd3, 0x89, 0xa6, 0x9c, 0x4d, 0x34, 0xe5,
+	0xef, 0x44, 0x53, 0x7e, 0x4e, 0xb5, 0xdc, 0xd9, 0x54, 0xcb, 0xfd, 0x99, 0x6a, 0xb9, 0x6f, 0x7b,
+	0x97, 0xda, 0xfb, 0x78, 0xf1, 0xd5, 0xe1, 0x4e, 0xef, 0x15, 0xf9, 0x37, 0xe7, 0xd9, 0xff, 0x01,
+	0x00, 0x2b, 0x8d, 0x58, 0x35, 0xf8, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -365,6 +574,12 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed block bitmap and the recent missed block
+	// heights of given cons address
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// DowntimeInfractions queries the downtime infractions of given cons address
+	// within the downtime infraction lookback period
+	DowntimeInfractions(ctx context.Context, in *QueryDowntimeInfractionsRequest, opts ...grpc.CallOption) (*QueryDowntimeInfractionsResponse, error)
 }
 
 type queryClient struct {
@@ -402,6 +617,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DowntimeInfractions(ctx context.Context, in *QueryDowntimeInfractionsRequest, opts ...grpc.CallOption) (*QueryDowntimeInfractionsResponse, error) {
+	out := new(QueryDowntimeInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.Query/DowntimeInfractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -410,6 +643,12 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed block bitmap and the recent missed block
+	// heights of given cons address
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// DowntimeInfractions queries the downtime infractions of given cons address
+	// within the downtime infraction lookback period
+	DowntimeInfractions(context.Context, *QueryDowntimeInfractionsRequest) (*QueryDowntimeInfractionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -425,6 +664,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (*UnimplementedQueryServer) DowntimeInfractions(ctx context.Context, req *QueryDowntimeInfractionsRequest) (*QueryDowntimeInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeInfractions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -484,6 +729,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DowntimeInfractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeInfractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.Query/DowntimeInfractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeInfractions(ctx, req.(*QueryDowntimeInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -500,6 +781,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "DowntimeInfractions",
+			Handler:    _Query_DowntimeInfractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/query.proto",
@@ -708,100 +997,533 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MissedHeights) > 0 {
+		dAtA6 := make([]byte, len(m.MissedHeights)*10)
+		var j5 int
+		for _, num1 := range m.MissedHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.MissedBlockBitmap) > 0 {
+		i -= len(m.MissedBlockBitmap)
+		copy(dAtA[i:], m.MissedBlockBitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MissedBlockBitmap)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDowntimeInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MissedBlockBitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MissedHeights) > 0 {
+		l = 0
+		for _, e := range m.MissedHeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDowntimeInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddress == nil {
+				m.ConsAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,12 +1546,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -854,7 +1609,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,15 +1632,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -912,7 +1667,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -940,7 +1698,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,17 +1721,17 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -983,24 +1741,60 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsAddress == nil {
-				m.ConsAddress = []byte{}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1027,7 +1821,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1050,17 +1844,17 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1070,23 +1864,24 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddress == nil {
+				m.ConsAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1113,7 +1908,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1136,17 +1931,17 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockBitmap", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1156,28 +1951,102 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MissedBlockBitmap = append(m.MissedBlockBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBlockBitmap == nil {
+				m.MissedBlockBitmap = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedHeights = append(m.MissedHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedHeights) == 0 {
+					m.MissedHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedHeights = append(m.MissedHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1202,7 +2071,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDowntimeInfractionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,17 +2094,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDowntimeInfractionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDowntimeInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1245,29 +2114,82 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddress == nil {
+				m.ConsAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeInfractionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1294,10 +2216,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Infractions = append(m.Infractions, DowntimeInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -75,6 +75,60 @@ func (m *MsgUnjail) GetValidatorAddr() github_com_cosmos_cosmos_sdk_types.ValAdd
 	return nil
 }
 
+// MsgEnterMaintenance - struct for removing a validator from the active set for
+// a planned maintenance window without jailing it
+type MsgEnterMaintenance struct {
+	ValidatorAddr github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"address" yaml:"address"`
+	Duration      time.Duration                                 `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgEnterMaintenance) Reset()         { *m = MsgEnterMaintenance{} }
+func (m *MsgEnterMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgEnterMaintenance) ProtoMessage()    {}
+func (*MsgEnterMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d04e6c6c2071212, []int{1}
+}
+func (m *MsgEnterMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnterMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnterMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnterMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnterMaintenance.Merge(m, src)
+}
+func (m *MsgEnterMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnterMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnterMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnterMaintenance proto.InternalMessageInfo
+
+func (m *MsgEnterMaintenance) GetValidatorAddr() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func (m *MsgEnterMaintenance) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 // ValidatorSigningInfo defines the signing info for a validator
 type ValidatorSigningInfo struct {
 	Address github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"address,omitempty"`
@@ -93,7 +147,7 @@ type ValidatorSigningInfo struct {
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
 func (*ValidatorSigningInfo) ProtoMessage() {}
 func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d04e6c6c2071212, []int{1}
+	return fileDescriptor_3d04e6c6c2071212, []int{2}
 }
 func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,6 +218,197 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// ValidatorMaintenance defines the maintenance windows of a validator
+type ValidatorMaintenance struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	// timestamp the current maintenance window ends at, zero if the validator is
+	// not in maintenance
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// timestamp the current maintenance epoch started at
+	EpochStartTime time.Time `protobuf:"bytes,3,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time" yaml:"epoch_start_time"`
+	// number of maintenance windows started in the current maintenance epoch
+	Windows uint32 `protobuf:"varint,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *ValidatorMaintenance) Reset()      { *m = ValidatorMaintenance{} }
+func (*ValidatorMaintenance) ProtoMessage() {}
+func (*ValidatorMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d04e6c6c2071212, []int{3}
+}
+func (m *ValidatorMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMaintenance.Merge(m, src)
+}
+func (m *ValidatorMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMaintenance proto.InternalMessageInfo
+
+func (m *ValidatorMaintenance) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *ValidatorMaintenance) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorMaintenance) GetEpochStartTime() time.Time {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorMaintenance) GetWindows() uint32 {
+	if m != nil {
+		return m.Windows
+	}
+	return 0
+}
+
+// DowntimeInfraction defines a downtime infraction of a validator along with the
+// penalties applied for it
+type DowntimeInfraction struct {
+	// height at which the validator was jailed for downtime
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp at which the validator was jailed for downtime
+	Time          time.Time                              `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	JailDuration  time.Duration                          `protobuf:"bytes,4,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+}
+
+func (m *DowntimeInfraction) Reset()         { *m = DowntimeInfraction{} }
+func (m *DowntimeInfraction) String() string { return proto.CompactTextString(m) }
+func (*DowntimeInfraction) ProtoMessage()    {}
+func (*DowntimeInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d04e6c6c2071212, []int{4}
+}
+func (m *DowntimeInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeInfraction.Merge(m, src)
+}
+func (m *DowntimeInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeInfraction proto.InternalMessageInfo
+
+func (m *DowntimeInfraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeInfraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeInfraction) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// ValidatorDowntimeInfractions defines the downtime infraction history of a
+// validator within the downtime infraction lookback period
+type ValidatorDowntimeInfractions struct {
+	Address     github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"address,omitempty"`
+	Infractions []DowntimeInfraction                           `protobuf:"bytes,2,rep,name=infractions,proto3" json:"infractions"`
+}
+
+func (m *ValidatorDowntimeInfractions) Reset()         { *m = ValidatorDowntimeInfractions{} }
+func (m *ValidatorDowntimeInfractions) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeInfractions) ProtoMessage()    {}
+func (*ValidatorDowntimeInfractions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d04e6c6c2071212, []int{5}
+}
+func (m *ValidatorDowntimeInfractions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeInfractions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeInfractions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeInfractions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeInfractions.Merge(m, src)
+}
+func (m *ValidatorDowntimeInfractions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeInfractions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeInfractions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeInfractions proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeInfractions) GetAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ValidatorDowntimeInfractions) GetInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
 // Params - used for initializing default parameter for slashing at genesis
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
@@ -171,13 +416,28 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	MaxMaintenanceDuration  time.Duration                          `protobuf:"bytes,6,opt,name=max_maintenance_duration,json=maxMaintenanceDuration,proto3,stdduration" json:"max_maintenance_duration" yaml:"max_maintenance_duration"`
+	MaintenanceEpoch        time.Duration                          `protobuf:"bytes,7,opt,name=maintenance_epoch,json=maintenanceEpoch,proto3,stdduration" json:"maintenance_epoch" yaml:"maintenance_epoch"`
+	MaxMaintenanceWindows   uint32                                 `protobuf:"varint,8,opt,name=max_maintenance_windows,json=maxMaintenanceWindows,proto3" json:"max_maintenance_windows,omitempty" yaml:"max_maintenance_windows"`
+	// period over which previous downtime infractions escalate the penalties of a
+	// new one
+	DowntimeInfractionLookback time.Duration `protobuf:"bytes,9,opt,name=downtime_infraction_lookback,json=downtimeInfractionLookback,proto3,stdduration" json:"downtime_infraction_lookback" yaml:"downtime_infraction_lookback"`
+	// factor the jail duration is multiplied by for each previous downtime
+	// infraction within the lookback period
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier" yaml:"downtime_jail_duration_multiplier"`
+	// factor the slash fraction is multiplied by for each previous downtime
+	// infraction within the lookback period
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier" yaml:"slash_fraction_downtime_multiplier"`
+	// fraction of the tokens slashed for evidence submitted by a reporter which
+	// is paid to the reporter
+	ReporterRewardFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=reporter_reward_fraction,json=reporterRewardFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reporter_reward_fraction" yaml:"reporter_reward_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d04e6c6c2071212, []int{2}
+	return fileDescriptor_3d04e6c6c2071212, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,60 +480,123 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxMaintenanceDuration() time.Duration {
+	if m != nil {
+		return m.MaxMaintenanceDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceEpoch() time.Duration {
+	if m != nil {
+		return m.MaintenanceEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceWindows() uint32 {
+	if m != nil {
+		return m.MaxMaintenanceWindows
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeInfractionLookback() time.Duration {
+	if m != nil {
+		return m.DowntimeInfractionLookback
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.MsgUnjail")
+	proto.RegisterType((*MsgEnterMaintenance)(nil), "cosmos.slashing.MsgEnterMaintenance")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.ValidatorSigningInfo")
+	proto.RegisterType((*ValidatorMaintenance)(nil), "cosmos.slashing.ValidatorMaintenance")
+	proto.RegisterType((*DowntimeInfraction)(nil), "cosmos.slashing.DowntimeInfraction")
+	proto.RegisterType((*ValidatorDowntimeInfractions)(nil), "cosmos.slashing.ValidatorDowntimeInfractions")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/slashing.proto", fileDescriptor_3d04e6c6c2071212) }
 
 var fileDescriptor_3d04e6c6c2071212 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0x35, 0xfd, 0xf5, 0x57, 0x2e, 0xa1, 0x48, 0x6e, 0x4a, 0x43, 0x00, 0x5f, 0xf0, 0x80,
-	0xc2, 0x50, 0x47, 0x2a, 0x5b, 0x37, 0xdc, 0x0a, 0xf1, 0x4d, 0x71, 0x3f, 0x90, 0x18, 0xb0, 0x9c,
-	0xf8, 0xe2, 0x1c, 0xb5, 0xef, 0x22, 0xdf, 0x99, 0xb6, 0x6c, 0x30, 0x31, 0x76, 0xec, 0xd8, 0x91,
-	0x3f, 0x82, 0x3f, 0xa0, 0x63, 0x47, 0xc4, 0x60, 0x50, 0xba, 0x20, 0xc6, 0x6c, 0x74, 0x42, 0xbe,
-	0xb3, 0xdb, 0xa8, 0x4d, 0x51, 0x3b, 0xd9, 0xef, 0xf3, 0xbc, 0x5f, 0xf7, 0x3e, 0xef, 0x1d, 0xd4,
-	0xdb, 0x8c, 0x87, 0x8c, 0x37, 0x79, 0xe0, 0xf2, 0x2e, 0xa1, 0xfe, 0xf1, 0x8f, 0xd9, 0x8b, 0x98,
-	0x60, 0xda, 0x35, 0xc5, 0x9b, 0x39, 0x5c, 0xab, 0xf8, 0xcc, 0x67, 0x92, 0x6b, 0xa6, 0x7f, 0xca,
-	0xad, 0xa6, 0xfb, 0x8c, 0xf9, 0x01, 0x6e, 0x4a, 0xab, 0x15, 0x77, 0x9a, 0x5e, 0x1c, 0xb9, 0x82,
-	0x30, 0x9a, 0xf1, 0xe8, 0x34, 0x2f, 0x48, 0x88, 0xb9, 0x70, 0xc3, 0x9e, 0x72, 0x30, 0x3e, 0x01,
-	0x78, 0xe5, 0x39, 0xf7, 0xd7, 0xe8, 0x3b, 0x97, 0x04, 0x5a, 0x0c, 0xa7, 0xde, 0xbb, 0x01, 0xf1,
-	0x5c, 0xc1, 0x22, 0xc7, 0xf5, 0xbc, 0xa8, 0x0a, 0xea, 0xa0, 0x51, 0xb6, 0x5e, 0xfc, 0x4e, 0xd0,
-	0xff, 0xa9, 0x8d, 0x39, 0x1f, 0x24, 0x68, 0x6a, 0xdb, 0x0d, 0x83, 0x05, 0x23, 0x03, 0x8c, 0xa3,
-	0x04, 0xcd, 0xf9, 0x44, 0x74, 0xe3, 0x96, 0xd9, 0x66, 0x61, 0x33, 0x3b, 0x99, 0xfa, 0xcc, 0x71,
-	0x6f, 0xa3, 0x29, 0xb6, 0x7b, 0x98, 0x9b, 0xeb, 0x6e, 0xf0, 0x40, 0x45, 0xd8, 0x57, 0x8f, 0xab,
-	0xa4, 0x88, 0xf1, 0xb5, 0x08, 0x2b, 0xeb, 0x39, 0xb2, 0x42, 0x7c, 0x4a, 0xa8, 0xff, 0x98, 0x76,
-	0x98, 0xf6, 0x0c, 0xe6, 0x55, 0xb3, 0x46, 0xe6, 0x8f, 0x12, 0x64, 0x5e, 0xa0, 0xd6, 0x22, 0xa3,
-	0x3c, 0x2f, 0x96, 0xa7, 0xd0, 0x16, 0x60, 0x99, 0x0b, 0x37, 0x12, 0x4e, 0x17, 0x13, 0xbf, 0x2b,
-	0xaa, 0x63, 0x75, 0xd0, 0x28, 0x5a, 0xb3, 0x83, 0x04, 0x4d, 0xab, 0x03, 0x0d, 0xb3, 0x86, 0x5d,
-	0x92, 0xe6, 0x23, 0x69, 0xa5, 0xb1, 0x84, 0x7a, 0x78, 0xcb, 0x61, 0x9d, 0x0e, 0xc7, 0xa2, 0x5a,
-	0x3c, 0x1d, 0x3b, 0xcc, 0x1a, 0x76, 0x49, 0x9a, 0x2f, 0xa5, 0xa5, 0xbd, 0x85, 0xe5, 0x74, 0xba,
-	0xd8, 0x73, 0x62, 0x2a, 0x48, 0x50, 0x1d, 0xaf, 0x83, 0x46, 0x69, 0xbe, 0x66, 0x2a, 0x6d, 0xcc,
-	0x5c, 0x1b, 0x73, 0x35, 0xd7, 0xc6, 0x42, 0xfb, 0x09, 0x2a, 0x9c, 0xe4, 0x1e, 0x8e, 0x36, 0x76,
-	0x7e, 0x20, 0x60, 0x97, 0x14, 0xb4, 0x96, 0x22, 0x9a, 0x0e, 0xa1, 0x60, 0x61, 0x8b, 0x0b, 0x46,
-	0xb1, 0x57, 0xfd, 0xaf, 0x0e, 0x1a, 0x93, 0xf6, 0x10, 0xa2, 0xad, 0xc2, 0x99, 0x90, 0x70, 0x8e,
-	0x3d, 0xa7, 0x15, 0xb0, 0xf6, 0x06, 0x77, 0xda, 0x2c, 0xa6, 0x02, 0x47, 0xd5, 0x09, 0x79, 0x88,
-	0xfa, 0x20, 0x41, 0xb7, 0x54, 0xa1, 0x91, 0x6e, 0x86, 0x3d, 0xad, 0x70, 0x4b, 0xc2, 0x8b, 0x0a,
-	0x5d, 0x98, 0xdc, 0xdd, 0x43, 0x85, 0x5f, 0x7b, 0x08, 0x18, 0x7f, 0xc6, 0xe1, 0xc4, 0xb2, 0x1b,
-	0xb9, 0x21, 0xd7, 0x5e, 0xc1, 0x0a, 0x27, 0x3e, 0x3d, 0xc9, 0xb1, 0x49, 0xa8, 0xc7, 0x36, 0xa5,
-	0x7a, 0x45, 0x0b, 0x0d, 0x12, 0x74, 0x33, 0x1b, 0xf5, 0x08, 0x2f, 0xc3, 0xd6, 0x14, 0xac, 0x0a,
-	0xbd, 0x96, 0xa0, 0xf6, 0x11, 0xa4, 0xed, 0x53, 0x27, 0x8b, 0xe8, 0xe1, 0x28, 0x4f, 0x3a, 0xa6,
-	0x76, 0x33, 0x9d, 0xd5, 0xf7, 0x04, 0xdd, 0xbd, 0xc0, 0x5a, 0x2c, 0xe1, 0xf6, 0xf0, 0x61, 0x47,
-	0x24, 0x35, 0x6c, 0x2d, 0x24, 0x74, 0x45, 0xc2, 0xcb, 0x38, 0xca, 0x7a, 0xf8, 0x00, 0xaf, 0x7b,
-	0x6c, 0x93, 0xa6, 0x97, 0xc7, 0x49, 0x27, 0xef, 0xe4, 0xd7, 0x4c, 0xee, 0x41, 0x69, 0xfe, 0xc6,
-	0x19, 0x2d, 0x97, 0x32, 0x07, 0xeb, 0x5e, 0x26, 0xe5, 0x6d, 0x55, 0x74, 0x74, 0x1a, 0x63, 0x37,
-	0x15, 0xb5, 0x92, 0x93, 0x4f, 0x5c, 0x12, 0xe4, 0x09, 0xb4, 0x1d, 0x00, 0x6b, 0xf2, 0x15, 0x70,
-	0x3a, 0x91, 0xdb, 0x4e, 0x21, 0xc7, 0x63, 0x71, 0x2b, 0xc0, 0xb2, 0x79, 0xb9, 0x4c, 0x65, 0x6b,
-	0xe5, 0xd2, 0x43, 0xb8, 0x93, 0xe9, 0x70, 0x6e, 0x66, 0xc3, 0x9e, 0x95, 0xe4, 0xc3, 0x8c, 0x5b,
-	0x92, 0x54, 0x3a, 0x19, 0xed, 0x33, 0x80, 0xb3, 0x67, 0x02, 0x55, 0xeb, 0x72, 0xfd, 0xca, 0xd6,
-	0xf2, 0xa5, 0xfb, 0xd1, 0xcf, 0xe9, 0x47, 0xa5, 0x35, 0xec, 0x99, 0x53, 0xcd, 0x28, 0xdc, 0x7a,
-	0xfa, 0xa5, 0xaf, 0x83, 0xfd, 0xbe, 0x0e, 0x0e, 0xfa, 0x3a, 0xf8, 0xd9, 0xd7, 0xc1, 0xce, 0xa1,
-	0x5e, 0x38, 0x38, 0xd4, 0x0b, 0xdf, 0x0e, 0xf5, 0xc2, 0x9b, 0x7f, 0x3f, 0x4b, 0x5b, 0x27, 0xaf,
-	0xaf, 0xec, 0xa4, 0x35, 0x21, 0xe5, 0xbb, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x37, 0x9f,
-	0x23, 0x9d, 0x05, 0x00, 0x00,
+	// 1198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xda, 0xf9, 0x25, 0xee, 0xd8, 0x49, 0xdb, 0x69, 0x3e, 0xf6, 0x97, 0x86, 0x5d, 0x77,
+	0x82, 0x50, 0x2a, 0x54, 0x5b, 0x0a, 0x17, 0x94, 0x0b, 0x62, 0x9b, 0x02, 0xa5, 0x0d, 0xa4, 0x9b,
+	0xb6, 0x48, 0x41, 0x62, 0x59, 0x7b, 0x27, 0x9b, 0x25, 0xbb, 0x33, 0xd6, 0xce, 0x9a, 0xa4, 0x3d,
+	0x01, 0x27, 0x24, 0x38, 0xe4, 0xd8, 0x63, 0x2f, 0x48, 0xfd, 0x0b, 0x10, 0x48, 0xfc, 0x01, 0xbd,
+	0x20, 0xf5, 0x88, 0x38, 0x18, 0x94, 0x5c, 0x10, 0x37, 0x7c, 0xec, 0x09, 0xcd, 0xc7, 0xda, 0xeb,
+	0x8f, 0xc4, 0x31, 0x42, 0xe2, 0x54, 0xcf, 0x3b, 0xef, 0xc7, 0x33, 0xcf, 0xfb, 0xbc, 0xef, 0x36,
+	0xc0, 0xa8, 0x53, 0x16, 0x51, 0x56, 0x65, 0xa1, 0xcb, 0xf6, 0x02, 0xe2, 0x77, 0x7e, 0x54, 0x1a,
+	0x31, 0x4d, 0x28, 0xbc, 0x28, 0xef, 0x2b, 0xa9, 0x79, 0x69, 0xce, 0xa7, 0x3e, 0x15, 0x77, 0x55,
+	0xfe, 0x4b, 0xba, 0x2d, 0x19, 0x3e, 0xa5, 0x7e, 0x88, 0xab, 0xe2, 0x54, 0x6b, 0xee, 0x56, 0xbd,
+	0x66, 0xec, 0x26, 0x01, 0x25, 0xea, 0xde, 0xec, 0xbf, 0x4f, 0x82, 0x08, 0xb3, 0xc4, 0x8d, 0x1a,
+	0xd2, 0x01, 0x7d, 0xa5, 0x81, 0x0b, 0x9b, 0xcc, 0x7f, 0x40, 0x3e, 0x73, 0x83, 0x10, 0x36, 0xc1,
+	0xec, 0xe7, 0x6e, 0x18, 0x78, 0x6e, 0x42, 0x63, 0xc7, 0xf5, 0xbc, 0x58, 0xd7, 0xca, 0xda, 0x6a,
+	0xc9, 0xfa, 0xe0, 0xcf, 0x96, 0x39, 0xcd, 0xcf, 0x98, 0xb1, 0x76, 0xcb, 0x9c, 0x7d, 0xe4, 0x46,
+	0xe1, 0x3a, 0x52, 0x06, 0xf4, 0xb2, 0x65, 0xde, 0xf0, 0x83, 0x64, 0xaf, 0x59, 0xab, 0xd4, 0x69,
+	0x54, 0x55, 0x2f, 0x93, 0xff, 0xdc, 0x60, 0xde, 0x7e, 0x35, 0x79, 0xd4, 0xc0, 0xac, 0xf2, 0xd0,
+	0x0d, 0xdf, 0x96, 0x11, 0xf6, 0x4c, 0xa7, 0x0a, 0xb7, 0xa0, 0x9f, 0x35, 0x70, 0x65, 0x93, 0xf9,
+	0xb7, 0x48, 0x82, 0xe3, 0x4d, 0x37, 0x20, 0x09, 0x26, 0x2e, 0xa9, 0xe3, 0xff, 0x08, 0x0e, 0x7c,
+	0x0b, 0x14, 0x52, 0x1a, 0xf5, 0x5c, 0x59, 0x5b, 0x2d, 0xae, 0xfd, 0xbf, 0x22, 0x79, 0xac, 0xa4,
+	0x3c, 0x56, 0x36, 0x94, 0x83, 0x55, 0x78, 0xde, 0x32, 0x27, 0x9e, 0xfc, 0x66, 0x6a, 0x76, 0x27,
+	0x08, 0xfd, 0x94, 0x07, 0x73, 0x0f, 0xd3, 0x94, 0xdb, 0x81, 0x4f, 0x02, 0xe2, 0xdf, 0x26, 0xbb,
+	0x14, 0xde, 0x05, 0x29, 0x6c, 0xf5, 0x92, 0xb5, 0x97, 0x2d, 0xb3, 0x72, 0x0e, 0xb0, 0x37, 0x29,
+	0x61, 0x29, 0xda, 0x34, 0x05, 0x5c, 0x07, 0x25, 0x96, 0xb8, 0x71, 0xe2, 0xec, 0xe1, 0xc0, 0xdf,
+	0x4b, 0x04, 0xd6, 0xbc, 0xb5, 0xd8, 0x6e, 0x99, 0x57, 0x24, 0x23, 0xd9, 0x5b, 0x64, 0x17, 0xc5,
+	0xf1, 0x3d, 0x71, 0xe2, 0xb1, 0x01, 0xf1, 0xf0, 0xa1, 0x43, 0x77, 0x77, 0x19, 0x4e, 0xf4, 0x7c,
+	0x7f, 0x6c, 0xf6, 0x16, 0xd9, 0x45, 0x71, 0xfc, 0x50, 0x9c, 0xe0, 0x27, 0xa0, 0xc4, 0xd5, 0x82,
+	0x3d, 0xa7, 0x49, 0x92, 0x20, 0xd4, 0x27, 0x05, 0x47, 0x4b, 0x03, 0x1c, 0xdd, 0x4f, 0xb5, 0x66,
+	0x99, 0x9c, 0xa4, 0x6e, 0xee, 0x6c, 0x34, 0x3a, 0xe2, 0xdc, 0x15, 0xa5, 0xe9, 0x01, 0xb7, 0x40,
+	0x03, 0x80, 0x84, 0x46, 0x35, 0x96, 0x50, 0x82, 0x3d, 0xfd, 0x7f, 0x65, 0x6d, 0xb5, 0x60, 0x67,
+	0x2c, 0xf0, 0x3e, 0x98, 0x8f, 0x02, 0xc6, 0xb0, 0xe7, 0xd4, 0x42, 0x5a, 0xdf, 0x67, 0x4e, 0x9d,
+	0x36, 0xb9, 0x74, 0xf4, 0x29, 0xf1, 0x88, 0x72, 0xbb, 0x65, 0x2e, 0xcb, 0x42, 0x43, 0xdd, 0x90,
+	0x7d, 0x45, 0xda, 0x2d, 0x61, 0xbe, 0x29, 0xad, 0xeb, 0x85, 0x27, 0x4f, 0xcd, 0x89, 0x3f, 0x9e,
+	0x9a, 0x1a, 0xfa, 0x2b, 0x97, 0x69, 0x5f, 0x56, 0x8f, 0x8f, 0xc1, 0xe5, 0x5e, 0x3d, 0x76, 0x1b,
+	0xb9, 0xd9, 0x6e, 0x99, 0xba, 0x2c, 0x3a, 0xe0, 0xf2, 0x0f, 0x14, 0x79, 0xa9, 0x47, 0x91, 0xbc,
+	0xd9, 0x36, 0x28, 0x60, 0xe2, 0x39, 0x7c, 0x7e, 0xf5, 0xdc, 0x48, 0xc2, 0xaf, 0x2a, 0xc2, 0x2f,
+	0x4a, 0x48, 0x69, 0xa4, 0x24, 0x7b, 0x1a, 0x13, 0x8f, 0xbb, 0xc2, 0x00, 0x5c, 0xc2, 0x0d, 0x5a,
+	0xdf, 0x73, 0xa4, 0x50, 0x44, 0xee, 0xfc, 0xc8, 0xdc, 0x2b, 0x2a, 0xf7, 0xa2, 0xca, 0xdd, 0x97,
+	0x41, 0xd6, 0x98, 0x15, 0xe6, 0x6d, 0x6e, 0x15, 0xa5, 0x74, 0x30, 0x7d, 0x10, 0x10, 0x8f, 0x1e,
+	0x30, 0x21, 0x97, 0x19, 0x3b, 0x3d, 0xae, 0x4f, 0x72, 0xde, 0xd1, 0x0f, 0x39, 0x00, 0x37, 0xe8,
+	0x01, 0xe1, 0x19, 0x6e, 0x93, 0xdd, 0xd8, 0xad, 0xf3, 0x49, 0x82, 0x0b, 0x60, 0x4a, 0x89, 0x9b,
+	0xd3, 0x9c, 0xb7, 0xd5, 0x09, 0xbe, 0x09, 0x26, 0xcf, 0xc9, 0x84, 0x98, 0x4f, 0x01, 0x49, 0x44,
+	0x40, 0x02, 0x66, 0xc5, 0x4e, 0x75, 0xd2, 0x1a, 0xe2, 0xc5, 0x25, 0xeb, 0x5d, 0xee, 0xf7, 0x6b,
+	0xcb, 0x7c, 0xed, 0x1c, 0x8d, 0xda, 0xc0, 0xf5, 0x76, 0xcb, 0x9c, 0x57, 0x43, 0xd6, 0x93, 0x0d,
+	0xd9, 0x33, 0xc2, 0xf0, 0x4e, 0xfa, 0x82, 0x4f, 0xc1, 0x0c, 0xd7, 0xb6, 0xd3, 0xd9, 0x28, 0x93,
+	0xa3, 0x36, 0x4a, 0x59, 0xf1, 0x3b, 0xd7, 0x1d, 0x96, 0x4e, 0x34, 0x12, 0x9b, 0x46, 0x8c, 0x5f,
+	0xea, 0x8f, 0x7e, 0xd4, 0xc0, 0x72, 0x47, 0xae, 0x83, 0x1c, 0xb2, 0x7f, 0x79, 0xeb, 0xdc, 0x01,
+	0xc5, 0xa0, 0x9b, 0x5c, 0xcf, 0x95, 0xf3, 0xab, 0xc5, 0xb5, 0x95, 0x4a, 0xdf, 0xf7, 0xaa, 0x32,
+	0x08, 0xc4, 0x9a, 0xe4, 0x0f, 0xb3, 0xb3, 0xd1, 0xe8, 0xfb, 0x12, 0x98, 0xda, 0x72, 0x63, 0x37,
+	0x62, 0xf0, 0x1e, 0x98, 0x63, 0x81, 0x4f, 0xba, 0xe3, 0x2a, 0x05, 0x22, 0x1b, 0x6f, 0x99, 0xed,
+	0x96, 0x79, 0x55, 0x11, 0x3e, 0xc4, 0x0b, 0xd9, 0x50, 0x9a, 0xe5, 0x4c, 0x7f, 0x24, 0x8c, 0xf0,
+	0x4b, 0x8d, 0x6f, 0x0a, 0xe2, 0xa8, 0x88, 0x06, 0x8e, 0xd3, 0xa4, 0x39, 0xf9, 0x1d, 0x19, 0xbb,
+	0xe7, 0x9d, 0xbd, 0x32, 0x24, 0x29, 0xb2, 0x61, 0x14, 0x90, 0x6d, 0x61, 0xde, 0xc2, 0xb1, 0xc2,
+	0xf0, 0x18, 0x2c, 0x78, 0x8a, 0x0a, 0xa7, 0x57, 0x08, 0xf9, 0x51, 0x42, 0xb8, 0xae, 0x84, 0xf0,
+	0x8a, 0x2c, 0x3a, 0x3c, 0x8d, 0x54, 0xc4, 0x5c, 0x7a, 0xf9, 0x7e, 0x46, 0x19, 0xf0, 0x48, 0x03,
+	0x4b, 0xbd, 0xf2, 0x74, 0x3c, 0xda, 0xac, 0x85, 0x58, 0x80, 0x17, 0x4a, 0x2c, 0x59, 0xdb, 0x63,
+	0x93, 0x70, 0x6d, 0x98, 0xf0, 0xb3, 0x99, 0x91, 0xbd, 0xd8, 0x33, 0x04, 0x1b, 0xe2, 0x8a, 0x33,
+	0x03, 0xbf, 0xd6, 0xc0, 0xe2, 0x40, 0xa0, 0x84, 0x2e, 0x36, 0x7d, 0xc9, 0xda, 0x1a, 0x1b, 0x8f,
+	0x71, 0x0a, 0x1e, 0x99, 0x16, 0xd9, 0xf3, 0x7d, 0x60, 0xa4, 0x1d, 0x7e, 0xa1, 0x01, 0x3d, 0x72,
+	0x0f, 0x9d, 0xa8, 0xbb, 0xe1, 0xbb, 0xcd, 0x99, 0x1a, 0xd5, 0x9c, 0xd7, 0x55, 0x73, 0x4c, 0xa5,
+	0x88, 0x53, 0x12, 0xc9, 0xf6, 0x2c, 0x44, 0xee, 0x61, 0xe6, 0x43, 0xd2, 0x69, 0x50, 0x08, 0x2e,
+	0x67, 0x83, 0xc4, 0xce, 0xd4, 0xa7, 0x47, 0x95, 0x7e, 0x55, 0x95, 0xd6, 0xd3, 0xd2, 0x7d, 0x19,
+	0x64, 0xcd, 0x4b, 0x19, 0xfb, 0x2d, 0x6e, 0x86, 0x3b, 0x60, 0xb1, 0x1f, 0x66, 0xba, 0x93, 0x0b,
+	0x7c, 0x27, 0x5b, 0xa8, 0x4b, 0xe6, 0x29, 0x8e, 0xc8, 0x9e, 0xef, 0x7d, 0x8a, 0x54, 0x39, 0x83,
+	0xdf, 0x6a, 0x60, 0xb9, 0x23, 0xd0, 0xee, 0x84, 0x3b, 0x21, 0xa5, 0xfb, 0x35, 0xb7, 0xbe, 0xaf,
+	0x5f, 0x18, 0xf5, 0xaa, 0xaa, 0x7a, 0xd5, 0x4a, 0x9f, 0xda, 0x87, 0x24, 0x93, 0x0f, 0x5c, 0xf2,
+	0x06, 0x56, 0xcc, 0x5d, 0xe5, 0x00, 0xbf, 0xd3, 0xc0, 0xb5, 0xe1, 0xf3, 0xe2, 0x44, 0xcd, 0x30,
+	0x09, 0x1a, 0x61, 0x80, 0x63, 0x1d, 0x08, 0xc1, 0xed, 0x8c, 0x2d, 0xb8, 0xd5, 0xb3, 0x06, 0x32,
+	0x53, 0x00, 0xd9, 0xc6, 0xb0, 0xb9, 0xdc, 0xec, 0x38, 0xc0, 0x67, 0x1a, 0x38, 0x4d, 0xb7, 0x59,
+	0xa0, 0x45, 0x01, 0xf4, 0xe3, 0xb1, 0x81, 0x5e, 0x3f, 0x73, 0x32, 0x7a, 0x90, 0x9a, 0x43, 0x87,
+	0x24, 0x03, 0xf5, 0x1b, 0x0d, 0xe8, 0x31, 0x6e, 0xd0, 0x38, 0xc1, 0xb1, 0x13, 0xe3, 0x03, 0x37,
+	0xf6, 0xba, 0xdf, 0xd0, 0x92, 0x00, 0x78, 0x6f, 0x6c, 0x80, 0x6a, 0x7a, 0x4e, 0xcb, 0x8b, 0xec,
+	0x85, 0xf4, 0xca, 0x16, 0x37, 0x29, 0x3e, 0xeb, 0xce, 0xb3, 0x63, 0x43, 0x7b, 0x7e, 0x6c, 0x68,
+	0x2f, 0x8e, 0x0d, 0xed, 0xf7, 0x63, 0x43, 0x3b, 0x3a, 0x31, 0x26, 0x5e, 0x9c, 0x18, 0x13, 0xbf,
+	0x9c, 0x18, 0x13, 0x3b, 0x67, 0xff, 0x6f, 0xeb, 0xb0, 0xfb, 0x57, 0x97, 0xc0, 0x52, 0x9b, 0x12,
+	0x6a, 0x7c, 0xe3, 0xef, 0x01, 0x00, 0xce, 0xf8, 0xda, 0x32, 0x95, 0x0d, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -300,6 +623,33 @@ func (this *MsgUnjail) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgEnterMaintenance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgEnterMaintenance)
+	if !ok {
+		that2, ok := that.(MsgEnterMaintenance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddr, that1.ValidatorAddr) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -339,14 +689,14 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *ValidatorMaintenance) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*ValidatorMaintenance)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(ValidatorMaintenance)
 		if ok {
 			that1 = &that2
 		} else {
@@ -358,64 +708,221 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
-		return false
-	}
-	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+	if !this.EndTime.Equal(that1.EndTime) {
 		return false
 	}
-	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+	if !this.EpochStartTime.Equal(that1.EpochStartTime) {
 		return false
 	}
-	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+	if this.Windows != that1.Windows {
 		return false
 	}
 	return true
 }
-func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DowntimeInfraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*DowntimeInfraction)
+	if !ok {
+		that2, ok := that.(DowntimeInfraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	return true
 }
-
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+func (this *ValidatorDowntimeInfractions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorDowntimeInfractions)
+	if !ok {
+		that2, ok := that.(ValidatorDowntimeInfractions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if len(this.Infractions) != len(that1.Infractions) {
+		return false
+	}
+	for i := range this.Infractions {
+		if !this.Infractions[i].Equal(&that1.Infractions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
+	if this.MaxMaintenanceDuration != that1.MaxMaintenanceDuration {
+		return false
+	}
+	if this.MaintenanceEpoch != that1.MaintenanceEpoch {
+		return false
+	}
+	if this.MaxMaintenanceWindows != that1.MaxMaintenanceWindows {
+		return false
+	}
+	if this.DowntimeInfractionLookback != that1.DowntimeInfractionLookback {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if !this.ReporterRewardFraction.Equal(that1.ReporterRewardFraction) {
+		return false
+	}
+	return true
+}
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnterMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnterMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnterMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -440,12 +947,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -468,7 +975,7 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorMaintenance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -478,95 +985,318 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorMaintenance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SlashFractionDowntime.Size()
-		i -= size
-		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	if m.Windows != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Windows))
+		i--
+		dAtA[i] = 0x20
 	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SlashFractionDoubleSign.Size()
-		i -= size
-		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	return len(dAtA) - i, nil
+}
+
+func (m *DowntimeInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	{
-		size := m.MinSignedPerWindow.Size()
+		size := m.SlashFraction.Size()
 		i -= size
-		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x12
-	if m.SignedBlocksWindow != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorDowntimeInfractions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUnjail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	return n
+
+func (m *ValidatorDowntimeInfractions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorDowntimeInfractions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReporterRewardFraction.Size()
+		i -= size
+		if _, err := m.ReporterRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeInfractionLookback, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInfractionLookback):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintSlashing(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxMaintenanceWindows != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMaintenanceWindows))
+		i--
+		dAtA[i] = 0x40
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaintenanceEpoch, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaintenanceEpoch):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintSlashing(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxMaintenanceDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxMaintenanceDuration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintSlashing(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintSlashing(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnterMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
 	}
 	if m.StartHeight != 0 {
 		n += 1 + sovSlashing(uint64(m.StartHeight))
@@ -585,6 +1315,63 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Windows != 0 {
+		n += 1 + sovSlashing(uint64(m.Windows))
+	}
+	return n
+}
+
+func (m *DowntimeInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorDowntimeInfractions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -602,6 +1389,21 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxMaintenanceDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaintenanceEpoch)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaxMaintenanceWindows != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMaintenanceWindows))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInfractionLookback)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.ReporterRewardFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -611,7 +1413,754 @@ func sovSlashing(x uint64) (n int) {
 func sozSlashing(x uint64) (n int) {
 	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnterMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnterMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnterMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			m.Windows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Windows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeInfraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDowntimeInfractions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -634,15 +2183,15 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDowntimeInfractions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDowntimeInfractions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -669,9 +2218,43 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddr == nil {
-				m.ValidatorAddr = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, DowntimeInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -698,7 +2281,7 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -721,15 +2304,34 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -756,16 +2358,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -775,16 +2376,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			m.IndexOffset = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -794,16 +2409,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -813,30 +2442,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceDuration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -846,17 +2475,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			m.MissedBlocksCounter = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxMaintenanceDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceEpoch", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -866,69 +2508,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaintenanceEpoch, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceWindows", wireType)
 			}
-			m.SignedBlocksWindow = 0
+			m.MaxMaintenanceWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -938,16 +2541,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				m.MaxMaintenanceWindows |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfractionLookback", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -957,30 +2560,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeInfractionLookback, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -990,28 +2593,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1038,13 +2641,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterRewardFraction", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1071,7 +2674,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReporterRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16,6 +16,7 @@ type DelegationI interface {
 // ValidatorI expected validator functions
 type ValidatorI interface {
 	IsJailed() bool                                         // whether the validator is jailed
+	IsInMaintenance() bool                                  // whether the validator is in a maintenance window
 	GetMoniker() string                                     // moniker of the validator
 	GetStatus() sdk.BondStatus                              // status of the validator
 	IsBonded() bool                                         // check if has a bonded status
@@ -91,6 +91,23 @@ func InitGenesis(
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		// the tokenized shares of each validator are those held by its records
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), record.Validator)
+		if found {
+			liquidShares := keeper.GetValidatorLiquidShares(ctx, record.Validator).Add(delegation.Shares)
+			keeper.SetValidatorLiquidShares(ctx, record.Validator, liquidShares)
+		}
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, rotation := range data.ConsPubkeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -170,14 +187,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    keeper.GetParams(ctx),
+		LastTotalPower:            keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                keeper.GetAllValidators(ctx),
+		Delegations:               keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
+		ConsPubkeyRotations:       keeper.GetAllConsPubKeyRotations(ctx),
 	}
 }
 
@@ -204,6 +224,14 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data.ConsPubkeyRotations, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -231,3 +259,55 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 
 	return
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last id %d", record.Id, lastID)
+		}
+
+		if record.Owner.Empty() || record.Validator.Empty() {
+			return fmt.Errorf("tokenize share record %d has an empty owner or validator", record.Id)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotations(rotations []types.ConsPubKeyRotation, validators []types.Validator) error {
+	consAddrs := make(map[string]bool, len(validators)+len(rotations))
+	for _, val := range validators {
+		consAddrs[val.GetConsAddr().String()] = true
+	}
+
+	operators := make(map[string]bool, len(rotations))
+
+	for _, rotation := range rotations {
+		if operators[rotation.OperatorAddress.String()] {
+			return fmt.Errorf("duplicate consensus pubkey rotation in genesis state: validator %s", rotation.OperatorAddress)
+		}
+
+		oldPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, rotation.OldConsPubkey)
+		if err != nil {
+			return err
+		}
+
+		oldConsAddr := sdk.GetConsAddress(oldPubKey).String()
+		if consAddrs[oldConsAddr] {
+			return fmt.Errorf("old consensus address %s of validator %s is already in use", oldConsAddr, rotation.OperatorAddress)
+		}
+
+		operators[rotation.OperatorAddress.String()] = true
+		consAddrs[oldConsAddr] = true
+	}
+
+	return nil
+}
@@ -17,7 +17,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,14 +28,14 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 	addrDels, addrVals := generateAddresses(app, ctx, numAddrs, accAmount)
 
 	amt := sdk.TokensFromConsensusPower(power)
-	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
+	totalSupply := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
+	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(totalSupply))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	app.BankKeeper.SetSupply(ctx, totalSupply)
 
 	return app, ctx, addrDels, addrVals
 }
@@ -1505,3 +1504,107 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestCommissionRateBelowMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create validator with a commission rate below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(valAddrs[0], PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+
+	// create validator with a commission rate at the minimum
+	msgCreateValidator = NewTestMsgCreateValidator(valAddrs[0], PKs[0], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(params.MinCommissionRate, sdk.OneDec(), sdk.OneDec())
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// lower the commission rate below the minimum
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(types.DefaultUnbondingTime))
+	newRate := sdk.NewDecWithPrec(1, 2)
+	msgEditValidator := types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+}
+
+func TestMaxVotingPowerRatio(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(100)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 4, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	// create three validators with the same power
+	for i := 0; i < 3; i++ {
+		msgCreateValidator := NewTestMsgCreateValidator(valAddrs[i], PKs[i], initBond)
+		res, err := handler(ctx, msgCreateValidator)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	}
+
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxVotingPowerRatio = sdk.NewDecWithPrec(40, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// delegating up to 40% of the bonded tokens succeeds
+	delegator := delAddrs[3]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	msgDelegate := types.NewMsgDelegate(delegator, valAddrs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(30)))
+	res, err := handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// delegating beyond 40% of the bonded tokens fails
+	msgDelegate = types.NewMsgDelegate(delegator, valAddrs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(5)))
+	res, err = handler(ctx, msgDelegate)
+	require.True(t, types.ErrMaxVotingPowerRatioExceeded.Is(err), err)
+	require.Nil(t, res)
+
+	// redelegating up to 40% of the bonded tokens succeeds
+	msgBeginRedelegate := types.NewMsgBeginRedelegate(
+		delegator, valAddrs[0], valAddrs[1], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(20)),
+	)
+	res, err = handler(ctx, msgBeginRedelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// redelegating beyond 40% of the bonded tokens fails
+	msgBeginRedelegate = types.NewMsgBeginRedelegate(
+		sdk.AccAddress(valAddrs[2]), valAddrs[2], valAddrs[1], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(15)),
+	)
+	res, err = handler(ctx, msgBeginRedelegate)
+	require.True(t, types.ErrMaxVotingPowerRatioExceeded.Is(err), err)
+	require.Nil(t, res)
+
+	// cancelling an unbonding beyond 40% of the bonded tokens fails
+	msgUndelegate := types.NewMsgUndelegate(sdk.AccAddress(valAddrs[0]), valAddrs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(30)))
+	res, err = handler(ctx, msgUndelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgDelegate = types.NewMsgDelegate(delegator, valAddrs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(40)))
+	res, err = handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgCancel := types.NewMsgCancelUnbondingDelegation(
+		sdk.AccAddress(valAddrs[0]), valAddrs[0], ctx.BlockHeight(), sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(30)),
+	)
+	res, err = handler(ctx, msgCancel)
+	require.True(t, types.ErrMaxVotingPowerRatioExceeded.Is(err), err)
+	require.Nil(t, res)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetConsPubKeyRotation returns the in-progress consensus pubkey rotation of a
+// validator
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets a consensus pubkey rotation, inserts it into the
+// rotation queue and indexes the validator by its old consensus address
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.OperatorAddress), bz)
+	store.Set(types.GetConsPubKeyRotationQueueKey(rotation.CompletionTime, rotation.OperatorAddress), rotation.OperatorAddress)
+	store.Set(types.GetValidatorByConsAddrKey(rotation.GetOldConsAddr()), rotation.OperatorAddress)
+}
+
+// DeleteConsPubKeyRotation removes a consensus pubkey rotation, its entry in
+// the rotation queue and the index of the validator by its old consensus
+// address
+func (k Keeper) DeleteConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetConsPubKeyRotationKey(rotation.OperatorAddress))
+	store.Delete(types.GetConsPubKeyRotationQueueKey(rotation.CompletionTime, rotation.OperatorAddress))
+	store.Delete(types.GetValidatorByConsAddrKey(rotation.GetOldConsAddr()))
+}
+
+// GetAllConsPubKeyRotations returns all in-progress consensus pubkey rotations
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator and burns the
+// key rotation fee from the operator account. The old pubkey keeps resolving
+// to the validator until the unbonding period has passed, so that evidence and
+// missed blocks signed with it can still be attributed to the validator.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, newPubKey crypto.PubKey) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	if _, found := k.GetConsPubKeyRotation(ctx, valAddr); found {
+		return types.ErrConsPubKeyRotationInProgress
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		fees := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, fees); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+			return err
+		}
+	}
+
+	oldConsAddr := validator.GetConsAddr()
+	rotation := types.NewConsPubKeyRotation(
+		valAddr, validator.ConsensusPubkey, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey),
+		ctx.BlockHeight(), ctx.BlockTime().Add(k.UnbondingTime(ctx)),
+	)
+
+	validator.ConsensusPubkey = rotation.NewConsPubkey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetConsPubKeyRotation(ctx, rotation)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingConsPubKeyRotationKey(valAddr), valAddr)
+
+	k.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+
+	return nil
+}
+
+// dequeuePendingConsPubKeyRotations returns the old consensus pubkeys of the
+// validators that rotated their key since the last validator set update, by
+// operator address, and removes them from the store.
+func (k Keeper) dequeuePendingConsPubKeyRotations(ctx sdk.Context) map[string]crypto.PubKey {
+	store := ctx.KVStore(k.storeKey)
+	oldPubKeys := make(map[string]crypto.PubKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Value())
+
+		rotation, found := k.GetConsPubKeyRotation(ctx, valAddr)
+		if !found {
+			panic("pending consensus pubkey rotation not found")
+		}
+
+		oldPubKeys[string(valAddr)] = rotation.GetOldConsPubKey()
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return oldPubKeys
+}
+
+// CompleteMatureConsPubKeyRotations removes the consensus pubkey rotations
+// whose unbonding period has passed, after which the old consensus pubkeys no
+// longer resolve to their validators.
+func (k Keeper) CompleteMatureConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(
+		types.ConsPubKeyRotationQueueKey,
+		sdk.PrefixEndBytes(types.GetConsPubKeyRotationTimeKey(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var matureRotations []types.ConsPubKeyRotation
+	for ; iterator.Valid(); iterator.Next() {
+		rotation, found := k.GetConsPubKeyRotation(ctx, sdk.ValAddress(iterator.Value()))
+		if !found {
+			panic("consensus pubkey rotation in queue not found")
+		}
+
+		matureRotations = append(matureRotations, rotation)
+	}
+
+	for _, rotation := range matureRotations {
+		k.DeleteConsPubKeyRotation(ctx, rotation)
+	}
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, fee.Amount.MulRaw(2))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens.MulRaw(2)))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	for i := range valAddrs {
+		validator := types.NewValidator(valAddrs[i], PKs[i], types.Description{})
+		validator, _ = validator.AddTokensFromDel(startTokens)
+		validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+		require.True(t, validator.IsBonded())
+		app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	}
+
+	// the new key cannot be used by another validator
+	require.Equal(t, types.ErrValidatorPubKeyExists, app.StakingKeeper.RotateConsPubKey(ctx, valAddrs[0], PKs[1]))
+
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddrs[0], PKs[2]))
+
+	// the fee is burned
+	require.Equal(t, fee.Amount, app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom).Amount)
+	require.Equal(t, supply.Sub(fee), app.BankKeeper.GetSupply(ctx, bondDenom))
+
+	// both the old and the new key resolve to the validator
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[2]))
+	require.True(t, found)
+	require.Equal(t, PKs[2], validator.GetConsPubKey())
+	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(t, found)
+	require.Equal(t, valAddrs[0], validator.OperatorAddress)
+
+	// the key can only be rotated once per unbonding period
+	require.Equal(t, types.ErrConsPubKeyRotationInProgress, app.StakingKeeper.RotateConsPubKey(ctx, valAddrs[0], PKs[3]))
+
+	// Tendermint replaces the old key of the validator with the new one
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(PKs[0]), Power: 0},
+		validator.ABCIValidatorUpdate(),
+	}, updates)
+	require.Empty(t, app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx))
+
+	// the old key stops resolving to the validator after the unbonding period
+	rotation, found := app.StakingKeeper.GetConsPubKeyRotation(ctx, valAddrs[0])
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(rotation.CompletionTime)
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetConsPubKeyRotation(ctx, valAddrs[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[2]))
+	require.True(t, found)
+}
+
+func TestRotateConsPubKeyOfUnbondingValidator(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, fee.Amount)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, _ = validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddrs[0], PKs[1]))
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(PKs[0]))
+
+	// the validator is removed from the set under its old key
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: tmtypes.TM2PB.PubKey(PKs[0]), Power: 0}}, updates)
+}
@@ -259,6 +259,27 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// Remove an unbonding delegation from the appropriate timeslice in the
+// unbonding queue. Only one occurrence is removed, as each entry of the
+// unbonding delegation is inserted separately.
+func (k Keeper) RemoveUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -734,6 +755,65 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation delegates back to the validator amount of the
+// balance of the unbonding delegation entry created at creationHeight. The
+// entry is removed from the unbonding delegation and the unbonding queue once
+// its whole balance is delegated back.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) (newShares sdk.Dec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.Dec{}, types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		// mature entries are completed by the EndBlocker and cannot be cancelled
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdk.Dec{}, types.ErrNoUnbondingDelegationEntry
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return sdk.Dec{}, types.ErrBadCancelUnbondingAmount
+	}
+
+	// the tokens are moved back from the not bonded pool if the validator is bonded
+	newShares, err = k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.RemoveUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex].Balance = entry.Balance.Sub(amount)
+		ubd.Entries[entryIndex].InitialBalance = entry.InitialBalance.Sub(amount)
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -829,6 +909,158 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// TransferDelegation moves delegation shares worth up to wantAmt tokens on the
+// given validator from fromAddr to toAddr without unbonding them. Delegations
+// that received an in-progress redelegation are left untouched, since slashing
+// of that redelegation must still find the shares under fromAddr. It returns the
+// amount of tokens represented by the transferred shares.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	if !wantAmt.IsPositive() || k.HasReceivingRedelegation(ctx, fromAddr, valAddr) {
+		return transferred
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	wantShares, err := validator.SharesFromTokensTruncated(wantAmt)
+	if err != nil {
+		return transferred
+	}
+
+	shares := sdk.MinDec(wantShares, delFrom.Shares)
+	if !shares.IsPositive() {
+		return transferred
+	}
+
+	validator = k.transferDelegationShares(ctx, validator, delFrom, toAddr, shares)
+
+	return validator.TokensFromShares(shares).TruncateInt()
+}
+
+// transferDelegationShares moves shares of delFrom to the delegation of toAddr
+// on the same validator, calling the delegation hooks so that the rewards of
+// both delegations are accounted for. The shares must not exceed those of
+// delFrom. It returns the validator, which is jailed if the transfer takes its
+// self-delegation below its minimum.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, validator types.Validator, delFrom types.Delegation, toAddr sdk.AccAddress, shares sdk.Dec,
+) types.Validator {
+	fromAddr, valAddr := delFrom.DelegatorAddress, delFrom.ValidatorAddress
+
+	// withdraw the rewards of the source delegation before its shares change
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	delFrom.Shares = delFrom.Shares.Sub(shares)
+
+	// If the source is the operator of the validator and the transfer decreases
+	// the validator's self-delegation below their minimum, we jail the validator.
+	isValidatorOperator := fromAddr.Equals(validator.OperatorAddress)
+	if isValidatorOperator && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+		validator = k.mustGetValidator(ctx, validator.OperatorAddress)
+	}
+
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(shares)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return validator
+}
+
+// TransferUnbonding moves up to wantAmt of the immature unbonding balance of
+// fromAddr on the given validator to toAddr. Each transferred entry keeps its
+// creation height and completion time, so slashing and the unbonding queue
+// treat it exactly as before. Entries are only moved while toAddr is below the
+// maximum number of unbonding entries. It returns the amount transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	ctxTime := ctx.BlockHeader().Time
+
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+
+		// mature entries are paid out to fromAddr by the EndBlocker
+		if entry.IsMature(ctxTime) || !entry.Balance.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		xferInitial := entry.InitialBalance.Mul(toXfer).Quo(entry.Balance)
+
+		if toXfer.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+		} else {
+			ubdFrom.Entries[i].Balance = entry.Balance.Sub(toXfer)
+			ubdFrom.Entries[i].InitialBalance = entry.InitialBalance.Sub(xferInitial)
+		}
+
+		ubdTo, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+		if !found {
+			ubdTo = types.UnbondingDelegation{DelegatorAddress: toAddr, ValidatorAddress: valAddr}
+		}
+
+		ubdTo.Entries = append(ubdTo.Entries, types.UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: xferInitial,
+			Balance:        toXfer,
+		})
+		k.SetUnbondingDelegation(ctx, ubdTo)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+	}
+
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
@@ -1023,3 +1023,86 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a bonded validator and a delegator to that validator
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// unbond half of the delegation
+	ctx = ctx.WithBlockHeight(10)
+	unbondTokens := startTokens.QuoRaw(2)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, delAddrs[0], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	bondedPoolAddr := app.StakingKeeper.GetBondedPool(ctx).GetAddress()
+	require.Equal(t, startTokens.Sub(unbondTokens), app.BankKeeper.GetBalance(ctx, bondedPoolAddr, bondDenom).Amount)
+
+	// the entry must exist at the given creation height
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 11, unbondTokens)
+	require.Equal(t, types.ErrNoUnbondingDelegationEntry, err)
+
+	// the amount cannot exceed the balance of the entry
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, unbondTokens.AddRaw(1))
+	require.Equal(t, types.ErrBadCancelUnbondingAmount, err)
+
+	// cancel part of the entry
+	cancelTokens := unbondTokens.QuoRaw(5)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, cancelTokens)
+	require.NoError(t, err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(unbondTokens).Add(cancelTokens), delegation.Shares.RoundInt())
+	require.Equal(t, startTokens.Sub(unbondTokens).Add(cancelTokens), app.BankKeeper.GetBalance(ctx, bondedPoolAddr, bondDenom).Amount)
+
+	_, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// cancel the rest of the entry
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, unbondTokens.Sub(cancelTokens))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens, delegation.Shares.RoundInt())
+	require.Equal(t, startTokens, app.BankKeeper.GetBalance(ctx, bondedPoolAddr, bondDenom).Amount)
+
+	_, broken = keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// a mature entry cannot be cancelled
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddrs[0], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, unbondTokens)
+	require.Equal(t, types.ErrNoUnbondingDelegationEntry, err)
+}
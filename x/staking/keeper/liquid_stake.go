@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and indexes it by owner
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerKey(record.Owner, record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its owner index
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerKey(record.Owner, record.Id))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records owned
+// by an address
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.TokenizeShareRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerKey(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	records := []types.TokenizeShareRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record indexed by owner does not exist")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) []types.TokenizeShareRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	records := []types.TokenizeShareRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator that are
+// held by tokenize share records
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &shares)
+
+	return shares.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized shares of a validator, removing
+// the entry once they reach zero
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorLiquidSharesKey(valAddr)
+
+	if !shares.IsPositive() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares}))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of staked tokens backing the
+// tokenized shares of all validators
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesPrefix)
+	defer iterator.Close()
+
+	total := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed validator address
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesPrefix)+1:])
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &shares)
+
+		total = total.Add(validator.TokensFromShares(shares.Dec).TruncateInt())
+	}
+
+	return total
+}
+
+// TokenizeShares moves shares worth amount of the delegation of delAddr to
+// valAddr to a new tokenize share record owned by owner, and mints to the owner
+// share tokens representing them. It returns the minted share tokens.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", amount.Denom, bondDenom)
+	}
+
+	// the shares of a delegation which is the destination of a redelegation
+	// must stay slashable for the infraction of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	// vesting coins must not become transferable through share tokens
+	vestingAcc, isVestingAcc := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	if isVestingAcc && amount.Amount.GT(vestingAcc.GetDelegatedFree().AmountOf(bondDenom)) {
+		return sdk.Coin{}, types.ErrTokenizeLockedVestingCoins
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// share tokens are minted one to one with whole shares
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyTokenizeShareAmount
+	}
+
+	totalLiquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(validator.TokensFromShares(shares).TruncateInt())
+	if totalLiquidTokens.ToDec().GT(k.GlobalLiquidStakingCap(ctx).MulInt(k.TotalBondedTokens(ctx))) {
+		return sdk.Coin{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	if liquidShares.GT(k.ValidatorLiquidStakingCap(ctx).Mul(validator.DelegatorShares)) {
+		return sdk.Coin{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, owner, valAddr)
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	// the tokenized coins are no longer delegated by the vesting account, so
+	// they must not be left in its delegated free coins
+	if tokens := validator.TokensFromShares(shares).TruncateInt(); isVestingAcc && tokens.IsPositive() {
+		vestingAcc.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, tokens)))
+		k.authKeeper.SetAccount(ctx, vestingAcc)
+	}
+
+	k.transferDelegationShares(ctx, validator, delegation, record.GetModuleAddress(), shares)
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt()))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareTokens[0], nil
+}
+
+// RedeemTokensForShares burns the share tokens of delAddr and moves the shares
+// they represent from the tokenize share record to the delegation of delAddr.
+// The record is removed once all of its shares are redeemed, and any rewards
+// left in its account are sent to its owner. It returns the validator and the
+// redeemed shares.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin,
+) (sdk.ValAddress, sdk.Dec, error) {
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return nil, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	// the redeemed shares are not tracked as delegated by vesting accounts, so
+	// undelegating them would corrupt the vesting schedule
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		return nil, sdk.Dec{}, sdkerrors.Wrapf(types.ErrRedeemToVestingAccount, "%s", delAddr)
+	}
+
+	valAddr, id, err := types.ParseShareTokenDenom(amount.Denom)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return nil, sdk.Dec{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	if !record.Validator.Equals(valAddr) {
+		return nil, sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidShareTokenDenom, amount.Denom)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdk.Dec{}, types.ErrNoValidatorFound
+	}
+
+	recordAddr := record.GetModuleAddress()
+
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return nil, sdk.Dec{}, types.ErrNoDelegation
+	}
+
+	shares := sdk.MinDec(amount.Amount.ToDec(), delegation.Shares)
+
+	shareTokens := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, shareTokens); err != nil {
+		return nil, sdk.Dec{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return nil, sdk.Dec{}, err
+	}
+
+	k.transferDelegationShares(ctx, validator, delegation, delAddr, shares)
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+
+	if shares.Equal(delegation.Shares) {
+		// the rewards of the record were withdrawn to its account when the
+		// delegation was removed
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, record.Owner, k.bankKeeper.GetAllBalances(ctx, recordAddr)); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return valAddr, shares, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and thus of the rewards of its delegation, from sender to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	if !record.Owner.Equals(sender) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	k.DeleteTokenizeShareRecord(ctx, record)
+	record.Owner = newOwner
+	k.SetTokenizeShareRecord(ctx, record)
+
+	return nil
+}
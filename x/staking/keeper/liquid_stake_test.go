@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrs[0], valAddrs[0], issuedShares))
+
+	// only the bond denom can be tokenized
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin("atom", startTokens), addrs[1])
+	require.Error(t, err)
+
+	// tokenizing 30% of the bonded tokens exceeds the default global cap of 25%
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, startTokens.QuoRaw(10).MulRaw(3)), addrs[1])
+	require.Equal(t, types.ErrGlobalLiquidStakingCapExceeded, err)
+
+	tokenizeAmt := startTokens.QuoRaw(5)
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, tokenizeAmt), addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmt, shareToken.Amount)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, addrs[1], record.Owner)
+	require.Equal(t, valAddrs[0], record.Validator)
+	require.Equal(t, record.GetShareTokenDenom(), shareToken.Denom)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrs[1], shareToken.Denom))
+	require.Equal(t, tokenizeAmt.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	require.Equal(t, tokenizeAmt, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	require.Len(t, record.GetModuleAddress(), address.Len)
+	recordDel, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.ToDec(), recordDel.Shares)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(tokenizeAmt.ToDec()), delegation.Shares)
+
+	// only the owner can transfer the record
+	require.Equal(t, types.ErrNotTokenizeShareRecordOwner, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrs[0], addrs[2]))
+	require.NoError(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrs[1], addrs[2]))
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]), 1)
+
+	// redeem half of the share tokens
+	redeemAmt := tokenizeAmt.QuoRaw(2)
+	_, shares, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], sdk.NewCoin(shareToken.Denom, redeemAmt))
+	require.NoError(t, err)
+	require.Equal(t, redeemAmt.ToDec(), shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, redeemAmt.ToDec(), delegation.Shares)
+	require.Equal(t, tokenizeAmt.Sub(redeemAmt).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+
+	// redeeming more tokens than held fails
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], sdk.NewCoin(shareToken.Denom, tokenizeAmt))
+	require.Error(t, err)
+
+	// redeeming the rest removes the record
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], sdk.NewCoin(shareToken.Denom, tokenizeAmt.Sub(redeemAmt)))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+}
+
+func TestTokenizeSharesFromVestingAccount(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(150))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[1], PKs[1], types.Description{})
+	validator, _ = validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	// the account has 100 vesting and 50 free coins, all delegated
+	acc := app.AccountKeeper.GetAccount(ctx, addrs[0]).(*authtypes.BaseAccount)
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewDelayedVestingAccount(acc, vestingCoins, ctx.BlockTime().Unix()+1000000000))
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[0], sdk.NewInt(150), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the vesting coins cannot be tokenized
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], sdk.NewInt64Coin(bondDenom, 51), addrs[2])
+	require.Equal(t, types.ErrTokenizeLockedVestingCoins, err)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], sdk.NewInt64Coin(bondDenom, 50), addrs[2])
+	require.NoError(t, err)
+
+	vestingAcc := app.AccountKeeper.GetAccount(ctx, addrs[0]).(*vestingtypes.DelayedVestingAccount)
+	require.True(t, vestingAcc.GetDelegatedFree().IsZero())
+	require.Equal(t, vestingCoins, vestingAcc.GetDelegatedVesting())
+
+	// undelegating the rest unlocks no vesting coins
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrs[0], valAddrs[1], sdk.NewDec(100))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = app.StakingKeeper.CompleteUnbonding(ctx, addrs[0], valAddrs[1])
+	require.NoError(t, err)
+
+	vestingAcc = app.AccountKeeper.GetAccount(ctx, addrs[0]).(*vestingtypes.DelayedVestingAccount)
+	require.Equal(t, vestingCoins, vestingAcc.LockedCoins(ctx.BlockTime()))
+	require.Equal(t, vestingCoins, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, addrs[0]).IsZero())
+}
+
+func TestRedeemTokensForSharesToVestingAccount(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrs[0], valAddrs[0], issuedShares))
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, startTokens.QuoRaw(10)), addrs[1])
+	require.NoError(t, err)
+
+	// the share tokens are held by an account which starts vesting
+	acc := app.AccountKeeper.GetAccount(ctx, addrs[1]).(*authtypes.BaseAccount)
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(acc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], shareToken)
+	require.True(t, types.ErrRedeemToVestingAccount.Is(err))
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrs[1], shareToken.Denom))
+}
+
+func TestTokenizeSharesValidatorCap(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrs[0], valAddrs[0], issuedShares))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(10, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, startTokens.QuoRaw(5)), addrs[1])
+	require.Equal(t, types.ErrValidatorLiquidStakingCapExceeded, err)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, startTokens.QuoRaw(10)), addrs[1])
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.NewCoin(bondDenom, sdk.OneInt()), addrs[1])
+	require.Equal(t, types.ErrValidatorLiquidStakingCapExceeded, err)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams sets the params added since the previous release, which don't
+// exist in the params store of the chains created before it, and raises the
+// commission rate of the validators below the given MinCommissionRate, along
+// with their max rate if needed. It is meant to be called once from an
+// x/upgrade handler.
+func (k Keeper) MigrateParams(ctx sdk.Context, minCommissionRate sdk.Dec) {
+	if !k.paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		k.paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+
+	if !k.paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap) {
+		k.paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	}
+
+	if !k.paramstore.Has(ctx, types.KeyMaxVotingPowerRatio) {
+		k.paramstore.Set(ctx, types.KeyMaxVotingPowerRatio, types.DefaultMaxVotingPowerRatio)
+	}
+
+	if !k.paramstore.Has(ctx, types.KeyKeyRotationFee) {
+		// the default fee is charged in the bond denom of the chain
+		fee := sdk.NewCoin(k.BondDenom(ctx), types.DefaultKeyRotationFee.Amount)
+		k.paramstore.Set(ctx, types.KeyKeyRotationFee, fee)
+	}
+
+	k.paramstore.Set(ctx, types.KeyMinCommissionRate, minCommissionRate)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minCommissionRate) {
+			continue
+		}
+
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission.Rate = minCommissionRate
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+
+		k.SetValidator(ctx, validator)
+	}
+}
+
+// MigrateAddressKeys migrates the store keys holding fixed length addresses to
+// length prefixed addresses. The validators by power index is rebuilt from the
+// migrated validators as the address is not at the start of its keys. It is
+// meant to be called once from an x/upgrade handler.
+func (k Keeper) MigrateAddressKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	address.MigratePrefixAddresses(store, types.LastValidatorPowerKey, 1)
+	address.MigratePrefixAddresses(store, types.ValidatorsKey, 1)
+	address.MigratePrefixAddresses(store, types.ValidatorsByConsAddrKey, 1)
+
+	address.MigratePrefixAddresses(store, types.DelegationKey, 2)
+	address.MigratePrefixAddresses(store, types.UnbondingDelegationKey, 2)
+	address.MigratePrefixAddresses(store, types.UnbondingDelegationByValIndexKey, 2)
+	address.MigratePrefixAddresses(store, types.RedelegationKey, 3)
+	address.MigratePrefixAddresses(store, types.RedelegationByValSrcIndexKey, 3)
+	address.MigratePrefixAddresses(store, types.RedelegationByValDstIndexKey, 3)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsByPowerIndexKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		k.SetValidatorByPowerIndex(ctx, validator)
+	}
+}
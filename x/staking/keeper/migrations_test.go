@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	minRate := sdk.NewDecWithPrec(5, 2)
+
+	commissions := []types.Commission{
+		types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+	}
+
+	for i, commission := range commissions {
+		validator := types.NewValidator(valAddrs[i], PKs[i], types.Description{})
+		validator.Commission = commission
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	// the key rotation fee already set is kept
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 42)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.KeyRotationFee = fee
+	app.StakingKeeper.SetParams(ctx, params)
+
+	app.StakingKeeper.MigrateParams(ctx, minRate)
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+	require.Equal(t, fee, app.StakingKeeper.KeyRotationFee(ctx))
+
+	// both the rate and the max rate are raised to the floor
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+
+	// only the rate is raised when the max rate is above the floor
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, commissions[1].MaxRate, validator.Commission.MaxRate)
+
+	// validators above the floor are left untouched
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, commissions[2], validator.Commission)
+}
@@ -56,6 +56,19 @@ func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) error {
 	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
 }
 
+// sendTokensToReporter sends slashed tokens from a pool module account to the
+// reporter of an infraction
+func (k Keeper) sendTokensToReporter(ctx sdk.Context, poolName string, reporter sdk.AccAddress, amt sdk.Int) error {
+	if !amt.IsPositive() {
+		// skip as no coins need to be sent
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, poolName, reporter, coins)
+}
+
 // TotalBondedTokens total staking tokens supply which is bonded
 func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 	bondedPool := k.GetBondedPool(ctx)
@@ -64,7 +77,7 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 
 // StakingTokenSupply staking tokens from the total supply
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
-	return k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(k.BondDenom(ctx))
+	return k.bankKeeper.GetSupply(ctx, k.BondDenom(ctx)).Amount
 }
 
 // BondedRatio the fraction of the staking tokens which are currently bonded
@@ -22,6 +22,24 @@ import (
 //    Infraction was committed at the current height or at a past height,
 //    not at a height in the future
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+	k.slash(ctx, consAddr, infractionHeight, power, slashFactor, nil, sdk.ZeroDec())
+}
+
+// SlashWithReward slashes a validator like Slash, but sends the rewardFraction
+// of the tokens slashed from the validator to the reporter of the infraction
+// instead of burning them. It returns the amount of tokens sent to the
+// reporter.
+func (k Keeper) SlashWithReward(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec,
+	reporter sdk.AccAddress, rewardFraction sdk.Dec,
+) sdk.Int {
+	return k.slash(ctx, consAddr, infractionHeight, power, slashFactor, reporter, rewardFraction)
+}
+
+func (k Keeper) slash(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec,
+	reporter sdk.AccAddress, rewardFraction sdk.Dec,
+) sdk.Int {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -45,7 +63,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			"WARNING: Ignored attempt to slash a nonexistent validator with address %s, we recommend you investigate immediately",
 			consAddr))
 
-		return
+		return sdk.ZeroInt()
 	}
 
 	// should not be slashing an unbonded validator
@@ -119,12 +137,26 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	// The reward of the reporter is paid from the slashed tokens instead of
+	// being burned.
+	reward := sdk.ZeroInt()
+	if !reporter.Empty() {
+		reward = tokensToBurn.ToDec().Mul(rewardFraction).TruncateInt()
+		tokensToBurn = tokensToBurn.Sub(reward)
+	}
+
 	switch validator.GetStatus() {
 	case sdk.Bonded:
+		if err := k.sendTokensToReporter(ctx, types.BondedPoolName, reporter, reward); err != nil {
+			panic(err)
+		}
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
 			panic(err)
 		}
 	case sdk.Unbonding, sdk.Unbonded:
+		if err := k.sendTokensToReporter(ctx, types.NotBondedPoolName, reporter, reward); err != nil {
+			panic(err)
+		}
 		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
 			panic(err)
 		}
@@ -134,8 +166,10 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
-		"validator %s slashed by slash factor of %s; burned %v tokens",
-		validator.GetOperator(), slashFactor.String(), tokensToBurn))
+		"validator %s slashed by slash factor of %s; burned %v tokens, rewarded %v tokens",
+		validator.GetOperator(), slashFactor.String(), tokensToBurn, reward))
+
+	return reward
 }
 
 // jail a validator
@@ -154,6 +188,24 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	logger.Info(fmt.Sprintf("validator %s unjailed", consAddr))
 }
 
+// StartMaintenance removes a validator from the active set for a maintenance
+// window without jailing it
+func (k Keeper) StartMaintenance(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
+	k.startValidatorMaintenance(ctx, validator)
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("validator %s started maintenance", consAddr))
+}
+
+// EndMaintenance lets a validator back into the active set after a
+// maintenance window
+func (k Keeper) EndMaintenance(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
+	k.endValidatorMaintenance(ctx, validator)
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("validator %s ended maintenance", consAddr))
+}
+
 // slash an unbonding delegation and update the pool
 // return the amount that would have been slashed assuming
 // the unbonding delegation had enough stake to slash
@@ -10,7 +10,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -22,10 +21,10 @@ func bootstrapSlashTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context,
 	addrDels, addrVals := generateAddresses(app, ctx, 100)
 
 	amt := sdk.TokensFromConsensusPower(power)
-	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
+	totalSupply := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
+	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(totalSupply))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
@@ -38,7 +37,7 @@ func bootstrapSlashTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context,
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	app.BankKeeper.SetSupply(ctx, totalSupply)
 
 	for i := int64(0); i < numVals; i++ {
 		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
@@ -619,3 +618,37 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests SlashWithReward paying the reporter from the slashed tokens
+func TestSlashWithReward(t *testing.T) {
+	app, ctx, addrDels, _ := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	rewardFraction := sdk.NewDecWithPrec(1, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	reporter := addrDels[99]
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	oldBondedPoolBalance := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	oldReporterBalance := app.BankKeeper.GetBalance(ctx, reporter, bondDenom).Amount
+	oldSupply := app.BankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	reward := app.StakingKeeper.SlashWithReward(ctx, consAddr, ctx.BlockHeight(), 10, fraction, reporter, rewardFraction)
+
+	// the reporter is paid the reward fraction of the slashed tokens
+	slashed := sdk.TokensFromConsensusPower(5)
+	expectedReward := slashed.ToDec().Mul(rewardFraction).TruncateInt()
+	require.Equal(t, expectedReward.String(), reward.String())
+	require.Equal(t, oldReporterBalance.Add(expectedReward).String(), app.BankKeeper.GetBalance(ctx, reporter, bondDenom).Amount.String())
+
+	// the validator loses all the slashed tokens, the rest being burned
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(5).String(), validator.GetTokens().String())
+	require.Equal(t, oldBondedPoolBalance.Sub(slashed).String(), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount.String())
+	require.Equal(t, oldSupply.Sub(slashed.Sub(expectedReward)).String(), app.BankKeeper.GetSupply(ctx, bondDenom).Amount.String())
+
+	// no reward is paid without a reporter
+	reward = app.StakingKeeper.SlashWithReward(ctx, consAddr, ctx.BlockHeight(), 5, fraction, nil, rewardFraction)
+	require.True(t, reward.IsZero())
+}
@@ -7,6 +7,7 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,6 +30,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// stop resolving the old consensus pubkeys of mature key rotations
+	k.CompleteMatureConsPubKeyRotations(ctx)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -96,6 +100,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the old consensus pubkeys of the validators that rotated their
+	// key since the last update, as Tendermint still knows them by these.
+	rotatedPubKeys := k.dequeuePendingConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -110,6 +118,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			panic("should never retrieve a jailed validator from the power store")
 		}
 
+		if validator.InMaintenance {
+			panic("should never retrieve a validator in maintenance from the power store")
+		}
+
 		// if we get to a zero-power validator (which we don't bond),
 		// there are no more possible bonded validators
 		if validator.PotentialConsensusPower() == 0 {
@@ -131,21 +143,28 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 
 		// fetch the old power bytes
-		var valAddrBytes [sdk.AddrLen]byte
-
-		copy(valAddrBytes[:], valAddr[:])
-		oldPowerBytes, found := last[valAddrBytes]
+		valAddrStr := string(valAddr)
+		oldPowerBytes, found := last[valAddrStr]
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		oldPubKey, rotated := rotatedPubKeys[valAddrStr]
+
+		switch {
+		case found && rotated:
+			// replace the old consensus pubkey of the validator with the new one
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+			updates = append(updates, validator.ABCIValidatorUpdate())
+
+			k.SetLastValidatorPower(ctx, valAddr, newPower)
+		case !found || !bytes.Equal(oldPowerBytes, newPowerBytes):
+			// update the validator set if power has changed
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
 
-		delete(last, valAddrBytes)
+		delete(last, valAddrStr)
 		count++
 
 		totalPower = totalPower.Add(sdk.NewInt(newPower))
@@ -157,7 +176,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		validator = k.bondedToUnbonding(ctx, validator)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		update := validator.ABCIValidatorUpdateZero()
+		if oldPubKey, rotated := rotatedPubKeys[string(valAddrBytes)]; rotated {
+			update.PubKey = tmtypes.TM2PB.PubKey(oldPubKey)
+		}
+
+		updates = append(updates, update)
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -240,6 +265,28 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	k.SetValidatorByPowerIndex(ctx, validator)
 }
 
+// remove a validator from the power index for a maintenance window
+func (k Keeper) startValidatorMaintenance(ctx sdk.Context, validator types.Validator) {
+	if validator.InMaintenance {
+		panic(fmt.Sprintf("cannot start maintenance of validator already in maintenance, validator: %v\n", validator))
+	}
+
+	validator.InMaintenance = true
+	k.SetValidator(ctx, validator)
+	k.DeleteValidatorByPowerIndex(ctx, validator)
+}
+
+// put a validator back into the power index after a maintenance window
+func (k Keeper) endValidatorMaintenance(ctx sdk.Context, validator types.Validator) {
+	if !validator.InMaintenance {
+		panic(fmt.Sprintf("cannot end maintenance of validator not in maintenance, validator: %v\n", validator))
+	}
+
+	validator.InMaintenance = false
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByPowerIndex(ctx, validator)
+}
+
 // perform all the store operations for when a validator status becomes bonded
 func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) types.Validator {
 	// delete the validator by power index, as the key will change
@@ -299,8 +346,8 @@ func (k Keeper) completeUnbondingValidator(ctx sdk.Context, validator types.Vali
 	return validator
 }
 
-// map of operator addresses to serialized power
-type validatorsByAddr map[[sdk.AddrLen]byte][]byte
+// map of operator addresses, as strings of their bytes, to serialized power
+type validatorsByAddr map[string][]byte
 
 // get the last validator set
 func (k Keeper) getLastValidatorsByAddr(ctx sdk.Context) validatorsByAddr {
@@ -310,9 +357,8 @@ func (k Keeper) getLastValidatorsByAddr(ctx sdk.Context) validatorsByAddr {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var valAddr [sdk.AddrLen]byte
-		// extract the validator address from the key (prefix is 1-byte)
-		copy(valAddr[:], iterator.Key()[1:])
+		// extract the validator address from the key
+		valAddr := string(types.AddressFromLastValidatorPowerKey(iterator.Key()))
 		powerBytes := iterator.Value()
 		last[valAddr] = make([]byte, len(powerBytes))
 		copy(last[valAddr], powerBytes)
@@ -328,10 +374,8 @@ func sortNoLongerBonded(last validatorsByAddr) [][]byte {
 	noLongerBonded := make([][]byte, len(last))
 	index := 0
 
-	for valAddrBytes := range last {
-		valAddr := make([]byte, sdk.AddrLen)
-		copy(valAddr, valAddrBytes[:])
-		noLongerBonded[index] = valAddr
+	for valAddrStr := range last {
+		noLongerBonded[index] = []byte(valAddrStr)
 		index++
 	}
 	// sorted by address - order doesn't matter
@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -108,8 +109,9 @@ func (k Keeper) SetValidatorByConsAddr(ctx sdk.Context, validator types.Validato
 
 // validator index
 func (k Keeper) SetValidatorByPowerIndex(ctx sdk.Context, validator types.Validator) {
-	// jailed validators are not kept in the power index
-	if validator.Jailed {
+	// jailed validators and validators in maintenance are not kept in the
+	// power index
+	if validator.Jailed || validator.InMaintenance {
 		return
 	}
 
@@ -173,12 +175,47 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "got %s, expected at least %s", newRate, minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// ValidateMaxVotingPowerRatio returns an error if the tokens of a validator
+// exceed the MaxVotingPowerRatio param of the bonded tokens. Validators that
+// are not bonded are accounted for as if they were, as a delegation may bond
+// them at the end of the block.
+func (k Keeper) ValidateMaxVotingPowerRatio(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	maxRatio := k.MaxVotingPowerRatio(ctx)
+	if maxRatio.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if !validator.IsBonded() {
+		totalBonded = totalBonded.Add(validator.Tokens)
+	}
+
+	if !totalBonded.IsPositive() {
+		return nil
+	}
+
+	if ratio := validator.Tokens.ToDec().QuoInt(totalBonded); ratio.GT(maxRatio) {
+		return sdkerrors.Wrapf(types.ErrMaxVotingPowerRatioExceeded, "got %s, expected at most %s", ratio, maxRatio)
+	}
+
+	return nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
@@ -319,7 +356,7 @@ func (k Keeper) IterateLastValidatorPowers(ctx sdk.Context, handler func(operato
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[len(types.LastValidatorPowerKey):])
+		addr := sdk.ValAddress(types.AddressFromLastValidatorPowerKey(iter.Key()))
 		intV := &gogotypes.Int64Value{}
 
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), intV)
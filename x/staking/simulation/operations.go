@@ -22,6 +22,9 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgRotateConsPubKey          = "op_weight_msg_rotate_cons_pubkey"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,6 +38,9 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+
+		weightMsgCancelUnbondingDelegation int
+		weightMsgRotateConsPubKey          int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +73,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRotateConsPubKey, &weightMsgRotateConsPubKey, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateConsPubKey = simappparams.DefaultWeightMsgRotateConsPubKey
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +106,14 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRotateConsPubKey,
+			SimulateMsgRotateConsPubKey(ak, bk, k),
+		),
 	}
 }
 
@@ -409,6 +435,167 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is not ok"), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator's invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if len(unbondingDelegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "keeper does have any unbonding delegation entries"), nil, nil
+		}
+
+		// get random unbonding delegation entry which is not mature
+		ubd := unbondingDelegations[r.Intn(len(unbondingDelegations))]
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) || !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is mature or empty"), nil, nil
+		}
+
+		// the first entry not mature with the same creation height is the one canceled
+		for _, e := range ubd.Entries {
+			if e.CreationHeight == entry.CreationHeight && !e.IsMature(ctx.BlockHeader().Time) {
+				entry = e
+				break
+			}
+		}
+		if !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is mature or empty"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid cancel amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			ubd.DelegatorAddress, valAddr, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(ubd.DelegatorAddress) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", ubd.DelegatorAddress)
+		}
+
+		account := ak.GetAccount(ctx, ubd.DelegatorAddress)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRotateConsPubKey generates a MsgRotateConsPubKey with random values
+// nolint: interfacer
+func SimulateMsgRotateConsPubKey(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		val, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to pick a validator"), nil, nil
+		}
+
+		address := val.GetOperator()
+		if _, found := k.GetConsPubKeyRotation(ctx, address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "consensus pubkey rotation in progress"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(address))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to find account"), nil, fmt.Errorf("validator %s not found", address)
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		rotationFee := k.KeyRotationFee(ctx)
+		spendable, hasNeg := spendable.SafeSub(sdk.NewCoins(rotationFee))
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "not enough balance to pay the key rotation fee"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to generate fees"), nil, err
+		}
+
+		newPubKey := simtypes.RandomAccounts(r, 1)[0].PubKey
+		if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "consensus pubkey already in use"), nil, nil
+		}
+
+		msg := types.NewMsgRotateConsPubKey(address, newPubKey)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgBeginRedelegate generates a MsgBeginRedelegate with random values
 // nolint: interfacer
 func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
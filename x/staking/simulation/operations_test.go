@@ -45,6 +45,8 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDelegate, types.ModuleName, types.TypeMsgDelegate},
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
+		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgRotateConsPubKey, types.ModuleName, types.TypeMsgRotateConsPubKey},
 	}
 
 	for i, w := range weightesOps {
@@ -259,6 +261,56 @@ func TestSimulateMsgBeginRedelegate(t *testing.T) {
 
 }
 
+// TestSimulateMsgCancelUnbondingDelegation tests the normal scenario of a valid message of type TypeMsgCancelUnbondingDelegation.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgCancelUnbondingDelegation(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup an unbonding delegation of accounts[1]
+	unbondingTokens := sdk.TokensFromConsensusPower(2)
+	delegator := accounts[1]
+	ubd := app.StakingKeeper.SetUnbondingDelegationEntry(
+		ctx, delegator.Address, validator0.OperatorAddress, 1, blockTime.Add(time.Hour), unbondingTokens,
+	)
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd, blockTime.Add(time.Hour))
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, unbondingTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	setupValidatorRewards(app, ctx, validator0.OperatorAddress)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelUnbondingDelegation(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCancelUnbondingDelegation
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address, msg.DelegatorAddress)
+	require.Equal(t, validator0.OperatorAddress, msg.ValidatorAddress)
+	require.Equal(t, int64(1), msg.CreationHeight)
+	require.True(t, msg.Amount.Amount.LTE(unbondingTokens))
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.Equal(t, types.TypeMsgCancelUnbondingDelegation, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
 // returns context and an app with updated mint keeper
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(isCheckTx)
@@ -270,6 +322,42 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	return app, ctx
 }
 
+// TestSimulateMsgRotateConsPubKey tests the normal scenario of a valid message of type TypeMsgRotateConsPubKey.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgRotateConsPubKey(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// the key rotation fee is burned, so the supply has to cover the balances
+	app.BankKeeper.SetSupply(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(200).MulRaw(3)))
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgRotateConsPubKey(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgRotateConsPubKey
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgRotateConsPubKey, msg.Type())
+	require.Equal(t, validator0.OperatorAddress, msg.ValidatorAddress)
+	require.NotEqual(t, validator0.ConsensusPubkey, msg.NewPubkey)
+	require.Len(t, futureOperations, 0)
+}
+
 func getTestingAccounts(t *testing.T, r *rand.Rand, app *simapp.SimApp, ctx sdk.Context, n int) []simtypes.Account {
 	accounts := simtypes.RandomAccounts(r, n)
 
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance.
+func NewConsPubKeyRotation(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey string, height int64, completionTime time.Time,
+) ConsPubKeyRotation {
+	return ConsPubKeyRotation{
+		OperatorAddress: valAddr,
+		OldConsPubkey:   oldPubKey,
+		NewConsPubkey:   newPubKey,
+		Height:          height,
+		CompletionTime:  completionTime,
+	}
+}
+
+// GetOldConsPubKey returns the consensus pubkey replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldConsPubkey)
+}
+
+// GetOldConsAddr returns the consensus address replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(r.GetOldConsPubKey().Address())
+}
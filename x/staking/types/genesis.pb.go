@@ -26,14 +26,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params               Params                                 `protobuf:"bytes,1,opt,name=params,proto3,casttype=Params" json:"params"`
-	LastTotalPower       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_total_power,json=lastTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_total_power" yaml:"last_total_power"`
-	LastValidatorPowers  []LastValidatorPower                   `protobuf:"bytes,3,rep,name=last_validator_powers,json=lastValidatorPowers,proto3,casttype=LastValidatorPower" json:"last_validator_powers" yaml:"last_validator_powers"`
-	Validators           []Validator                            `protobuf:"bytes,4,rep,name=validators,proto3,casttype=Validator" json:"validators"`
-	Delegations          []Delegation                           `protobuf:"bytes,5,rep,name=delegations,proto3,casttype=Delegation" json:"delegations"`
-	UnbondingDelegations []UnbondingDelegation                  `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3,casttype=UnbondingDelegation" json:"unbonding_delegations" yaml:"unbonding_delegations"`
-	Redelegations        []Redelegation                         `protobuf:"bytes,7,rep,name=redelegations,proto3,casttype=Redelegation" json:"redelegations"`
-	Exported             bool                                   `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	Params                    Params                                 `protobuf:"bytes,1,opt,name=params,proto3,casttype=Params" json:"params"`
+	LastTotalPower            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_total_power,json=lastTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_total_power" yaml:"last_total_power"`
+	LastValidatorPowers       []LastValidatorPower                   `protobuf:"bytes,3,rep,name=last_validator_powers,json=lastValidatorPowers,proto3,casttype=LastValidatorPower" json:"last_validator_powers" yaml:"last_validator_powers"`
+	Validators                []Validator                            `protobuf:"bytes,4,rep,name=validators,proto3,casttype=Validator" json:"validators"`
+	Delegations               []Delegation                           `protobuf:"bytes,5,rep,name=delegations,proto3,casttype=Delegation" json:"delegations"`
+	UnbondingDelegations      []UnbondingDelegation                  `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3,casttype=UnbondingDelegation" json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations             []Redelegation                         `protobuf:"bytes,7,rep,name=redelegations,proto3,casttype=Redelegation" json:"redelegations"`
+	Exported                  bool                                   `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	TokenizeShareRecords      []TokenizeShareRecord                  `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	LastTokenizeShareRecordId uint64                                 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
+	ConsPubkeyRotations       []ConsPubKeyRotation                   `protobuf:"bytes,11,rep,name=cons_pubkey_rotations,json=consPubkeyRotations,proto3" json:"cons_pubkey_rotations" yaml:"cons_pubkey_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +121,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubkeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubkeyRotations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic
 type LastValidatorPower struct {
 	Address github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"address,omitempty"`
@@ -179,40 +203,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/genesis.proto", fileDescriptor_10c2ca02caf42801) }
 
 var fileDescriptor_10c2ca02caf42801 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xd6, 0xcd, 0xd6, 0x69, 0x2d, 0x3a, 0xdb, 0xd5, 0x58, 0x4a, 0x52, 0x22, 0x48,
-	0x0f, 0x6e, 0x82, 0xeb, 0x6d, 0x05, 0xc1, 0x20, 0xca, 0xe2, 0x1e, 0xca, 0xa8, 0x8b, 0x78, 0x29,
-	0xd3, 0xcd, 0x10, 0xc3, 0xa6, 0x99, 0x90, 0x99, 0xba, 0xbb, 0x5f, 0xc0, 0xb3, 0x08, 0x7e, 0xa7,
-	0x3d, 0xee, 0x51, 0x3c, 0x04, 0x69, 0xbf, 0xc1, 0x1e, 0x7b, 0x92, 0xcc, 0xa4, 0xd9, 0x34, 0x2d,
-	0xe2, 0x29, 0x9d, 0xff, 0x7b, 0xff, 0xdf, 0xfb, 0x67, 0x9a, 0x07, 0x7b, 0x27, 0x8c, 0x4f, 0x18,
-	0x77, 0xb8, 0x20, 0xa7, 0x41, 0xe4, 0x3b, 0x3e, 0x8d, 0x28, 0x0f, 0xb8, 0x1d, 0x27, 0x4c, 0x30,
-	0xd4, 0x56, 0x55, 0x3b, 0xaf, 0x76, 0x3b, 0x3e, 0xf3, 0x99, 0x2c, 0x39, 0xd9, 0x2f, 0xd5, 0xd5,
-	0xad, 0x32, 0xf2, 0xa7, 0xaa, 0x5a, 0xdf, 0x34, 0xd8, 0x7a, 0xab, 0xa8, 0xef, 0x05, 0x11, 0x14,
-	0xbd, 0x84, 0x5a, 0x4c, 0x12, 0x32, 0xe1, 0x3a, 0xe8, 0x83, 0x41, 0x73, 0xff, 0x81, 0xbd, 0x3a,
-	0xc5, 0x1e, 0xca, 0xaa, 0xdb, 0xbe, 0x4c, 0xcd, 0xda, 0x22, 0x35, 0x35, 0x75, 0xc6, 0xb9, 0x0b,
-	0x71, 0x78, 0x2f, 0x24, 0x5c, 0x8c, 0x04, 0x13, 0x24, 0x1c, 0xc5, 0xec, 0x8c, 0x26, 0xfa, 0xad,
-	0x3e, 0x18, 0xb4, 0xdc, 0xc3, 0xcc, 0xf1, 0x3b, 0x35, 0x9f, 0xf8, 0x81, 0xf8, 0x32, 0x1d, 0xdb,
-	0x27, 0x6c, 0xe2, 0xe4, 0xd9, 0xd4, 0x63, 0x8f, 0x7b, 0xa7, 0x8e, 0xb8, 0x88, 0x29, 0xb7, 0x0f,
-	0x23, 0x71, 0x9d, 0x9a, 0x0f, 0x2f, 0xc8, 0x24, 0x3c, 0xb0, 0xaa, 0x3c, 0x0b, 0xb7, 0x33, 0xe9,
-	0x43, 0xa6, 0x0c, 0x33, 0x01, 0xfd, 0x00, 0x70, 0x57, 0x76, 0x7d, 0x25, 0x61, 0xe0, 0x11, 0xc1,
-	0x12, 0xd5, 0xc9, 0xf5, 0x7a, 0xbf, 0x3e, 0x68, 0xee, 0x5b, 0xd5, 0x97, 0x38, 0x22, 0x5c, 0x1c,
-	0x2f, 0x7b, 0x25, 0xc3, 0x3d, 0xc8, 0xe2, 0x5d, 0xa7, 0x66, 0xaf, 0x34, 0xb4, 0x8a, 0xb3, 0x16,
-	0xa9, 0x89, 0xd6, 0xbd, 0x78, 0x27, 0x5c, 0xd3, 0x38, 0x3a, 0x82, 0xb0, 0xf0, 0x73, 0xfd, 0xb6,
-	0x0c, 0xf2, 0xa8, 0x1a, 0xa4, 0x30, 0xb9, 0xf7, 0xf3, 0x0b, 0xbd, 0x53, 0x48, 0xb8, 0xe4, 0x47,
-	0x43, 0xd8, 0xf4, 0x68, 0x48, 0x7d, 0x22, 0x02, 0x16, 0x71, 0x7d, 0x4b, 0xe2, 0xba, 0x55, 0xdc,
-	0xeb, 0xa2, 0xc5, 0x45, 0x39, 0x0f, 0xde, 0x68, 0xb8, 0x8c, 0x40, 0x3f, 0x01, 0xdc, 0x9d, 0x46,
-	0x63, 0x16, 0x79, 0x41, 0xe4, 0x8f, 0xca, 0x70, 0x4d, 0xc2, 0x1f, 0x57, 0xe1, 0x1f, 0x97, 0xcd,
-	0xa5, 0x29, 0x2f, 0x56, 0x6f, 0x6d, 0x23, 0x2f, 0xbb, 0xb5, 0x9d, 0x0d, 0x66, 0xdc, 0x99, 0xae,
-	0x8b, 0x1c, 0x7d, 0x82, 0x77, 0x13, 0x5a, 0x8e, 0xb3, 0x2d, 0xe3, 0xf4, 0xaa, 0x71, 0x70, 0xa9,
-	0xc9, 0xed, 0xe4, 0x6f, 0xdb, 0x2a, 0xab, 0x78, 0x15, 0x84, 0xba, 0xb0, 0x41, 0xcf, 0x63, 0x96,
-	0x08, 0xea, 0xe9, 0x8d, 0x3e, 0x18, 0x34, 0x70, 0x71, 0xb6, 0xce, 0xe0, 0x86, 0x3f, 0x16, 0xbd,
-	0x83, 0xdb, 0xc4, 0xf3, 0x12, 0xca, 0xd5, 0x3a, 0xb4, 0xdc, 0x67, 0x8b, 0xd4, 0xdc, 0xfb, 0x8f,
-	0x0f, 0xf8, 0x98, 0x84, 0xaf, 0x94, 0x11, 0x2f, 0x09, 0xa8, 0x03, 0xb7, 0x6e, 0xf6, 0xa1, 0x8e,
-	0xd5, 0xc1, 0x7d, 0x73, 0x39, 0x33, 0xc0, 0xd5, 0xcc, 0x00, 0x7f, 0x66, 0x06, 0xf8, 0x3e, 0x37,
-	0x6a, 0x57, 0x73, 0xa3, 0xf6, 0x6b, 0x6e, 0xd4, 0x3e, 0x3f, 0xfd, 0xe7, 0x9c, 0xf3, 0x62, 0xa3,
-	0xe5, 0xc4, 0xb1, 0x26, 0x17, 0xfa, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xd7, 0x71,
-	0x39, 0x34, 0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xb6, 0x75, 0x9d, 0x5b, 0x26, 0xf0, 0xba, 0x91, 0x55, 0x5b, 0x53, 0x85, 0x81,
+	0x7a, 0x60, 0xa9, 0x18, 0xb7, 0x21, 0x21, 0x11, 0x10, 0x68, 0xda, 0x0e, 0x95, 0x37, 0x26, 0xc4,
+	0x25, 0x72, 0x1b, 0x2b, 0x0b, 0x4d, 0xe3, 0x28, 0x76, 0xd9, 0x8a, 0x38, 0xf0, 0x11, 0x10, 0x12,
+	0xdf, 0x69, 0xc7, 0x1d, 0x11, 0x87, 0x08, 0x6d, 0xdf, 0x60, 0xdc, 0x76, 0x42, 0x89, 0xbd, 0x34,
+	0x4d, 0x23, 0xc4, 0x29, 0xf5, 0x7b, 0xff, 0xff, 0xef, 0xbd, 0xbc, 0xc6, 0x0f, 0x6c, 0xf4, 0x29,
+	0x1b, 0x52, 0xd6, 0x61, 0x1c, 0x0f, 0x5c, 0xdf, 0xe9, 0x38, 0xc4, 0x27, 0xcc, 0x65, 0x46, 0x10,
+	0x52, 0x4e, 0xe1, 0xb2, 0xc8, 0x1a, 0x32, 0xdb, 0xa8, 0x3b, 0xd4, 0xa1, 0x49, 0xaa, 0x13, 0xff,
+	0x12, 0xaa, 0x46, 0x9e, 0x21, 0x9f, 0x22, 0xab, 0xff, 0xa9, 0x80, 0xda, 0x5b, 0x41, 0x3d, 0xe4,
+	0x98, 0x13, 0xf8, 0x02, 0x94, 0x03, 0x1c, 0xe2, 0x21, 0x53, 0x95, 0x96, 0xd2, 0xae, 0xee, 0xac,
+	0x19, 0xd3, 0x55, 0x8c, 0x6e, 0x92, 0x35, 0x97, 0xcf, 0x23, 0xad, 0x74, 0x13, 0x69, 0x65, 0x71,
+	0x46, 0xd2, 0x05, 0x19, 0xb8, 0xe7, 0x61, 0xc6, 0x2d, 0x4e, 0x39, 0xf6, 0xac, 0x80, 0x9e, 0x92,
+	0x50, 0xbd, 0xd3, 0x52, 0xda, 0x35, 0x73, 0x2f, 0x76, 0xfc, 0x8a, 0xb4, 0xc7, 0x8e, 0xcb, 0x4f,
+	0x46, 0x3d, 0xa3, 0x4f, 0x87, 0x1d, 0xd9, 0x9b, 0x78, 0x6c, 0x33, 0x7b, 0xd0, 0xe1, 0xe3, 0x80,
+	0x30, 0x63, 0xcf, 0xe7, 0xd7, 0x91, 0xf6, 0x60, 0x8c, 0x87, 0xde, 0xae, 0x9e, 0xe7, 0xe9, 0x68,
+	0x39, 0x0e, 0x1d, 0xc5, 0x91, 0x6e, 0x1c, 0x80, 0xdf, 0x15, 0xb0, 0x9a, 0xa8, 0x3e, 0x61, 0xcf,
+	0xb5, 0x31, 0xa7, 0xa1, 0x50, 0x32, 0x75, 0xae, 0x35, 0xd7, 0xae, 0xee, 0xe8, 0xf9, 0x97, 0x38,
+	0xc0, 0x8c, 0x1f, 0xdf, 0x6a, 0x13, 0x86, 0xb9, 0x1b, 0xb7, 0x77, 0x1d, 0x69, 0x1b, 0x99, 0xa2,
+	0x79, 0x9c, 0x7e, 0x13, 0x69, 0x70, 0xd6, 0x8b, 0x56, 0xbc, 0x99, 0x18, 0x83, 0x07, 0x00, 0xa4,
+	0x7e, 0xa6, 0xce, 0x27, 0x8d, 0xac, 0xe7, 0x1b, 0x49, 0x4d, 0xe6, 0x7d, 0x39, 0xd0, 0xa5, 0x34,
+	0x84, 0x32, 0x7e, 0xd8, 0x05, 0x55, 0x9b, 0x78, 0xc4, 0xc1, 0xdc, 0xa5, 0x3e, 0x53, 0x17, 0x12,
+	0x5c, 0x23, 0x8f, 0x7b, 0x9d, 0x4a, 0x4c, 0x28, 0x79, 0x60, 0x12, 0x43, 0x59, 0x04, 0xfc, 0xa1,
+	0x80, 0xd5, 0x91, 0xdf, 0xa3, 0xbe, 0xed, 0xfa, 0x8e, 0x95, 0x85, 0x97, 0x13, 0xf8, 0xc3, 0x3c,
+	0xfc, 0xdd, 0xad, 0x38, 0x53, 0xe5, 0xf9, 0xf4, 0xd4, 0x0a, 0x79, 0xf1, 0xd4, 0x56, 0x0a, 0xcc,
+	0xa8, 0x3e, 0x9a, 0x0d, 0x32, 0xf8, 0x1e, 0xdc, 0x0d, 0x49, 0xb6, 0x9d, 0xc5, 0xa4, 0x9d, 0x8d,
+	0x7c, 0x3b, 0x28, 0x23, 0x32, 0xeb, 0xf2, 0x6d, 0x6b, 0xd9, 0x28, 0x9a, 0x06, 0xc1, 0x06, 0xa8,
+	0x90, 0xb3, 0x80, 0x86, 0x9c, 0xd8, 0x6a, 0xa5, 0xa5, 0xb4, 0x2b, 0x28, 0x3d, 0xc3, 0xaf, 0x0a,
+	0x58, 0xe3, 0x74, 0x40, 0x7c, 0xf7, 0x33, 0xb1, 0xd8, 0x09, 0x0e, 0x89, 0x15, 0x92, 0x3e, 0x0d,
+	0x6d, 0xa6, 0x2e, 0x15, 0x8f, 0xe3, 0x48, 0xaa, 0x0f, 0x63, 0x31, 0x4a, 0xb4, 0xe6, 0x23, 0x39,
+	0x8e, 0x4d, 0x31, 0x8e, 0x62, 0xa0, 0x8e, 0xea, 0x7c, 0xd6, 0xcb, 0xe0, 0x47, 0xb0, 0x29, 0x3f,
+	0xf5, 0x02, 0x97, 0xe5, 0xda, 0x2a, 0x68, 0x29, 0xed, 0x79, 0xb3, 0x7d, 0x1d, 0x69, 0x5b, 0x53,
+	0x37, 0xa3, 0x58, 0xae, 0xa3, 0x75, 0x71, 0x4d, 0x66, 0x4a, 0xed, 0xd9, 0xf0, 0x0b, 0x58, 0xed,
+	0x53, 0x9f, 0x59, 0xc1, 0xa8, 0x37, 0x20, 0x63, 0x2b, 0xa4, 0x5c, 0x0e, 0xbb, 0x5a, 0x7c, 0x61,
+	0x5e, 0x51, 0x9f, 0x75, 0x47, 0xbd, 0x7d, 0x32, 0x46, 0x52, 0x6a, 0x6e, 0x4d, 0xff, 0xf5, 0x85,
+	0x38, 0x1d, 0xad, 0xf4, 0x85, 0x73, 0x30, 0x71, 0x32, 0xfd, 0x14, 0x14, 0xdc, 0x22, 0xb8, 0x0f,
+	0x16, 0xb1, 0x6d, 0x87, 0x84, 0x89, 0xdd, 0x53, 0x33, 0x9f, 0xde, 0x44, 0xda, 0xf6, 0x7f, 0x6c,
+	0x8b, 0x63, 0xec, 0xbd, 0x14, 0x46, 0x74, 0x4b, 0x80, 0x75, 0xb0, 0x30, 0x59, 0x3e, 0x73, 0x48,
+	0x1c, 0xcc, 0x37, 0xe7, 0x97, 0x4d, 0xe5, 0xe2, 0xb2, 0xa9, 0xfc, 0xbe, 0x6c, 0x2a, 0xdf, 0xae,
+	0x9a, 0xa5, 0x8b, 0xab, 0x66, 0xe9, 0xe7, 0x55, 0xb3, 0xf4, 0xe1, 0xc9, 0x3f, 0xeb, 0x9c, 0xa5,
+	0xeb, 0x33, 0xa9, 0xd8, 0x2b, 0x27, 0xdb, 0xf3, 0xd9, 0xdf, 0x01, 0x00, 0x2b, 0x6b, 0x4a, 0x6e,
+	0xa1, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +267,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubkeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +492,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for _, e := range m.ConsPubkeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +817,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotations = append(m.ConsPubkeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubkeyRotations[len(m.ConsPubkeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,6 +36,11 @@ func (h MultiStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAdd
 		h[i].AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
 	}
 }
+func (h MultiStakingHooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
 func (h MultiStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	for i := range h {
 		h[i].BeforeDelegationCreated(ctx, delAddr, valAddr)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -46,23 +47,36 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for a tokenize share record
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // prefix for each key to a tokenize share record id, by owner
+	LastTokenizeShareRecordIDKey       = []byte{0x63} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesPrefix        = []byte{0x64} // prefix for the tokenized shares of each validator
+
+	ConsPubKeyRotationKey        = []byte{0x71} // prefix for the consensus pubkey rotation of each validator
+	ConsPubKeyRotationQueueKey   = []byte{0x72} // prefix for the timestamps in consensus pubkey rotation queue
+	PendingConsPubKeyRotationKey = []byte{0x73} // prefix for the consensus pubkey rotations not yet sent to Tendermint
 )
 
 // gets the key for the validator with address
 // VALUE: staking/Validator
 func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
-	return append(ValidatorsKey, operatorAddr.Bytes()...)
+	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr.Bytes())...)
 }
 
 // gets the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
-	return append(ValidatorsByConsAddrKey, addr.Bytes()...)
+	return append(ValidatorsByConsAddrKey, address.MustLengthPrefix(addr.Bytes())...)
 }
 
 // Get the validator operator address from LastValidatorPowerKey
 func AddressFromLastValidatorPowerKey(key []byte) []byte {
-	return key[1:] // remove prefix bytes
+	addr, rest := address.ParseLengthPrefixed(key[1:]) // remove prefix bytes
+	if len(rest) != 0 {
+		panic("unexpected key length")
+	}
+	return addr
 }
 
 // get the validator by power index.
@@ -76,7 +90,7 @@ func GetValidatorsByPowerIndexKey(validator Validator) []byte {
 
 // get the bonded validator index key for an operator address
 func GetLastValidatorPowerKey(operator sdk.ValAddress) []byte {
-	return append(LastValidatorPowerKey, operator...)
+	return append(LastValidatorPowerKey, address.MustLengthPrefix(operator)...)
 }
 
 // get the power ranking of a validator
@@ -89,19 +103,20 @@ func getValidatorPowerRank(validator Validator) []byte {
 	powerBytes := consensusPowerBytes
 	powerBytesLen := len(powerBytes) // 8
 
-	// key is of format prefix || powerbytes || addrBytes
-	key := make([]byte, 1+powerBytesLen+sdk.AddrLen)
-
-	key[0] = ValidatorsByPowerIndexKey[0]
-	copy(key[1:powerBytesLen+1], powerBytes)
-
 	operAddrInvr := sdk.CopyBytes(validator.OperatorAddress)
+	addrLen := len(operAddrInvr)
 
 	for i, b := range operAddrInvr {
 		operAddrInvr[i] = ^b
 	}
 
-	copy(key[powerBytesLen+1:], operAddrInvr)
+	// key is of format prefix || powerbytes || addrLen (1byte) || addrBytes
+	key := make([]byte, 1+powerBytesLen+1+addrLen)
+
+	key[0] = ValidatorsByPowerIndexKey[0]
+	copy(key[1:powerBytesLen+1], powerBytes)
+	key[powerBytesLen+1] = byte(addrLen)
+	copy(key[powerBytesLen+2:], operAddrInvr)
 
 	return key
 }
@@ -109,11 +124,16 @@ func getValidatorPowerRank(validator Validator) []byte {
 // parse the validators operator address from power rank key
 func ParseValidatorPowerRankKey(key []byte) (operAddr []byte) {
 	powerBytesLen := 8
-	if len(key) != 1+powerBytesLen+sdk.AddrLen {
+	if len(key) < 1+powerBytesLen+1 {
 		panic("Invalid validator power rank key length")
 	}
 
-	operAddr = sdk.CopyBytes(key[powerBytesLen+1:])
+	addr, rest := address.ParseLengthPrefixed(key[powerBytesLen+1:])
+	if len(rest) != 0 {
+		panic("Invalid validator power rank key length")
+	}
+
+	operAddr = sdk.CopyBytes(addr)
 
 	for i, b := range operAddr {
 		operAddr[i] = ^b
@@ -169,12 +189,12 @@ func ParseValidatorQueueKey(bz []byte) (time.Time, int64, error) {
 // gets the key for delegator bond with validator
 // VALUE: staking/Delegation
 func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
-	return append(GetDelegationsKey(delAddr), valAddr.Bytes()...)
+	return append(GetDelegationsKey(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
 }
 
 // gets the prefix for a delegator for all validators
 func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
-	return append(DelegationKey, delAddr.Bytes()...)
+	return append(DelegationKey, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // gets the key for an unbonding delegation by delegator and validator addr
@@ -182,36 +202,36 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 func GetUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
 	return append(
 		GetUBDsKey(delAddr.Bytes()),
-		valAddr.Bytes()...)
+		address.MustLengthPrefix(valAddr.Bytes())...)
 }
 
 // gets the index-key for an unbonding delegation, stored by validator-index
 // VALUE: none (key rearrangement used)
 func GetUBDByValIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
-	return append(GetUBDsByValIndexKey(valAddr), delAddr.Bytes()...)
+	return append(GetUBDsByValIndexKey(valAddr), address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // rearranges the ValIndexKey to get the UBDKey
 func GetUBDKeyFromValIndexKey(indexKey []byte) []byte {
 	addrs := indexKey[1:] // remove prefix bytes
-	if len(addrs) != 2*sdk.AddrLen {
+
+	valAddr, rest := address.ParseLengthPrefixed(addrs)
+	delAddr, rest := address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
 		panic("unexpected key length")
 	}
 
-	valAddr := addrs[:sdk.AddrLen]
-	delAddr := addrs[sdk.AddrLen:]
-
 	return GetUBDKey(delAddr, valAddr)
 }
 
 // gets the prefix for all unbonding delegations from a delegator
 func GetUBDsKey(delAddr sdk.AccAddress) []byte {
-	return append(UnbondingDelegationKey, delAddr.Bytes()...)
+	return append(UnbondingDelegationKey, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // gets the prefix keyspace for the indexes of unbonding delegations for a validator
 func GetUBDsByValIndexKey(valAddr sdk.ValAddress) []byte {
-	return append(UnbondingDelegationByValIndexKey, valAddr.Bytes()...)
+	return append(UnbondingDelegationByValIndexKey, address.MustLengthPrefix(valAddr.Bytes())...)
 }
 
 // gets the prefix for all unbonding delegations from a delegator
@@ -223,11 +243,10 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 // GetREDKey returns a key prefix for indexing a redelegation from a delegator
 // and source validator to a destination validator.
 func GetREDKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
-	key := make([]byte, 1+sdk.AddrLen*3)
-
-	copy(key[0:sdk.AddrLen+1], GetREDsKey(delAddr.Bytes()))
-	copy(key[sdk.AddrLen+1:2*sdk.AddrLen+1], valSrcAddr.Bytes())
-	copy(key[2*sdk.AddrLen+1:3*sdk.AddrLen+1], valDstAddr.Bytes())
+	// key is of the form GetREDsKey || valSrcAddrLen (1 byte) || valSrcAddr || valDstAddrLen (1 byte) || valDstAddr
+	key := GetREDsKey(delAddr.Bytes())
+	key = append(key, address.MustLengthPrefix(valSrcAddr.Bytes())...)
+	key = append(key, address.MustLengthPrefix(valDstAddr.Bytes())...)
 
 	return key
 }
@@ -235,14 +254,10 @@ func GetREDKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []
 // gets the index-key for a redelegation, stored by source-validator-index
 // VALUE: none (key rearrangement used)
 func GetREDByValSrcIndexKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
-	REDSFromValsSrcKey := GetREDsFromValSrcIndexKey(valSrcAddr)
-	offset := len(REDSFromValsSrcKey)
-
-	// key is of the form REDSFromValsSrcKey || delAddr || valDstAddr
-	key := make([]byte, len(REDSFromValsSrcKey)+2*sdk.AddrLen)
-	copy(key[0:offset], REDSFromValsSrcKey)
-	copy(key[offset:offset+sdk.AddrLen], delAddr.Bytes())
-	copy(key[offset+sdk.AddrLen:offset+2*sdk.AddrLen], valDstAddr.Bytes())
+	// key is of the form REDSFromValsSrcKey || delAddrLen (1 byte) || delAddr || valDstAddrLen (1 byte) || valDstAddr
+	key := GetREDsFromValSrcIndexKey(valSrcAddr)
+	key = append(key, address.MustLengthPrefix(delAddr.Bytes())...)
+	key = append(key, address.MustLengthPrefix(valDstAddr.Bytes())...)
 
 	return key
 }
@@ -250,14 +265,10 @@ func GetREDByValSrcIndexKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.V
 // gets the index-key for a redelegation, stored by destination-validator-index
 // VALUE: none (key rearrangement used)
 func GetREDByValDstIndexKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
-	REDSToValsDstKey := GetREDsToValDstIndexKey(valDstAddr)
-	offset := len(REDSToValsDstKey)
-
-	// key is of the form REDSToValsDstKey || delAddr || valSrcAddr
-	key := make([]byte, len(REDSToValsDstKey)+2*sdk.AddrLen)
-	copy(key[0:offset], REDSToValsDstKey)
-	copy(key[offset:offset+sdk.AddrLen], delAddr.Bytes())
-	copy(key[offset+sdk.AddrLen:offset+2*sdk.AddrLen], valSrcAddr.Bytes())
+	// key is of the form REDSToValsDstKey || delAddrLen (1 byte) || delAddr || valSrcAddrLen (1 byte) || valSrcAddr
+	key := GetREDsToValDstIndexKey(valDstAddr)
+	key = append(key, address.MustLengthPrefix(delAddr.Bytes())...)
+	key = append(key, address.MustLengthPrefix(valSrcAddr.Bytes())...)
 
 	return key
 }
@@ -265,28 +276,26 @@ func GetREDByValDstIndexKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.V
 // GetREDKeyFromValSrcIndexKey rearranges the ValSrcIndexKey to get the REDKey
 func GetREDKeyFromValSrcIndexKey(indexKey []byte) []byte {
 	// note that first byte is prefix byte
-	if len(indexKey) != 3*sdk.AddrLen+1 {
+	valSrcAddr, rest := address.ParseLengthPrefixed(indexKey[1:])
+	delAddr, rest := address.ParseLengthPrefixed(rest)
+	valDstAddr, rest := address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
 		panic("unexpected key length")
 	}
 
-	valSrcAddr := indexKey[1 : sdk.AddrLen+1]
-	delAddr := indexKey[sdk.AddrLen+1 : 2*sdk.AddrLen+1]
-	valDstAddr := indexKey[2*sdk.AddrLen+1 : 3*sdk.AddrLen+1]
-
 	return GetREDKey(delAddr, valSrcAddr, valDstAddr)
 }
 
 // GetREDKeyFromValDstIndexKey rearranges the ValDstIndexKey to get the REDKey
 func GetREDKeyFromValDstIndexKey(indexKey []byte) []byte {
 	// note that first byte is prefix byte
-	if len(indexKey) != 3*sdk.AddrLen+1 {
+	valDstAddr, rest := address.ParseLengthPrefixed(indexKey[1:])
+	delAddr, rest := address.ParseLengthPrefixed(rest)
+	valSrcAddr, rest := address.ParseLengthPrefixed(rest)
+	if len(rest) != 0 {
 		panic("unexpected key length")
 	}
 
-	valDstAddr := indexKey[1 : sdk.AddrLen+1]
-	delAddr := indexKey[sdk.AddrLen+1 : 2*sdk.AddrLen+1]
-	valSrcAddr := indexKey[2*sdk.AddrLen+1 : 3*sdk.AddrLen+1]
-
 	return GetREDKey(delAddr, valSrcAddr, valDstAddr)
 }
 
@@ -300,28 +309,81 @@ func GetRedelegationTimeKey(timestamp time.Time) []byte {
 // GetREDsKey returns a key prefix for indexing a redelegation from a delegator
 // address.
 func GetREDsKey(delAddr sdk.AccAddress) []byte {
-	return append(RedelegationKey, delAddr.Bytes()...)
+	return append(RedelegationKey, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // GetREDsFromValSrcIndexKey returns a key prefix for indexing a redelegation to
 // a source validator.
 func GetREDsFromValSrcIndexKey(valSrcAddr sdk.ValAddress) []byte {
-	return append(RedelegationByValSrcIndexKey, valSrcAddr.Bytes()...)
+	return append(RedelegationByValSrcIndexKey, address.MustLengthPrefix(valSrcAddr.Bytes())...)
 }
 
 // GetREDsToValDstIndexKey returns a key prefix for indexing a redelegation to a
 // destination (target) validator.
 func GetREDsToValDstIndexKey(valDstAddr sdk.ValAddress) []byte {
-	return append(RedelegationByValDstIndexKey, valDstAddr.Bytes()...)
+	return append(RedelegationByValDstIndexKey, address.MustLengthPrefix(valDstAddr.Bytes())...)
 }
 
 // GetREDsByDelToValDstIndexKey returns a key prefix for indexing a redelegation
 // from an address to a source validator.
 func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) []byte {
-	return append(GetREDsToValDstIndexKey(valDstAddr), delAddr.Bytes()...)
+	return append(GetREDsToValDstIndexKey(valDstAddr), address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
 // GetHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerKey returns the prefix of the ids of the
+// tokenize share records owned by an address.
+func GetTokenizeShareRecordIDsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// GetTokenizeShareRecordIDByOwnerKey returns the index key of a tokenize share
+// record by its owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of a
+// validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetConsPubKeyRotationKey returns the key of the consensus pubkey rotation of
+// a validator.
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetConsPubKeyRotationTimeKey returns the prefix of the consensus pubkey
+// rotations completing at a given time.
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	return append(ConsPubKeyRotationQueueKey, sdk.FormatTimeBytes(timestamp)...)
+}
+
+// GetConsPubKeyRotationQueueKey returns the key of a consensus pubkey rotation
+// in the queue.
+// VALUE: none (key rearrangement used)
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(timestamp), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetPendingConsPubKeyRotationKey returns the key of a consensus pubkey
+// rotation not yet sent to Tendermint.
+// VALUE: validator operator address ([]byte)
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, address.MustLengthPrefix(valAddr.Bytes())...)
+}
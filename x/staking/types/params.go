@@ -32,12 +32,38 @@ const (
 	DefaultHistoricalEntries uint32 = 100
 )
 
+var (
+	// DefaultGlobalLiquidStakingCap allows a quarter of the bonded tokens to be
+	// tokenized.
+	DefaultGlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+
+	// DefaultValidatorLiquidStakingCap allows half of the shares of a validator
+	// to be tokenized.
+	DefaultValidatorLiquidStakingCap = sdk.NewDecWithPrec(50, 2)
+
+	// DefaultKeyRotationFee is burned each time a validator rotates its
+	// consensus public key.
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultMinCommissionRate lets validators charge no commission.
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultMaxVotingPowerRatio doesn't cap the voting power of validators.
+	DefaultMaxVotingPowerRatio = sdk.OneDec()
+)
+
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxValidators     = []byte("MaxValidators")
 	KeyMaxEntries        = []byte("MaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+	KeyKeyRotationFee            = []byte("KeyRotationFee")
+	KeyMinCommissionRate         = []byte("MinCommissionRate")
+	KeyMaxVotingPowerRatio       = []byte("MaxVotingPowerRatio")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,13 +74,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec, keyRotationFee sdk.Coin,
+	minCommissionRate, maxVotingPowerRatio sdk.Dec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		KeyRotationFee:            keyRotationFee,
+		MinCommissionRate:         minCommissionRate,
+		MaxVotingPowerRatio:       maxVotingPowerRatio,
 	}
 }
 
@@ -66,6 +101,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyMaxVotingPowerRatio, &p.MaxVotingPowerRatio, validateMaxVotingPowerRatio),
 	}
 }
 
@@ -77,6 +117,11 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultKeyRotationFee,
+		DefaultMinCommissionRate,
+		DefaultMaxVotingPowerRatio,
 	)
 }
 
@@ -124,6 +169,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateMaxVotingPowerRatio(p.MaxVotingPowerRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,3 +256,67 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid key rotation fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxVotingPowerRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max voting power ratio must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max voting power ratio cannot be greater than 1: %s", v)
+	}
+
+	return nil
+}
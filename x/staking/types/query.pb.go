@@ -1450,6 +1450,263 @@ func (m *QueryParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTokenizeShareRecordByIdRequest is request type for the Query/TokenizeShareRecordById RPC method
+type QueryTokenizeShareRecordByIdRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordByIdRequest) Reset()         { *m = QueryTokenizeShareRecordByIdRequest{} }
+func (m *QueryTokenizeShareRecordByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordByIdRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{28}
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordByIdRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordByIdRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTokenizeShareRecordByIdResponse is response type for the Query/TokenizeShareRecordById RPC method
+type QueryTokenizeShareRecordByIdResponse struct {
+	Record TokenizeShareRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Reset()         { *m = QueryTokenizeShareRecordByIdResponse{} }
+func (m *QueryTokenizeShareRecordByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordByIdResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{29}
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordByIdResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordByIdResponse) GetRecord() TokenizeShareRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TokenizeShareRecord{}
+}
+
+// QueryTokenizeShareRecordsOwnedRequest is request type for the Query/TokenizeShareRecordsOwned RPC method
+type QueryTokenizeShareRecordsOwnedRequest struct {
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Reset()         { *m = QueryTokenizeShareRecordsOwnedRequest{} }
+func (m *QueryTokenizeShareRecordsOwnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordsOwnedRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordsOwnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{30}
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+// QueryTokenizeShareRecordsOwnedResponse is response type for the Query/TokenizeShareRecordsOwned RPC method
+type QueryTokenizeShareRecordsOwnedResponse struct {
+	Records []TokenizeShareRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Reset() {
+	*m = QueryTokenizeShareRecordsOwnedResponse{}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordsOwnedResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordsOwnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{31}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) GetRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryTotalLiquidStakedRequest is request type for the Query/TotalLiquidStaked RPC method
+type QueryTotalLiquidStakedRequest struct {
+}
+
+func (m *QueryTotalLiquidStakedRequest) Reset()         { *m = QueryTotalLiquidStakedRequest{} }
+func (m *QueryTotalLiquidStakedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{32}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedRequest proto.InternalMessageInfo
+
+// QueryTotalLiquidStakedResponse is response type for the Query/TotalLiquidStaked RPC method
+type QueryTotalLiquidStakedResponse struct {
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+}
+
+func (m *QueryTotalLiquidStakedResponse) Reset()         { *m = QueryTotalLiquidStakedResponse{} }
+func (m *QueryTotalLiquidStakedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_802d43a0c79dce0e, []int{33}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.QueryValidatorsResponse")
@@ -1479,81 +1736,99 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordByIdRequest)(nil), "cosmos.staking.QueryTokenizeShareRecordByIdRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordByIdResponse)(nil), "cosmos.staking.QueryTokenizeShareRecordByIdResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordsOwnedRequest)(nil), "cosmos.staking.QueryTokenizeShareRecordsOwnedRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordsOwnedResponse)(nil), "cosmos.staking.QueryTokenizeShareRecordsOwnedResponse")
+	proto.RegisterType((*QueryTotalLiquidStakedRequest)(nil), "cosmos.staking.QueryTotalLiquidStakedRequest")
+	proto.RegisterType((*QueryTotalLiquidStakedResponse)(nil), "cosmos.staking.QueryTotalLiquidStakedResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/query.proto", fileDescriptor_802d43a0c79dce0e) }
 
 var fileDescriptor_802d43a0c79dce0e = []byte{
-	// 1099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0xc1, 0x52, 0x1e, 0x6d, 0x54, 0xc6, 0xae, 0x49, 0xb7, 0xd4, 0x76, 0xb7, 0x50,
-	0xda, 0x42, 0xed, 0x34, 0xf4, 0xd2, 0x4a, 0x08, 0x25, 0x54, 0x08, 0x0e, 0x48, 0xed, 0x22, 0x02,
-	0x2a, 0x48, 0x66, 0xe3, 0x5d, 0xd6, 0x2b, 0x3b, 0x1e, 0x67, 0x67, 0x0d, 0x14, 0x89, 0x2b, 0x5c,
-	0x39, 0x21, 0x6e, 0xdc, 0xf9, 0x01, 0x70, 0x80, 0x03, 0x07, 0x24, 0x7a, 0xe0, 0x50, 0x09, 0x90,
-	0x38, 0x05, 0x94, 0xfc, 0x03, 0x8e, 0x3d, 0x21, 0xcf, 0xbe, 0x1d, 0xaf, 0x77, 0x67, 0x37, 0x9b,
-	0xd8, 0xa9, 0x92, 0x93, 0xed, 0x99, 0xf7, 0xbe, 0xf7, 0xe6, 0x7b, 0x6f, 0xde, 0x7b, 0x63, 0xd0,
-	0xda, 0x8c, 0x6f, 0x31, 0xde, 0xe4, 0xbe, 0xd9, 0x75, 0xfb, 0x4e, 0x73, 0x7b, 0x68, 0x7b, 0x0f,
-	0x1a, 0x03, 0x8f, 0xf9, 0x8c, 0x2e, 0x05, 0x7b, 0x0d, 0xdc, 0xd3, 0x2e, 0xa0, 0xac, 0x90, 0x69,
-	0x0e, 0x4c, 0xc7, 0xed, 0x9b, 0xbe, 0xcb, 0xfa, 0x81, 0xb8, 0x56, 0x76, 0x98, 0xc3, 0xc4, 0xd7,
-	0xe6, 0xe8, 0x1b, 0xae, 0x3e, 0x17, 0x33, 0x80, 0x9f, 0xc1, 0xae, 0xde, 0x85, 0xca, 0xbd, 0x11,
-	0xda, 0x86, 0xd9, 0x73, 0x2d, 0xd3, 0x67, 0x1e, 0x37, 0xec, 0xed, 0xa1, 0xcd, 0x7d, 0x5a, 0x81,
-	0x22, 0xf7, 0x4d, 0x7f, 0xc8, 0x97, 0x49, 0x9d, 0x5c, 0x59, 0x34, 0xf0, 0x17, 0xbd, 0x05, 0x30,
-	0xb6, 0xbc, 0x3c, 0x57, 0x27, 0x57, 0x9e, 0x5e, 0x3d, 0xd7, 0x40, 0x4f, 0x03, 0xef, 0xef, 0x9a,
-	0x8e, 0x8d, 0x30, 0x46, 0x44, 0x58, 0xff, 0x86, 0xc0, 0xb3, 0x09, 0x6b, 0x7c, 0xc0, 0xfa, 0xdc,
-	0xa6, 0xaf, 0x01, 0x7c, 0x22, 0x57, 0x97, 0x49, 0x7d, 0x3e, 0x0a, 0x1b, 0xfa, 0x2c, 0xf5, 0xd6,
-	0x17, 0x1e, 0xee, 0xd4, 0x0a, 0x46, 0x44, 0x85, 0xde, 0x56, 0xf8, 0xa5, 0xa9, 0xfc, 0x0a, 0x0c,
-	0x4e, 0x38, 0xb6, 0x0d, 0x67, 0x27, 0xfd, 0x0a, 0x49, 0x78, 0x1f, 0x96, 0xa4, 0x89, 0x96, 0x69,
-	0x59, 0x9e, 0x20, 0xe3, 0xd4, 0xfa, 0x8d, 0xc7, 0x3b, 0xb5, 0xeb, 0x8e, 0xeb, 0x77, 0x86, 0x9b,
-	0x8d, 0x36, 0xdb, 0x6a, 0x22, 0xc7, 0xc1, 0xc7, 0x75, 0x6e, 0x75, 0x9b, 0xfe, 0x83, 0x81, 0xcd,
-	0x47, 0x0e, 0xaf, 0x59, 0x96, 0x67, 0x73, 0x6e, 0x9c, 0x96, 0x40, 0xa3, 0x15, 0xfd, 0xbd, 0x38,
-	0xf1, 0x92, 0x89, 0x57, 0x61, 0x51, 0x8a, 0x0a, 0x73, 0x39, 0x88, 0x18, 0x6b, 0xe8, 0x3f, 0x12,
-	0xa8, 0x4f, 0x22, 0xdf, 0xb1, 0x7b, 0xb6, 0x23, 0x0e, 0xca, 0x8f, 0xfc, 0x5c, 0xd3, 0xa4, 0xc7,
-	0x1f, 0x04, 0x2e, 0x66, 0x78, 0x8e, 0xf4, 0x78, 0x50, 0xb6, 0xe4, 0x72, 0xcb, 0xc3, 0xe5, 0x30,
-	0x65, 0xf4, 0x38, 0x53, 0x63, 0x88, 0x10, 0x61, 0xfd, 0xfc, 0x88, 0xb2, 0xef, 0xff, 0xa9, 0x95,
-	0x92, 0x7b, 0xdc, 0x28, 0x59, 0xc9, 0xc5, 0xa9, 0x72, 0xeb, 0x17, 0x02, 0x57, 0x27, 0x4f, 0xf5,
-	0x6e, 0x7f, 0x93, 0xf5, 0x2d, 0xb7, 0xef, 0x9c, 0x94, 0xc0, 0xfc, 0x4a, 0xe0, 0x5a, 0x9e, 0x23,
-	0x60, 0x84, 0xee, 0x43, 0x69, 0x18, 0xee, 0x27, 0x02, 0x74, 0x29, 0x1e, 0x20, 0x05, 0x14, 0x26,
-	0x35, 0x95, 0x28, 0xb3, 0x89, 0xc4, 0xef, 0x04, 0xef, 0x5c, 0x34, 0xee, 0x92, 0x76, 0x8c, 0xfb,
-	0xe1, 0x68, 0x5f, 0x6b, 0xb7, 0x25, 0xed, 0x12, 0x48, 0xd0, 0x9e, 0x0c, 0xe8, 0xdc, 0x8c, 0x2a,
-	0xc8, 0x97, 0x61, 0x35, 0x4d, 0xa6, 0x31, 0xed, 0x42, 0x49, 0x71, 0x49, 0xb0, 0x9a, 0xe4, 0xb9,
-	0x23, 0x95, 0xc7, 0x3b, 0x35, 0x9a, 0x5c, 0x37, 0x68, 0xf2, 0x7a, 0xe8, 0x7f, 0x11, 0xa8, 0x09,
-	0x47, 0x14, 0xa1, 0x3c, 0xc9, 0x04, 0xdb, 0x58, 0x48, 0x95, 0xc7, 0x42, 0xa2, 0xd7, 0xa0, 0x18,
-	0x64, 0x29, 0x72, 0x7b, 0x80, 0xf4, 0x46, 0xc5, 0x71, 0xc1, 0xbe, 0x13, 0x9e, 0x4b, 0x5d, 0x17,
-	0x8e, 0x88, 0xbf, 0x29, 0xea, 0xc2, 0xcf, 0x61, 0xc1, 0x56, 0x7b, 0x8e, 0x14, 0x7d, 0x30, 0x75,
-	0xc1, 0x0e, 0xf8, 0x3a, 0xba, 0xca, 0x2c, 0xdd, 0xdf, 0xa7, 0x32, 0x1f, 0xbf, 0x08, 0xc8, 0xca,
-	0xbc, 0xcf, 0x11, 0x8e, 0x79, 0x65, 0xfe, 0x6f, 0x0e, 0xce, 0x89, 0x63, 0x18, 0xb6, 0xf5, 0x24,
-	0x99, 0x6f, 0x01, 0xe5, 0x5e, 0xbb, 0x35, 0xab, 0xfa, 0x71, 0x86, 0x7b, 0xed, 0x8d, 0x89, 0xa6,
-	0xdb, 0x02, 0x6a, 0x71, 0x3f, 0x6e, 0x60, 0xfe, 0xd0, 0x06, 0x2c, 0xee, 0x6f, 0x64, 0x74, 0xf5,
-	0x85, 0x83, 0xe4, 0xce, 0x4f, 0x04, 0x34, 0x15, 0xe9, 0x98, 0x2b, 0x26, 0x54, 0x3c, 0x3b, 0xe3,
-	0xe2, 0x3e, 0x1f, 0x4f, 0x97, 0x28, 0x4c, 0xec, 0xea, 0x9e, 0xf5, 0xec, 0x59, 0x5f, 0xde, 0x1f,
-	0xc2, 0xa6, 0x23, 0x33, 0x3f, 0xf9, 0x84, 0x39, 0x96, 0x57, 0xf6, 0xbb, 0x44, 0xb9, 0x3f, 0x6e,
-	0xaf, 0xa1, 0x3f, 0x09, 0x54, 0x53, 0x3c, 0x3c, 0xc9, 0xed, 0xfc, 0xa3, 0xd4, 0x84, 0x99, 0xd5,
-	0xd3, 0xeb, 0x26, 0x5e, 0xa8, 0x37, 0x5d, 0xee, 0x33, 0xcf, 0x6d, 0x9b, 0xbd, 0xb7, 0xfa, 0x1f,
-	0xb3, 0xc8, 0x83, 0xba, 0x63, 0xbb, 0x4e, 0xc7, 0x17, 0xc8, 0xf3, 0x06, 0xfe, 0xd2, 0xef, 0xc1,
-	0x79, 0xa5, 0x16, 0xfa, 0xb4, 0x0a, 0x0b, 0x1d, 0x97, 0xfb, 0xe8, 0x4e, 0x35, 0xee, 0x4e, 0x4c,
-	0x4b, 0xc8, 0xea, 0x14, 0xce, 0x08, 0xc8, 0xbb, 0x8c, 0xf5, 0xd0, 0xbc, 0xfe, 0x3a, 0x3c, 0x13,
-	0x59, 0x43, 0xf0, 0x06, 0x2c, 0x0c, 0x18, 0xeb, 0x21, 0x78, 0x39, 0x0e, 0x3e, 0x92, 0xc5, 0x63,
-	0x0a, 0x39, 0xbd, 0x0c, 0x34, 0x00, 0x31, 0x3d, 0x73, 0x2b, 0xbc, 0x67, 0xfa, 0x57, 0x04, 0x4a,
-	0x13, 0xcb, 0x88, 0x7e, 0x13, 0x8a, 0x03, 0xb1, 0x82, 0xf8, 0x95, 0x04, 0xbe, 0xd8, 0x0d, 0xe7,
-	0xa1, 0x40, 0x76, 0x9a, 0xd4, 0x5d, 0xfd, 0xed, 0x14, 0x3c, 0x25, 0x3c, 0xa1, 0x2d, 0x80, 0xf1,
-	0xbd, 0xa2, 0x97, 0xe3, 0x96, 0xd5, 0x7f, 0x7a, 0x68, 0x2f, 0xee, 0x2b, 0x87, 0x33, 0x6f, 0x81,
-	0x7e, 0x08, 0x8b, 0x72, 0x9d, 0xbe, 0x90, 0xad, 0x17, 0xc2, 0x5f, 0xde, 0x4f, 0x4c, 0xa2, 0x7f,
-	0x01, 0x65, 0xd5, 0x2b, 0x98, 0xae, 0x64, 0x23, 0x24, 0xe7, 0x16, 0xed, 0xc6, 0x01, 0x34, 0xa4,
-	0xf9, 0x6f, 0x09, 0x5c, 0xc8, 0x7c, 0xec, 0xd1, 0x5b, 0xd9, 0xb0, 0x19, 0x93, 0x94, 0x76, 0xfb,
-	0x30, 0xaa, 0xd2, 0xb5, 0x16, 0xc0, 0x78, 0x23, 0x25, 0xb0, 0x89, 0xf7, 0x47, 0x4a, 0x60, 0x93,
-	0xb3, 0xa6, 0x5e, 0xa0, 0x9f, 0x43, 0x49, 0xe1, 0x02, 0x6d, 0x2a, 0x11, 0xd2, 0x9f, 0x3c, 0xda,
-	0x4a, 0x7e, 0x85, 0x68, 0xd8, 0x55, 0xb3, 0x74, 0x4a, 0xd8, 0x33, 0x1e, 0x0c, 0x29, 0x61, 0xcf,
-	0x1a, 0xd4, 0x31, 0xec, 0x99, 0x93, 0x64, 0x4a, 0xd8, 0xf3, 0x0c, 0xd0, 0x29, 0x61, 0xcf, 0x35,
-	0xb8, 0xea, 0x05, 0xda, 0x81, 0xd3, 0x13, 0x73, 0x0a, 0xbd, 0xaa, 0x84, 0x53, 0x0d, 0x90, 0xda,
-	0xb5, 0x3c, 0xa2, 0xd1, 0xf8, 0x2b, 0x5a, 0x73, 0x4a, 0xfc, 0xd3, 0xa7, 0x0f, 0x6d, 0x25, 0xbf,
-	0x82, 0xb4, 0xfd, 0x29, 0xd0, 0xa4, 0x00, 0x6d, 0xe4, 0x44, 0x0a, 0x2d, 0x37, 0x73, 0xcb, 0x4b,
-	0xc3, 0x5d, 0x58, 0x9a, 0xec, 0x24, 0x54, 0x4d, 0x9a, 0xb2, 0xb5, 0x69, 0x2f, 0xe5, 0x92, 0x95,
-	0xc6, 0xde, 0x86, 0x85, 0x51, 0x67, 0xa1, 0x75, 0xa5, 0x5a, 0xa4, 0x69, 0x69, 0x17, 0x33, 0x24,
-	0x24, 0xdc, 0x3b, 0x50, 0x0c, 0x1a, 0x09, 0xd5, 0xd5, 0xe2, 0xd1, 0x66, 0xa5, 0x5d, 0xca, 0x94,
-	0x09, 0x41, 0xd7, 0xdf, 0x78, 0xb8, 0x5b, 0x25, 0x8f, 0x76, 0xab, 0xe4, 0xdf, 0xdd, 0x2a, 0xf9,
-	0x7a, 0xaf, 0x5a, 0x78, 0xb4, 0x57, 0x2d, 0xfc, 0xbd, 0x57, 0x2d, 0xdc, 0x7f, 0x39, 0x73, 0x0a,
-	0xf9, 0x4c, 0xfe, 0xd1, 0x2e, 0xe6, 0x91, 0xcd, 0xa2, 0xf8, 0x9f, 0xfd, 0x95, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x9c, 0xed, 0xaa, 0xa9, 0xe8, 0x17, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x26, 0xae, 0xbf, 0xca, 0xfb, 0xd2, 0xd0, 0x8e, 0xd3, 0x90, 0x6c, 0x89, 0xed, 0x6e,
+	0xda, 0xf4, 0x07, 0xc4, 0x4e, 0xd3, 0x14, 0xa9, 0x95, 0x10, 0x4a, 0x5a, 0x15, 0x22, 0x81, 0x68,
+	0x37, 0x10, 0x50, 0x41, 0x32, 0x1b, 0xef, 0x60, 0xaf, 0xec, 0xec, 0x38, 0x3b, 0x6b, 0x42, 0x2a,
+	0x71, 0x85, 0x0b, 0x07, 0x4e, 0x88, 0x1b, 0x77, 0xfe, 0x00, 0x38, 0xc0, 0x81, 0x03, 0x87, 0x1e,
+	0x38, 0x54, 0x82, 0x4a, 0x88, 0x43, 0x40, 0xc9, 0x7f, 0xc0, 0xb1, 0x27, 0xb4, 0xbb, 0xb3, 0xe3,
+	0xf5, 0xee, 0xec, 0x7a, 0x13, 0x3b, 0x28, 0x39, 0xd9, 0x7e, 0xf3, 0xde, 0xe7, 0xfd, 0x9c, 0xb7,
+	0xef, 0xad, 0x41, 0xae, 0x11, 0xba, 0x49, 0x68, 0x85, 0xda, 0x5a, 0xd3, 0x30, 0xeb, 0x95, 0xad,
+	0x0e, 0xb6, 0x76, 0xca, 0x6d, 0x8b, 0xd8, 0x04, 0x8d, 0x7b, 0x67, 0x65, 0x76, 0x26, 0xcf, 0x30,
+	0x5e, 0x97, 0xa7, 0xd2, 0xd6, 0xea, 0x86, 0xa9, 0xd9, 0x06, 0x31, 0x3d, 0x76, 0x79, 0xa2, 0x4e,
+	0xea, 0xc4, 0xfd, 0x5a, 0x71, 0xbe, 0x31, 0xea, 0x8b, 0x21, 0x05, 0xec, 0xd3, 0x3b, 0x55, 0x9a,
+	0x30, 0xf9, 0xc0, 0x41, 0x5b, 0xd7, 0x5a, 0x86, 0xae, 0xd9, 0xc4, 0xa2, 0x2a, 0xde, 0xea, 0x60,
+	0x6a, 0xa3, 0x49, 0xc8, 0x51, 0x5b, 0xb3, 0x3b, 0x74, 0x4a, 0x2a, 0x49, 0x57, 0xc6, 0x54, 0xf6,
+	0x0b, 0xdd, 0x02, 0xe8, 0x6a, 0x9e, 0x1a, 0x29, 0x49, 0x57, 0xfe, 0xbf, 0x38, 0x5d, 0x66, 0x96,
+	0x7a, 0xd6, 0xdf, 0xd7, 0xea, 0x98, 0xc1, 0xa8, 0x01, 0x66, 0xe5, 0x6b, 0x09, 0x5e, 0x88, 0x68,
+	0xa3, 0x6d, 0x62, 0x52, 0x8c, 0x5e, 0x03, 0xf8, 0x84, 0x53, 0xa7, 0xa4, 0xd2, 0x68, 0x10, 0xd6,
+	0xb7, 0x99, 0xcb, 0xad, 0x64, 0x1f, 0xef, 0x16, 0x33, 0x6a, 0x40, 0x04, 0xdd, 0x16, 0xd8, 0x25,
+	0x8b, 0xec, 0xf2, 0x14, 0xf6, 0x18, 0xb6, 0x05, 0xe7, 0x7a, 0xed, 0xf2, 0x83, 0xf0, 0x3e, 0x8c,
+	0x73, 0x15, 0x55, 0x4d, 0xd7, 0x2d, 0x37, 0x18, 0xcf, 0xad, 0x5c, 0x7f, 0xb6, 0x5b, 0x9c, 0xaf,
+	0x1b, 0x76, 0xa3, 0xb3, 0x51, 0xae, 0x91, 0xcd, 0x0a, 0x8b, 0xb1, 0xf7, 0x31, 0x4f, 0xf5, 0x66,
+	0xc5, 0xde, 0x69, 0x63, 0xea, 0x18, 0xbc, 0xac, 0xeb, 0x16, 0xa6, 0x54, 0x3d, 0xcd, 0x81, 0x1c,
+	0x8a, 0xf2, 0x5e, 0x38, 0xf0, 0x3c, 0x12, 0xaf, 0xc2, 0x18, 0x67, 0x75, 0xd5, 0xa5, 0x08, 0x44,
+	0x57, 0x42, 0xf9, 0x41, 0x82, 0x52, 0x2f, 0xf2, 0x5d, 0xdc, 0xc2, 0x75, 0xd7, 0x51, 0x7a, 0xe4,
+	0x7e, 0x0d, 0x52, 0x1e, 0xbf, 0x49, 0x70, 0x21, 0xc1, 0x72, 0x16, 0x1e, 0x0b, 0x26, 0x74, 0x4e,
+	0xae, 0x5a, 0x8c, 0xec, 0x97, 0x8c, 0x12, 0x8e, 0x54, 0x17, 0xc2, 0x47, 0x58, 0x39, 0xef, 0x84,
+	0xec, 0xbb, 0xbf, 0x8a, 0xf9, 0xe8, 0x19, 0x55, 0xf3, 0x7a, 0x94, 0x38, 0x50, 0x6d, 0xfd, 0x2c,
+	0xc1, 0xd5, 0x5e, 0xaf, 0xde, 0x35, 0x37, 0x88, 0xa9, 0x1b, 0x66, 0xfd, 0xa4, 0x24, 0xe6, 0x17,
+	0x09, 0xae, 0xa5, 0x71, 0x81, 0x65, 0xe8, 0x21, 0xe4, 0x3b, 0xfe, 0x79, 0x24, 0x41, 0xb3, 0xe1,
+	0x04, 0x09, 0xa0, 0x58, 0x51, 0x23, 0x8e, 0x32, 0x9c, 0x4c, 0xfc, 0x2a, 0xb1, 0x3b, 0x17, 0xcc,
+	0x3b, 0x0f, 0x3b, 0xcb, 0xfb, 0xe1, 0xc2, 0xbe, 0x5c, 0xab, 0xf1, 0xb0, 0x73, 0x20, 0x37, 0xec,
+	0xd1, 0x84, 0x8e, 0x0c, 0xa9, 0x83, 0x7c, 0xee, 0x77, 0xd3, 0x68, 0x19, 0xa3, 0x26, 0xe4, 0x05,
+	0x97, 0x84, 0x75, 0x93, 0x34, 0x77, 0x64, 0xf2, 0xd9, 0x6e, 0x11, 0x45, 0xe9, 0x2a, 0x8a, 0x5e,
+	0x0f, 0xe5, 0xa9, 0x04, 0x45, 0xd7, 0x10, 0x41, 0x2a, 0x4f, 0x72, 0x80, 0x31, 0x94, 0xe2, 0xdd,
+	0x62, 0x81, 0x5e, 0x86, 0x9c, 0x57, 0xa5, 0x2c, 0xb6, 0x07, 0x28, 0x6f, 0x26, 0xd8, 0x6d, 0xd8,
+	0x77, 0x7d, 0xbf, 0xc4, 0x7d, 0xe1, 0x88, 0xe2, 0x37, 0x40, 0x5f, 0xf8, 0xc9, 0x6f, 0xd8, 0x62,
+	0xcb, 0x59, 0x88, 0x3e, 0x18, 0xb8, 0x61, 0x7b, 0xf1, 0x3a, 0xba, 0xce, 0xcc, 0xcd, 0xef, 0xd3,
+	0x99, 0x8f, 0x5f, 0x06, 0x78, 0x67, 0xee, 0xe3, 0xc2, 0x31, 0xef, 0xcc, 0xff, 0x8c, 0xc0, 0xb4,
+	0xeb, 0x86, 0x8a, 0xf5, 0xff, 0x32, 0xf2, 0x55, 0x40, 0xd4, 0xaa, 0x55, 0x87, 0xd5, 0x3f, 0xce,
+	0x50, 0xab, 0xb6, 0xde, 0xf3, 0xd0, 0xad, 0x02, 0xd2, 0xa9, 0x1d, 0x56, 0x30, 0x7a, 0x68, 0x05,
+	0x3a, 0xb5, 0xd7, 0x13, 0x9e, 0xea, 0xd9, 0x83, 0xd4, 0xce, 0x8f, 0x12, 0xc8, 0xa2, 0xa0, 0xb3,
+	0x5a, 0xd1, 0x60, 0xd2, 0xc2, 0x09, 0x17, 0xf7, 0x62, 0xb8, 0x5c, 0x82, 0x30, 0xa1, 0xab, 0x7b,
+	0xce, 0xc2, 0xc3, 0xbe, 0xbc, 0xdf, 0xfb, 0x0f, 0x1d, 0x5e, 0xf9, 0xd1, 0x15, 0xe6, 0x58, 0x5e,
+	0xd9, 0x6f, 0x23, 0xed, 0xfe, 0xb8, 0x6d, 0x43, 0xbf, 0x4b, 0x50, 0x88, 0xb1, 0xf0, 0x24, 0x3f,
+	0xce, 0x3f, 0x8a, 0x2d, 0x98, 0x61, 0xad, 0x5e, 0x4b, 0xec, 0x42, 0xbd, 0x61, 0x50, 0x9b, 0x58,
+	0x46, 0x4d, 0x6b, 0xad, 0x9a, 0x1f, 0x93, 0xc0, 0x42, 0xdd, 0xc0, 0x46, 0xbd, 0x61, 0xbb, 0xc8,
+	0xa3, 0x2a, 0xfb, 0xa5, 0x3c, 0x80, 0xf3, 0x42, 0x29, 0x66, 0xd3, 0x22, 0x64, 0x1b, 0x06, 0xb5,
+	0x99, 0x39, 0x85, 0xb0, 0x39, 0x21, 0x29, 0x97, 0x57, 0x41, 0x70, 0xc6, 0x85, 0xbc, 0x4f, 0x48,
+	0x8b, 0xa9, 0x57, 0xee, 0xc0, 0xd9, 0x00, 0x8d, 0x81, 0x97, 0x21, 0xdb, 0x26, 0xa4, 0xc5, 0xc0,
+	0x27, 0xc2, 0xe0, 0x0e, 0x2f, 0x73, 0xd3, 0xe5, 0x53, 0x26, 0x00, 0x79, 0x20, 0x9a, 0xa5, 0x6d,
+	0xfa, 0xf7, 0x4c, 0xf9, 0x42, 0x82, 0x7c, 0x0f, 0x99, 0xa1, 0x2f, 0x41, 0xae, 0xed, 0x52, 0x18,
+	0xfe, 0x64, 0x04, 0xdf, 0x3d, 0xf5, 0xe7, 0x21, 0x8f, 0x77, 0xa0, 0xd2, 0xbd, 0x09, 0xb3, 0xae,
+	0x21, 0xef, 0x90, 0x26, 0x36, 0x8d, 0x47, 0x78, 0xad, 0xa1, 0x59, 0x58, 0xc5, 0x35, 0x62, 0xe9,
+	0x2b, 0x3b, 0xab, 0xba, 0x9f, 0x8a, 0x71, 0x18, 0x31, 0xbc, 0x89, 0x2d, 0xab, 0x8e, 0x18, 0xba,
+	0x62, 0xc0, 0xc5, 0x64, 0xb1, 0xee, 0xb4, 0x67, 0xb9, 0xd4, 0xb8, 0x69, 0x4f, 0x04, 0xc0, 0xbc,
+	0xf3, 0x04, 0x95, 0x36, 0x5c, 0x8a, 0x53, 0x45, 0xdf, 0xde, 0x36, 0x31, 0xb7, 0xf1, 0x75, 0x38,
+	0x45, 0xb6, 0x4d, 0x3c, 0xc0, 0xcd, 0xf2, 0xe4, 0x95, 0x4d, 0x98, 0xeb, 0xa7, 0x91, 0xb9, 0x77,
+	0x07, 0xfe, 0xe7, 0x59, 0x19, 0x3b, 0x12, 0xc4, 0xfb, 0xe7, 0x4b, 0x2a, 0x45, 0x98, 0x61, 0xea,
+	0x6c, 0xad, 0xf5, 0xa6, 0xb1, 0xd5, 0x31, 0xf4, 0x35, 0x5b, 0x6b, 0x72, 0xc7, 0x94, 0x06, 0x14,
+	0xe2, 0x18, 0x98, 0x1d, 0xf7, 0x20, 0x67, 0x3b, 0x8a, 0x28, 0xf3, 0xbd, 0xec, 0x68, 0xf8, 0x73,
+	0xb7, 0x38, 0x97, 0xc2, 0xff, 0x55, 0xd3, 0x56, 0x99, 0xf4, 0xe2, 0xd3, 0xe7, 0xe1, 0x94, 0xab,
+	0x0a, 0x55, 0x01, 0xba, 0x5d, 0x16, 0xcd, 0x85, 0xdd, 0x12, 0xbf, 0x02, 0x93, 0x2f, 0xf7, 0xe5,
+	0x63, 0x1b, 0x50, 0x06, 0x7d, 0x08, 0x63, 0x9c, 0x8e, 0x2e, 0x25, 0xcb, 0xf9, 0xf0, 0x73, 0xfd,
+	0xd8, 0x38, 0xfa, 0x67, 0x30, 0x21, 0x7a, 0x27, 0x82, 0x16, 0x92, 0x11, 0xa2, 0x53, 0xac, 0x7c,
+	0xfd, 0x00, 0x12, 0x5c, 0xfd, 0x37, 0x12, 0xcc, 0x24, 0xae, 0xfe, 0xe8, 0x56, 0x32, 0x6c, 0xc2,
+	0x5c, 0x2d, 0xdf, 0x3e, 0x8c, 0x28, 0x37, 0xad, 0x0a, 0xd0, 0x3d, 0x88, 0x49, 0x6c, 0x64, 0x1b,
+	0x95, 0x2f, 0xf7, 0xe5, 0xe3, 0x0a, 0x1e, 0x41, 0x5e, 0x60, 0x02, 0xaa, 0x08, 0x11, 0xe2, 0x17,
+	0x60, 0x79, 0x21, 0xbd, 0x40, 0x30, 0xed, 0xa2, 0xcd, 0x2a, 0x26, 0xed, 0x09, 0xeb, 0x63, 0x4c,
+	0xda, 0x93, 0xd6, 0x36, 0x96, 0xf6, 0xc4, 0xbd, 0x22, 0x26, 0xed, 0x69, 0xd6, 0xa9, 0x98, 0xb4,
+	0xa7, 0x5a, 0x63, 0x94, 0x0c, 0x6a, 0xc0, 0xe9, 0x9e, 0xa9, 0x15, 0x5d, 0x15, 0xc2, 0x89, 0xd6,
+	0x09, 0xf9, 0x5a, 0x1a, 0xd6, 0x60, 0xfe, 0x05, 0x83, 0x5a, 0x4c, 0xfe, 0xe3, 0x67, 0x51, 0x79,
+	0x21, 0xbd, 0x00, 0xd7, 0xbd, 0x0d, 0x28, 0xca, 0x80, 0xca, 0x29, 0x91, 0x7c, 0xcd, 0x95, 0xd4,
+	0xfc, 0x5c, 0x71, 0x13, 0xc6, 0x7b, 0xe7, 0x0a, 0x24, 0x0e, 0x9a, 0x70, 0xd0, 0x91, 0x5f, 0x4a,
+	0xc5, 0xcb, 0x95, 0xbd, 0x05, 0x59, 0x67, 0xce, 0x40, 0x25, 0xa1, 0x58, 0x60, 0x84, 0x91, 0x2f,
+	0x24, 0x70, 0x70, 0xb8, 0x35, 0xc8, 0x79, 0x63, 0x05, 0x52, 0xc4, 0xec, 0xc1, 0xd1, 0x45, 0x9e,
+	0x4d, 0xe4, 0xe1, 0xa0, 0xce, 0xbb, 0xb6, 0x98, 0xe1, 0x00, 0xdd, 0x10, 0x42, 0x24, 0x4f, 0x20,
+	0xf2, 0xd2, 0xc1, 0x84, 0xb8, 0x21, 0x5f, 0x4a, 0x30, 0x1d, 0xfb, 0x20, 0x47, 0x37, 0xd3, 0xa2,
+	0xf6, 0x8c, 0x1a, 0xf2, 0x2b, 0x07, 0x15, 0xe3, 0xe6, 0xd8, 0x70, 0x36, 0xf2, 0x18, 0x47, 0xf3,
+	0x31, 0x70, 0xe2, 0x79, 0x40, 0x2e, 0xa7, 0x65, 0xf7, 0xb5, 0xae, 0xdc, 0x7b, 0xbc, 0x57, 0x90,
+	0x9e, 0xec, 0x15, 0xa4, 0xbf, 0xf7, 0x0a, 0xd2, 0x57, 0xfb, 0x85, 0xcc, 0x93, 0xfd, 0x42, 0xe6,
+	0x8f, 0xfd, 0x42, 0xe6, 0xe1, 0xcb, 0x89, 0x13, 0xc2, 0xa7, 0xfc, 0x4f, 0x30, 0x77, 0x56, 0xd8,
+	0xc8, 0xb9, 0xff, 0x81, 0xdd, 0xf8, 0x77, 0x00, 0xd4, 0xf1, 0xae, 0x0f, 0x84, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1596,6 +1871,12 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries the tokenize share record with the given id
+	TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of staked tokens that are tokenized
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
 }
 
 type queryClient struct {
@@ -1732,6 +2013,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error) {
+	out := new(QueryTokenizeShareRecordByIdResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.Query/TokenizeShareRecordById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.Query/TokenizeShareRecordsOwned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.Query/TotalLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status
@@ -1762,6 +2070,12 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries the tokenize share record with the given id
+	TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of staked tokens that are tokenized
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1810,6 +2124,15 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenizeShareRecordById(ctx context.Context, req *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordById not implemented")
+}
+func (*UnimplementedQueryServer) TokenizeShareRecordsOwned(ctx context.Context, req *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidStaked(ctx context.Context, req *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2067,6 +2390,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.Query/TokenizeShareRecordById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, req.(*QueryTokenizeShareRecordByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.Query/TokenizeShareRecordsOwned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.Query/TotalLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2127,6 +2504,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecordById",
+			Handler:    _Query_TokenizeShareRecordById_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/query.proto",
@@ -3245,32 +3634,216 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTokenizeShareRecordByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTokenizeShareRecordByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryValidatorsResponse) Size() (n int) {
@@ -3695,6 +4268,77 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenizeShareRecordByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalLiquidStakedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidStakedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6761,6 +7405,477 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizeShareRecordByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TokenizeShareRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
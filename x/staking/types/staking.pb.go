@@ -376,25 +376,29 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-// HistoricalInfo contains the historical information that gets stored at
-// each height.
-type HistoricalInfo struct {
-	Header types1.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
-	Valset []Validator   `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset"`
+// MsgCancelUnbondingDelegation defines an SDK message for cancelling an entry of
+// an unbonding delegation and delegating its balance back to the validator.
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount is always less than or equal to the balance of the entry
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height at which the entry was created
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
 }
 
-func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
-func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
-func (*HistoricalInfo) ProtoMessage()    {}
-func (*HistoricalInfo) Descriptor() ([]byte, []int) {
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{5}
 }
-func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HistoricalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HistoricalInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -404,51 +408,67 @@ func (m *HistoricalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *HistoricalInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HistoricalInfo.Merge(m, src)
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
 }
-func (m *HistoricalInfo) XXX_Size() int {
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *HistoricalInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_HistoricalInfo.DiscardUnknown(m)
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HistoricalInfo proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
 
-func (m *HistoricalInfo) GetHeader() types1.Header {
+func (m *MsgCancelUnbondingDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Header
+		return m.DelegatorAddress
 	}
-	return types1.Header{}
+	return nil
 }
 
-func (m *HistoricalInfo) GetValset() []Validator {
+func (m *MsgCancelUnbondingDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.Valset
+		return m.ValidatorAddress
 	}
 	return nil
 }
 
-// CommissionRates defines the initial commission rates to be used for creating
-// a validator.
-type CommissionRates struct {
-	Rate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
-	MaxRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate" yaml:"max_rate"`
-	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+func (m *MsgCancelUnbondingDelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
-func (*CommissionRates) ProtoMessage() {}
-func (*CommissionRates) Descriptor() ([]byte, []int) {
+func (m *MsgCancelUnbondingDelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// MsgTokenizeShares defines an SDK message for converting a delegation into
+// transferable tokens representing shares of a validator.
+type MsgTokenizeShares struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount              types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	TokenizedShareOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"tokenized_share_owner,omitempty" yaml:"tokenized_share_owner"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{6}
 }
-func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommissionRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommissionRates.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,35 +478,65 @@ func (m *CommissionRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *CommissionRates) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommissionRates.Merge(m, src)
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
 }
-func (m *CommissionRates) XXX_Size() int {
+func (m *MsgTokenizeShares) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommissionRates) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommissionRates.DiscardUnknown(m)
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommissionRates proto.InternalMessageInfo
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
 
-// Commission defines a commission parameters for a given validator.
-type Commission struct {
-	CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3,embedded=commission_rates" json:"commission_rates"`
-	UpdateTime      time.Time `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
+func (m *MsgTokenizeShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
 }
 
-func (m *Commission) Reset()      { *m = Commission{} }
-func (*Commission) ProtoMessage() {}
-func (*Commission) Descriptor() ([]byte, []int) {
+func (m *MsgTokenizeShares) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgTokenizeShares) GetTokenizedShareOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.TokenizedShareOwner
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares defines an SDK message for converting share tokens
+// back into a delegation.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin                                    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{7}
 }
-func (m *Commission) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -496,45 +546,52 @@ func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Commission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Commission.Merge(m, src)
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
 }
-func (m *Commission) XXX_Size() int {
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
 	return m.Size()
 }
-func (m *Commission) XXX_DiscardUnknown() {
-	xxx_messageInfo_Commission.DiscardUnknown(m)
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Commission proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
 
-func (m *Commission) GetUpdateTime() time.Time {
+func (m *MsgRedeemTokensForShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.UpdateTime
+		return m.DelegatorAddress
 	}
-	return time.Time{}
+	return nil
 }
 
-// Description defines a validator description.
-type Description struct {
-	Moniker         string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	Identity        string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
-	Website         string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
-	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
-	Details         string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+func (m *MsgRedeemTokensForShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *Description) Reset()      { *m = Description{} }
-func (*Description) ProtoMessage() {}
-func (*Description) Descriptor() ([]byte, []int) {
+// MsgTransferTokenizeShareRecord defines an SDK message for transferring the
+// ownership of a tokenize share record, and thus of its rewards.
+type MsgTransferTokenizeShareRecord struct {
+	TokenizeShareRecordId uint64                                        `protobuf:"varint,1,opt,name=tokenize_share_record_id,json=tokenizeShareRecordId,proto3" json:"tokenize_share_record_id,omitempty" yaml:"tokenize_share_record_id"`
+	Sender                github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	NewOwner              github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferTokenizeShareRecord) Reset()         { *m = MsgTransferTokenizeShareRecord{} }
+func (m *MsgTransferTokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTokenizeShareRecord) ProtoMessage()    {}
+func (*MsgTransferTokenizeShareRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{8}
 }
-func (m *Description) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferTokenizeShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Description) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferTokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Description.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferTokenizeShareRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -544,85 +601,58 @@ func (m *Description) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Description) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Description.Merge(m, src)
+func (m *MsgTransferTokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTokenizeShareRecord.Merge(m, src)
 }
-func (m *Description) XXX_Size() int {
+func (m *MsgTransferTokenizeShareRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *Description) XXX_DiscardUnknown() {
-	xxx_messageInfo_Description.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Description proto.InternalMessageInfo
-
-func (m *Description) GetMoniker() string {
-	if m != nil {
-		return m.Moniker
-	}
-	return ""
+func (m *MsgTransferTokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTokenizeShareRecord.DiscardUnknown(m)
 }
 
-func (m *Description) GetIdentity() string {
-	if m != nil {
-		return m.Identity
-	}
-	return ""
-}
+var xxx_messageInfo_MsgTransferTokenizeShareRecord proto.InternalMessageInfo
 
-func (m *Description) GetWebsite() string {
+func (m *MsgTransferTokenizeShareRecord) GetTokenizeShareRecordId() uint64 {
 	if m != nil {
-		return m.Website
+		return m.TokenizeShareRecordId
 	}
-	return ""
+	return 0
 }
 
-func (m *Description) GetSecurityContact() string {
+func (m *MsgTransferTokenizeShareRecord) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.SecurityContact
+		return m.Sender
 	}
-	return ""
+	return nil
 }
 
-func (m *Description) GetDetails() string {
+func (m *MsgTransferTokenizeShareRecord) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Details
+		return m.NewOwner
 	}
-	return ""
+	return nil
 }
 
-// Validator defines the total amount of bond shares and their exchange rate to
-// coins. Slashing results in a decrease in the exchange rate, allowing correct
-// calculation of future undelegations without iterating over delegators.
-// When coins are delegated to this validator, the validator is credited with a
-// delegation whose number of bond shares is based on the amount of coins
-// delegated divided by the current exchange rate. Voting power can be
-// calculated as total bonded shares multiplied by exchange rate.
-type Validator struct {
-	OperatorAddress   github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"operator_address,omitempty" yaml:"operator_address"`
-	ConsensusPubkey   string                                        `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty" yaml:"consensus_pubkey"`
-	Jailed            bool                                          `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
-	Status            github_com_cosmos_cosmos_sdk_types.BondStatus `protobuf:"varint,4,opt,name=status,proto3,casttype=github.com/cosmos/cosmos-sdk/types.BondStatus" json:"status,omitempty"`
-	Tokens            github_com_cosmos_cosmos_sdk_types.Int        `protobuf:"bytes,5,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
-	DelegatorShares   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares" yaml:"delegator_shares"`
-	Description       Description                                   `protobuf:"bytes,7,opt,name=description,proto3" json:"description"`
-	UnbondingHeight   int64                                         `protobuf:"varint,8,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty" yaml:"unbonding_height"`
-	UnbondingTime     time.Time                                     `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3,stdtime" json:"unbonding_time" yaml:"unbonding_time"`
-	Commission        Commission                                    `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
-	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int        `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
+// MsgRotateConsPubKey defines an SDK message for replacing the consensus
+// public key of a validator.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	NewPubkey        string                                        `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty" yaml:"new_pubkey"`
 }
 
-func (m *Validator) Reset()      { *m = Validator{} }
-func (*Validator) ProtoMessage() {}
-func (*Validator) Descriptor() ([]byte, []int) {
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{9}
 }
-func (m *Validator) XXX_Unmarshal(b []byte) error {
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -632,37 +662,55 @@ func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Validator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Validator.Merge(m, src)
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
 }
-func (m *Validator) XXX_Size() int {
+func (m *MsgRotateConsPubKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *Validator) XXX_DiscardUnknown() {
-	xxx_messageInfo_Validator.DiscardUnknown(m)
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Validator proto.InternalMessageInfo
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
 
-// DVPair is struct that just has a delegator-validator pair with no other data.
-// It is intended to be used as a marshalable pointer. For example, a DVPair can
-// be used to construct the key to getting an UnbondingDelegation from state.
-type DVPair struct {
-	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+func (m *MsgRotateConsPubKey) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
 }
 
-func (m *DVPair) Reset()      { *m = DVPair{} }
-func (*DVPair) ProtoMessage() {}
-func (*DVPair) Descriptor() ([]byte, []int) {
+func (m *MsgRotateConsPubKey) GetNewPubkey() string {
+	if m != nil {
+		return m.NewPubkey
+	}
+	return ""
+}
+
+// ConsPubKeyRotation records the rotation of the consensus public key of a
+// validator. The old key keeps resolving to the validator until the
+// completion time so that evidence and downtime for it can still be handled.
+type ConsPubKeyRotation struct {
+	OperatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"operator_address,omitempty" yaml:"operator_address"`
+	OldConsPubkey   string                                        `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty" yaml:"old_cons_pubkey"`
+	NewConsPubkey   string                                        `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty" yaml:"new_cons_pubkey"`
+	Height          int64                                         `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	CompletionTime  time.Time                                     `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{10}
 }
-func (m *DVPair) XXX_Unmarshal(b []byte) error {
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DVPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DVPair.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -672,49 +720,75 @@ func (m *DVPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DVPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DVPair.Merge(m, src)
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
 }
-func (m *DVPair) XXX_Size() int {
+func (m *ConsPubKeyRotation) XXX_Size() int {
 	return m.Size()
 }
-func (m *DVPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_DVPair.DiscardUnknown(m)
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DVPair proto.InternalMessageInfo
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
 
-func (m *DVPair) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *ConsPubKeyRotation) GetOperatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.OperatorAddress
 	}
 	return nil
 }
 
-func (m *DVPair) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *ConsPubKeyRotation) GetOldConsPubkey() string {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.OldConsPubkey
 	}
-	return nil
+	return ""
 }
 
-// DVPairs defines an array of DVPair objects.
-type DVPairs struct {
-	Pairs []DVPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs"`
+func (m *ConsPubKeyRotation) GetNewConsPubkey() string {
+	if m != nil {
+		return m.NewConsPubkey
+	}
+	return ""
 }
 
-func (m *DVPairs) Reset()         { *m = DVPairs{} }
-func (m *DVPairs) String() string { return proto.CompactTextString(m) }
-func (*DVPairs) ProtoMessage()    {}
-func (*DVPairs) Descriptor() ([]byte, []int) {
+func (m *ConsPubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsPubKeyRotation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// TokenizeShareRecord records a tokenized delegation. The delegation is held by
+// a dedicated module account and the rewards it earns belong to the owner of
+// the record.
+type TokenizeShareRecord struct {
+	Id            uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	ModuleAccount string                                        `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	Validator     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{11}
 }
-func (m *DVPairs) XXX_Unmarshal(b []byte) error {
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DVPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DVPairs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -724,46 +798,65 @@ func (m *DVPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DVPairs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DVPairs.Merge(m, src)
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
 }
-func (m *DVPairs) XXX_Size() int {
+func (m *TokenizeShareRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *DVPairs) XXX_DiscardUnknown() {
-	xxx_messageInfo_DVPairs.DiscardUnknown(m)
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DVPairs proto.InternalMessageInfo
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
 
-func (m *DVPairs) GetPairs() []DVPair {
+func (m *TokenizeShareRecord) GetId() uint64 {
 	if m != nil {
-		return m.Pairs
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-// DVVTriplet is struct that just has a delegator-validator-validator triplet
-// with no other data. It is intended to be used as a marshalable pointer. For
-// example, a DVVTriplet can be used to construct the key to getting a
-// Redelegation from state.
-type DVVTriplet struct {
-	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	ValidatorSrcAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
-	ValidatorDstAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
+func (m *TokenizeShareRecord) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
 }
 
-func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
-func (*DVVTriplet) ProtoMessage() {}
-func (*DVVTriplet) Descriptor() ([]byte, []int) {
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+// HistoricalInfo contains the historical information that gets stored at
+// each height.
+type HistoricalInfo struct {
+	Header types1.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	Valset []Validator   `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset"`
+}
+
+func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
+func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
+func (*HistoricalInfo) ProtoMessage()    {}
+func (*HistoricalInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{12}
 }
-func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
+func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DVVTriplet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HistoricalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DVVTriplet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HistoricalInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -773,56 +866,51 @@ func (m *DVVTriplet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DVVTriplet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DVVTriplet.Merge(m, src)
+func (m *HistoricalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalInfo.Merge(m, src)
 }
-func (m *DVVTriplet) XXX_Size() int {
+func (m *HistoricalInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *DVVTriplet) XXX_DiscardUnknown() {
-	xxx_messageInfo_DVVTriplet.DiscardUnknown(m)
+func (m *HistoricalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DVVTriplet proto.InternalMessageInfo
-
-func (m *DVVTriplet) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.DelegatorAddress
-	}
-	return nil
-}
+var xxx_messageInfo_HistoricalInfo proto.InternalMessageInfo
 
-func (m *DVVTriplet) GetValidatorSrcAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *HistoricalInfo) GetHeader() types1.Header {
 	if m != nil {
-		return m.ValidatorSrcAddress
+		return m.Header
 	}
-	return nil
+	return types1.Header{}
 }
 
-func (m *DVVTriplet) GetValidatorDstAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *HistoricalInfo) GetValset() []Validator {
 	if m != nil {
-		return m.ValidatorDstAddress
+		return m.Valset
 	}
 	return nil
 }
 
-// DVVTriplets defines an array of DVVTriplet objects.
-type DVVTriplets struct {
-	Triplets []DVVTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets"`
+// CommissionRates defines the initial commission rates to be used for creating
+// a validator.
+type CommissionRates struct {
+	Rate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	MaxRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate" yaml:"max_rate"`
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
 }
 
-func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
-func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
-func (*DVVTriplets) ProtoMessage()    {}
-func (*DVVTriplets) Descriptor() ([]byte, []int) {
+func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
+func (*CommissionRates) ProtoMessage() {}
+func (*CommissionRates) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{13}
 }
-func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
+func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DVVTriplets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommissionRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DVVTriplets.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommissionRates.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -832,45 +920,35 @@ func (m *DVVTriplets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DVVTriplets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DVVTriplets.Merge(m, src)
+func (m *CommissionRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRates.Merge(m, src)
 }
-func (m *DVVTriplets) XXX_Size() int {
+func (m *CommissionRates) XXX_Size() int {
 	return m.Size()
 }
-func (m *DVVTriplets) XXX_DiscardUnknown() {
-	xxx_messageInfo_DVVTriplets.DiscardUnknown(m)
+func (m *CommissionRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRates.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DVVTriplets proto.InternalMessageInfo
-
-func (m *DVVTriplets) GetTriplets() []DVVTriplet {
-	if m != nil {
-		return m.Triplets
-	}
-	return nil
-}
+var xxx_messageInfo_CommissionRates proto.InternalMessageInfo
 
-// Delegation represents the bond with tokens held by an account. It is
-// owned by one delegator, and is associated with the voting power of one
-// validator.
-type Delegation struct {
-	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
-	Shares           github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+// Commission defines a commission parameters for a given validator.
+type Commission struct {
+	CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3,embedded=commission_rates" json:"commission_rates"`
+	UpdateTime      time.Time `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
 }
 
-func (m *Delegation) Reset()      { *m = Delegation{} }
-func (*Delegation) ProtoMessage() {}
-func (*Delegation) Descriptor() ([]byte, []int) {
+func (m *Commission) Reset()      { *m = Commission{} }
+func (*Commission) ProtoMessage() {}
+func (*Commission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{14}
 }
-func (m *Delegation) XXX_Unmarshal(b []byte) error {
+func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -880,51 +958,45 @@ func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Delegation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Delegation.Merge(m, src)
+func (m *Commission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commission.Merge(m, src)
 }
-func (m *Delegation) XXX_Size() int {
+func (m *Commission) XXX_Size() int {
 	return m.Size()
 }
-func (m *Delegation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Delegation.DiscardUnknown(m)
+func (m *Commission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Delegation proto.InternalMessageInfo
-
-func (m *Delegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.DelegatorAddress
-	}
-	return nil
-}
+var xxx_messageInfo_Commission proto.InternalMessageInfo
 
-func (m *Delegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *Commission) GetUpdateTime() time.Time {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.UpdateTime
 	}
-	return nil
+	return time.Time{}
 }
 
-// UnbondingDelegation stores all of a single delegator's unbonding bonds
-// for a single validator in an time-ordered list
-type UnbondingDelegation struct {
-	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
-	Entries          []UnbondingDelegationEntry                    `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+// Description defines a validator description.
+type Description struct {
+	Moniker         string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Identity        string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Website         string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
+	Details         string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
-func (*UnbondingDelegation) ProtoMessage() {}
-func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
+func (m *Description) Reset()      { *m = Description{} }
+func (*Description) ProtoMessage() {}
+func (*Description) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{15}
 }
-func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
+func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Description) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnbondingDelegation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Description.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -934,112 +1006,128 @@ func (m *UnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *UnbondingDelegation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbondingDelegation.Merge(m, src)
+func (m *Description) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Description.Merge(m, src)
 }
-func (m *UnbondingDelegation) XXX_Size() int {
+func (m *Description) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnbondingDelegation) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnbondingDelegation.DiscardUnknown(m)
+func (m *Description) XXX_DiscardUnknown() {
+	xxx_messageInfo_Description.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnbondingDelegation proto.InternalMessageInfo
+var xxx_messageInfo_Description proto.InternalMessageInfo
 
-func (m *UnbondingDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *Description) GetMoniker() string {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Moniker
 	}
-	return nil
+	return ""
 }
 
-func (m *UnbondingDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *Description) GetIdentity() string {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.Identity
 	}
-	return nil
+	return ""
 }
 
-func (m *UnbondingDelegation) GetEntries() []UnbondingDelegationEntry {
+func (m *Description) GetWebsite() string {
 	if m != nil {
-		return m.Entries
+		return m.Website
 	}
-	return nil
+	return ""
 }
 
-// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
-type UnbondingDelegationEntry struct {
-	CreationHeight int64                                  `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
-	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
-	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
-	Balance        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+func (m *Description) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
+	}
+	return ""
 }
 
-func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
-func (*UnbondingDelegationEntry) ProtoMessage() {}
-func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{16}
-}
-func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UnbondingDelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UnbondingDelegationEntry.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Description) GetDetails() string {
+	if m != nil {
+		return m.Details
 	}
+	return ""
 }
-func (m *UnbondingDelegationEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbondingDelegationEntry.Merge(m, src)
-}
-func (m *UnbondingDelegationEntry) XXX_Size() int {
-	return m.Size()
-}
-func (m *UnbondingDelegationEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnbondingDelegationEntry.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UnbondingDelegationEntry proto.InternalMessageInfo
 
-func (m *UnbondingDelegationEntry) GetCreationHeight() int64 {
-	if m != nil {
-		return m.CreationHeight
-	}
-	return 0
+// Validator defines the total amount of bond shares and their exchange rate to
+// coins. Slashing results in a decrease in the exchange rate, allowing correct
+// calculation of future undelegations without iterating over delegators.
+// When coins are delegated to this validator, the validator is credited with a
+// delegation whose number of bond shares is based on the amount of coins
+// delegated divided by the current exchange rate. Voting power can be
+// calculated as total bonded shares multiplied by exchange rate.
+type Validator struct {
+	OperatorAddress   github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"operator_address,omitempty" yaml:"operator_address"`
+	ConsensusPubkey   string                                        `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty" yaml:"consensus_pubkey"`
+	Jailed            bool                                          `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Status            github_com_cosmos_cosmos_sdk_types.BondStatus `protobuf:"varint,4,opt,name=status,proto3,casttype=github.com/cosmos/cosmos-sdk/types.BondStatus" json:"status,omitempty"`
+	Tokens            github_com_cosmos_cosmos_sdk_types.Int        `protobuf:"bytes,5,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	DelegatorShares   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares" yaml:"delegator_shares"`
+	Description       Description                                   `protobuf:"bytes,7,opt,name=description,proto3" json:"description"`
+	UnbondingHeight   int64                                         `protobuf:"varint,8,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty" yaml:"unbonding_height"`
+	UnbondingTime     time.Time                                     `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3,stdtime" json:"unbonding_time" yaml:"unbonding_time"`
+	Commission        Commission                                    `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int        `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
+	// in_maintenance is set while the validator is in a planned maintenance
+	// window, during which it is kept out of the active set without being jailed.
+	InMaintenance bool `protobuf:"varint,12,opt,name=in_maintenance,json=inMaintenance,proto3" json:"in_maintenance,omitempty" yaml:"in_maintenance"`
 }
 
-func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
-	if m != nil {
-		return m.CompletionTime
+func (m *Validator) Reset()      { *m = Validator{} }
+func (*Validator) ProtoMessage() {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{16}
+}
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return time.Time{}
+}
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
+}
+func (m *Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
 }
 
-// RedelegationEntry defines a redelegation object with relevant metadata.
-type RedelegationEntry struct {
-	CreationHeight int64                                  `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
-	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
-	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
-	SharesDst      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+// DVPair is struct that just has a delegator-validator pair with no other data.
+// It is intended to be used as a marshalable pointer. For example, a DVPair can
+// be used to construct the key to getting an UnbondingDelegation from state.
+type DVPair struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
 }
 
-func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
-func (*RedelegationEntry) ProtoMessage() {}
-func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+func (m *DVPair) Reset()      { *m = DVPair{} }
+func (*DVPair) ProtoMessage() {}
+func (*DVPair) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{17}
 }
-func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
+func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DVPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RedelegationEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DVPair.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1049,52 +1137,49 @@ func (m *RedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RedelegationEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RedelegationEntry.Merge(m, src)
+func (m *DVPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVPair.Merge(m, src)
 }
-func (m *RedelegationEntry) XXX_Size() int {
+func (m *DVPair) XXX_Size() int {
 	return m.Size()
 }
-func (m *RedelegationEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_RedelegationEntry.DiscardUnknown(m)
+func (m *DVPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVPair.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RedelegationEntry proto.InternalMessageInfo
+var xxx_messageInfo_DVPair proto.InternalMessageInfo
 
-func (m *RedelegationEntry) GetCreationHeight() int64 {
+func (m *DVPair) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.CreationHeight
+		return m.DelegatorAddress
 	}
-	return 0
+	return nil
 }
 
-func (m *RedelegationEntry) GetCompletionTime() time.Time {
+func (m *DVPair) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.CompletionTime
+		return m.ValidatorAddress
 	}
-	return time.Time{}
+	return nil
 }
 
-// Redelegation contains the list of a particular delegator's redelegating bonds
-// from a particular source validator to a particular destination validator.
-type Redelegation struct {
-	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	ValidatorSrcAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
-	ValidatorDstAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
-	Entries             []RedelegationEntry                           `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+// DVPairs defines an array of DVPair objects.
+type DVPairs struct {
+	Pairs []DVPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs"`
 }
 
-func (m *Redelegation) Reset()      { *m = Redelegation{} }
-func (*Redelegation) ProtoMessage() {}
-func (*Redelegation) Descriptor() ([]byte, []int) {
+func (m *DVPairs) Reset()         { *m = DVPairs{} }
+func (m *DVPairs) String() string { return proto.CompactTextString(m) }
+func (*DVPairs) ProtoMessage()    {}
+func (*DVPairs) Descriptor() ([]byte, []int) {
 	return fileDescriptor_146003fcdb99b683, []int{18}
 }
-func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DVPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DVPairs.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1104,66 +1189,105 @@ func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Redelegation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Redelegation.Merge(m, src)
+func (m *DVPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVPairs.Merge(m, src)
 }
-func (m *Redelegation) XXX_Size() int {
+func (m *DVPairs) XXX_Size() int {
 	return m.Size()
 }
-func (m *Redelegation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+func (m *DVPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVPairs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+var xxx_messageInfo_DVPairs proto.InternalMessageInfo
 
-func (m *Redelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *DVPairs) GetPairs() []DVPair {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Pairs
 	}
 	return nil
 }
 
-func (m *Redelegation) GetValidatorSrcAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+// DVVTriplet is struct that just has a delegator-validator-validator triplet
+// with no other data. It is intended to be used as a marshalable pointer. For
+// example, a DVVTriplet can be used to construct the key to getting a
+// Redelegation from state.
+type DVVTriplet struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorSrcAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
+	ValidatorDstAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
+}
+
+func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
+func (*DVVTriplet) ProtoMessage() {}
+func (*DVVTriplet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{19}
+}
+func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DVVTriplet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DVVTriplet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DVVTriplet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVVTriplet.Merge(m, src)
+}
+func (m *DVVTriplet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DVVTriplet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVVTriplet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DVVTriplet proto.InternalMessageInfo
+
+func (m *DVVTriplet) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.ValidatorSrcAddress
+		return m.DelegatorAddress
 	}
 	return nil
 }
 
-func (m *Redelegation) GetValidatorDstAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (m *DVVTriplet) GetValidatorSrcAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.ValidatorDstAddress
+		return m.ValidatorSrcAddress
 	}
 	return nil
 }
 
-func (m *Redelegation) GetEntries() []RedelegationEntry {
+func (m *DVVTriplet) GetValidatorDstAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.Entries
+		return m.ValidatorDstAddress
 	}
 	return nil
 }
 
-// Params defines the parameters for the staking module.
-type Params struct {
-	UnbondingTime     time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
-	MaxValidators     uint32        `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
-	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
-	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
-	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+// DVVTriplets defines an array of DVVTriplet objects.
+type DVVTriplets struct {
+	Triplets []DVVTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets"`
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{19}
+func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
+func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
+func (*DVVTriplets) ProtoMessage()    {}
+func (*DVVTriplets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{20}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DVVTriplets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DVVTriplets.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1173,71 +1297,99 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *DVVTriplets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVVTriplets.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *DVVTriplets) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *DVVTriplets) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVVTriplets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_DVVTriplets proto.InternalMessageInfo
 
-func (m *Params) GetUnbondingTime() time.Duration {
+func (m *DVVTriplets) GetTriplets() []DVVTriplet {
 	if m != nil {
-		return m.UnbondingTime
+		return m.Triplets
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetMaxValidators() uint32 {
-	if m != nil {
-		return m.MaxValidators
-	}
-	return 0
+// Delegation represents the bond with tokens held by an account. It is
+// owned by one delegator, and is associated with the voting power of one
+// validator.
+type Delegation struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Shares           github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
 }
 
-func (m *Params) GetMaxEntries() uint32 {
-	if m != nil {
-		return m.MaxEntries
+func (m *Delegation) Reset()      { *m = Delegation{} }
+func (*Delegation) ProtoMessage() {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{21}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
 }
 
-func (m *Params) GetHistoricalEntries() uint32 {
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.HistoricalEntries
+		return m.DelegatorAddress
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetBondDenom() string {
+func (m *Delegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.BondDenom
+		return m.ValidatorAddress
 	}
-	return ""
+	return nil
 }
 
-// DelegationResponse is equivalent to Delegation except that it contains a balance
-// in addition to shares which is more suitable for client responses.
-type DelegationResponse struct {
-	Delegation Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
-	Balance    types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+// UnbondingDelegation stores all of a single delegator's unbonding bonds
+// for a single validator in an time-ordered list
+type UnbondingDelegation struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Entries          []UnbondingDelegationEntry                    `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
-func (*DelegationResponse) ProtoMessage() {}
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{20}
+func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
+func (*UnbondingDelegation) ProtoMessage() {}
+func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{22}
 }
-func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1247,52 +1399,58 @@ func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationResponse.Merge(m, src)
+func (m *UnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingDelegation.Merge(m, src)
 }
-func (m *DelegationResponse) XXX_Size() int {
+func (m *UnbondingDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+func (m *UnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingDelegation proto.InternalMessageInfo
 
-func (m *DelegationResponse) GetDelegation() Delegation {
+func (m *UnbondingDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Delegation
+		return m.DelegatorAddress
 	}
-	return Delegation{}
+	return nil
 }
 
-func (m *DelegationResponse) GetBalance() types.Coin {
+func (m *UnbondingDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if m != nil {
-		return m.Balance
+		return m.ValidatorAddress
 	}
-	return types.Coin{}
+	return nil
 }
 
-// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
-// contains a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationEntryResponse struct {
-	RedelegationEntry RedelegationEntry                      `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry"`
-	Balance           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+func (m *UnbondingDelegation) GetEntries() []UnbondingDelegationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResponse{} }
-func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
-func (*RedelegationEntryResponse) ProtoMessage()    {}
-func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{21}
+// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
+type UnbondingDelegationEntry struct {
+	CreationHeight int64                                  `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
+	Balance        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
 }
-func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
+func (*UnbondingDelegationEntry) ProtoMessage() {}
+func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{23}
+}
+func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingDelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RedelegationEntryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingDelegationEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1302,45 +1460,51 @@ func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *RedelegationEntryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RedelegationEntryResponse.Merge(m, src)
+func (m *UnbondingDelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingDelegationEntry.Merge(m, src)
 }
-func (m *RedelegationEntryResponse) XXX_Size() int {
+func (m *UnbondingDelegationEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *RedelegationEntryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RedelegationEntryResponse.DiscardUnknown(m)
+func (m *UnbondingDelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingDelegationEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RedelegationEntryResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingDelegationEntry proto.InternalMessageInfo
 
-func (m *RedelegationEntryResponse) GetRedelegationEntry() RedelegationEntry {
+func (m *UnbondingDelegationEntry) GetCreationHeight() int64 {
 	if m != nil {
-		return m.RedelegationEntry
+		return m.CreationHeight
 	}
-	return RedelegationEntry{}
+	return 0
 }
 
-// RedelegationResponse is equivalent to a Redelegation except that its entries
-// contain a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationResponse struct {
-	Redelegation Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
-	Entries      []RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
 }
 
-func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
-func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*RedelegationResponse) ProtoMessage()    {}
-func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{22}
+// RedelegationEntry defines a redelegation object with relevant metadata.
+type RedelegationEntry struct {
+	CreationHeight int64                                  `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
+	SharesDst      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
 }
-func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
+func (*RedelegationEntry) ProtoMessage() {}
+func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{24}
+}
+func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RedelegationEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1350,50 +1514,52 @@ func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+func (m *RedelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntry.Merge(m, src)
 }
-func (m *RedelegationResponse) XXX_Size() int {
+func (m *RedelegationEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *RedelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+func (m *RedelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_RedelegationEntry proto.InternalMessageInfo
 
-func (m *RedelegationResponse) GetRedelegation() Redelegation {
+func (m *RedelegationEntry) GetCreationHeight() int64 {
 	if m != nil {
-		return m.Redelegation
+		return m.CreationHeight
 	}
-	return Redelegation{}
+	return 0
 }
 
-func (m *RedelegationResponse) GetEntries() []RedelegationEntryResponse {
+func (m *RedelegationEntry) GetCompletionTime() time.Time {
 	if m != nil {
-		return m.Entries
+		return m.CompletionTime
 	}
-	return nil
+	return time.Time{}
 }
 
-// Pool - tracking bonded and not-bonded token supply of the bond denomination
-type Pool struct {
-	NotBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=not_bonded_tokens,json=notBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"not_bonded_tokens"`
-	BondedTokens    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+// Redelegation contains the list of a particular delegator's redelegating bonds
+// from a particular source validator to a particular destination validator.
+type Redelegation struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorSrcAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
+	ValidatorDstAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
+	Entries             []RedelegationEntry                           `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *Pool) Reset()         { *m = Pool{} }
-func (m *Pool) String() string { return proto.CompactTextString(m) }
-func (*Pool) ProtoMessage()    {}
-func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{23}
+func (m *Redelegation) Reset()      { *m = Redelegation{} }
+func (*Redelegation) ProtoMessage() {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{25}
 }
-func (m *Pool) XXX_Unmarshal(b []byte) error {
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1403,1102 +1569,1214 @@ func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Pool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Pool.Merge(m, src)
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
 }
-func (m *Pool) XXX_Size() int {
+func (m *Redelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *Pool) XXX_DiscardUnknown() {
-	xxx_messageInfo_Pool.DiscardUnknown(m)
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Pool proto.InternalMessageInfo
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.MsgCreateValidator")
-	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.MsgEditValidator")
-	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
-	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
-	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.staking.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.staking.Validator")
-	proto.RegisterType((*DVPair)(nil), "cosmos.staking.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "cosmos.staking.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.Redelegation")
-	proto.RegisterType((*Params)(nil), "cosmos.staking.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "cosmos.staking.Pool")
+func (m *Redelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
-
-var fileDescriptor_146003fcdb99b683 = []byte{
-	// 1836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0xcd, 0x6f, 0x1b, 0x59,
-	0x3d, 0x63, 0xbb, 0x4e, 0xf2, 0x73, 0x62, 0x27, 0xaf, 0x6d, 0xd6, 0xc9, 0x76, 0x33, 0xe9, 0x1c,
-	0x50, 0x40, 0xbb, 0x8e, 0x28, 0x48, 0x2b, 0x05, 0x90, 0xa8, 0xed, 0x46, 0x89, 0x68, 0xa4, 0x32,
-	0xed, 0x06, 0x09, 0x90, 0xac, 0xe7, 0x99, 0x57, 0x67, 0xc8, 0x7c, 0x98, 0x79, 0xcf, 0xdd, 0x04,
-	0xed, 0x15, 0x09, 0x21, 0x3e, 0xf6, 0xb8, 0xc7, 0x8a, 0x3f, 0x00, 0x8e, 0xc0, 0x99, 0xcb, 0x72,
-	0xab, 0x38, 0x20, 0xc4, 0x61, 0x80, 0xf6, 0x00, 0xe2, 0x84, 0x7c, 0xe0, 0xc0, 0x05, 0xf4, 0x3e,
-	0xe6, 0xc3, 0x63, 0x67, 0x63, 0x87, 0xb2, 0x54, 0x6a, 0x2e, 0xad, 0xdf, 0x6f, 0x7e, 0x5f, 0xef,
-	0xf7, 0xfd, 0x7e, 0x81, 0x5b, 0x56, 0x40, 0xbd, 0x80, 0xee, 0x50, 0x86, 0x4f, 0x1c, 0xbf, 0x17,
-	0xff, 0xdf, 0xe8, 0x87, 0x01, 0x0b, 0x50, 0x55, 0x7e, 0x6d, 0x28, 0xe8, 0xc6, 0x8d, 0x5e, 0xd0,
-	0x0b, 0xc4, 0xa7, 0x1d, 0xfe, 0x4b, 0x62, 0x6d, 0xdc, 0x66, 0xc4, 0xb7, 0x49, 0xe8, 0x39, 0x3e,
-	0xdb, 0xc1, 0x5d, 0xcb, 0xd9, 0x61, 0x67, 0x7d, 0x42, 0xe5, 0xbf, 0x0a, 0x45, 0xef, 0x05, 0x41,
-	0xcf, 0x25, 0x3b, 0xe2, 0xd4, 0x1d, 0x3c, 0xde, 0x61, 0x8e, 0x47, 0x28, 0xc3, 0x5e, 0x5f, 0x21,
-	0x6c, 0xe6, 0x11, 0xec, 0x41, 0x88, 0x99, 0x13, 0xf8, 0xea, 0xfb, 0x75, 0xa5, 0xa7, 0x52, 0x48,
-	0x00, 0x8d, 0xa8, 0x04, 0xe8, 0x90, 0xf6, 0x5a, 0x21, 0xc1, 0x8c, 0x1c, 0x61, 0xd7, 0xb1, 0x31,
-	0x0b, 0x42, 0xd4, 0x82, 0x8a, 0x4d, 0xa8, 0x15, 0x3a, 0x7d, 0xce, 0xa0, 0xae, 0x6d, 0x69, 0xdb,
-	0x95, 0x3b, 0x6f, 0x36, 0x46, 0xef, 0xd2, 0x68, 0xa7, 0x28, 0xcd, 0xd2, 0xc7, 0x91, 0x3e, 0x67,
-	0x66, 0xa9, 0xd0, 0x3d, 0x00, 0x2b, 0xf0, 0x3c, 0x87, 0x52, 0xce, 0xa3, 0x20, 0x78, 0xe8, 0x79,
-	0x1e, 0xad, 0x04, 0xc3, 0xc4, 0x8c, 0x50, 0xc5, 0x27, 0x43, 0x88, 0x3e, 0x80, 0xeb, 0x9e, 0xe3,
-	0x77, 0x28, 0x71, 0x1f, 0x77, 0x6c, 0xe2, 0x92, 0x9e, 0xb8, 0x54, 0xbd, 0xb8, 0xa5, 0x6d, 0x2f,
-	0x36, 0xef, 0x73, 0xf4, 0x3f, 0x46, 0xfa, 0x67, 0x7a, 0x0e, 0x3b, 0x1e, 0x74, 0x1b, 0x56, 0xe0,
-	0xed, 0x8c, 0xdc, 0xf3, 0x1d, 0x6a, 0x9f, 0x28, 0x3b, 0x1e, 0xf8, 0x6c, 0x18, 0xe9, 0x1b, 0x67,
-	0xd8, 0x73, 0x77, 0x8d, 0x09, 0x2c, 0x0d, 0x73, 0xd5, 0x73, 0xfc, 0x87, 0xc4, 0x7d, 0xdc, 0x4e,
-	0x60, 0xe8, 0x7b, 0xb0, 0xaa, 0x30, 0x82, 0xb0, 0x83, 0x6d, 0x3b, 0x24, 0x94, 0xd6, 0x4b, 0x5b,
-	0xda, 0xf6, 0x52, 0xf3, 0x70, 0x18, 0xe9, 0x75, 0xc9, 0x6d, 0x0c, 0xc5, 0xf8, 0x57, 0xa4, 0xbf,
-	0x33, 0x85, 0x4e, 0x77, 0x2d, 0xeb, 0xae, 0xa4, 0x30, 0x57, 0x12, 0x26, 0x0a, 0xc2, 0x65, 0x3f,
-	0x89, 0x5d, 0x92, 0xc8, 0xbe, 0x96, 0x97, 0x3d, 0x86, 0x32, 0xad, 0xec, 0x23, 0xec, 0x26, 0xb2,
-	0x13, 0x26, 0xb1, 0xec, 0x35, 0x28, 0xf7, 0x07, 0xdd, 0x13, 0x72, 0x56, 0x2f, 0x73, 0x43, 0x9b,
-	0xea, 0x84, 0xb6, 0xe1, 0xda, 0x13, 0xec, 0x0e, 0x48, 0x7d, 0x5e, 0xf8, 0x73, 0x29, 0xf6, 0x67,
-	0x2b, 0x70, 0xe2, 0x20, 0x90, 0x08, 0xbb, 0xa5, 0xbf, 0x3d, 0xd5, 0x35, 0xe3, 0x57, 0x45, 0x58,
-	0x39, 0xa4, 0xbd, 0x7b, 0xb6, 0xc3, 0x5e, 0x72, 0x78, 0xf5, 0x27, 0x59, 0xa7, 0x20, 0xac, 0xd3,
-	0x1a, 0x46, 0x7a, 0x55, 0x5a, 0xe7, 0x65, 0xda, 0xc4, 0x83, 0x5a, 0x1a, 0x97, 0x9d, 0x10, 0x33,
-	0xa2, 0xa2, 0xb0, 0x3d, 0x65, 0x04, 0xb6, 0x89, 0x35, 0x8c, 0xf4, 0x35, 0xa9, 0x59, 0x8e, 0x95,
-	0x61, 0x56, 0xad, 0x91, 0x5c, 0x40, 0xa7, 0x93, 0x03, 0xbf, 0x24, 0x44, 0xee, 0xff, 0x0f, 0x83,
-	0x5e, 0xb9, 0xee, 0x97, 0x05, 0xa8, 0x1c, 0xd2, 0x9e, 0x82, 0x93, 0xc9, 0xa9, 0xa0, 0xfd, 0x1f,
-	0x53, 0xa1, 0xf0, 0xe9, 0xa4, 0xc2, 0xe7, 0xa0, 0x8c, 0xbd, 0x60, 0xe0, 0x33, 0xe1, 0xed, 0xc9,
-	0x31, 0xaf, 0x30, 0x94, 0xe5, 0x7e, 0x57, 0x14, 0x55, 0xb5, 0x49, 0x7a, 0x8e, 0x6f, 0x12, 0xfb,
-	0x55, 0x30, 0xe0, 0xf7, 0x35, 0xb8, 0x99, 0x9a, 0x87, 0x86, 0x56, 0xce, 0x8a, 0x5f, 0x1f, 0x46,
-	0xfa, 0xad, 0xbc, 0x15, 0x33, 0x68, 0x97, 0xb0, 0xe4, 0xf5, 0x84, 0xd1, 0xc3, 0xd0, 0x9a, 0xac,
-	0x87, 0x4d, 0x59, 0xa2, 0x47, 0xf1, 0x7c, 0x3d, 0x32, 0x68, 0xff, 0x95, 0x1e, 0x6d, 0xca, 0xc6,
-	0x9d, 0x5a, 0x9a, 0xd2, 0xa9, 0xbf, 0x2e, 0xc0, 0xf2, 0x21, 0xed, 0xbd, 0xe7, 0xdb, 0x57, 0x09,
-	0x31, 0x6b, 0x42, 0xfc, 0x48, 0x83, 0xea, 0xbe, 0x43, 0x59, 0x10, 0x3a, 0x16, 0x76, 0x0f, 0xfc,
-	0xc7, 0x01, 0xfa, 0x12, 0x94, 0x8f, 0x09, 0xb6, 0x49, 0xa8, 0xca, 0xff, 0x5b, 0x8d, 0x74, 0x06,
-	0x6a, 0xf0, 0x19, 0xa8, 0x21, 0x35, 0xd9, 0x17, 0x48, 0x31, 0x57, 0x49, 0x82, 0xde, 0x85, 0xf2,
-	0x13, 0xec, 0x52, 0xc2, 0xea, 0x85, 0xad, 0xe2, 0x76, 0xe5, 0xce, 0x7a, 0xbe, 0x77, 0x24, 0xbd,
-	0x26, 0x26, 0x94, 0xe8, 0x4a, 0x9d, 0x5f, 0x14, 0xa0, 0x96, 0x1b, 0x3c, 0x50, 0x13, 0x4a, 0xa2,
-	0xa2, 0x6b, 0xa2, 0xbc, 0x36, 0x66, 0x98, 0x2b, 0xda, 0xc4, 0x32, 0x05, 0x2d, 0xfa, 0x36, 0x2c,
-	0x78, 0xf8, 0x54, 0x76, 0x86, 0x82, 0xe0, 0x73, 0x77, 0x36, 0x3e, 0xc3, 0x48, 0xaf, 0xa9, 0x52,
-	0xad, 0xf8, 0x18, 0xe6, 0xbc, 0x87, 0x4f, 0x45, 0x3f, 0xe8, 0x43, 0x8d, 0x43, 0xad, 0x63, 0xec,
-	0xf7, 0x48, 0xb6, 0xfd, 0xec, 0xcf, 0x2c, 0x64, 0x2d, 0x15, 0x92, 0x61, 0x67, 0x98, 0xcb, 0x1e,
-	0x3e, 0x6d, 0x09, 0x00, 0x97, 0xb8, 0xbb, 0xf0, 0xd1, 0x53, 0x7d, 0x4e, 0x58, 0xec, 0xb7, 0x1a,
-	0x40, 0x6a, 0x31, 0xf4, 0x08, 0x56, 0x72, 0xed, 0x8b, 0x2a, 0x37, 0x5e, 0x38, 0xe0, 0x2d, 0x70,
-	0x65, 0x9f, 0x45, 0xba, 0x66, 0xd6, 0xac, 0x9c, 0x0b, 0xbe, 0x05, 0x95, 0x41, 0xdf, 0xc6, 0x8c,
-	0x74, 0xf8, 0x6c, 0xab, 0x26, 0xc6, 0x8d, 0x86, 0x9c, 0x6b, 0x1b, 0xf1, 0x5c, 0xdb, 0x78, 0x14,
-	0x0f, 0xbe, 0xcd, 0x4d, 0xce, 0x6b, 0x18, 0xe9, 0x48, 0x5e, 0x27, 0x43, 0x6c, 0x7c, 0xf8, 0x27,
-	0x5d, 0x33, 0x41, 0x42, 0x38, 0xc1, 0xe8, 0x5d, 0x2a, 0x99, 0xd9, 0x02, 0xd5, 0x61, 0xde, 0x0b,
-	0x7c, 0xe7, 0x44, 0x85, 0xe2, 0xa2, 0x19, 0x1f, 0xd1, 0x06, 0x2c, 0x38, 0x36, 0xf1, 0x99, 0xc3,
-	0xce, 0xa4, 0x3f, 0xcd, 0xe4, 0xcc, 0xa9, 0xde, 0x27, 0x5d, 0xea, 0xc4, 0x5e, 0x30, 0xe3, 0x23,
-	0xda, 0x83, 0x15, 0x4a, 0xac, 0x41, 0xe8, 0xb0, 0xb3, 0x8e, 0x15, 0xf8, 0x0c, 0x5b, 0x4c, 0x35,
-	0xed, 0x37, 0x87, 0x91, 0xfe, 0x86, 0xd4, 0x35, 0x8f, 0x61, 0x98, 0xb5, 0x18, 0xd4, 0x92, 0x10,
-	0x2e, 0xc1, 0x26, 0x0c, 0x3b, 0xae, 0x1c, 0xfa, 0x16, 0xcd, 0xf8, 0x98, 0xb9, 0xcb, 0xcf, 0xe7,
-	0x61, 0x31, 0x9d, 0xab, 0xde, 0x87, 0x95, 0xa0, 0x4f, 0xc2, 0x09, 0xf5, 0xe8, 0x7e, 0x2a, 0x39,
-	0x8f, 0x71, 0x89, 0x92, 0x50, 0x8b, 0x79, 0xc4, 0x15, 0x61, 0x8f, 0xc7, 0x83, 0x4f, 0x89, 0x4f,
-	0x07, 0xb4, 0xa3, 0xe6, 0xc6, 0x42, 0xfe, 0xca, 0x79, 0x0c, 0x83, 0x47, 0x80, 0x02, 0x3d, 0x90,
-	0xd3, 0xe5, 0x1a, 0x94, 0xbf, 0x83, 0x1d, 0x97, 0xd8, 0xc2, 0xa6, 0x0b, 0xa6, 0x3a, 0xa1, 0x03,
-	0x28, 0x53, 0x86, 0xd9, 0x40, 0x8e, 0xde, 0xd7, 0x9a, 0x9f, 0x9f, 0x52, 0xe7, 0x66, 0xe0, 0xdb,
-	0x0f, 0x05, 0xa1, 0xa9, 0x18, 0xa0, 0x3d, 0x28, 0xb3, 0xe0, 0x84, 0xf8, 0xca, 0xa8, 0x33, 0x65,
-	0xfa, 0x81, 0xcf, 0x4c, 0x45, 0x8d, 0x18, 0xa4, 0x45, 0xb9, 0x43, 0x8f, 0x71, 0x48, 0xa8, 0x1c,
-	0x95, 0x9b, 0x07, 0x33, 0xa7, 0xe3, 0x1b, 0xf9, 0x4e, 0x21, 0xf9, 0x19, 0x66, 0x2d, 0x01, 0x3d,
-	0x14, 0x90, 0xfc, 0xe4, 0x3c, 0x7f, 0xa9, 0xc9, 0x79, 0x0f, 0x56, 0x06, 0x7e, 0x37, 0xf0, 0x6d,
-	0xc7, 0xef, 0x75, 0x8e, 0x89, 0xd3, 0x3b, 0x66, 0xf5, 0x85, 0x2d, 0x6d, 0xbb, 0x98, 0xf5, 0x56,
-	0x1e, 0xc3, 0x30, 0x6b, 0x09, 0x68, 0x5f, 0x40, 0x90, 0x0d, 0xd5, 0x14, 0x4b, 0xa4, 0xec, 0xe2,
-	0x85, 0x29, 0x7b, 0x5b, 0xa5, 0xec, 0xcd, 0xbc, 0x94, 0x34, 0x6b, 0x97, 0x13, 0x20, 0x27, 0x43,
-	0x5f, 0x1d, 0x79, 0x46, 0x82, 0x92, 0x70, 0x6e, 0x95, 0x99, 0xfe, 0x05, 0x59, 0xf9, 0x54, 0x5e,
-	0x90, 0xbb, 0x4b, 0x3f, 0x78, 0xaa, 0xcf, 0x25, 0x09, 0xfb, 0xc3, 0x02, 0x94, 0xdb, 0x47, 0x0f,
-	0xb0, 0x13, 0xbe, 0xae, 0xe3, 0x43, 0xa6, 0x7a, 0x7d, 0x05, 0xe6, 0xa5, 0x2d, 0x28, 0xba, 0x03,
-	0xd7, 0xfa, 0xfc, 0x47, 0x5d, 0x13, 0x0d, 0x7d, 0x6d, 0x2c, 0xa4, 0x05, 0x5e, 0xfc, 0xc2, 0x14,
-	0xa8, 0xc6, 0xcf, 0x8a, 0x00, 0xed, 0xa3, 0xa3, 0x47, 0xa1, 0xd3, 0x77, 0x09, 0xbb, 0x1a, 0xaf,
-	0x5f, 0x9d, 0xf1, 0x3a, 0xe3, 0xe3, 0xaf, 0x41, 0x25, 0xf5, 0x11, 0x45, 0x5f, 0x86, 0x05, 0xa6,
-	0x7e, 0x2b, 0x57, 0x6f, 0x8c, 0xbb, 0x3a, 0x46, 0x57, 0xee, 0x4e, 0x28, 0x8c, 0xdf, 0x17, 0x00,
-	0x2e, 0x5a, 0xce, 0xbc, 0x06, 0x03, 0xf8, 0x1e, 0x94, 0x55, 0xc7, 0x29, 0x5e, 0x6a, 0x5a, 0x55,
-	0xd4, 0x19, 0x2f, 0xfd, 0xa5, 0x00, 0xd7, 0xdf, 0x8b, 0xcb, 0xee, 0x95, 0x85, 0xd1, 0x3e, 0xcc,
-	0x13, 0x9f, 0x85, 0x8e, 0x30, 0x31, 0x8f, 0xd2, 0xed, 0x7c, 0x94, 0x4e, 0xb0, 0xd6, 0x3d, 0x9f,
-	0x85, 0x67, 0x2a, 0x66, 0x63, 0xf2, 0x8c, 0x8d, 0x7f, 0x5a, 0x84, 0xfa, 0x79, 0x54, 0xa8, 0x05,
-	0x35, 0x2b, 0x24, 0x02, 0x10, 0xb7, 0x64, 0x4d, 0xb4, 0xe4, 0x8d, 0xcc, 0xc6, 0x68, 0x14, 0xc1,
-	0x30, 0xab, 0x31, 0x44, 0x35, 0xe4, 0x9e, 0x58, 0x50, 0xf1, 0x54, 0xe1, 0x58, 0x53, 0x0e, 0xd1,
-	0x86, 0xea, 0xc8, 0xe9, 0x5a, 0x2a, 0xcb, 0x40, 0xb6, 0xe4, 0x6a, 0x0a, 0x15, 0x3d, 0xf9, 0xbb,
-	0x50, 0x73, 0x7c, 0x87, 0x39, 0xd8, 0xed, 0x74, 0xb1, 0x8b, 0x7d, 0xeb, 0x32, 0x4f, 0x11, 0xd9,
-	0x4d, 0x95, 0xd8, 0x1c, 0x3b, 0xc3, 0xac, 0x2a, 0x48, 0x53, 0x02, 0xb8, 0x47, 0x62, 0x51, 0xa5,
-	0x4b, 0x0d, 0x6e, 0x31, 0x79, 0xc6, 0x23, 0x3f, 0x2e, 0xc2, 0x6a, 0xb2, 0x9f, 0xb9, 0x72, 0xc5,
-	0xb4, 0xae, 0x38, 0x04, 0x90, 0x05, 0x84, 0x77, 0x8e, 0x4b, 0x78, 0x83, 0x97, 0xa0, 0x45, 0xc9,
-	0xa1, 0x4d, 0x59, 0xc6, 0x1f, 0x7f, 0x2d, 0xc2, 0x52, 0xd6, 0x1f, 0x57, 0x2d, 0xfd, 0x15, 0xda,
-	0x98, 0xdd, 0x4d, 0x4b, 0x62, 0x49, 0x94, 0xc4, 0xdb, 0xf9, 0x92, 0x38, 0x96, 0x4a, 0xe7, 0xd7,
-	0xc2, 0x7f, 0x16, 0xa0, 0xfc, 0x00, 0x87, 0xd8, 0xa3, 0xc8, 0x1a, 0x7b, 0x45, 0xc8, 0x4d, 0xc2,
-	0xfa, 0x58, 0xa2, 0xb4, 0xd5, 0x1f, 0xb4, 0x2e, 0x78, 0x44, 0x7c, 0x34, 0xf1, 0x11, 0x51, 0xf5,
-	0xf0, 0x69, 0x27, 0xb9, 0x97, 0x74, 0xe2, 0x72, 0x73, 0x3d, 0xe5, 0x32, 0xfa, 0x5d, 0xee, 0x42,
-	0x92, 0xa7, 0x35, 0x45, 0xef, 0x42, 0x85, 0x63, 0xa4, 0x5d, 0x81, 0x93, 0xaf, 0xa5, 0xcb, 0x87,
-	0xcc, 0x47, 0xc3, 0x04, 0x0f, 0x9f, 0xde, 0x93, 0x07, 0x74, 0x1f, 0xd0, 0x71, 0xb2, 0xfa, 0xea,
-	0xa4, 0x26, 0xe4, 0xf4, 0x6f, 0x0d, 0x23, 0x7d, 0x5d, 0xd2, 0x8f, 0xe3, 0x18, 0xe6, 0x6a, 0x0a,
-	0x8c, 0xb9, 0x7d, 0x11, 0x80, 0xdf, 0xab, 0x63, 0x13, 0x3f, 0xf0, 0xd4, 0x13, 0xf6, 0xe6, 0x30,
-	0xd2, 0x57, 0x25, 0x97, 0xf4, 0x9b, 0x61, 0x2e, 0xf2, 0x43, 0x9b, 0xff, 0xce, 0x18, 0xfe, 0x27,
-	0x1a, 0xa0, 0xb4, 0xf7, 0x98, 0x84, 0xf6, 0xf9, 0x13, 0x9c, 0x3f, 0xb2, 0x32, 0x2f, 0x23, 0x6d,
-	0xf2, 0x23, 0x2b, 0xa5, 0x8b, 0x1f, 0x59, 0x99, 0x54, 0x7d, 0x3b, 0xad, 0xcf, 0x85, 0x73, 0xb7,
-	0x82, 0x13, 0x6a, 0xf0, 0x6f, 0x34, 0x58, 0x1f, 0x0b, 0x9c, 0x44, 0xaf, 0x23, 0x40, 0x61, 0xe6,
-	0xa3, 0x30, 0xcd, 0x99, 0xd2, 0x6f, 0xea, 0xf8, 0x5b, 0x0d, 0xc7, 0x6a, 0xfc, 0xcb, 0xeb, 0x26,
-	0x6a, 0xa3, 0xa8, 0xc1, 0x8d, 0xac, 0xf8, 0xe4, 0x02, 0x7b, 0xb0, 0x94, 0x95, 0xae, 0x54, 0xbf,
-	0xf5, 0x49, 0xaa, 0x2b, 0xad, 0x47, 0xe8, 0xd0, 0x41, 0x9a, 0x7d, 0x72, 0xe5, 0xf9, 0xd9, 0x0b,
-	0x6f, 0x1f, 0xeb, 0x90, 0xcf, 0x42, 0xa9, 0xf1, 0xbf, 0x35, 0x28, 0x3d, 0x08, 0x02, 0x17, 0x05,
-	0xb0, 0xea, 0x07, 0xac, 0xc3, 0x83, 0x85, 0xd8, 0x1d, 0xb5, 0x1b, 0x91, 0x5b, 0xd0, 0xd6, 0x6c,
-	0x46, 0xf9, 0x7b, 0xa4, 0x8f, 0xb3, 0x32, 0x6b, 0x7e, 0xc0, 0x9a, 0x02, 0xf2, 0x48, 0x6e, 0x4e,
-	0x3e, 0x80, 0xe5, 0x51, 0x61, 0x72, 0x53, 0xf4, 0x8d, 0x99, 0x85, 0x8d, 0xb2, 0x19, 0x46, 0xfa,
-	0x8d, 0x34, 0x09, 0x12, 0xb0, 0x61, 0x2e, 0x75, 0x33, 0xd2, 0x77, 0x17, 0xf8, 0xed, 0xff, 0xf1,
-	0x54, 0xd7, 0x9a, 0x7b, 0x1f, 0x3f, 0xdf, 0xd4, 0x9e, 0x3d, 0xdf, 0xd4, 0xfe, 0xfc, 0x7c, 0x53,
-	0xfb, 0xf0, 0xc5, 0xe6, 0xdc, 0xb3, 0x17, 0x9b, 0x73, 0x7f, 0x78, 0xb1, 0x39, 0xf7, 0xcd, 0xb7,
-	0x3f, 0x51, 0x85, 0xd3, 0xe4, 0x4f, 0xfd, 0x42, 0x99, 0x6e, 0x59, 0x14, 0xa8, 0x2f, 0xfc, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0xb2, 0x69, 0x31, 0x7c, 0x09, 0x20, 0x00, 0x00,
+func (m *Redelegation) GetValidatorSrcAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorSrcAddress
+	}
+	return nil
 }
 
-func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
-}
-func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
-	var gzipped = []byte{
-		// 9675 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x70, 0x24, 0xc7,
-		0x79, 0x1f, 0xf6, 0x81, 0xc5, 0xee, 0xb7, 0x8b, 0xc5, 0xa2, 0x81, 0xbb, 0xc3, 0x2d, 0xc9, 0xdb,
-		0xe3, 0x1c, 0x1f, 0x38, 0x3e, 0x70, 0xd4, 0x89, 0x0f, 0x71, 0x8f, 0x22, 0x85, 0x05, 0x70, 0x77,
-		0x20, 0x81, 0x3b, 0x70, 0x00, 0x1c, 0x29, 0xca, 0xf6, 0x78, 0x30, 0xdb, 0x58, 0x0c, 0xb1, 0x3b,
-		0xb3, 0x9c, 0x99, 0xbd, 0x03, 0x58, 0x4a, 0x15, 0x53, 0x96, 0xf5, 0x70, 0x64, 0x4b, 0x51, 0x6c,
-		0x87, 0x96, 0x25, 0x99, 0x52, 0x2a, 0x91, 0xa3, 0x24, 0x7e, 0x24, 0x8a, 0x1e, 0x76, 0xfe, 0x50,
-		0x55, 0x1e, 0x56, 0xaa, 0x5c, 0x29, 0x29, 0x71, 0x52, 0xae, 0x54, 0x0a, 0xb6, 0x48, 0x55, 0x59,
-		0x51, 0x98, 0x44, 0xb9, 0xd0, 0x2e, 0x97, 0xf4, 0x47, 0x52, 0xfd, 0x9a, 0xd7, 0xce, 0xee, 0x0c,
-		0x8e, 0x47, 0x8a, 0x2e, 0xe9, 0x2f, 0x6c, 0xf7, 0x7c, 0xdf, 0xaf, 0xbb, 0xbf, 0xfe, 0xfa, 0xfb,
-		0xbe, 0xfe, 0xba, 0x67, 0x00, 0xbf, 0x79, 0x0e, 0x4e, 0xb6, 0x4c, 0xb3, 0xd5, 0xc6, 0x67, 0xba,
-		0x96, 0xe9, 0x98, 0x5b, 0xbd, 0xed, 0x33, 0x4d, 0x6c, 0x6b, 0x96, 0xde, 0x75, 0x4c, 0x6b, 0x8e,
-		0xd6, 0xa1, 0x09, 0x46, 0x31, 0x27, 0x28, 0xa4, 0x55, 0x98, 0x3c, 0xaf, 0xb7, 0xf1, 0xa2, 0x4b,
-		0xb8, 0x8e, 0x1d, 0xf4, 0x1e, 0xc8, 0x6e, 0xeb, 0x6d, 0x3c, 0x93, 0x3a, 0x99, 0x99, 0x2d, 0x9e,
-		0xbd, 0x63, 0x2e, 0xc4, 0x34, 0x17, 0xe4, 0x58, 0x23, 0xd5, 0x32, 0xe5, 0x90, 0xbe, 0x9b, 0x85,
-		0xa9, 0x88, 0xa7, 0x08, 0x41, 0xd6, 0x50, 0x3b, 0x04, 0x31, 0x35, 0x5b, 0x90, 0xe9, 0x6f, 0x34,
-		0x03, 0x63, 0x5d, 0x55, 0xdb, 0x55, 0x5b, 0x78, 0x26, 0x4d, 0xab, 0x45, 0x11, 0x9d, 0x00, 0x68,
-		0xe2, 0x2e, 0x36, 0x9a, 0xd8, 0xd0, 0xf6, 0x67, 0x32, 0x27, 0x33, 0xb3, 0x05, 0xd9, 0x57, 0x83,
-		0xee, 0x85, 0xc9, 0x6e, 0x6f, 0xab, 0xad, 0x6b, 0x8a, 0x8f, 0x0c, 0x4e, 0x66, 0x66, 0x47, 0xe5,
-		0x0a, 0x7b, 0xb0, 0xe8, 0x11, 0xdf, 0x0d, 0x13, 0xd7, 0xb0, 0xba, 0xeb, 0x27, 0x2d, 0x52, 0xd2,
-		0x32, 0xa9, 0xf6, 0x11, 0x2e, 0x40, 0xa9, 0x83, 0x6d, 0x5b, 0x6d, 0x61, 0xc5, 0xd9, 0xef, 0xe2,
-		0x99, 0x2c, 0x1d, 0xfd, 0xc9, 0xbe, 0xd1, 0x87, 0x47, 0x5e, 0xe4, 0x5c, 0x1b, 0xfb, 0x5d, 0x8c,
-		0xe6, 0xa1, 0x80, 0x8d, 0x5e, 0x87, 0x21, 0x8c, 0x0e, 0x90, 0xdf, 0x92, 0xd1, 0xeb, 0x84, 0x51,
-		0xf2, 0x84, 0x8d, 0x43, 0x8c, 0xd9, 0xd8, 0xba, 0xaa, 0x6b, 0x78, 0x26, 0x47, 0x01, 0xee, 0xee,
-		0x03, 0x58, 0x67, 0xcf, 0xc3, 0x18, 0x82, 0x0f, 0x2d, 0x40, 0x01, 0xef, 0x39, 0xd8, 0xb0, 0x75,
-		0xd3, 0x98, 0x19, 0xa3, 0x20, 0x77, 0x46, 0xcc, 0x22, 0x6e, 0x37, 0xc3, 0x10, 0x1e, 0x1f, 0x7a,
-		0x18, 0xc6, 0xcc, 0xae, 0xa3, 0x9b, 0x86, 0x3d, 0x93, 0x3f, 0x99, 0x9a, 0x2d, 0x9e, 0xbd, 0x35,
-		0x52, 0x11, 0x2e, 0x33, 0x1a, 0x59, 0x10, 0xa3, 0x65, 0xa8, 0xd8, 0x66, 0xcf, 0xd2, 0xb0, 0xa2,
-		0x99, 0x4d, 0xac, 0xe8, 0xc6, 0xb6, 0x39, 0x53, 0xa0, 0x00, 0xb5, 0xfe, 0x81, 0x50, 0xc2, 0x05,
-		0xb3, 0x89, 0x97, 0x8d, 0x6d, 0x53, 0x2e, 0xdb, 0x81, 0x32, 0x3a, 0x0a, 0x39, 0x7b, 0xdf, 0x70,
-		0xd4, 0xbd, 0x99, 0x12, 0xd5, 0x10, 0x5e, 0x92, 0xbe, 0x9e, 0x83, 0x89, 0x24, 0x2a, 0x76, 0x0e,
-		0x46, 0xb7, 0xc9, 0x28, 0x67, 0xd2, 0x87, 0x91, 0x01, 0xe3, 0x09, 0x0a, 0x31, 0x77, 0x83, 0x42,
-		0x9c, 0x87, 0xa2, 0x81, 0x6d, 0x07, 0x37, 0x99, 0x46, 0x64, 0x12, 0xea, 0x14, 0x30, 0xa6, 0x7e,
-		0x95, 0xca, 0xde, 0x90, 0x4a, 0x3d, 0x0b, 0x13, 0x6e, 0x97, 0x14, 0x4b, 0x35, 0x5a, 0x42, 0x37,
-		0xcf, 0xc4, 0xf5, 0x64, 0x6e, 0x49, 0xf0, 0xc9, 0x84, 0x4d, 0x2e, 0xe3, 0x40, 0x19, 0x2d, 0x02,
-		0x98, 0x06, 0x36, 0xb7, 0x95, 0x26, 0xd6, 0xda, 0x33, 0xf9, 0x01, 0x52, 0xba, 0x4c, 0x48, 0xfa,
-		0xa4, 0x64, 0xb2, 0x5a, 0xad, 0x8d, 0x1e, 0xf5, 0x54, 0x6d, 0x6c, 0x80, 0xa6, 0xac, 0xb2, 0x45,
-		0xd6, 0xa7, 0x6d, 0x9b, 0x50, 0xb6, 0x30, 0xd1, 0x7b, 0xdc, 0xe4, 0x23, 0x2b, 0xd0, 0x4e, 0xcc,
-		0xc5, 0x8e, 0x4c, 0xe6, 0x6c, 0x6c, 0x60, 0xe3, 0x96, 0xbf, 0x88, 0x4e, 0x81, 0x5b, 0xa1, 0x50,
-		0xb5, 0x02, 0x6a, 0x85, 0x4a, 0xa2, 0xf2, 0x92, 0xda, 0xc1, 0xd5, 0x17, 0xa1, 0x1c, 0x14, 0x0f,
-		0x9a, 0x86, 0x51, 0xdb, 0x51, 0x2d, 0x87, 0x6a, 0xe1, 0xa8, 0xcc, 0x0a, 0xa8, 0x02, 0x19, 0x6c,
-		0x34, 0xa9, 0x95, 0x1b, 0x95, 0xc9, 0x4f, 0xf4, 0x3e, 0x6f, 0xc0, 0x19, 0x3a, 0xe0, 0xbb, 0xfa,
-		0x67, 0x34, 0x80, 0x1c, 0x1e, 0x77, 0xf5, 0x11, 0x18, 0x0f, 0x0c, 0x20, 0x69, 0xd3, 0xd2, 0x07,
-		0xe1, 0x48, 0x24, 0x34, 0x7a, 0x16, 0xa6, 0x7b, 0x86, 0x6e, 0x38, 0xd8, 0xea, 0x5a, 0x98, 0x68,
-		0x2c, 0x6b, 0x6a, 0xe6, 0x2f, 0xc6, 0x06, 0xe8, 0xdc, 0xa6, 0x9f, 0x9a, 0xa1, 0xc8, 0x53, 0xbd,
-		0xfe, 0xca, 0x7b, 0x0a, 0xf9, 0xef, 0x8d, 0x55, 0x5e, 0x7a, 0xe9, 0xa5, 0x97, 0xd2, 0xd2, 0xcb,
-		0x39, 0x98, 0x8e, 0x5a, 0x33, 0x91, 0xcb, 0xf7, 0x28, 0xe4, 0x8c, 0x5e, 0x67, 0x0b, 0x5b, 0x54,
-		0x48, 0xa3, 0x32, 0x2f, 0xa1, 0x79, 0x18, 0x6d, 0xab, 0x5b, 0xb8, 0x3d, 0x93, 0x3d, 0x99, 0x9a,
-		0x2d, 0x9f, 0xbd, 0x37, 0xd1, 0xaa, 0x9c, 0x5b, 0x21, 0x2c, 0x32, 0xe3, 0x44, 0x8f, 0x43, 0x96,
-		0x9b, 0x68, 0x82, 0x70, 0x4f, 0x32, 0x04, 0xb2, 0x96, 0x64, 0xca, 0x87, 0x6e, 0x81, 0x02, 0xf9,
-		0xcb, 0x74, 0x23, 0x47, 0xfb, 0x9c, 0x27, 0x15, 0x44, 0x2f, 0x50, 0x15, 0xf2, 0x74, 0x99, 0x34,
-		0xb1, 0x70, 0x6d, 0x6e, 0x99, 0x28, 0x56, 0x13, 0x6f, 0xab, 0xbd, 0xb6, 0xa3, 0x5c, 0x55, 0xdb,
-		0x3d, 0x4c, 0x15, 0xbe, 0x20, 0x97, 0x78, 0xe5, 0x15, 0x52, 0x87, 0x6a, 0x50, 0x64, 0xab, 0x4a,
-		0x37, 0x9a, 0x78, 0x8f, 0x5a, 0xcf, 0x51, 0x99, 0x2d, 0xb4, 0x65, 0x52, 0x43, 0x9a, 0x7f, 0xde,
-		0x36, 0x0d, 0xa1, 0x9a, 0xb4, 0x09, 0x52, 0x41, 0x9b, 0x7f, 0x24, 0x6c, 0xb8, 0x6f, 0x8b, 0x1e,
-		0x5e, 0x58, 0xa7, 0xa4, 0xaf, 0xa4, 0x21, 0x4b, 0xed, 0xc5, 0x04, 0x14, 0x37, 0xde, 0xbf, 0xb6,
-		0xa4, 0x2c, 0x5e, 0xde, 0x6c, 0xac, 0x2c, 0x55, 0x52, 0xa8, 0x0c, 0x40, 0x2b, 0xce, 0xaf, 0x5c,
-		0x9e, 0xdf, 0xa8, 0xa4, 0xdd, 0xf2, 0xf2, 0xa5, 0x8d, 0x87, 0x1f, 0xac, 0x64, 0x5c, 0x86, 0x4d,
-		0x56, 0x91, 0xf5, 0x13, 0xbc, 0xfb, 0x6c, 0x65, 0x14, 0x55, 0xa0, 0xc4, 0x00, 0x96, 0x9f, 0x5d,
-		0x5a, 0x7c, 0xf8, 0xc1, 0x4a, 0x2e, 0x58, 0xf3, 0xee, 0xb3, 0x95, 0x31, 0x34, 0x0e, 0x05, 0x5a,
-		0xd3, 0xb8, 0x7c, 0x79, 0xa5, 0x92, 0x77, 0x31, 0xd7, 0x37, 0xe4, 0xe5, 0x4b, 0x17, 0x2a, 0x05,
-		0x17, 0xf3, 0x82, 0x7c, 0x79, 0x73, 0xad, 0x02, 0x2e, 0xc2, 0xea, 0xd2, 0xfa, 0xfa, 0xfc, 0x85,
-		0xa5, 0x4a, 0xd1, 0xa5, 0x68, 0xbc, 0x7f, 0x63, 0x69, 0xbd, 0x52, 0x0a, 0x74, 0xeb, 0xdd, 0x67,
-		0x2b, 0xe3, 0x6e, 0x13, 0x4b, 0x97, 0x36, 0x57, 0x2b, 0x65, 0x34, 0x09, 0xe3, 0xac, 0x09, 0xd1,
-		0x89, 0x89, 0x50, 0xd5, 0xc3, 0x0f, 0x56, 0x2a, 0x5e, 0x47, 0x18, 0xca, 0x64, 0xa0, 0xe2, 0xe1,
-		0x07, 0x2b, 0x48, 0x5a, 0x80, 0x51, 0xaa, 0x5d, 0x08, 0x41, 0x79, 0x65, 0xbe, 0xb1, 0xb4, 0xa2,
-		0x5c, 0x5e, 0xdb, 0x58, 0xbe, 0x7c, 0x69, 0x7e, 0xa5, 0x92, 0xf2, 0xea, 0xe4, 0xa5, 0xa7, 0x37,
-		0x97, 0xe5, 0xa5, 0xc5, 0x4a, 0xda, 0x5f, 0xb7, 0xb6, 0x34, 0xbf, 0xb1, 0xb4, 0x58, 0xc9, 0x48,
-		0x1a, 0x4c, 0x47, 0xd9, 0xc9, 0xc8, 0x95, 0xe1, 0x9b, 0xe2, 0xf4, 0x80, 0x29, 0xa6, 0x58, 0x7d,
-		0x53, 0xfc, 0x5a, 0x1a, 0xa6, 0x22, 0x7c, 0x45, 0x64, 0x23, 0x4f, 0xc0, 0x28, 0x53, 0x51, 0xe6,
-		0x3d, 0x4f, 0x47, 0x3a, 0x1d, 0xaa, 0xb0, 0x7d, 0x1e, 0x94, 0xf2, 0xf9, 0x23, 0x88, 0xcc, 0x80,
-		0x08, 0x82, 0x40, 0xf4, 0xd9, 0xf4, 0x9f, 0xed, 0xb3, 0xe9, 0xcc, 0xed, 0x3d, 0x9c, 0xc4, 0xed,
-		0xd1, 0xba, 0xc3, 0xd9, 0xf6, 0xd1, 0x08, 0xdb, 0x7e, 0x0e, 0x26, 0xfb, 0x80, 0x12, 0xdb, 0xd8,
-		0x5f, 0x48, 0xc1, 0xcc, 0x20, 0xe1, 0xc4, 0x58, 0xba, 0x74, 0xc0, 0xd2, 0x9d, 0x0b, 0x4b, 0xf0,
-		0xf6, 0xc1, 0x93, 0xd0, 0x37, 0xd7, 0x5f, 0x4c, 0xc1, 0xd1, 0xe8, 0x48, 0x31, 0xb2, 0x0f, 0x8f,
-		0x43, 0xae, 0x83, 0x9d, 0x1d, 0x53, 0x44, 0x4b, 0x77, 0x45, 0xf8, 0x60, 0xf2, 0x38, 0x3c, 0xd9,
-		0x9c, 0xcb, 0xef, 0xc4, 0x33, 0x83, 0xc2, 0x3d, 0xd6, 0x9b, 0xbe, 0x9e, 0x7e, 0x2c, 0x0d, 0x47,
-		0x22, 0xc1, 0x23, 0x3b, 0x7a, 0x1b, 0x80, 0x6e, 0x74, 0x7b, 0x0e, 0x8b, 0x88, 0x98, 0x81, 0x2d,
-		0xd0, 0x1a, 0x6a, 0xbc, 0x88, 0xf1, 0xec, 0x39, 0xee, 0xf3, 0x0c, 0x7d, 0x0e, 0xac, 0x8a, 0x12,
-		0xbc, 0xc7, 0xeb, 0x68, 0x96, 0x76, 0xf4, 0xc4, 0x80, 0x91, 0xf6, 0x29, 0xe6, 0x03, 0x50, 0xd1,
-		0xda, 0x3a, 0x36, 0x1c, 0xc5, 0x76, 0x2c, 0xac, 0x76, 0x74, 0xa3, 0x45, 0x3d, 0x48, 0xbe, 0x3e,
-		0xba, 0xad, 0xb6, 0x6d, 0x2c, 0x4f, 0xb0, 0xc7, 0xeb, 0xe2, 0x29, 0xe1, 0xa0, 0x0a, 0x64, 0xf9,
-		0x38, 0x72, 0x01, 0x0e, 0xf6, 0xd8, 0xe5, 0x90, 0x3e, 0x55, 0x80, 0xa2, 0x2f, 0xae, 0x46, 0xb7,
-		0x43, 0xe9, 0x79, 0xf5, 0xaa, 0xaa, 0x88, 0xbd, 0x12, 0x93, 0x44, 0x91, 0xd4, 0xad, 0xf1, 0xfd,
-		0xd2, 0x03, 0x30, 0x4d, 0x49, 0xcc, 0x9e, 0x83, 0x2d, 0x45, 0x6b, 0xab, 0xb6, 0x4d, 0x85, 0x96,
-		0xa7, 0xa4, 0x88, 0x3c, 0xbb, 0x4c, 0x1e, 0x2d, 0x88, 0x27, 0xe8, 0x21, 0x98, 0xa2, 0x1c, 0x9d,
-		0x5e, 0xdb, 0xd1, 0xbb, 0x6d, 0xac, 0x90, 0xdd, 0x9b, 0x4d, 0x3d, 0x89, 0xdb, 0xb3, 0x49, 0x42,
-		0xb1, 0xca, 0x09, 0x48, 0x8f, 0x6c, 0xb4, 0x08, 0xb7, 0x51, 0xb6, 0x16, 0x36, 0xb0, 0xa5, 0x3a,
-		0x58, 0xc1, 0x2f, 0xf4, 0xd4, 0xb6, 0xad, 0xa8, 0x46, 0x53, 0xd9, 0x51, 0xed, 0x9d, 0x99, 0x69,
-		0x02, 0xd0, 0x48, 0xcf, 0xa4, 0xe4, 0xe3, 0x84, 0xf0, 0x02, 0xa7, 0x5b, 0xa2, 0x64, 0xf3, 0x46,
-		0xf3, 0xa2, 0x6a, 0xef, 0xa0, 0x3a, 0x1c, 0xa5, 0x28, 0xb6, 0x63, 0xe9, 0x46, 0x4b, 0xd1, 0x76,
-		0xb0, 0xb6, 0xab, 0xf4, 0x9c, 0xed, 0xf7, 0xcc, 0xdc, 0xe2, 0x6f, 0x9f, 0xf6, 0x70, 0x9d, 0xd2,
-		0x2c, 0x10, 0x92, 0x4d, 0x67, 0xfb, 0x3d, 0x68, 0x1d, 0x4a, 0x64, 0x32, 0x3a, 0xfa, 0x8b, 0x58,
-		0xd9, 0x36, 0x2d, 0xea, 0x1a, 0xcb, 0x11, 0xa6, 0xc9, 0x27, 0xc1, 0xb9, 0xcb, 0x9c, 0x61, 0xd5,
-		0x6c, 0xe2, 0xfa, 0xe8, 0xfa, 0xda, 0xd2, 0xd2, 0xa2, 0x5c, 0x14, 0x28, 0xe7, 0x4d, 0x8b, 0x28,
-		0x54, 0xcb, 0x74, 0x05, 0x5c, 0x64, 0x0a, 0xd5, 0x32, 0x85, 0x78, 0x1f, 0x82, 0x29, 0x4d, 0x63,
-		0x63, 0xd6, 0x35, 0x85, 0xef, 0xb1, 0xec, 0x99, 0x4a, 0x40, 0x58, 0x9a, 0x76, 0x81, 0x11, 0x70,
-		0x1d, 0xb7, 0xd1, 0xa3, 0x70, 0xc4, 0x13, 0x96, 0x9f, 0x71, 0xb2, 0x6f, 0x94, 0x61, 0xd6, 0x87,
-		0x60, 0xaa, 0xbb, 0xdf, 0xcf, 0x88, 0x02, 0x2d, 0x76, 0xf7, 0xc3, 0x6c, 0x8f, 0xc0, 0x74, 0x77,
-		0xa7, 0xdb, 0xcf, 0x77, 0x8f, 0x9f, 0x0f, 0x75, 0x77, 0xba, 0x61, 0xc6, 0x3b, 0xe9, 0x86, 0xdb,
-		0xc2, 0x9a, 0xea, 0xe0, 0xe6, 0xcc, 0x31, 0x3f, 0xb9, 0xef, 0x01, 0x3a, 0x03, 0x15, 0x4d, 0x53,
-		0xb0, 0xa1, 0x6e, 0xb5, 0xb1, 0xa2, 0x5a, 0xd8, 0x50, 0xed, 0x99, 0x9a, 0x9f, 0xb8, 0xac, 0x69,
-		0x4b, 0xf4, 0xe9, 0x3c, 0x7d, 0x88, 0xee, 0x81, 0x49, 0x73, 0xeb, 0x79, 0x8d, 0xa9, 0xa4, 0xd2,
-		0xb5, 0xf0, 0xb6, 0xbe, 0x37, 0x73, 0x07, 0x95, 0xef, 0x04, 0x79, 0x40, 0x15, 0x72, 0x8d, 0x56,
-		0xa3, 0xd3, 0x50, 0xd1, 0xec, 0x1d, 0xd5, 0xea, 0x52, 0x9b, 0x6c, 0x77, 0x55, 0x0d, 0xcf, 0xdc,
-		0xc9, 0x48, 0x59, 0xfd, 0x25, 0x51, 0x4d, 0x96, 0x84, 0x7d, 0x4d, 0xdf, 0x76, 0x04, 0xe2, 0xdd,
-		0x6c, 0x49, 0xd0, 0x3a, 0x8e, 0x36, 0x0b, 0x15, 0x22, 0x8a, 0x40, 0xc3, 0xb3, 0x94, 0xac, 0xdc,
-		0xdd, 0xe9, 0xfa, 0xdb, 0x3d, 0x05, 0xe3, 0x84, 0xd2, 0x6b, 0xf4, 0x34, 0x0b, 0xc8, 0xba, 0x3b,
-		0xbe, 0x16, 0x1f, 0x84, 0xa3, 0x84, 0xa8, 0x83, 0x1d, 0xb5, 0xa9, 0x3a, 0xaa, 0x8f, 0xfa, 0x3e,
-		0x4a, 0x4d, 0xe4, 0xbe, 0xca, 0x1f, 0x06, 0xfa, 0x69, 0xf5, 0xb6, 0xf6, 0x5d, 0xcd, 0xba, 0x9f,
-		0xf5, 0x93, 0xd4, 0x09, 0xdd, 0x7a, 0xcb, 0x82, 0x6e, 0xa9, 0x0e, 0x25, 0xbf, 0xe2, 0xa3, 0x02,
-		0x30, 0xd5, 0xaf, 0xa4, 0x48, 0x14, 0xb4, 0x70, 0x79, 0x91, 0xc4, 0x2f, 0xcf, 0x2d, 0x55, 0xd2,
-		0x24, 0x8e, 0x5a, 0x59, 0xde, 0x58, 0x52, 0xe4, 0xcd, 0x4b, 0x1b, 0xcb, 0xab, 0x4b, 0x95, 0x8c,
-		0x2f, 0x60, 0x7f, 0x32, 0x9b, 0xbf, 0xab, 0x72, 0xb7, 0xf4, 0xed, 0x34, 0x94, 0x83, 0x3b, 0x30,
-		0xf4, 0x18, 0x1c, 0x13, 0xe9, 0x12, 0x1b, 0x3b, 0xca, 0x35, 0xdd, 0xa2, 0x2b, 0xb2, 0xa3, 0x32,
-		0xef, 0xe8, 0xea, 0xc4, 0x34, 0xa7, 0x5a, 0xc7, 0xce, 0x33, 0xba, 0x45, 0xd6, 0x5b, 0x47, 0x75,
-		0xd0, 0x0a, 0xd4, 0x0c, 0x53, 0xb1, 0x1d, 0xd5, 0x68, 0xaa, 0x56, 0x53, 0xf1, 0x12, 0x55, 0x8a,
-		0xaa, 0x69, 0xd8, 0xb6, 0x4d, 0xe6, 0x09, 0x5d, 0x94, 0x5b, 0x0d, 0x73, 0x9d, 0x13, 0x7b, 0x2e,
-		0x62, 0x9e, 0x93, 0x86, 0xf4, 0x37, 0x33, 0x48, 0x7f, 0x6f, 0x81, 0x42, 0x47, 0xed, 0x2a, 0xd8,
-		0x70, 0xac, 0x7d, 0x1a, 0x77, 0xe7, 0xe5, 0x7c, 0x47, 0xed, 0x2e, 0x91, 0xf2, 0xdb, 0xb2, 0xfd,
-		0x79, 0x32, 0x9b, 0xcf, 0x57, 0x0a, 0x4f, 0x66, 0xf3, 0x85, 0x0a, 0x48, 0xaf, 0x66, 0xa0, 0xe4,
-		0x8f, 0xc3, 0xc9, 0xb6, 0x46, 0xa3, 0x2e, 0x2b, 0x45, 0x8d, 0xda, 0xa9, 0xa1, 0x51, 0xfb, 0xdc,
-		0x02, 0xf1, 0x65, 0xf5, 0x1c, 0x8b, 0x8e, 0x65, 0xc6, 0x49, 0xe2, 0x08, 0xa2, 0x6c, 0x98, 0x45,
-		0x23, 0x79, 0x99, 0x97, 0xd0, 0x05, 0xc8, 0x3d, 0x6f, 0x53, 0xec, 0x1c, 0xc5, 0xbe, 0x63, 0x38,
-		0xf6, 0x93, 0xeb, 0x14, 0xbc, 0xf0, 0xe4, 0xba, 0x72, 0xe9, 0xb2, 0xbc, 0x3a, 0xbf, 0x22, 0x73,
-		0x76, 0x74, 0x1c, 0xb2, 0x6d, 0xf5, 0xc5, 0xfd, 0xa0, 0xd7, 0xa3, 0x55, 0x49, 0x27, 0xe1, 0x38,
-		0x64, 0xaf, 0x61, 0x75, 0x37, 0xe8, 0x6b, 0x68, 0xd5, 0x5b, 0xb8, 0x18, 0xce, 0xc0, 0x28, 0x95,
-		0x17, 0x02, 0xe0, 0x12, 0xab, 0x8c, 0xa0, 0x3c, 0x64, 0x17, 0x2e, 0xcb, 0x64, 0x41, 0x54, 0xa0,
-		0xc4, 0x6a, 0x95, 0xb5, 0xe5, 0xa5, 0x85, 0xa5, 0x4a, 0x5a, 0x7a, 0x08, 0x72, 0x4c, 0x08, 0x64,
-		0xb1, 0xb8, 0x62, 0xa8, 0x8c, 0xf0, 0x22, 0xc7, 0x48, 0x89, 0xa7, 0x9b, 0xab, 0x8d, 0x25, 0xb9,
-		0x92, 0x0e, 0x4e, 0x75, 0xb6, 0x32, 0x2a, 0xd9, 0x50, 0xf2, 0x07, 0xe2, 0x6f, 0xcf, 0x26, 0xfb,
-		0x1b, 0x29, 0x28, 0xfa, 0x02, 0x6b, 0x12, 0x11, 0xa9, 0xed, 0xb6, 0x79, 0x4d, 0x51, 0xdb, 0xba,
-		0x6a, 0x73, 0xd5, 0x00, 0x5a, 0x35, 0x4f, 0x6a, 0x92, 0x4e, 0xdd, 0xdb, 0xb4, 0x44, 0x46, 0x2b,
-		0x39, 0xe9, 0x73, 0x29, 0xa8, 0x84, 0x23, 0xdb, 0x50, 0x37, 0x53, 0x3f, 0xce, 0x6e, 0x4a, 0x9f,
-		0x49, 0x41, 0x39, 0x18, 0xce, 0x86, 0xba, 0x77, 0xfb, 0x8f, 0xb5, 0x7b, 0x7f, 0x9e, 0x86, 0xf1,
-		0x40, 0x10, 0x9b, 0xb4, 0x77, 0x2f, 0xc0, 0xa4, 0xde, 0xc4, 0x9d, 0xae, 0xe9, 0x60, 0x43, 0xdb,
-		0x57, 0xda, 0xf8, 0x2a, 0x6e, 0xcf, 0x48, 0xd4, 0x68, 0x9c, 0x19, 0x1e, 0x26, 0xcf, 0x2d, 0x7b,
-		0x7c, 0x2b, 0x84, 0xad, 0x3e, 0xb5, 0xbc, 0xb8, 0xb4, 0xba, 0x76, 0x79, 0x63, 0xe9, 0xd2, 0xc2,
-		0xfb, 0x95, 0xcd, 0x4b, 0x4f, 0x5d, 0xba, 0xfc, 0xcc, 0x25, 0xb9, 0xa2, 0x87, 0xc8, 0xde, 0xc2,
-		0x65, 0xbf, 0x06, 0x95, 0x70, 0xa7, 0xd0, 0x31, 0x88, 0xea, 0x56, 0x65, 0x04, 0x4d, 0xc1, 0xc4,
-		0xa5, 0xcb, 0xca, 0xfa, 0xf2, 0xe2, 0x92, 0xb2, 0x74, 0xfe, 0xfc, 0xd2, 0xc2, 0xc6, 0x3a, 0x4b,
-		0x7c, 0xb8, 0xd4, 0x1b, 0x81, 0x05, 0x2e, 0x7d, 0x3a, 0x03, 0x53, 0x11, 0x3d, 0x41, 0xf3, 0x7c,
-		0xcb, 0xc2, 0x76, 0x51, 0xf7, 0x27, 0xe9, 0xfd, 0x1c, 0x89, 0x19, 0xd6, 0x54, 0xcb, 0xe1, 0x3b,
-		0x9c, 0xd3, 0x40, 0xa4, 0x64, 0x38, 0xfa, 0xb6, 0x8e, 0x2d, 0x9e, 0x27, 0x62, 0xfb, 0x98, 0x09,
-		0xaf, 0x9e, 0xa5, 0x8a, 0xee, 0x03, 0xd4, 0x35, 0x6d, 0xdd, 0xd1, 0xaf, 0x62, 0x45, 0x37, 0x44,
-		0x52, 0x89, 0xec, 0x6b, 0xb2, 0x72, 0x45, 0x3c, 0x59, 0x36, 0x1c, 0x97, 0xda, 0xc0, 0x2d, 0x35,
-		0x44, 0x4d, 0x8c, 0x79, 0x46, 0xae, 0x88, 0x27, 0x2e, 0xf5, 0xed, 0x50, 0x6a, 0x9a, 0x3d, 0x12,
-		0xec, 0x31, 0x3a, 0xe2, 0x3b, 0x52, 0x72, 0x91, 0xd5, 0xb9, 0x24, 0x3c, 0x8c, 0xf7, 0xb2, 0x59,
-		0x25, 0xb9, 0xc8, 0xea, 0x18, 0xc9, 0xdd, 0x30, 0xa1, 0xb6, 0x5a, 0x16, 0x01, 0x17, 0x40, 0x6c,
-		0x63, 0x52, 0x76, 0xab, 0x29, 0x61, 0xf5, 0x49, 0xc8, 0x0b, 0x39, 0x10, 0x57, 0x4d, 0x24, 0xa1,
-		0x74, 0xd9, 0x6e, 0x3b, 0x3d, 0x5b, 0x90, 0xf3, 0x86, 0x78, 0x78, 0x3b, 0x94, 0x74, 0x5b, 0xf1,
-		0x92, 0xf3, 0xe9, 0x93, 0xe9, 0xd9, 0xbc, 0x5c, 0xd4, 0x6d, 0x37, 0xb1, 0x29, 0x7d, 0x31, 0x0d,
-		0xe5, 0xe0, 0xe1, 0x02, 0x5a, 0x84, 0x7c, 0xdb, 0xd4, 0x54, 0xaa, 0x5a, 0xec, 0x64, 0x6b, 0x36,
-		0xe6, 0x3c, 0x62, 0x6e, 0x85, 0xd3, 0xcb, 0x2e, 0x67, 0xf5, 0x3f, 0xa4, 0x20, 0x2f, 0xaa, 0xd1,
-		0x51, 0xc8, 0x76, 0x55, 0x67, 0x87, 0xc2, 0x8d, 0x36, 0xd2, 0x95, 0x94, 0x4c, 0xcb, 0xa4, 0xde,
-		0xee, 0xaa, 0x06, 0x55, 0x01, 0x5e, 0x4f, 0xca, 0x64, 0x5e, 0xdb, 0x58, 0x6d, 0xd2, 0x5d, 0x8f,
-		0xd9, 0xe9, 0x60, 0xc3, 0xb1, 0xc5, 0xbc, 0xf2, 0xfa, 0x05, 0x5e, 0x8d, 0xee, 0x85, 0x49, 0xc7,
-		0x52, 0xf5, 0x76, 0x80, 0x36, 0x4b, 0x69, 0x2b, 0xe2, 0x81, 0x4b, 0x5c, 0x87, 0xe3, 0x02, 0xb7,
-		0x89, 0x1d, 0x55, 0xdb, 0xc1, 0x4d, 0x8f, 0x29, 0x47, 0xb3, 0x1b, 0xc7, 0x38, 0xc1, 0x22, 0x7f,
-		0x2e, 0x78, 0xa5, 0x6f, 0xa7, 0x60, 0x52, 0xec, 0xd3, 0x9a, 0xae, 0xb0, 0x56, 0x01, 0x54, 0xc3,
-		0x30, 0x1d, 0xbf, 0xb8, 0xfa, 0x55, 0xb9, 0x8f, 0x6f, 0x6e, 0xde, 0x65, 0x92, 0x7d, 0x00, 0xd5,
-		0x0e, 0x80, 0xf7, 0x64, 0xa0, 0xd8, 0x6a, 0x50, 0xe4, 0x27, 0x47, 0xf4, 0xf8, 0x91, 0xed, 0xec,
-		0x81, 0x55, 0x91, 0x0d, 0x1d, 0x9a, 0x86, 0xd1, 0x2d, 0xdc, 0xd2, 0x0d, 0x9e, 0x0f, 0x66, 0x05,
-		0x91, 0x7f, 0xc9, 0xba, 0xf9, 0x97, 0xc6, 0x27, 0x52, 0x30, 0xa5, 0x99, 0x9d, 0x70, 0x7f, 0x1b,
-		0x95, 0x50, 0x7a, 0xc1, 0xbe, 0x98, 0x7a, 0xee, 0xf1, 0x96, 0xee, 0xec, 0xf4, 0xb6, 0xe6, 0x34,
-		0xb3, 0x73, 0xa6, 0x65, 0xb6, 0x55, 0xa3, 0xe5, 0x9d, 0x9f, 0xd2, 0x1f, 0xda, 0xfd, 0x2d, 0x6c,
-		0xdc, 0xdf, 0x32, 0x7d, 0xa7, 0xa9, 0xe7, 0xbc, 0x9f, 0x7f, 0x9d, 0x4a, 0x7d, 0x21, 0x9d, 0xb9,
-		0xb0, 0xd6, 0xf8, 0x52, 0xba, 0x7a, 0x81, 0x35, 0xb7, 0x26, 0xc4, 0x23, 0xe3, 0xed, 0x36, 0xd6,
-		0xc8, 0x90, 0xe1, 0xfb, 0xf7, 0xc2, 0x74, 0xcb, 0x6c, 0x99, 0x14, 0xf1, 0x0c, 0xf9, 0xc5, 0x4f,
-		0x64, 0x0b, 0x6e, 0x6d, 0x35, 0xf6, 0xf8, 0xb6, 0x7e, 0x09, 0xa6, 0x38, 0xb1, 0x42, 0x8f, 0x84,
-		0xd8, 0xc6, 0x06, 0x0d, 0x4d, 0xab, 0xcd, 0xfc, 0xfe, 0x77, 0xa9, 0x43, 0x97, 0x27, 0x39, 0x2b,
-		0x79, 0xc6, 0xf6, 0x3e, 0x75, 0x19, 0x8e, 0x04, 0xf0, 0xd8, 0xb2, 0xc5, 0x56, 0x0c, 0xe2, 0xbf,
-		0xe5, 0x88, 0x53, 0x3e, 0xc4, 0x75, 0xce, 0x5a, 0x5f, 0x80, 0xf1, 0xc3, 0x60, 0xfd, 0x3b, 0x8e,
-		0x55, 0xc2, 0x7e, 0x90, 0x0b, 0x30, 0x41, 0x41, 0xb4, 0x9e, 0xed, 0x98, 0x1d, 0x6a, 0x13, 0x87,
-		0xc3, 0xfc, 0xd1, 0x77, 0xd9, 0x3a, 0x2a, 0x13, 0xb6, 0x05, 0x97, 0xab, 0x5e, 0x07, 0x7a, 0x0a,
-		0xd6, 0xc4, 0x5a, 0x3b, 0x06, 0xe1, 0x9b, 0xbc, 0x23, 0x2e, 0x7d, 0xfd, 0x0a, 0x4c, 0x93, 0xdf,
-		0xd4, 0x64, 0xf9, 0x7b, 0x12, 0x9f, 0x83, 0x9b, 0xf9, 0xf6, 0x2f, 0xb0, 0xa5, 0x3a, 0xe5, 0x02,
-		0xf8, 0xfa, 0xe4, 0x9b, 0xc5, 0x16, 0x76, 0x1c, 0x6c, 0xd9, 0x8a, 0xda, 0x8e, 0xea, 0x9e, 0x2f,
-		0x89, 0x31, 0xf3, 0x1b, 0xaf, 0x07, 0x67, 0xf1, 0x02, 0xe3, 0x9c, 0x6f, 0xb7, 0xeb, 0x9b, 0x70,
-		0x2c, 0x42, 0x2b, 0x12, 0x60, 0x7e, 0x9a, 0x63, 0x4e, 0xf7, 0x69, 0x06, 0x81, 0x5d, 0x03, 0x51,
-		0xef, 0xce, 0x65, 0x02, 0xcc, 0xdf, 0xe4, 0x98, 0x88, 0xf3, 0x8a, 0x29, 0x25, 0x88, 0x4f, 0xc2,
-		0xe4, 0x55, 0x6c, 0x6d, 0x99, 0x36, 0x4f, 0x1c, 0x25, 0x80, 0xfb, 0x0c, 0x87, 0x9b, 0xe0, 0x8c,
-		0x34, 0x93, 0x44, 0xb0, 0x1e, 0x85, 0xfc, 0xb6, 0xaa, 0xe1, 0x04, 0x10, 0x9f, 0xe5, 0x10, 0x63,
-		0x84, 0x9e, 0xb0, 0xce, 0x43, 0xa9, 0x65, 0x72, 0xaf, 0x15, 0xcf, 0xfe, 0x39, 0xce, 0x5e, 0x14,
-		0x3c, 0x1c, 0xa2, 0x6b, 0x76, 0x7b, 0x6d, 0xe2, 0xd2, 0xe2, 0x21, 0x7e, 0x4b, 0x40, 0x08, 0x1e,
-		0x0e, 0x71, 0x08, 0xb1, 0xbe, 0x22, 0x20, 0x6c, 0x9f, 0x3c, 0x9f, 0x80, 0xa2, 0x69, 0xb4, 0xf7,
-		0x4d, 0x23, 0x49, 0x27, 0x3e, 0xcf, 0x11, 0x80, 0xb3, 0x10, 0x80, 0x73, 0x50, 0x48, 0x3a, 0x11,
-		0xff, 0xf0, 0x75, 0xb1, 0x3c, 0xc4, 0x0c, 0x5c, 0x80, 0x09, 0x61, 0xa0, 0x74, 0xd3, 0x48, 0x00,
-		0xf1, 0x8f, 0x38, 0x44, 0xd9, 0xc7, 0xc6, 0x87, 0xe1, 0x60, 0xdb, 0x69, 0xe1, 0x24, 0x20, 0x5f,
-		0x14, 0xc3, 0xe0, 0x2c, 0x5c, 0x94, 0x5b, 0xd8, 0xd0, 0x76, 0x92, 0x21, 0xfc, 0xb6, 0x10, 0xa5,
-		0xe0, 0x21, 0x10, 0x0b, 0x30, 0xde, 0x51, 0x2d, 0x7b, 0x47, 0x6d, 0x27, 0x9a, 0x8e, 0x7f, 0xcc,
-		0x31, 0x4a, 0x2e, 0x13, 0x97, 0x48, 0xcf, 0x38, 0x0c, 0xcc, 0x97, 0x84, 0x44, 0x7c, 0x6c, 0x7c,
-		0xe9, 0xd9, 0x0e, 0xcd, 0xb2, 0x1d, 0x06, 0xed, 0x9f, 0x88, 0xa5, 0xc7, 0x78, 0x57, 0xfd, 0x88,
-		0xe7, 0xa0, 0x60, 0xeb, 0x2f, 0x26, 0x82, 0xf9, 0xa7, 0x62, 0xa6, 0x29, 0x03, 0x61, 0x7e, 0x3f,
-		0x1c, 0x8f, 0x74, 0x13, 0x09, 0xc0, 0xfe, 0x19, 0x07, 0x3b, 0x1a, 0xe1, 0x2a, 0xb8, 0x49, 0x38,
-		0x2c, 0xe4, 0xef, 0x08, 0x93, 0x80, 0x43, 0x58, 0x6b, 0x64, 0x1f, 0x61, 0xab, 0xdb, 0x87, 0x93,
-		0xda, 0xef, 0x0a, 0xa9, 0x31, 0xde, 0x80, 0xd4, 0x36, 0xe0, 0x28, 0x47, 0x3c, 0xdc, 0xbc, 0xfe,
-		0x9e, 0x30, 0xac, 0x8c, 0x7b, 0x33, 0x38, 0xbb, 0x1f, 0x80, 0xaa, 0x2b, 0x4e, 0x11, 0xb0, 0xda,
-		0x4a, 0x47, 0xed, 0x26, 0x40, 0xfe, 0x7d, 0x8e, 0x2c, 0x2c, 0xbe, 0x1b, 0xf1, 0xda, 0xab, 0x6a,
-		0x97, 0x80, 0x3f, 0x0b, 0x33, 0x02, 0xbc, 0x67, 0x58, 0x58, 0x33, 0x5b, 0x86, 0xfe, 0x22, 0x6e,
-		0x26, 0x80, 0xfe, 0xe7, 0xa1, 0xa9, 0xda, 0xf4, 0xb1, 0x13, 0xe4, 0x65, 0xa8, 0xb8, 0xb1, 0x8a,
-		0xa2, 0x77, 0xba, 0xa6, 0xe5, 0xc4, 0x20, 0xfe, 0x0b, 0x31, 0x53, 0x2e, 0xdf, 0x32, 0x65, 0xab,
-		0x2f, 0x41, 0x99, 0x16, 0x93, 0xaa, 0xe4, 0x97, 0x39, 0xd0, 0xb8, 0xc7, 0xc5, 0x0d, 0x87, 0x66,
-		0x76, 0xba, 0xaa, 0x95, 0xc4, 0xfe, 0xfd, 0x4b, 0x61, 0x38, 0x38, 0x0b, 0x37, 0x1c, 0xce, 0x7e,
-		0x17, 0x13, 0x6f, 0x9f, 0x00, 0xe1, 0x2b, 0xc2, 0x70, 0x08, 0x1e, 0x0e, 0x21, 0x02, 0x86, 0x04,
-		0x10, 0x5f, 0x15, 0x10, 0x82, 0x87, 0x40, 0x3c, 0xed, 0x39, 0x5a, 0x0b, 0xb7, 0x74, 0xdb, 0xb1,
-		0x58, 0x98, 0x3c, 0x1c, 0xea, 0x6b, 0xaf, 0x07, 0x83, 0x30, 0xd9, 0xc7, 0x4a, 0x2c, 0x11, 0x4f,
-		0xbb, 0xd2, 0x5d, 0x54, 0x7c, 0xc7, 0xbe, 0x2e, 0x2c, 0x91, 0x8f, 0x8d, 0xf4, 0xcd, 0x17, 0x21,
-		0x12, 0xb1, 0x6b, 0x64, 0xef, 0x90, 0x00, 0xee, 0x0f, 0x42, 0x9d, 0x5b, 0x17, 0xbc, 0x04, 0xd3,
-		0x17, 0xff, 0xf4, 0x8c, 0x5d, 0xbc, 0x9f, 0x48, 0x3b, 0xff, 0x30, 0x14, 0xff, 0x6c, 0x32, 0x4e,
-		0x66, 0x43, 0x26, 0x42, 0xf1, 0x14, 0x8a, 0xbb, 0x3f, 0x34, 0xf3, 0xb7, 0xdf, 0xe0, 0xe3, 0x0d,
-		0x86, 0x53, 0xf5, 0x15, 0xa2, 0xe4, 0xc1, 0xa0, 0x27, 0x1e, 0xec, 0x17, 0xde, 0x70, 0xf5, 0x3c,
-		0x10, 0xf3, 0xd4, 0xcf, 0xc3, 0x78, 0x20, 0xe0, 0x89, 0x87, 0xfa, 0x10, 0x87, 0x2a, 0xf9, 0xe3,
-		0x9d, 0xfa, 0x43, 0x90, 0x25, 0xc1, 0x4b, 0x3c, 0xfb, 0x2f, 0x72, 0x76, 0x4a, 0x5e, 0x7f, 0x2f,
-		0xe4, 0x45, 0xd0, 0x12, 0xcf, 0xfa, 0x61, 0xce, 0xea, 0xb2, 0x10, 0x76, 0x11, 0xb0, 0xc4, 0xb3,
-		0x7f, 0x44, 0xb0, 0x0b, 0x16, 0xc2, 0x9e, 0x5c, 0x84, 0xdf, 0xf8, 0x3b, 0x59, 0xee, 0x74, 0x84,
-		0xec, 0xce, 0xc1, 0x18, 0x8f, 0x54, 0xe2, 0xb9, 0x3f, 0xc6, 0x1b, 0x17, 0x1c, 0xf5, 0x47, 0x60,
-		0x34, 0xa1, 0xc0, 0x7f, 0x99, 0xb3, 0x32, 0xfa, 0xfa, 0x02, 0x14, 0x7d, 0xd1, 0x49, 0x3c, 0xfb,
-		0xaf, 0x70, 0x76, 0x3f, 0x17, 0xe9, 0x3a, 0x8f, 0x4e, 0xe2, 0x01, 0x3e, 0x21, 0xba, 0xce, 0x39,
-		0x88, 0xd8, 0x44, 0x60, 0x12, 0xcf, 0xfd, 0x49, 0x21, 0x75, 0xc1, 0x52, 0x7f, 0x02, 0x0a, 0xae,
-		0xb3, 0x89, 0xe7, 0xff, 0xbb, 0x9c, 0xdf, 0xe3, 0x21, 0x12, 0xf0, 0x39, 0xbb, 0x78, 0x88, 0x4f,
-		0x09, 0x09, 0xf8, 0xb8, 0xc8, 0x32, 0x0a, 0x07, 0x30, 0xf1, 0x48, 0x7f, 0x4f, 0x2c, 0xa3, 0x50,
-		0xfc, 0x42, 0x66, 0x93, 0xda, 0xfc, 0x78, 0x88, 0x5f, 0x15, 0xb3, 0x49, 0xe9, 0x49, 0x37, 0xc2,
-		0x11, 0x41, 0x3c, 0xc6, 0xdf, 0x17, 0xdd, 0x08, 0x05, 0x04, 0xf5, 0x35, 0x40, 0xfd, 0xd1, 0x40,
-		0x3c, 0xde, 0xcb, 0x1c, 0x6f, 0xb2, 0x2f, 0x18, 0xa8, 0x3f, 0x03, 0x47, 0xa3, 0x23, 0x81, 0x78,
-		0xd4, 0xdf, 0x78, 0x23, 0xb4, 0x77, 0xf3, 0x07, 0x02, 0xf5, 0x0d, 0xcf, 0xa5, 0xf8, 0xa3, 0x80,
-		0x78, 0xd8, 0x4f, 0xbf, 0x11, 0x34, 0xdc, 0xfe, 0x20, 0xa0, 0x3e, 0x0f, 0xe0, 0x39, 0xe0, 0x78,
-		0xac, 0xcf, 0x70, 0x2c, 0x1f, 0x13, 0x59, 0x1a, 0xdc, 0xff, 0xc6, 0xf3, 0x7f, 0x56, 0x2c, 0x0d,
-		0xce, 0x41, 0x96, 0x86, 0x70, 0xbd, 0xf1, 0xdc, 0x9f, 0x13, 0x4b, 0x43, 0xb0, 0x10, 0xcd, 0xf6,
-		0x79, 0xb7, 0x78, 0x84, 0xcf, 0x0b, 0xcd, 0xf6, 0x71, 0xd5, 0x2f, 0xc1, 0x64, 0x9f, 0x43, 0x8c,
-		0x87, 0xfa, 0x02, 0x87, 0xaa, 0x84, 0xfd, 0xa1, 0xdf, 0x79, 0x71, 0x67, 0x18, 0x8f, 0xf6, 0x0f,
-		0x42, 0xce, 0x8b, 0xfb, 0xc2, 0xfa, 0x39, 0xc8, 0x1b, 0xbd, 0x76, 0x9b, 0x2c, 0x1e, 0x34, 0xfc,
-		0xce, 0xdf, 0xcc, 0x7f, 0xff, 0x11, 0x97, 0x8e, 0x60, 0xa8, 0x3f, 0x04, 0xa3, 0xb8, 0xb3, 0x85,
-		0x9b, 0x71, 0x9c, 0xdf, 0xff, 0x91, 0x30, 0x98, 0x84, 0xba, 0xfe, 0x04, 0x00, 0x4b, 0x8d, 0xd0,
-		0xe3, 0xc1, 0x18, 0xde, 0xff, 0xf1, 0x23, 0x7e, 0x1b, 0xc7, 0x63, 0xf1, 0x00, 0xd8, 0xdd, 0x9e,
-		0xe1, 0x00, 0xaf, 0x07, 0x01, 0xe8, 0x8c, 0x3c, 0x0a, 0x63, 0xcf, 0xdb, 0xa6, 0xe1, 0xa8, 0xad,
-		0x38, 0xee, 0xff, 0xc9, 0xb9, 0x05, 0x3d, 0x11, 0x58, 0xc7, 0xb4, 0xb0, 0xa3, 0xb6, 0xec, 0x38,
-		0xde, 0xff, 0xc5, 0x79, 0x5d, 0x06, 0xc2, 0xac, 0xa9, 0xb6, 0x93, 0x64, 0xdc, 0xff, 0x5b, 0x30,
-		0x0b, 0x06, 0xd2, 0x69, 0xf2, 0x7b, 0x17, 0xef, 0xc7, 0xf1, 0xfe, 0x40, 0x74, 0x9a, 0xd3, 0xd7,
-		0xdf, 0x0b, 0x05, 0xf2, 0x93, 0x5d, 0xb1, 0x8b, 0x61, 0xfe, 0x3f, 0x9c, 0xd9, 0xe3, 0x20, 0x2d,
-		0xdb, 0x4e, 0xd3, 0xd1, 0xe3, 0x85, 0x7d, 0x9d, 0xcf, 0xb4, 0xa0, 0xaf, 0xcf, 0x43, 0xd1, 0x76,
-		0x9a, 0xcd, 0x1e, 0x8f, 0x4f, 0x63, 0xd8, 0xff, 0xef, 0x8f, 0xdc, 0x94, 0x85, 0xcb, 0x43, 0x66,
-		0xfb, 0xda, 0xae, 0xd3, 0x35, 0xe9, 0x11, 0x48, 0x1c, 0xc2, 0x1b, 0x1c, 0xc1, 0xc7, 0x52, 0x5f,
-		0x80, 0x12, 0x19, 0x8b, 0x85, 0xbb, 0x98, 0x9e, 0x57, 0xc5, 0x40, 0xfc, 0x25, 0x17, 0x40, 0x80,
-		0xa9, 0xf1, 0xb3, 0xdf, 0x7c, 0xf5, 0x44, 0xea, 0x5b, 0xaf, 0x9e, 0x48, 0xfd, 0xf9, 0xab, 0x27,
-		0x52, 0x9f, 0x7c, 0xed, 0xc4, 0xc8, 0xb7, 0x5e, 0x3b, 0x31, 0xf2, 0xa7, 0xaf, 0x9d, 0x18, 0x89,
-		0x4e, 0x1b, 0xc3, 0x05, 0xf3, 0x82, 0xc9, 0x12, 0xc6, 0xcf, 0x49, 0x81, 0x74, 0x71, 0xcb, 0xf4,
-		0xb2, 0xb5, 0xee, 0x26, 0x07, 0xfe, 0x20, 0x0d, 0x77, 0xd2, 0xeb, 0xbe, 0x56, 0x47, 0x37, 0x9c,
-		0x33, 0x9a, 0xb5, 0xdf, 0x75, 0xcc, 0x33, 0x1d, 0x6c, 0xed, 0xb6, 0x31, 0xff, 0xc3, 0xb3, 0xbf,
-		0x33, 0x1e, 0xd9, 0x1c, 0x23, 0x9b, 0x63, 0xcf, 0xab, 0x91, 0xd9, 0x62, 0x69, 0x01, 0xc6, 0xd6,
-		0x2c, 0xd3, 0xdc, 0xbe, 0xdc, 0x45, 0x88, 0xdf, 0x60, 0xe6, 0x37, 0xe3, 0xa8, 0x1a, 0x56, 0x20,
-		0xb3, 0x8b, 0xf7, 0x69, 0xe2, 0xbc, 0x24, 0x93, 0x9f, 0x84, 0xaa, 0xa9, 0x3a, 0x2a, 0x4d, 0x98,
-		0x97, 0x64, 0xfa, 0x5b, 0x6a, 0xc0, 0x28, 0x05, 0x41, 0x8f, 0x42, 0xc6, 0xec, 0xda, 0x3c, 0xbb,
-		0x7f, 0xfb, 0xdc, 0xa0, 0xbe, 0xcc, 0xf1, 0x26, 0x1b, 0xd9, 0x6f, 0x1e, 0xd4, 0x46, 0x64, 0xc2,
-		0xd3, 0x58, 0xfb, 0xeb, 0xef, 0x9c, 0x48, 0xfd, 0xf6, 0xab, 0x27, 0x52, 0x83, 0x24, 0xf9, 0xdc,
-		0x9c, 0x4f, 0x50, 0x3e, 0x61, 0x0c, 0x92, 0xcb, 0x56, 0x8e, 0x8e, 0xf0, 0xdd, 0xf0, 0x4b, 0x69,
-		0x38, 0xe1, 0x23, 0x6a, 0xeb, 0x5b, 0xf6, 0x99, 0xdd, 0xab, 0x67, 0xc8, 0xf8, 0x6c, 0x2e, 0x35,
-		0xe4, 0xeb, 0x29, 0x79, 0x3e, 0xb7, 0x7b, 0x75, 0x80, 0xbc, 0xe6, 0x20, 0xbb, 0xa6, 0xea, 0x96,
-		0x10, 0x4c, 0xca, 0x13, 0xcc, 0xb4, 0x77, 0xb9, 0x95, 0xd4, 0xb1, 0x82, 0x74, 0x16, 0xf2, 0x4f,
-		0x2d, 0x3f, 0xfc, 0x60, 0x12, 0x9e, 0x0c, 0xe7, 0x69, 0xc8, 0x42, 0x14, 0x5f, 0x8b, 0x10, 0xc7,
-		0x37, 0x5e, 0x3b, 0x91, 0x72, 0x45, 0x32, 0x1b, 0x2b, 0x12, 0x3e, 0x5a, 0x57, 0x18, 0x9f, 0x4c,
-		0x43, 0x2d, 0x7c, 0x2a, 0x40, 0x96, 0xa2, 0xed, 0xa8, 0x9d, 0xee, 0xa0, 0x77, 0xba, 0xce, 0x41,
-		0x61, 0x43, 0xd0, 0xa0, 0x19, 0x18, 0xb3, 0xb1, 0x66, 0x1a, 0x4d, 0x9b, 0x8e, 0x24, 0x23, 0x8b,
-		0x22, 0x19, 0x8d, 0xa1, 0x1a, 0xa6, 0xcd, 0xaf, 0x9c, 0xb2, 0x42, 0xe3, 0xd7, 0x53, 0x87, 0x5b,
-		0x1b, 0x65, 0xb7, 0x29, 0xba, 0x40, 0xd6, 0x52, 0xcf, 0xdd, 0x3b, 0xec, 0x40, 0x85, 0x4e, 0xa3,
-		0x37, 0x04, 0xdf, 0xe9, 0xc9, 0x89, 0xf0, 0xe9, 0xc9, 0x33, 0xb8, 0xdd, 0x7e, 0xca, 0x30, 0xaf,
-		0x19, 0x1b, 0x84, 0xc7, 0x15, 0xc9, 0xc7, 0xd3, 0x70, 0xa2, 0xef, 0xa0, 0x84, 0x9b, 0x97, 0x41,
-		0x12, 0xa9, 0x43, 0x7e, 0x51, 0x58, 0xad, 0xc3, 0x0a, 0xe4, 0x57, 0x0f, 0x29, 0x90, 0x71, 0xd1,
-		0x92, 0x90, 0xc7, 0x3d, 0xf1, 0xf2, 0x10, 0xfd, 0xbf, 0x01, 0x71, 0x7c, 0xe8, 0x71, 0xb8, 0xdd,
-		0xa7, 0x40, 0xea, 0x96, 0xa6, 0x9f, 0xe1, 0x42, 0xf6, 0xad, 0x98, 0x23, 0xbe, 0x15, 0x43, 0x48,
-		0xe6, 0xe8, 0xc3, 0xe8, 0x45, 0x53, 0x4d, 0x66, 0xbb, 0xaa, 0x31, 0xab, 0xb4, 0x1a, 0xa7, 0xb8,
-		0xd5, 0x98, 0x69, 0x94, 0xfe, 0x6a, 0x14, 0xc6, 0x64, 0xfc, 0x42, 0x0f, 0xdb, 0xf4, 0x95, 0x44,
-		0xac, 0xed, 0x98, 0xfc, 0xb6, 0xbb, 0x34, 0x17, 0x39, 0x9e, 0x39, 0x4e, 0xbd, 0xa4, 0xed, 0x98,
-		0x17, 0x47, 0x64, 0xca, 0x41, 0xdf, 0x01, 0x6b, 0xf7, 0xec, 0x1d, 0x7e, 0x29, 0xf9, 0xd4, 0x70,
-		0xd6, 0xf3, 0x84, 0xf4, 0xe2, 0x88, 0xcc, 0x78, 0x48, 0xb3, 0xf4, 0xfd, 0xb5, 0x6c, 0x92, 0x66,
-		0x97, 0x8d, 0x6d, 0xda, 0x2c, 0xe1, 0x40, 0x17, 0x01, 0x6c, 0xec, 0x88, 0xab, 0x0c, 0xa3, 0x94,
-		0xff, 0xee, 0xe1, 0xfc, 0xeb, 0xd8, 0x61, 0x6e, 0xeb, 0xe2, 0x88, 0x5c, 0xb0, 0x45, 0x81, 0x20,
-		0xe9, 0x86, 0xee, 0x28, 0xda, 0x8e, 0xaa, 0x1b, 0xf4, 0x0c, 0x3e, 0x16, 0x69, 0xd9, 0xd0, 0x9d,
-		0x05, 0x42, 0x4e, 0x90, 0x74, 0x51, 0x20, 0xa2, 0x78, 0xa1, 0x87, 0xf9, 0xdd, 0xb7, 0x58, 0x51,
-		0x3c, 0x4d, 0x48, 0x89, 0x28, 0x28, 0x0f, 0x7a, 0x0a, 0x8a, 0xf4, 0xb8, 0x55, 0xd9, 0x6a, 0x9b,
-		0xda, 0x2e, 0x7f, 0xb3, 0x64, 0x76, 0x38, 0x44, 0x83, 0x30, 0x34, 0x08, 0xfd, 0xc5, 0x11, 0x19,
-		0xb6, 0xdc, 0x12, 0x6a, 0x40, 0x9e, 0x5d, 0xfb, 0x75, 0xf6, 0xf8, 0xbb, 0x81, 0x77, 0x0e, 0x47,
-		0xa2, 0x37, 0x80, 0x37, 0xf6, 0x2e, 0x8e, 0xc8, 0x63, 0x1a, 0xfb, 0x89, 0x96, 0xa0, 0x80, 0x8d,
-		0x26, 0xef, 0x4e, 0x91, 0xbf, 0x45, 0x35, 0x5c, 0x2f, 0x8c, 0xa6, 0xe8, 0x4c, 0x1e, 0xf3, 0xdf,
-		0xe8, 0x71, 0xc8, 0x69, 0x66, 0xa7, 0xa3, 0x3b, 0xf4, 0x1d, 0xc3, 0xe2, 0xd9, 0x3b, 0x62, 0x3a,
-		0x42, 0x69, 0x2f, 0x8e, 0xc8, 0x9c, 0x8b, 0x4c, 0x4f, 0x13, 0xb7, 0xf5, 0xab, 0xd8, 0x22, 0x83,
-		0x99, 0x4a, 0x32, 0x3d, 0x8b, 0x8c, 0x9e, 0x0e, 0xa7, 0xd0, 0x14, 0x85, 0xc6, 0x18, 0x77, 0x2f,
-		0xd2, 0xdd, 0x50, 0xf4, 0x69, 0x32, 0xb1, 0x58, 0x7c, 0x07, 0xc2, 0x9d, 0xbd, 0x28, 0x4a, 0x65,
-		0x28, 0xf9, 0xf5, 0x56, 0xea, 0xb8, 0x8c, 0xf4, 0x10, 0x7f, 0x06, 0xc6, 0xae, 0x62, 0xcb, 0x66,
-		0x27, 0xf8, 0x94, 0x91, 0x17, 0xd1, 0x29, 0x18, 0xa7, 0x72, 0x53, 0xc4, 0xf3, 0x34, 0xbd, 0x30,
-		0x52, 0xa2, 0x95, 0x57, 0x38, 0x51, 0x0d, 0x8a, 0xdd, 0xb3, 0x5d, 0x97, 0x24, 0x43, 0x49, 0xa0,
-		0x7b, 0xb6, 0xcb, 0x09, 0xa4, 0x3a, 0x54, 0xc2, 0xaa, 0xeb, 0xf7, 0x9a, 0x85, 0x08, 0xaf, 0x59,
-		0x10, 0x9e, 0xf6, 0xf7, 0xd2, 0x2e, 0xb3, 0xab, 0xad, 0x64, 0xb9, 0x11, 0x23, 0x41, 0xb9, 0x8b,
-		0x67, 0xab, 0x7d, 0xa1, 0x9d, 0xeb, 0x6b, 0x1a, 0x79, 0x12, 0x8a, 0x7c, 0xf2, 0xcf, 0x6a, 0x29,
-		0x99, 0x72, 0xa0, 0xe3, 0x44, 0xa1, 0x54, 0xdd, 0x50, 0xf4, 0xa6, 0x78, 0x9b, 0x98, 0x96, 0x97,
-		0x9b, 0xe8, 0x69, 0xa8, 0x68, 0xa6, 0x61, 0x63, 0xc3, 0xee, 0xd9, 0x4a, 0x57, 0xb5, 0xd4, 0x8e,
-		0xf7, 0xd2, 0x5d, 0xf4, 0x34, 0x2d, 0x08, 0xf2, 0x35, 0x4a, 0x2d, 0x4f, 0x68, 0xc1, 0x0a, 0xb4,
-		0x02, 0x70, 0x55, 0x6d, 0xeb, 0x4d, 0xd5, 0x31, 0x2d, 0x9b, 0xbf, 0x9c, 0x32, 0x08, 0xec, 0x8a,
-		0x20, 0xdc, 0xec, 0x36, 0x55, 0x07, 0xf3, 0x20, 0xca, 0xc7, 0x8f, 0xee, 0x82, 0x09, 0xb5, 0xdb,
-		0x55, 0x6c, 0x47, 0x75, 0xb0, 0xb2, 0xb5, 0xef, 0x60, 0x9b, 0xda, 0x8b, 0x92, 0x3c, 0xae, 0x76,
-		0xbb, 0xeb, 0xa4, 0xb6, 0x41, 0x2a, 0xa5, 0xa6, 0x3b, 0xdb, 0x74, 0x69, 0xba, 0xb1, 0x5d, 0xca,
-		0x8b, 0xed, 0x48, 0x1d, 0xbd, 0x5a, 0xc1, 0x64, 0x20, 0x6e, 0xa3, 0xe4, 0x76, 0xb0, 0xde, 0xda,
-		0x71, 0xe8, 0xb0, 0x33, 0x32, 0x2f, 0x91, 0x89, 0xe9, 0x5a, 0xe6, 0x55, 0x76, 0x5b, 0x28, 0x2f,
-		0xb3, 0x82, 0xf4, 0x6b, 0x69, 0x98, 0xec, 0x5b, 0xbe, 0x04, 0x97, 0x5e, 0xf0, 0xe7, 0x6d, 0x91,
-		0xdf, 0xe8, 0x1c, 0xc1, 0x55, 0x9b, 0xfc, 0xa5, 0x95, 0xe2, 0xd9, 0xdb, 0x06, 0x48, 0xe0, 0x22,
-		0x25, 0xe2, 0x03, 0xe7, 0x2c, 0x68, 0x13, 0x2a, 0x6d, 0xd5, 0x76, 0x14, 0xb6, 0x8a, 0xd8, 0x5b,
-		0xc2, 0x99, 0xa1, 0x96, 0x60, 0x45, 0x15, 0xab, 0x8f, 0x28, 0x37, 0x87, 0x2b, 0xb7, 0x03, 0xb5,
-		0xe8, 0x59, 0x98, 0xde, 0xda, 0x7f, 0x51, 0x35, 0x1c, 0xdd, 0xa0, 0x97, 0x8d, 0x82, 0x73, 0x54,
-		0x1b, 0x00, 0xbd, 0x74, 0x55, 0x6f, 0x62, 0x43, 0x13, 0x93, 0x33, 0xe5, 0x42, 0xb8, 0x93, 0x67,
-		0x4b, 0xcf, 0x42, 0x39, 0x68, 0x8b, 0x50, 0x19, 0xd2, 0xce, 0x1e, 0x97, 0x48, 0xda, 0xd9, 0x43,
-		0x0f, 0xf3, 0x88, 0x3c, 0x4d, 0x6f, 0xcb, 0x0d, 0x72, 0x16, 0x9c, 0xdb, 0x7b, 0x97, 0x50, 0x92,
-		0xdc, 0x95, 0xe0, 0x1a, 0x86, 0x30, 0xb6, 0x74, 0x1a, 0x26, 0x42, 0x46, 0xcc, 0x37, 0xad, 0x29,
-		0xff, 0xb4, 0x4a, 0x13, 0x30, 0x1e, 0xb0, 0x55, 0xd2, 0x1f, 0xe7, 0x20, 0x2f, 0x63, 0xbb, 0x4b,
-		0x94, 0x18, 0x5d, 0x84, 0x02, 0xde, 0xd3, 0x70, 0xd7, 0x11, 0x56, 0x61, 0x98, 0x11, 0x67, 0x3c,
-		0x4b, 0x82, 0x9e, 0x98, 0x2b, 0x97, 0x19, 0x3d, 0x1a, 0x70, 0xc9, 0xa7, 0xe2, 0x40, 0xfc, 0x3e,
-		0xf9, 0xb1, 0xa0, 0x4f, 0xbe, 0x23, 0x86, 0x37, 0xe4, 0x94, 0x1f, 0x0d, 0x38, 0xe5, 0xb8, 0x86,
-		0x03, 0x5e, 0x79, 0x39, 0xc2, 0x2b, 0xc7, 0x0d, 0x7f, 0x80, 0x5b, 0x5e, 0x8e, 0x70, 0xcb, 0xb3,
-		0xb1, 0x7d, 0x89, 0xf4, 0xcb, 0x8f, 0x05, 0xfd, 0x72, 0x9c, 0x38, 0x42, 0x8e, 0x79, 0x25, 0xca,
-		0x31, 0x9f, 0x8e, 0xc1, 0x18, 0xe8, 0x99, 0x17, 0xfa, 0x3c, 0xf3, 0x5d, 0x31, 0x50, 0x11, 0xae,
-		0x79, 0x39, 0xe0, 0x13, 0x21, 0x91, 0x6c, 0xa2, 0x9d, 0x22, 0x3a, 0xdf, 0xef, 0xe5, 0xef, 0x8e,
-		0x53, 0xb5, 0x28, 0x37, 0xff, 0x44, 0xc8, 0xcd, 0xdf, 0x19, 0x37, 0xaa, 0x90, 0x9f, 0xf7, 0xbc,
-		0xf3, 0x69, 0x62, 0x1f, 0x43, 0x2b, 0x83, 0xd8, 0x52, 0x6c, 0x59, 0xa6, 0xc5, 0x1d, 0x1f, 0x2b,
-		0x48, 0xb3, 0xc4, 0x62, 0x7b, 0xfa, 0x3f, 0xc4, 0x93, 0xd3, 0x45, 0xeb, 0xd3, 0x76, 0xe9, 0x6b,
-		0x29, 0x8f, 0x97, 0x5a, 0x36, 0xbf, 0xb5, 0x2f, 0x70, 0x6b, 0xef, 0x73, 0xf0, 0xe9, 0xa0, 0x83,
-		0xaf, 0x41, 0x91, 0xf8, 0x94, 0x90, 0xef, 0x56, 0xbb, 0xc2, 0x77, 0xa3, 0x7b, 0x60, 0x92, 0xda,
-		0x5f, 0x16, 0x06, 0x70, 0x43, 0x92, 0xa5, 0x86, 0x64, 0x82, 0x3c, 0x60, 0x12, 0x64, 0x8e, 0xe2,
-		0x7e, 0x98, 0xf2, 0xd1, 0x12, 0x5c, 0xea, 0x0b, 0x98, 0x93, 0xaa, 0xb8, 0xd4, 0xf3, 0xdd, 0xee,
-		0x45, 0xd5, 0xde, 0x91, 0x56, 0x3d, 0x01, 0x79, 0x71, 0x01, 0x82, 0xac, 0x66, 0x36, 0xd9, 0xb8,
-		0xc7, 0x65, 0xfa, 0x9b, 0xc4, 0x0a, 0x6d, 0xb3, 0xc5, 0x6f, 0x40, 0x92, 0x9f, 0x84, 0xca, 0x5d,
-		0xda, 0x05, 0xb6, 0x66, 0xa5, 0x2f, 0xa7, 0x3c, 0x3c, 0x2f, 0x54, 0x88, 0xf2, 0xea, 0xa9, 0x9b,
-		0xe9, 0xd5, 0xd3, 0x6f, 0xce, 0xab, 0x4b, 0x6f, 0xa4, 0xbc, 0x29, 0x75, 0xfd, 0xf5, 0x8d, 0x89,
-		0x80, 0x68, 0x17, 0x7b, 0x13, 0x9c, 0xdd, 0xd4, 0x65, 0x05, 0x11, 0x6a, 0xe5, 0x22, 0x12, 0x14,
-		0x63, 0xbe, 0xa4, 0x06, 0x7a, 0x88, 0xfa, 0x79, 0x73, 0x9b, 0x9b, 0x86, 0x5a, 0x4c, 0xa2, 0x47,
-		0x66, 0xd4, 0x3e, 0xff, 0x52, 0x08, 0x84, 0x0d, 0xb7, 0x42, 0x81, 0x74, 0x9d, 0xbd, 0xfe, 0x04,
-		0x3c, 0xbd, 0x28, 0x2a, 0xa4, 0x26, 0xa0, 0x7e, 0x1b, 0x83, 0x2e, 0x41, 0x0e, 0x5f, 0xa5, 0xb7,
-		0x51, 0x59, 0xb2, 0xe9, 0xd6, 0x81, 0x8e, 0x18, 0x1b, 0x4e, 0x63, 0x86, 0x08, 0xf3, 0xfb, 0x07,
-		0xb5, 0x0a, 0xe3, 0xb9, 0xcf, 0xec, 0xe8, 0x0e, 0xee, 0x74, 0x9d, 0x7d, 0x99, 0xa3, 0x48, 0x1f,
-		0x49, 0x13, 0x7f, 0x18, 0xb0, 0x3f, 0x91, 0xe2, 0x15, 0x8b, 0x26, 0xed, 0x0b, 0x91, 0x92, 0x89,
-		0xfc, 0x36, 0x80, 0x96, 0x6a, 0x2b, 0xd7, 0x54, 0xc3, 0xc1, 0x4d, 0x2e, 0xf7, 0x42, 0x4b, 0xb5,
-		0x9f, 0xa1, 0x15, 0x24, 0xde, 0x24, 0x8f, 0x7b, 0x36, 0x6e, 0xd2, 0x09, 0xc8, 0xc8, 0x63, 0x2d,
-		0xd5, 0xde, 0xb4, 0x71, 0xd3, 0x37, 0xd6, 0xb1, 0x9b, 0x31, 0xd6, 0xa0, 0xbc, 0xf3, 0x61, 0x79,
-		0x7f, 0x2c, 0xed, 0xad, 0x0e, 0x2f, 0x7c, 0xf8, 0xc9, 0x94, 0xc5, 0x67, 0xe9, 0x9e, 0x22, 0xe8,
-		0x04, 0xd0, 0xfb, 0x61, 0xd2, 0x5d, 0x95, 0x4a, 0x8f, 0xae, 0x56, 0xa1, 0x85, 0x87, 0x5b, 0xdc,
-		0x95, 0xab, 0xc1, 0x6a, 0x1b, 0xfd, 0x1c, 0x1c, 0x0b, 0xd9, 0x20, 0xb7, 0x81, 0xf4, 0xa1, 0x4c,
-		0xd1, 0x91, 0xa0, 0x29, 0x12, 0xf8, 0x9e, 0xf4, 0x32, 0x37, 0x65, 0xd5, 0xdc, 0x41, 0x42, 0x58,
-		0xbf, 0x7b, 0x8b, 0xd2, 0x09, 0xe9, 0x4f, 0x52, 0x30, 0x11, 0xea, 0x20, 0x7a, 0x0f, 0x8c, 0x32,
-		0x0f, 0x9c, 0x1a, 0x9a, 0x08, 0xa1, 0x12, 0xe7, 0x63, 0x62, 0x0c, 0x68, 0x1e, 0xf2, 0x98, 0x47,
-		0xd7, 0x5c, 0x28, 0x77, 0xc6, 0x04, 0xe1, 0x9c, 0xdf, 0x65, 0x43, 0x8b, 0x50, 0x70, 0x45, 0x1f,
-		0xb3, 0x73, 0x73, 0x67, 0x8e, 0x83, 0x78, 0x8c, 0xd2, 0x02, 0x14, 0x7d, 0xdd, 0x63, 0xef, 0x02,
-		0xee, 0xf1, 0xed, 0x16, 0x0b, 0xa0, 0xf3, 0x1d, 0x75, 0x8f, 0xee, 0xb4, 0xd0, 0x31, 0x18, 0x23,
-		0x0f, 0x5b, 0xfc, 0x65, 0xa9, 0x8c, 0x9c, 0xeb, 0xa8, 0x7b, 0x17, 0x54, 0x5b, 0xfa, 0x78, 0x0a,
-		0xca, 0xc1, 0x7e, 0xa2, 0x7b, 0x01, 0x11, 0x5a, 0xb5, 0x85, 0x15, 0xa3, 0xd7, 0x61, 0x3e, 0x52,
-		0x20, 0x4e, 0x74, 0xd4, 0xbd, 0xf9, 0x16, 0xbe, 0xd4, 0xeb, 0xd0, 0xa6, 0x6d, 0xb4, 0x0a, 0x15,
-		0x41, 0x2c, 0x92, 0x5d, 0x5c, 0x2a, 0xc7, 0xfb, 0xbf, 0x57, 0xc3, 0x09, 0xd8, 0x5e, 0xf7, 0x65,
-		0xb2, 0xd7, 0x2d, 0x33, 0x3c, 0xf1, 0x44, 0x7a, 0x08, 0x26, 0x42, 0x23, 0x46, 0x12, 0x8c, 0x77,
-		0x7b, 0x5b, 0xca, 0x2e, 0xde, 0xa7, 0xaf, 0xbf, 0x33, 0x55, 0x2f, 0xc8, 0xc5, 0x6e, 0x6f, 0xeb,
-		0x29, 0xbc, 0x4f, 0x73, 0x87, 0x92, 0x06, 0xe5, 0xe0, 0x66, 0x8a, 0x38, 0x0e, 0xcb, 0xec, 0x19,
-		0x4d, 0xf1, 0x61, 0x03, 0x5a, 0x40, 0xe7, 0x60, 0xf4, 0xaa, 0xc9, 0xb4, 0x79, 0xd8, 0xee, 0xe9,
-		0x8a, 0xe9, 0x60, 0xdf, 0x96, 0x8c, 0xf1, 0x48, 0x36, 0x8c, 0x52, 0xbd, 0x8c, 0x3c, 0xa8, 0xb8,
-		0x02, 0xa0, 0x3a, 0x8e, 0xa5, 0x6f, 0xf5, 0x3c, 0xf8, 0x99, 0xb9, 0xfe, 0xb4, 0xfe, 0xdc, 0x9a,
-		0xaa, 0x5b, 0x8d, 0x5b, 0xb9, 0x66, 0x4f, 0x7b, 0x3c, 0x3e, 0xed, 0xf6, 0x21, 0x49, 0xaf, 0x67,
-		0x21, 0xc7, 0xb6, 0x9b, 0xe8, 0xf1, 0x60, 0xf2, 0xa3, 0x78, 0xf6, 0xc4, 0xa0, 0xee, 0x33, 0x2a,
-		0xde, 0x7b, 0x37, 0x82, 0xba, 0x2b, 0x9c, 0x51, 0x68, 0x14, 0x5f, 0x3d, 0xa8, 0x8d, 0xd1, 0xe8,
-		0x63, 0x79, 0xd1, 0x4b, 0x2f, 0x0c, 0xda, 0x5d, 0x8b, 0x5c, 0x46, 0xf6, 0xd0, 0xb9, 0x8c, 0x8b,
-		0x30, 0xee, 0x0b, 0xb7, 0xf4, 0x26, 0xdf, 0xa7, 0x9c, 0x18, 0xb6, 0xe8, 0x96, 0x17, 0x79, 0xff,
-		0x8b, 0x6e, 0x38, 0xb6, 0xdc, 0x44, 0xb3, 0xc1, 0x4d, 0x36, 0x8d, 0xda, 0x58, 0xb8, 0xe0, 0xdb,
-		0x37, 0xd3, 0x77, 0xf2, 0x6f, 0x81, 0x02, 0x7d, 0xb1, 0x99, 0x92, 0xb0, 0xe8, 0x21, 0x4f, 0x2a,
-		0xe8, 0xc3, 0xbb, 0x61, 0xc2, 0x0b, 0x6c, 0x18, 0x49, 0x9e, 0xa1, 0x78, 0xd5, 0x94, 0xf0, 0x01,
-		0x98, 0x36, 0xf0, 0x9e, 0xa3, 0x84, 0xa9, 0x0b, 0x94, 0x1a, 0x91, 0x67, 0x57, 0x82, 0x1c, 0x77,
-		0x42, 0xd9, 0x33, 0xa1, 0x94, 0x16, 0x58, 0xea, 0xc3, 0xad, 0xa5, 0x64, 0xc7, 0x21, 0xef, 0x86,
-		0x9d, 0x45, 0x4a, 0x30, 0xa6, 0xb2, 0x68, 0xd3, 0x0d, 0x64, 0x2d, 0x6c, 0xf7, 0xda, 0x0e, 0x07,
-		0x29, 0x51, 0x1a, 0x1a, 0xc8, 0xca, 0xac, 0x9e, 0xd2, 0x9e, 0x82, 0x71, 0x61, 0x55, 0x18, 0xdd,
-		0x38, 0xa5, 0x2b, 0x89, 0x4a, 0x4a, 0x74, 0x1a, 0x2a, 0x5d, 0xcb, 0xec, 0x9a, 0x36, 0xb6, 0x14,
-		0xb5, 0xd9, 0xb4, 0xb0, 0x6d, 0xcf, 0x94, 0x19, 0x9e, 0xa8, 0x9f, 0x67, 0xd5, 0xd2, 0xbb, 0x60,
-		0x4c, 0xc4, 0xd3, 0xd3, 0x30, 0xda, 0x70, 0x2d, 0x64, 0x56, 0x66, 0x05, 0xe2, 0x5f, 0xe7, 0xbb,
-		0x5d, 0x9e, 0x5d, 0x23, 0x3f, 0xa5, 0x36, 0x8c, 0xf1, 0x09, 0x8b, 0xcc, 0xa9, 0xac, 0x42, 0xa9,
-		0xab, 0x5a, 0x64, 0x18, 0xfe, 0xcc, 0xca, 0xa0, 0x1d, 0xe1, 0x9a, 0x6a, 0x39, 0xeb, 0xd8, 0x09,
-		0x24, 0x58, 0x8a, 0x94, 0x9f, 0x55, 0x49, 0x8f, 0xc2, 0x78, 0x80, 0x86, 0x74, 0xd3, 0x31, 0x1d,
-		0xb5, 0x2d, 0x16, 0x3a, 0x2d, 0xb8, 0x3d, 0x49, 0x7b, 0x3d, 0x91, 0xce, 0x41, 0xc1, 0x9d, 0x2b,
-		0xb2, 0xd1, 0x10, 0xa2, 0x48, 0x71, 0xf1, 0xb3, 0x22, 0x4d, 0x22, 0x99, 0xd7, 0xf8, 0x27, 0x9a,
-		0x32, 0x32, 0x2b, 0x48, 0xd8, 0x67, 0x98, 0x98, 0x37, 0x43, 0x8f, 0xc1, 0x18, 0x37, 0x4c, 0x7c,
-		0x3d, 0x0e, 0x4a, 0x17, 0xad, 0x51, 0x4b, 0x25, 0xd2, 0x45, 0xcc, 0x6e, 0x79, 0xcd, 0xa4, 0xfd,
-		0xcd, 0x7c, 0x10, 0xf2, 0xc2, 0xf8, 0x04, 0xbd, 0x04, 0x6b, 0xe1, 0x64, 0x9c, 0x97, 0xe0, 0x8d,
-		0x78, 0x8c, 0x44, 0x9b, 0x6c, 0xbd, 0x65, 0xe0, 0xa6, 0xe2, 0x2d, 0x41, 0xfe, 0xc2, 0xec, 0x04,
-		0x7b, 0xb0, 0x22, 0xd6, 0x97, 0xf4, 0x00, 0xe4, 0x58, 0x5f, 0x23, 0x4d, 0x5c, 0x94, 0x6b, 0xfd,
-		0x6e, 0x0a, 0xf2, 0xc2, 0x7d, 0x44, 0x32, 0x05, 0x06, 0x91, 0xbe, 0xd1, 0x41, 0xdc, 0x7c, 0x93,
-		0x74, 0x1f, 0x20, 0xaa, 0x29, 0xca, 0x55, 0xd3, 0xd1, 0x8d, 0x96, 0xc2, 0xe6, 0x82, 0xbf, 0x37,
-		0x48, 0x9f, 0x5c, 0xa1, 0x0f, 0xd6, 0x48, 0xfd, 0x3d, 0xa7, 0xa0, 0xe8, 0xcb, 0x72, 0xa1, 0x31,
-		0xc8, 0x5c, 0xc2, 0xd7, 0x2a, 0x23, 0xa8, 0x08, 0x63, 0x32, 0xa6, 0x39, 0x82, 0x4a, 0xea, 0xec,
-		0xeb, 0x63, 0x30, 0x31, 0xdf, 0x58, 0x58, 0x9e, 0xef, 0x76, 0xdb, 0x3a, 0x7f, 0x9f, 0xee, 0x32,
-		0x64, 0xe9, 0x3e, 0x39, 0xc1, 0xf9, 0x4e, 0x35, 0x49, 0xc2, 0x09, 0xc9, 0x30, 0x4a, 0xb7, 0xd3,
-		0x28, 0xc9, 0xb1, 0x4f, 0x35, 0x51, 0x1e, 0x8a, 0x74, 0x92, 0x2a, 0x5c, 0x82, 0xd3, 0xa0, 0x6a,
-		0x92, 0xe4, 0x14, 0xfa, 0x39, 0x28, 0x78, 0xfb, 0xe4, 0xa4, 0x67, 0x44, 0xd5, 0xc4, 0x69, 0x2b,
-		0x82, 0xef, 0xed, 0x0c, 0x92, 0x1e, 0x4d, 0x54, 0x13, 0xe7, 0x6b, 0xd0, 0xb3, 0x30, 0x26, 0xf6,
-		0x60, 0xc9, 0x4e, 0x71, 0xaa, 0x09, 0x53, 0x4a, 0x64, 0xfa, 0xd8, 0xd6, 0x39, 0xc9, 0x51, 0x55,
-		0x35, 0x51, 0xde, 0x0c, 0x6d, 0x42, 0x8e, 0x07, 0xbf, 0x89, 0x4e, 0x7a, 0xaa, 0xc9, 0x12, 0x45,
-		0x44, 0xc8, 0x5e, 0x72, 0x22, 0xe9, 0xf1, 0x5c, 0x35, 0x71, 0xc2, 0x10, 0xa9, 0x00, 0xbe, 0xfd,
-		0x74, 0xe2, 0x73, 0xb7, 0x6a, 0xf2, 0x44, 0x20, 0xfa, 0x00, 0xe4, 0xdd, 0x5d, 0x53, 0xc2, 0x93,
-		0xb4, 0x6a, 0xd2, 0x5c, 0x5c, 0x63, 0x33, 0xf1, 0x2d, 0x89, 0x7b, 0x63, 0x6f, 0x49, 0x78, 0x87,
-		0xdc, 0xee, 0x31, 0xf8, 0x5f, 0xa6, 0xe0, 0x78, 0xf8, 0x38, 0x59, 0x35, 0xf6, 0x07, 0x5c, 0x08,
-		0x18, 0x70, 0x5b, 0xe4, 0x31, 0xc8, 0xcc, 0x1b, 0xfb, 0x24, 0xd8, 0xa0, 0xdf, 0xf6, 0xeb, 0x59,
-		0x6d, 0x91, 0xa6, 0x23, 0xe5, 0x4d, 0xab, 0x1d, 0x7d, 0x6b, 0xa4, 0x9e, 0xfd, 0xc1, 0xe7, 0x6b,
-		0x23, 0x8d, 0xdd, 0x88, 0x51, 0xc5, 0xdc, 0x15, 0xc8, 0xcf, 0x1b, 0xfb, 0xe2, 0x9a, 0xc0, 0x28,
-		0x1d, 0xd0, 0x61, 0x8f, 0xff, 0x5f, 0x2b, 0x11, 0x64, 0xbb, 0x63, 0xda, 0x67, 0xd8, 0x1f, 0x3e,
-		0xe2, 0x1c, 0x2b, 0x0d, 0x38, 0xe1, 0x8f, 0xbf, 0x31, 0x50, 0x1d, 0x2c, 0x4d, 0xe9, 0x02, 0x64,
-		0x17, 0x4c, 0x9d, 0x86, 0x3c, 0x4d, 0x6c, 0x98, 0x1d, 0x91, 0xf3, 0xa4, 0x05, 0x74, 0x0a, 0x72,
-		0x6a, 0xc7, 0xec, 0x19, 0x8e, 0x88, 0x9a, 0x89, 0x2b, 0xf9, 0xaf, 0x07, 0xb5, 0xcc, 0xb2, 0xe1,
-		0xc8, 0xfc, 0x51, 0x3d, 0xfb, 0xbd, 0x57, 0x6a, 0x29, 0xe9, 0x49, 0x18, 0x5b, 0xc4, 0xda, 0x8d,
-		0x60, 0x2d, 0x62, 0x2d, 0x84, 0x75, 0x1a, 0xf2, 0xcb, 0x86, 0xc3, 0x3e, 0x1a, 0x76, 0x1b, 0x64,
-		0x74, 0x83, 0x1d, 0x8b, 0x84, 0xda, 0x27, 0xf5, 0x84, 0x74, 0x11, 0x6b, 0x2e, 0x69, 0x13, 0x6b,
-		0x61, 0x52, 0x02, 0x4f, 0xea, 0xa5, 0x06, 0x94, 0xae, 0xa8, 0x6d, 0x1e, 0xee, 0x61, 0x1b, 0xdd,
-		0x07, 0x05, 0x55, 0x14, 0xe8, 0xce, 0xaa, 0xd4, 0x28, 0xff, 0xf0, 0xa0, 0x06, 0x1e, 0x91, 0xec,
-		0x11, 0xd4, 0xb3, 0x2f, 0xfd, 0xb7, 0x93, 0x29, 0xc9, 0x84, 0xb1, 0x0b, 0xaa, 0x4d, 0x2d, 0xfd,
-		0x83, 0x81, 0x44, 0x0a, 0x8d, 0x14, 0x1b, 0x47, 0xae, 0x1f, 0xd4, 0x26, 0xf7, 0xd5, 0x4e, 0xbb,
-		0x2e, 0x79, 0xcf, 0x24, 0x7f, 0x7e, 0x65, 0xce, 0x97, 0x5f, 0xa1, 0x91, 0x64, 0x63, 0xea, 0xfa,
-		0x41, 0x6d, 0xc2, 0xe3, 0x21, 0x4f, 0x24, 0x37, 0xe9, 0x22, 0x75, 0x21, 0xc7, 0x82, 0xde, 0xc8,
-		0x13, 0x42, 0x9e, 0xf2, 0x49, 0x7b, 0x29, 0x9f, 0xfa, 0xa1, 0xd2, 0x0c, 0x3c, 0x2e, 0x63, 0x1c,
-		0xf5, 0xec, 0x47, 0x5f, 0xa9, 0x8d, 0x48, 0x16, 0xa0, 0x75, 0xbd, 0xd3, 0x6b, 0xb3, 0x17, 0xbf,
-		0xc5, 0x51, 0xd3, 0x83, 0xac, 0xdf, 0x34, 0x9d, 0xc4, 0x02, 0xb2, 0x89, 0x39, 0xae, 0xa4, 0x5c,
-		0x20, 0x2c, 0xce, 0xf8, 0xd6, 0x41, 0x2d, 0x45, 0x7b, 0x4f, 0x65, 0x74, 0x17, 0xe4, 0x58, 0x28,
-		0xcf, 0xe3, 0x9f, 0xb2, 0xe0, 0x61, 0x63, 0x92, 0xf9, 0x53, 0xe9, 0x71, 0x18, 0x5b, 0xb5, 0x5b,
-		0x8b, 0x64, 0x48, 0xc7, 0x21, 0xdf, 0xb1, 0x5b, 0x8a, 0x2f, 0x9a, 0x1a, 0xeb, 0xd8, 0xad, 0x8d,
-		0x01, 0x51, 0x18, 0x9f, 0x96, 0x77, 0x43, 0x6e, 0x63, 0x8f, 0xb2, 0x9f, 0x72, 0xa5, 0x94, 0xf1,
-		0xf7, 0x91, 0xa3, 0x07, 0x98, 0x3e, 0x94, 0x01, 0xd8, 0xd8, 0x73, 0x47, 0x38, 0xe0, 0x08, 0x0e,
-		0x49, 0x90, 0x73, 0xf6, 0xdc, 0x88, 0xba, 0xd0, 0x80, 0x57, 0x0f, 0x6a, 0xb9, 0x8d, 0x3d, 0xb2,
-		0xbd, 0x90, 0xf9, 0x93, 0x60, 0x2a, 0x2b, 0x13, 0x4a, 0x65, 0xb9, 0x09, 0xbc, 0x6c, 0x44, 0x02,
-		0x6f, 0xd4, 0x77, 0x02, 0x70, 0x0c, 0xc6, 0x2c, 0xf5, 0x9a, 0x42, 0x66, 0x94, 0x7d, 0x85, 0x34,
-		0x67, 0xa9, 0xd7, 0x56, 0xcc, 0x16, 0x5a, 0x80, 0x6c, 0xdb, 0x6c, 0x89, 0xbc, 0xdb, 0x51, 0x31,
-		0x28, 0x12, 0x71, 0xf1, 0xdb, 0xc4, 0x2b, 0x66, 0xab, 0x71, 0x8c, 0xc8, 0xff, 0x4b, 0x7f, 0x56,
-		0x9b, 0x08, 0xd6, 0xdb, 0x32, 0x65, 0x76, 0x93, 0x81, 0xf9, 0x81, 0xc9, 0xc0, 0xc2, 0xb0, 0x64,
-		0x20, 0x04, 0x93, 0x81, 0x77, 0xd0, 0x33, 0x4d, 0x76, 0x86, 0x33, 0xdd, 0x17, 0x7c, 0xce, 0x1b,
-		0xfb, 0xf4, 0x14, 0xf5, 0x56, 0x28, 0xb8, 0x17, 0x85, 0xf8, 0x67, 0x9f, 0xbd, 0x0a, 0xae, 0x6f,
-		0x1f, 0x4d, 0x41, 0x39, 0xd8, 0x63, 0x9a, 0xcf, 0xb1, 0x5b, 0xfc, 0x83, 0xa9, 0x2c, 0xed, 0x49,
-		0x94, 0x62, 0x59, 0x64, 0xca, 0x43, 0x3a, 0x3f, 0x1f, 0xd2, 0xf9, 0x29, 0x21, 0x20, 0xf6, 0xee,
-		0x0e, 0x53, 0xf5, 0x69, 0x2e, 0x9d, 0x92, 0xaf, 0xd2, 0xf6, 0x54, 0x9f, 0x6a, 0xc4, 0xcf, 0x43,
-		0xd1, 0xf7, 0x34, 0x32, 0xa8, 0x7f, 0x24, 0x22, 0xd9, 0x31, 0xe9, 0x4e, 0x88, 0x78, 0x22, 0x8e,
-		0x10, 0x3c, 0x52, 0x57, 0x51, 0x0b, 0x2e, 0x51, 0xd2, 0xeb, 0x15, 0x8d, 0xc5, 0x3f, 0xfd, 0xce,
-		0x89, 0x91, 0x97, 0x5e, 0x3d, 0x31, 0x32, 0xf0, 0x7e, 0xa6, 0xff, 0x22, 0x6b, 0xc0, 0x83, 0xdc,
-		0x6f, 0x37, 0x77, 0x43, 0xde, 0xf5, 0x63, 0xef, 0x83, 0x5b, 0x39, 0x8d, 0xed, 0xa8, 0xbb, 0xba,
-		0xd1, 0x12, 0x7f, 0xb9, 0xbb, 0x29, 0xf3, 0xd1, 0xf0, 0xda, 0x1b, 0x77, 0x3b, 0x6f, 0xf6, 0xd2,
-		0x58, 0x35, 0xca, 0x1b, 0x4a, 0x07, 0x59, 0x40, 0xab, 0x76, 0x6b, 0xc1, 0xc2, 0xec, 0x63, 0x23,
-		0x7c, 0x9f, 0x14, 0x7c, 0xd9, 0x87, 0xdb, 0xa8, 0x5b, 0xe6, 0x82, 0x63, 0x71, 0xbf, 0x1b, 0xed,
-		0xe5, 0x88, 0x02, 0xaf, 0x08, 0x2d, 0x01, 0xd0, 0xf4, 0x8a, 0x6d, 0x7b, 0xc9, 0xbc, 0x5a, 0x18,
-		0x63, 0xc1, 0xa5, 0x90, 0x55, 0x07, 0xdb, 0x62, 0xae, 0x3d, 0x46, 0xf4, 0x41, 0x98, 0xea, 0xe8,
-		0x86, 0x62, 0xe3, 0xf6, 0xb6, 0xd2, 0xc4, 0x6d, 0xfa, 0x29, 0x16, 0x7e, 0x70, 0x57, 0x68, 0xac,
-		0x70, 0xc7, 0x74, 0x57, 0xfc, 0x9c, 0xcd, 0x2d, 0x1b, 0xce, 0xf5, 0x83, 0x5a, 0x95, 0x79, 0x87,
-		0x08, 0x48, 0x49, 0x9e, 0xec, 0xe8, 0xc6, 0x3a, 0x6e, 0x6f, 0x2f, 0xba, 0x75, 0xe8, 0x45, 0x98,
-		0xe4, 0x14, 0xa6, 0x97, 0xf4, 0x20, 0xb6, 0xa7, 0xd4, 0x58, 0xbd, 0x7e, 0x50, 0x9b, 0x61, 0x68,
-		0x7d, 0x24, 0xd2, 0x0f, 0x0f, 0x6a, 0xf7, 0x27, 0xe8, 0xd3, 0xbc, 0xa6, 0x09, 0xf7, 0x58, 0x71,
-		0x41, 0x78, 0x0d, 0x69, 0xdb, 0x4b, 0xd0, 0x8b, 0xb6, 0x47, 0xc3, 0x6d, 0xf7, 0x91, 0x24, 0x6d,
-		0xdb, 0xe7, 0x9a, 0xbd, 0x0c, 0xbe, 0x68, 0xfb, 0x28, 0xe4, 0xba, 0xbd, 0x2d, 0x71, 0x8a, 0x56,
-		0x90, 0x79, 0x09, 0xcd, 0xfa, 0x0f, 0xd2, 0x8a, 0x67, 0x4b, 0x62, 0x3e, 0x49, 0xac, 0xe2, 0xa6,
-		0x39, 0x59, 0xec, 0x47, 0xa3, 0x8f, 0xaf, 0x65, 0xa0, 0xb2, 0x6a, 0xb7, 0x96, 0x9a, 0xba, 0x73,
-		0x93, 0xd5, 0xab, 0x1b, 0x25, 0x1d, 0xea, 0xcd, 0x1a, 0x0b, 0xd7, 0x0f, 0x6a, 0x65, 0x26, 0x9d,
-		0x9b, 0x29, 0x93, 0x0e, 0x4c, 0x78, 0x7a, 0xa9, 0x58, 0xaa, 0xc3, 0xdd, 0x53, 0x63, 0x31, 0xa1,
-		0x06, 0x2e, 0x62, 0xed, 0xfa, 0x41, 0xed, 0x28, 0xeb, 0x59, 0x08, 0x4a, 0x92, 0xcb, 0x5a, 0x60,
-		0x2d, 0xa0, 0xbd, 0x68, 0xc5, 0xa7, 0xe7, 0x4f, 0x8d, 0x8b, 0x6f, 0xa1, 0xd2, 0xf3, 0xa9, 0xfb,
-		0x6a, 0x1a, 0x8a, 0xc4, 0xd5, 0xb3, 0x7a, 0x1c, 0xbd, 0x14, 0x52, 0x3f, 0xc6, 0xa5, 0x90, 0x7e,
-		0x7b, 0x96, 0xc2, 0x3d, 0x6e, 0xac, 0x9d, 0x19, 0xa8, 0xf3, 0xc1, 0x90, 0xfb, 0x3f, 0x66, 0xa8,
-		0x55, 0xa5, 0x3b, 0x48, 0x19, 0x37, 0xdf, 0x09, 0x02, 0xfc, 0xc5, 0x14, 0x1c, 0xf1, 0xc4, 0x63,
-		0x5b, 0x5a, 0x48, 0x8a, 0x4f, 0x5f, 0x3f, 0xa8, 0xdd, 0x1a, 0x96, 0xa2, 0x8f, 0xec, 0x06, 0x24,
-		0x39, 0xe5, 0x02, 0xad, 0x5b, 0x5a, 0x74, 0x3f, 0x9a, 0xb6, 0xe3, 0xf6, 0x23, 0x33, 0xb8, 0x1f,
-		0x3e, 0xb2, 0x37, 0xd5, 0x8f, 0x45, 0xdb, 0xe9, 0x9f, 0xd4, 0x6c, 0xc2, 0x49, 0xfd, 0x7a, 0x1a,
-		0xc6, 0x57, 0xed, 0xd6, 0xa6, 0xd1, 0xfc, 0xe9, 0x82, 0x38, 0xec, 0x82, 0xf8, 0x78, 0x0a, 0xca,
-		0x17, 0x75, 0xdb, 0x31, 0x2d, 0x5d, 0x53, 0xdb, 0x74, 0x37, 0xe3, 0xdd, 0x91, 0x4c, 0x1d, 0xfe,
-		0x8e, 0xe4, 0x23, 0x90, 0xbb, 0xaa, 0xb6, 0x6d, 0xec, 0xf0, 0xa0, 0xf1, 0x78, 0xd8, 0x77, 0x84,
-		0x73, 0xc0, 0x9c, 0x9c, 0x77, 0xe7, 0x77, 0xd3, 0x30, 0x11, 0x0a, 0x3c, 0x50, 0x03, 0xb2, 0xd4,
-		0xa2, 0xb3, 0x0d, 0xef, 0xdc, 0x21, 0xe2, 0x0a, 0xb2, 0x27, 0xa6, 0xbc, 0xe8, 0x67, 0x20, 0xdf,
-		0x51, 0xf7, 0x98, 0x67, 0x60, 0xfb, 0x9b, 0xf9, 0xc3, 0xe1, 0x78, 0xbb, 0x57, 0x81, 0x23, 0xc9,
-		0x63, 0x1d, 0x75, 0x8f, 0xfa, 0x83, 0x2e, 0x4c, 0x90, 0x5a, 0x6d, 0x47, 0x35, 0x5a, 0xd8, 0xef,
-		0x7e, 0x2e, 0x1e, 0xba, 0x91, 0xa3, 0x5e, 0x23, 0x3e, 0x38, 0x49, 0x1e, 0xef, 0xa8, 0x7b, 0x0b,
-		0xb4, 0x82, 0xb4, 0x58, 0xcf, 0xbf, 0xfc, 0x4a, 0x6d, 0x84, 0x4a, 0xec, 0xdf, 0xa7, 0x00, 0x3c,
-		0x89, 0xa1, 0x0d, 0xa8, 0x84, 0xdc, 0x97, 0xb8, 0x63, 0x14, 0x1b, 0xe0, 0x79, 0x1b, 0xdb, 0x09,
-		0x2d, 0x34, 0x05, 0x1f, 0x80, 0x22, 0xbb, 0x25, 0xa0, 0xd0, 0x64, 0x7c, 0x3a, 0x36, 0x19, 0x7f,
-		0x82, 0x60, 0x5d, 0x3f, 0xa8, 0x21, 0x36, 0x1c, 0x1f, 0xb3, 0x44, 0x53, 0xf4, 0xc0, 0x6a, 0x08,
-		0x43, 0x70, 0x2c, 0x45, 0x5f, 0x6c, 0x41, 0xef, 0x9e, 0x99, 0x86, 0xbe, 0x8b, 0x2d, 0x77, 0x8f,
-		0xcc, 0x8a, 0xa8, 0x0a, 0x79, 0xf6, 0x55, 0x41, 0x67, 0x5f, 0xfc, 0xbb, 0x0a, 0x51, 0x26, 0x5c,
-		0xd7, 0xf0, 0x96, 0xad, 0x8b, 0x59, 0x90, 0x45, 0x11, 0x9d, 0x87, 0x8a, 0x8d, 0xb5, 0x9e, 0xa5,
-		0x3b, 0xfb, 0x8a, 0x66, 0x1a, 0x8e, 0xaa, 0x39, 0xdc, 0x69, 0xdf, 0x72, 0xfd, 0xa0, 0x76, 0x8c,
-		0xf5, 0x35, 0x4c, 0x21, 0xc9, 0x13, 0xa2, 0x6a, 0x81, 0xd5, 0x90, 0x16, 0x9a, 0xd8, 0x51, 0xf5,
-		0xb6, 0xcd, 0x37, 0xb6, 0xa2, 0xe8, 0x1b, 0xcb, 0xef, 0x8c, 0xf9, 0x0f, 0xa3, 0xae, 0x41, 0xc5,
-		0xec, 0x62, 0x2b, 0xc2, 0x1e, 0xad, 0x78, 0x2d, 0x87, 0x29, 0x6e, 0xc0, 0x24, 0x4c, 0x08, 0x0c,
-		0x61, 0x11, 0xce, 0x07, 0xee, 0x9c, 0xb1, 0xb8, 0x31, 0x1d, 0x1e, 0x72, 0x98, 0x42, 0xf2, 0x5f,
-		0x34, 0x63, 0xd1, 0xe5, 0x51, 0xc8, 0x3d, 0xaf, 0xea, 0x6d, 0xf1, 0xa9, 0x55, 0x99, 0x97, 0xd0,
-		0x32, 0xe4, 0x6c, 0x47, 0x75, 0x7a, 0x2c, 0xf4, 0x1e, 0x6d, 0xbc, 0x2b, 0x61, 0x9f, 0x1b, 0xa6,
-		0xd1, 0x5c, 0xa7, 0x8c, 0x32, 0x07, 0x40, 0xe7, 0x21, 0xe7, 0x98, 0xbb, 0xd8, 0xe0, 0x42, 0x3d,
-		0xd4, 0x4a, 0xa7, 0x89, 0x3a, 0xc6, 0x8d, 0x1c, 0xf0, 0x8c, 0xb2, 0x62, 0xef, 0xa8, 0x16, 0xb6,
-		0x59, 0xa8, 0xdc, 0x58, 0x3e, 0xf4, 0x72, 0x3c, 0x16, 0xf6, 0x14, 0x0c, 0x4f, 0x92, 0x27, 0xdc,
-		0xaa, 0x75, 0x5a, 0x13, 0x8e, 0x9c, 0xc7, 0x6e, 0x28, 0x72, 0x3e, 0x0f, 0x95, 0x9e, 0xb1, 0x65,
-		0x1a, 0xf4, 0xb3, 0x88, 0x3c, 0x4d, 0x93, 0x3f, 0x99, 0x9a, 0xcd, 0xf8, 0x67, 0x2b, 0x4c, 0x21,
-		0xc9, 0x13, 0x6e, 0x15, 0xbf, 0xfd, 0xd8, 0x84, 0xb2, 0x47, 0x45, 0x97, 0x6c, 0x21, 0x76, 0xc9,
-		0xde, 0xce, 0x97, 0xec, 0x91, 0x70, 0x2b, 0xde, 0xaa, 0x1d, 0x77, 0x2b, 0x09, 0x1b, 0x7a, 0x5f,
-		0x60, 0x1b, 0x09, 0xbc, 0x85, 0x81, 0x56, 0x26, 0xf9, 0x0e, 0xb2, 0xf8, 0xb6, 0xec, 0x20, 0xeb,
-		0xa5, 0x8f, 0xbe, 0x52, 0x1b, 0x71, 0x17, 0xec, 0x2f, 0xa5, 0x21, 0xb7, 0x78, 0x85, 0xbe, 0x46,
-		0xf9, 0x13, 0x1a, 0x3e, 0xf8, 0xac, 0xd7, 0x7b, 0x61, 0x8c, 0xc9, 0xc2, 0x46, 0x67, 0x61, 0xb4,
-		0x4b, 0x7e, 0xf0, 0x5c, 0xe3, 0xd1, 0x3e, 0x95, 0xa6, 0x74, 0x62, 0x87, 0x49, 0x49, 0xa5, 0x2f,
-		0x64, 0x00, 0x16, 0xaf, 0x5c, 0xd9, 0xb0, 0xf4, 0x6e, 0x1b, 0x3b, 0x3f, 0x0d, 0xaf, 0xdf, 0x39,
-		0xe1, 0xb5, 0x6f, 0x8e, 0x9f, 0x82, 0xa2, 0x37, 0x47, 0x36, 0x7a, 0x0c, 0xf2, 0x0e, 0xff, 0xcd,
-		0xa7, 0xba, 0xda, 0x3f, 0xd5, 0x82, 0x9c, 0x4f, 0xb7, 0xcb, 0x21, 0xfd, 0x97, 0x34, 0x40, 0x5c,
-		0x72, 0xe6, 0x27, 0x20, 0x00, 0x3f, 0x0f, 0x39, 0xee, 0x71, 0x32, 0x37, 0x14, 0xad, 0x72, 0x6e,
-		0xdf, 0x2c, 0x7d, 0x27, 0x0d, 0x53, 0x9b, 0xc2, 0xec, 0xfe, 0x54, 0xc2, 0xe8, 0x22, 0x8c, 0x61,
-		0xc3, 0xb1, 0x74, 0x2c, 0xd2, 0xe0, 0xb3, 0x61, 0x2d, 0x8d, 0x90, 0x16, 0xfd, 0x77, 0x09, 0xe2,
-		0xb6, 0x1c, 0x67, 0xf7, 0xc9, 0xf8, 0x13, 0x19, 0x98, 0x19, 0xc4, 0x85, 0x16, 0x60, 0x42, 0xb3,
-		0x30, 0xad, 0x50, 0xfc, 0x27, 0x27, 0x8d, 0xaa, 0x2f, 0x63, 0x14, 0x24, 0x90, 0xe4, 0xb2, 0xa8,
-		0xe1, 0x0e, 0xb9, 0x45, 0x13, 0x54, 0x64, 0xa9, 0x10, 0xaa, 0x84, 0x41, 0xb4, 0xc4, 0x3d, 0xb2,
-		0x97, 0x96, 0xf2, 0x03, 0x30, 0x97, 0x5c, 0xf6, 0x6a, 0xa9, 0x4f, 0x7e, 0x01, 0x26, 0x74, 0x43,
-		0x77, 0x74, 0xb5, 0xad, 0x6c, 0xa9, 0x6d, 0xd5, 0xd0, 0x6e, 0x64, 0x2b, 0xc2, 0xbc, 0x29, 0x6f,
-		0x36, 0x04, 0x27, 0xc9, 0x65, 0x5e, 0xd3, 0x60, 0x15, 0x64, 0x46, 0x44, 0x53, 0xd9, 0x1b, 0x0a,
-		0xdc, 0x04, 0xbb, 0x6f, 0x46, 0x7e, 0x39, 0x03, 0x93, 0x6e, 0x7e, 0xe6, 0xa7, 0x53, 0x91, 0x74,
-		0x2a, 0x56, 0x01, 0x98, 0x01, 0x21, 0x9e, 0xe3, 0x06, 0x66, 0x83, 0x98, 0xa0, 0x02, 0x43, 0x58,
-		0xb4, 0x1d, 0xdf, 0x7c, 0xfc, 0x45, 0x06, 0x4a, 0xfe, 0xf9, 0xf8, 0xa9, 0x4b, 0x7f, 0x07, 0x65,
-		0xcc, 0xe6, 0x3d, 0x93, 0x98, 0xe5, 0xdf, 0x45, 0x09, 0x99, 0xc4, 0xbe, 0xa5, 0x34, 0xd8, 0x16,
-		0xfe, 0x55, 0x1a, 0x72, 0xfc, 0x5e, 0xb6, 0xd6, 0xb7, 0x8b, 0x48, 0xc5, 0xdd, 0xfb, 0x1e, 0xbe,
-		0x89, 0x78, 0x39, 0x72, 0x13, 0x51, 0xee, 0xa8, 0x7b, 0x4a, 0xe0, 0x2d, 0xa6, 0xd4, 0xec, 0x78,
-		0xe3, 0xb8, 0x87, 0x12, 0x7c, 0xce, 0x72, 0x21, 0xde, 0x9d, 0x5c, 0xf4, 0x08, 0x14, 0x09, 0x85,
-		0xe7, 0x15, 0x08, 0xfb, 0x51, 0x2f, 0xf9, 0xe0, 0x7b, 0x28, 0xc9, 0xd0, 0x51, 0xf7, 0x96, 0x58,
-		0x01, 0xad, 0x00, 0xda, 0x71, 0x53, 0x5f, 0x8a, 0x27, 0x42, 0xc2, 0x7f, 0xdb, 0xf5, 0x83, 0xda,
-		0x71, 0xc6, 0xdf, 0x4f, 0x23, 0xc9, 0x93, 0x5e, 0xa5, 0x40, 0x7b, 0x10, 0x80, 0x8c, 0x4b, 0x61,
-		0x77, 0x42, 0xd8, 0x16, 0xd6, 0x77, 0x51, 0xc2, 0x7b, 0x26, 0xc9, 0x05, 0x52, 0x58, 0x24, 0xbf,
-		0x7d, 0x82, 0xff, 0x95, 0x14, 0x20, 0xcf, 0xf7, 0xb8, 0xe7, 0xf5, 0xef, 0xa3, 0xef, 0x25, 0x8a,
-		0x9d, 0x51, 0x2a, 0x7a, 0x93, 0xe5, 0xf1, 0x89, 0x4d, 0x96, 0x6f, 0xa9, 0xde, 0xe7, 0xd9, 0xe7,
-		0xf4, 0xc0, 0xac, 0x60, 0x84, 0x0d, 0xfe, 0xd7, 0x29, 0x38, 0xde, 0xa7, 0x38, 0x6e, 0xbf, 0xae,
-		0x00, 0xb2, 0x7c, 0x0f, 0xf9, 0x7f, 0x28, 0x4a, 0xf1, 0xff, 0xf8, 0x97, 0x50, 0xff, 0x26, 0xad,
-		0x3e, 0x1b, 0x7f, 0xf3, 0xbc, 0x09, 0xcf, 0x28, 0xa6, 0x60, 0xda, 0xdf, 0xbc, 0x3b, 0x80, 0xf3,
-		0x50, 0xf2, 0xb7, 0xce, 0xbb, 0x7e, 0xeb, 0xb0, 0xae, 0xf3, 0x5e, 0x07, 0xf8, 0xd0, 0xb2, 0xb7,
-		0xfa, 0xc4, 0x3f, 0x9d, 0x8c, 0x1b, 0xbd, 0x7b, 0x91, 0x2d, 0xb4, 0x0a, 0x59, 0x8f, 0xff, 0x5f,
-		0x0a, 0xb2, 0x6b, 0xa6, 0xd9, 0x46, 0x26, 0x4c, 0x1a, 0xa6, 0xa3, 0x10, 0x65, 0xc1, 0x4d, 0x85,
-		0xe7, 0x46, 0x58, 0x16, 0x74, 0xe1, 0x70, 0x42, 0xf9, 0xfe, 0x41, 0xad, 0x1f, 0x4a, 0x9e, 0x30,
-		0x4c, 0xa7, 0x41, 0x6b, 0x36, 0x58, 0xe6, 0xe4, 0x83, 0x30, 0x1e, 0x6c, 0x8c, 0x65, 0x8a, 0x9e,
-		0x39, 0x74, 0x63, 0x41, 0x98, 0xeb, 0x07, 0xb5, 0x69, 0x6f, 0x11, 0xb8, 0xd5, 0x92, 0x5c, 0xda,
-		0xf2, 0xb5, 0x5e, 0xcf, 0x93, 0xd1, 0xff, 0xe0, 0x95, 0x5a, 0xaa, 0x71, 0x7e, 0xe0, 0x0d, 0x80,
-		0xfb, 0x86, 0x76, 0x61, 0xcf, 0x3d, 0xea, 0x0f, 0xde, 0x05, 0xf8, 0x52, 0x1a, 0x6e, 0xe3, 0xd4,
-		0xf4, 0xfd, 0xe3, 0x33, 0x5d, 0xb5, 0xa5, 0x1b, 0xfe, 0xcf, 0xef, 0x94, 0xf8, 0x94, 0xd1, 0xc7,
-		0x92, 0x01, 0xc5, 0x35, 0xb5, 0x85, 0xc5, 0x87, 0x5c, 0xfa, 0xbf, 0xaa, 0x74, 0x14, 0x72, 0xe6,
-		0xf6, 0x36, 0xcb, 0x72, 0xa7, 0x66, 0xb3, 0x32, 0x2f, 0xa1, 0x69, 0x18, 0x6d, 0xeb, 0x1d, 0xdd,
-		0xe1, 0x2f, 0xaf, 0xb2, 0x02, 0xaa, 0x41, 0x51, 0x33, 0x7b, 0x86, 0xa3, 0xb0, 0x6b, 0xec, 0x59,
-		0xf1, 0x4d, 0xdf, 0x9e, 0xe1, 0x6c, 0x90, 0x1a, 0xe9, 0x09, 0x28, 0xb1, 0xf6, 0xb8, 0x6a, 0x1e,
-		0x87, 0x3c, 0x7d, 0x27, 0xc1, 0x6b, 0x75, 0x8c, 0x94, 0xf9, 0xa5, 0x72, 0x86, 0xc2, 0x1a, 0x66,
-		0x85, 0x46, 0x63, 0xa0, 0xc0, 0x66, 0xe3, 0xe7, 0x8c, 0xc9, 0xc4, 0x15, 0xd6, 0xf7, 0xce, 0x40,
-		0x35, 0x74, 0x71, 0x82, 0x12, 0x0c, 0xb8, 0x36, 0x31, 0x5c, 0xb0, 0x03, 0x6e, 0x55, 0x0c, 0xbd,
-		0x99, 0x21, 0xed, 0xc2, 0x51, 0x7a, 0xef, 0xd5, 0xb3, 0xf1, 0x62, 0x26, 0x8e, 0xba, 0xd9, 0xc6,
-		0x14, 0xff, 0xe7, 0xea, 0x2c, 0x75, 0xf8, 0x28, 0x80, 0xd7, 0xb2, 0xfb, 0x76, 0x92, 0x7f, 0x4e,
-		0xe7, 0x7c, 0x13, 0x2a, 0xfb, 0x88, 0xa5, 0x5f, 0x4f, 0xc1, 0xb1, 0xbe, 0xd6, 0xf8, 0x3c, 0x3c,
-		0x11, 0x78, 0xbb, 0x36, 0x95, 0xec, 0x40, 0xc3, 0xff, 0x99, 0x8c, 0x7a, 0x44, 0xbf, 0xaa, 0x51,
-		0xfd, 0x62, 0x0d, 0x06, 0x3a, 0xf6, 0x02, 0x1c, 0x09, 0xf6, 0x4b, 0x08, 0xe1, 0x59, 0x28, 0x07,
-		0xb7, 0x56, 0x3c, 0xee, 0x7a, 0xd7, 0xe1, 0xc3, 0x89, 0xf1, 0xc0, 0xf6, 0x4a, 0x7a, 0x26, 0x2c,
-		0x78, 0x57, 0x12, 0xef, 0xed, 0x7f, 0x53, 0x21, 0x56, 0x10, 0xbe, 0x17, 0xd9, 0xbe, 0x9a, 0x82,
-		0x93, 0x41, 0x64, 0xcf, 0x63, 0xd9, 0x6f, 0xf9, 0xb8, 0xde, 0x8c, 0x7a, 0xfc, 0xa7, 0x14, 0xdc,
-		0x3e, 0xa4, 0xe7, 0x5c, 0x3c, 0x16, 0x4c, 0xfb, 0x5c, 0xa1, 0xc5, 0xab, 0x85, 0xca, 0x48, 0x83,
-		0xdd, 0xb5, 0xeb, 0x09, 0x6e, 0xe1, 0xf7, 0xb6, 0xa6, 0xfa, 0x9f, 0xd9, 0xf2, 0x54, 0xbf, 0xfb,
-		0x7a, 0x73, 0xba, 0xf5, 0x8d, 0x14, 0x9c, 0x0e, 0x8e, 0x2a, 0x62, 0xfb, 0xfb, 0xce, 0x9e, 0x98,
-		0x7f, 0x93, 0x82, 0x7b, 0x92, 0x0c, 0x81, 0xcf, 0xd0, 0x73, 0x30, 0xe5, 0x05, 0xa3, 0xe1, 0x09,
-		0x3a, 0x95, 0x20, 0x85, 0xc0, 0x95, 0x1a, 0xb9, 0x28, 0x37, 0x67, 0x26, 0xfe, 0x38, 0xc5, 0xd7,
-		0x9c, 0x7f, 0xde, 0x5d, 0xb1, 0x07, 0xf7, 0x4f, 0x87, 0x14, 0xbb, 0x6f, 0x0f, 0x35, 0x1e, 0xd8,
-		0x43, 0x45, 0x4c, 0x68, 0xfa, 0x26, 0x59, 0x90, 0x0f, 0x0b, 0x6b, 0x1a, 0x11, 0xc9, 0xee, 0xc2,
-		0x54, 0xc4, 0x22, 0x71, 0x5f, 0xcf, 0x8d, 0x5f, 0x23, 0x47, 0x7f, 0x78, 0x50, 0x8b, 0x08, 0x91,
-		0x65, 0xd4, 0xbf, 0x3c, 0xa4, 0xff, 0x9c, 0x82, 0x1a, 0xed, 0x48, 0xc4, 0x54, 0xfe, 0x4d, 0x16,
-		0x30, 0xe6, 0x86, 0x34, 0x72, 0x58, 0x5c, 0xd0, 0xf3, 0x90, 0x63, 0x5a, 0xca, 0x65, 0x7b, 0x08,
-		0xf5, 0xe6, 0x8c, 0x9e, 0xc1, 0x5e, 0x14, 0xe3, 0x8a, 0xb6, 0x0b, 0x6f, 0x91, 0xfc, 0xde, 0x84,
-		0x5d, 0xf8, 0x57, 0xc2, 0x60, 0x47, 0xf7, 0x9c, 0x8b, 0xe8, 0x03, 0x6f, 0xda, 0x60, 0xf3, 0xcf,
-		0x2e, 0xbd, 0x65, 0x96, 0xd9, 0xed, 0x7e, 0x8c, 0x65, 0x7e, 0xe7, 0xcd, 0x80, 0x6b, 0x99, 0x63,
-		0x86, 0xf0, 0x0e, 0xb7, 0xcc, 0xd7, 0xd3, 0x70, 0x9c, 0x0e, 0xc3, 0xbf, 0x7d, 0x7b, 0x1b, 0x24,
-		0xaf, 0x00, 0xb2, 0x2d, 0x4d, 0xb9, 0x59, 0xf6, 0xa3, 0x62, 0x5b, 0xda, 0x95, 0x80, 0xd3, 0x55,
-		0x00, 0x35, 0x6d, 0x27, 0xdc, 0x40, 0xe6, 0x86, 0x1b, 0x68, 0xda, 0xce, 0x95, 0x21, 0x5e, 0x3d,
-		0x7b, 0x18, 0xdd, 0xf9, 0xc3, 0x14, 0x54, 0xa3, 0x84, 0xce, 0x75, 0x45, 0x85, 0xa3, 0x81, 0xa4,
-		0x43, 0x58, 0x5d, 0xee, 0x18, 0xb6, 0xf5, 0x0e, 0x2d, 0xdd, 0x23, 0x16, 0xbe, 0xd9, 0x8b, 0xf7,
-		0x2b, 0xc2, 0xe9, 0xb8, 0x9a, 0xdf, 0xbf, 0x85, 0x79, 0x47, 0x2e, 0xd9, 0xdf, 0xea, 0x33, 0xf7,
-		0xef, 0xb4, 0xdd, 0xd0, 0x9f, 0xa4, 0xe0, 0xc4, 0x80, 0x1e, 0xfe, 0x4d, 0x76, 0xe7, 0x3f, 0x3f,
-		0x50, 0x61, 0x6e, 0xd6, 0xd6, 0xeb, 0x41, 0xbe, 0xa0, 0x82, 0x97, 0xfc, 0x7c, 0x1b, 0xea, 0xc8,
-		0x0f, 0xf2, 0x3d, 0x0d, 0xb7, 0x44, 0x72, 0xf1, 0x3e, 0x9d, 0x85, 0xec, 0x8e, 0x6e, 0x3b, 0xee,
-		0x57, 0x2a, 0x42, 0xdd, 0x09, 0x71, 0x51, 0x5a, 0x09, 0x41, 0x85, 0x42, 0xae, 0x99, 0x66, 0x9b,
-		0x37, 0x2f, 0x2d, 0xc0, 0xa4, 0xaf, 0x8e, 0x83, 0xcf, 0x41, 0xb6, 0x6b, 0x9a, 0x6d, 0x0e, 0x3e,
-		0x1d, 0x06, 0x27, 0xb4, 0x7c, 0x98, 0x94, 0x4e, 0x9a, 0x06, 0xc4, 0x40, 0xd8, 0xf7, 0x53, 0x38,
-		0xf4, 0x47, 0x52, 0x30, 0x15, 0xa8, 0x76, 0xdf, 0xf0, 0xca, 0x05, 0x3e, 0xbd, 0xd5, 0x77, 0x9f,
-		0x81, 0xd1, 0xbb, 0xef, 0xf2, 0xb3, 0x54, 0xf8, 0x9b, 0x50, 0xdd, 0xb3, 0x7f, 0x54, 0x12, 0xaf,
-		0x04, 0x2b, 0x00, 0xbe, 0xbc, 0xf5, 0x5d, 0xe1, 0x96, 0xa3, 0x93, 0x1e, 0xd5, 0xbb, 0x63, 0xe9,
-		0x78, 0xcc, 0x3b, 0x82, 0x7e, 0xc6, 0x7f, 0xe7, 0xec, 0xce, 0xe1, 0x7c, 0x02, 0xfe, 0xae, 0x38,
-		0x32, 0x17, 0xfd, 0x6f, 0xc1, 0x74, 0xd4, 0x2e, 0x18, 0x3d, 0x30, 0x1c, 0xa1, 0x3f, 0x6e, 0xa9,
-		0xbe, 0xeb, 0x10, 0x1c, 0x6e, 0xf3, 0x2f, 0xa7, 0xe0, 0xb6, 0xa1, 0x9b, 0x3d, 0xf4, 0xe8, 0x70,
-		0xd8, 0x21, 0x91, 0x54, 0xb5, 0x7e, 0x23, 0xac, 0x6e, 0xd7, 0x94, 0xc0, 0xe5, 0x87, 0x68, 0x89,
-		0xf6, 0xed, 0x3f, 0x06, 0x4c, 0x6c, 0x7f, 0xac, 0x29, 0x8d, 0xa0, 0x17, 0xa3, 0x2f, 0x01, 0x9c,
-		0x89, 0x44, 0x18, 0xbc, 0xe5, 0xa9, 0x3e, 0x90, 0x9c, 0xc1, 0x3f, 0xed, 0x51, 0xb1, 0xf4, 0x80,
-		0x69, 0x1f, 0xb2, 0x61, 0x18, 0x30, 0xed, 0xc3, 0x02, 0x75, 0x3e, 0xed, 0x43, 0x23, 0xc9, 0x01,
-		0xd3, 0x9e, 0x24, 0x80, 0x1e, 0x30, 0xed, 0x89, 0x02, 0x57, 0x69, 0x04, 0xed, 0xc0, 0x78, 0x20,
-		0x4e, 0x41, 0xa7, 0x23, 0xe1, 0xa2, 0x02, 0xc8, 0xea, 0x3d, 0x49, 0x48, 0xfd, 0xf3, 0x1f, 0xe1,
-		0x9a, 0x07, 0xcc, 0xff, 0xe0, 0xe8, 0xa3, 0xfa, 0x40, 0x72, 0x06, 0xb7, 0xed, 0x6b, 0xee, 0xb9,
-		0x94, 0x8f, 0x00, 0xcd, 0x25, 0x44, 0x12, 0x2d, 0x9f, 0x49, 0x4c, 0xef, 0x36, 0xbc, 0xdb, 0x77,
-		0x35, 0x3d, 0x5a, 0x68, 0x91, 0xae, 0xad, 0x7a, 0x6f, 0x22, 0x5a, 0xb7, 0xb1, 0x55, 0x7e, 0xe8,
-		0x72, 0x32, 0x92, 0xcd, 0xe7, 0xb4, 0xaa, 0xb7, 0x0f, 0xa1, 0x70, 0xe1, 0xd6, 0xdd, 0x53, 0x54,
-		0x29, 0x9a, 0xdc, 0xef, 0xac, 0xaa, 0xa7, 0x86, 0xd2, 0x08, 0xd0, 0x9b, 0x7e, 0x2e, 0xf2, 0xe5,
-		0x7c, 0xdf, 0x3b, 0x92, 0x2d, 0x6c, 0x60, 0x5b, 0xb7, 0x0f, 0xf5, 0x8e, 0xe4, 0xf0, 0x6c, 0xfe,
-		0x87, 0x73, 0x50, 0xba, 0xc0, 0x50, 0xe9, 0x87, 0xa1, 0xd1, 0xe3, 0x09, 0x3d, 0x70, 0x99, 0x78,
-		0xe0, 0x1f, 0x1e, 0xd4, 0xb8, 0x20, 0x5d, 0x5f, 0x6c, 0xf3, 0x2f, 0x44, 0xb1, 0xaf, 0xbb, 0x78,
-		0x9f, 0xd8, 0x29, 0x1d, 0xea, 0x7e, 0x2f, 0xbb, 0x58, 0xc1, 0xaf, 0xd4, 0x86, 0xf1, 0x24, 0xf6,
-		0xb1, 0x29, 0x7a, 0x3a, 0x43, 0xbf, 0x0f, 0x83, 0x3e, 0x95, 0x82, 0x23, 0x94, 0xca, 0x0b, 0x04,
-		0x29, 0xa5, 0xb8, 0x85, 0xd4, 0x37, 0xcb, 0x2b, 0xaa, 0x6f, 0x5b, 0x44, 0x31, 0x1a, 0x75, 0x7e,
-		0x38, 0x7e, 0xab, 0xaf, 0xd1, 0x30, 0x9c, 0xf4, 0xc3, 0x83, 0x1a, 0xea, 0xe7, 0x95, 0xe9, 0xd7,
-		0x4c, 0x83, 0x75, 0xd1, 0xdf, 0xf4, 0x1e, 0x12, 0x1b, 0x4e, 0x72, 0x81, 0x7a, 0x31, 0x42, 0x20,
-		0x3c, 0x5f, 0x83, 0xa2, 0xcf, 0xf8, 0xcc, 0x8c, 0x0e, 0xb8, 0x03, 0xe8, 0xed, 0xbb, 0x11, 0xc7,
-		0xf3, 0xf9, 0x3e, 0xd9, 0x0f, 0x81, 0x7e, 0x2d, 0x05, 0x47, 0xbc, 0xbd, 0xbd, 0x1f, 0x3c, 0x97,
-		0x7c, 0x77, 0x7f, 0x2e, 0x28, 0xb5, 0x48, 0x3c, 0x22, 0xb5, 0x28, 0x07, 0x29, 0x4f, 0xf7, 0xa2,
-		0x1c, 0xc6, 0xb3, 0x30, 0xee, 0xdf, 0xfc, 0x79, 0x5f, 0x7a, 0x1c, 0x76, 0xf6, 0x3b, 0xcd, 0x47,
-		0x1b, 0xb8, 0x07, 0x23, 0x07, 0x81, 0x50, 0x15, 0xf2, 0x78, 0xaf, 0x6b, 0x5a, 0x0e, 0x6e, 0xd2,
-		0x8b, 0xdb, 0x79, 0xd9, 0x2d, 0x4b, 0xd7, 0x20, 0x62, 0x62, 0xd1, 0x53, 0xa1, 0xcf, 0x54, 0xdd,
-		0xc8, 0xa6, 0xa2, 0xff, 0xcb, 0x56, 0xfe, 0x4f, 0x4e, 0xdd, 0x6c, 0xb3, 0xf1, 0xff, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0x93, 0x55, 0x11, 0xf3, 0xb7, 0x9b, 0x00, 0x00,
-	}
-	r := bytes.NewReader(gzipped)
-	gzipr, err := compress_gzip.NewReader(r)
-	if err != nil {
-		panic(err)
-	}
-	ungzipped, err := io_ioutil.ReadAll(gzipr)
-	if err != nil {
-		panic(err)
-	}
-	if err := github_com_gogo_protobuf_proto.Unmarshal(ungzipped, d); err != nil {
-		panic(err)
+func (m *Redelegation) GetValidatorDstAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorDstAddress
 	}
-	return d
+	return nil
 }
-func (this *MsgCreateValidator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgCreateValidator)
-	if !ok {
-		that2, ok := that.(MsgCreateValidator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Description.Equal(&that1.Description) {
-		return false
-	}
-	if !this.Commission.Equal(&that1.Commission) {
-		return false
-	}
-	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
-		return false
-	}
-	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
-		return false
-	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
-		return false
-	}
-	if this.Pubkey != that1.Pubkey {
-		return false
-	}
-	if !this.Value.Equal(&that1.Value) {
-		return false
+func (m *Redelegation) GetEntries() []RedelegationEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return true
+	return nil
 }
-func (this *MsgEditValidator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgEditValidator)
-	if !ok {
-		that2, ok := that.(MsgEditValidator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Description.Equal(&that1.Description) {
-		return false
-	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
-		return false
-	}
-	if that1.CommissionRate == nil {
-		if this.CommissionRate != nil {
-			return false
-		}
-	} else if !this.CommissionRate.Equal(*that1.CommissionRate) {
-		return false
-	}
-	if that1.MinSelfDelegation == nil {
-		if this.MinSelfDelegation != nil {
-			return false
-		}
-	} else if !this.MinSelfDelegation.Equal(*that1.MinSelfDelegation) {
-		return false
-	}
-	return true
+// Params defines the parameters for the staking module.
+type Params struct {
+	UnbondingTime     time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
+	MaxValidators     uint32        `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
+	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
+	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
+	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens
+	// that may be tokenized.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the shares of a
+	// validator that may be tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// key_rotation_fee is burned from the operator account when a validator
+	// rotates its consensus public key.
+	KeyRotationFee types.Coin `protobuf:"bytes,8,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// min_commission_rate is the minimum commission rate a validator can charge.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// max_voting_power_ratio is the maximum fraction of the bonded tokens a
+	// validator can reach through delegations and redelegations.
+	MaxVotingPowerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_voting_power_ratio,json=maxVotingPowerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_voting_power_ratio" yaml:"max_voting_power_ratio"`
 }
-func (this *MsgDelegate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgDelegate)
-	if !ok {
-		that2, ok := that.(MsgDelegate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{26}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
-		return false
-	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
-		return false
-	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *MsgBeginRedelegate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgBeginRedelegate)
-	if !ok {
-		that2, ok := that.(MsgBeginRedelegate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
-		return false
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
-		return false
+	return 0
+}
+
+func (m *Params) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
 	}
-	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
-		return false
+	return 0
+}
+
+func (m *Params) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
 	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
+	return 0
+}
+
+func (m *Params) GetHistoricalEntries() uint32 {
+	if m != nil {
+		return m.HistoricalEntries
 	}
-	return true
+	return 0
 }
-func (this *MsgUndelegate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Params) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgUndelegate)
-	if !ok {
-		that2, ok := that.(MsgUndelegate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Params) GetKeyRotationFee() types.Coin {
+	if m != nil {
+		return m.KeyRotationFee
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
-		return false
-	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
-		return false
-	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
-	}
-	return true
+	return types.Coin{}
 }
-func (this *HistoricalInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*HistoricalInfo)
-	if !ok {
-		that2, ok := that.(HistoricalInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DelegationResponse is equivalent to Delegation except that it contains a balance
+// in addition to shares which is more suitable for client responses.
+type DelegationResponse struct {
+	Delegation Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	Balance    types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
+func (*DelegationResponse) ProtoMessage() {}
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{27}
+}
+func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Header.Equal(&that1.Header) {
-		return false
-	}
-	if len(this.Valset) != len(that1.Valset) {
-		return false
-	}
-	for i := range this.Valset {
-		if !this.Valset[i].Equal(&that1.Valset[i]) {
-			return false
-		}
+}
+func (m *DelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationResponse.Merge(m, src)
+}
+func (m *DelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+
+func (m *DelegationResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	return true
+	return Delegation{}
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DelegationResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
+	return types.Coin{}
+}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
+// contains a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationEntryResponse struct {
+	RedelegationEntry RedelegationEntry                      `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry"`
+	Balance           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResponse{} }
+func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationEntryResponse) ProtoMessage()    {}
+func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{28}
+}
+func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
-	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
-	}
-	return true
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RedelegationEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntryResponse.Merge(m, src)
+}
+func (m *RedelegationEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationEntryResponse proto.InternalMessageInfo
+
+func (m *RedelegationEntryResponse) GetRedelegationEntry() RedelegationEntry {
+	if m != nil {
+		return m.RedelegationEntry
 	}
+	return RedelegationEntry{}
+}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RedelegationResponse is equivalent to a Redelegation except that its entries
+// contain a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationResponse struct {
+	Redelegation Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
+	Entries      []RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
+func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationResponse) ProtoMessage()    {}
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{29}
+}
+func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
-	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
+}
+func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+}
+func (m *RedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+
+func (m *RedelegationResponse) GetRedelegation() Redelegation {
+	if m != nil {
+		return m.Redelegation
 	}
-	return true
+	return Redelegation{}
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RedelegationResponse) GetEntries() []RedelegationEntryResponse {
+	if m != nil {
+		return m.Entries
 	}
+	return nil
+}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Pool - tracking bonded and not-bonded token supply of the bond denomination
+type Pool struct {
+	NotBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=not_bonded_tokens,json=notBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"not_bonded_tokens"`
+	BondedTokens    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{30}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.MsgCreateValidator")
+	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.MsgEditValidator")
+	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
+	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
+	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.MsgTokenizeShares")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgTransferTokenizeShareRecord)(nil), "cosmos.staking.MsgTransferTokenizeShareRecord")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.MsgRotateConsPubKey")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.staking.ConsPubKeyRotation")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.TokenizeShareRecord")
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.staking.Description")
+	proto.RegisterType((*Validator)(nil), "cosmos.staking.Validator")
+	proto.RegisterType((*DVPair)(nil), "cosmos.staking.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "cosmos.staking.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.Redelegation")
+	proto.RegisterType((*Params)(nil), "cosmos.staking.Params")
+	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "cosmos.staking.Pool")
+}
+
+func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
+
+var fileDescriptor_146003fcdb99b683 = []byte{
+	// 2411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcd, 0x6f, 0x5b, 0x59,
+	0xf5, 0x79, 0xb6, 0xf3, 0xe1, 0x93, 0xc4, 0x4e, 0x6e, 0xa6, 0x19, 0x27, 0xd3, 0xe6, 0xb5, 0x6f,
+	0xa4, 0x9f, 0xf2, 0x43, 0x33, 0x8e, 0x28, 0x23, 0x8d, 0x54, 0x40, 0x6a, 0x6d, 0x37, 0x24, 0x9a,
+	0x46, 0x0d, 0xaf, 0x6d, 0x46, 0x82, 0x91, 0x9e, 0x9e, 0xdf, 0xbb, 0x71, 0x1e, 0x79, 0x1f, 0x9e,
+	0x77, 0xaf, 0x9b, 0x64, 0x34, 0x4b, 0x10, 0x08, 0x51, 0x98, 0x15, 0x9a, 0x05, 0x8b, 0x8a, 0x7f,
+	0x80, 0x05, 0x0b, 0x60, 0x8d, 0x90, 0xca, 0x02, 0xa9, 0x62, 0x81, 0x10, 0x0b, 0x03, 0xed, 0x02,
+	0xc4, 0x6a, 0xe4, 0x25, 0x12, 0x02, 0xdd, 0x8f, 0xf7, 0xe1, 0x67, 0x67, 0x6a, 0x87, 0x4e, 0xa7,
+	0x12, 0xd9, 0x24, 0xbe, 0xe7, 0x9e, 0x73, 0xee, 0xf9, 0xbe, 0xf7, 0x1c, 0x1b, 0x2e, 0x5a, 0x01,
+	0xf1, 0x02, 0xb2, 0x41, 0xa8, 0x79, 0xe8, 0xf8, 0xad, 0xe8, 0x7f, 0xb5, 0x1d, 0x06, 0x34, 0x40,
+	0x25, 0xb1, 0x5b, 0x95, 0xd0, 0xd5, 0x57, 0x5a, 0x41, 0x2b, 0xe0, 0x5b, 0x1b, 0xec, 0x93, 0xc0,
+	0x5a, 0xbd, 0x42, 0xb1, 0x6f, 0xe3, 0xd0, 0x73, 0x7c, 0xba, 0x61, 0x36, 0x2d, 0x67, 0x83, 0x9e,
+	0xb4, 0x31, 0x11, 0x7f, 0x25, 0x8a, 0xda, 0x0a, 0x82, 0x96, 0x8b, 0x37, 0xf8, 0xaa, 0xd9, 0xd9,
+	0xdf, 0xa0, 0x8e, 0x87, 0x09, 0x35, 0xbd, 0xb6, 0x44, 0x58, 0xcb, 0x22, 0xd8, 0x9d, 0xd0, 0xa4,
+	0x4e, 0xe0, 0xcb, 0xfd, 0x25, 0x29, 0xa7, 0x14, 0x88, 0x03, 0xb5, 0x6e, 0x01, 0xd0, 0x0e, 0x69,
+	0xd5, 0x43, 0x6c, 0x52, 0xbc, 0x67, 0xba, 0x8e, 0x6d, 0xd2, 0x20, 0x44, 0x75, 0x98, 0xb5, 0x31,
+	0xb1, 0x42, 0xa7, 0xcd, 0x18, 0x54, 0x94, 0xcb, 0xca, 0xfa, 0xec, 0xd5, 0xd7, 0xaa, 0xfd, 0xba,
+	0x54, 0x1b, 0x09, 0x4a, 0xad, 0xf0, 0xa8, 0xab, 0x4e, 0xe8, 0x69, 0x2a, 0x74, 0x13, 0xc0, 0x0a,
+	0x3c, 0xcf, 0x21, 0x84, 0xf1, 0xc8, 0x71, 0x1e, 0x6a, 0x96, 0x47, 0x3d, 0xc6, 0xd0, 0x4d, 0x8a,
+	0x89, 0xe4, 0x93, 0x22, 0x44, 0x1f, 0xc2, 0x92, 0xe7, 0xf8, 0x06, 0xc1, 0xee, 0xbe, 0x61, 0x63,
+	0x17, 0xb7, 0xb8, 0x52, 0x95, 0xfc, 0x65, 0x65, 0xbd, 0x58, 0xbb, 0xc5, 0xd0, 0xff, 0xd4, 0x55,
+	0xff, 0xaf, 0xe5, 0xd0, 0x83, 0x4e, 0xb3, 0x6a, 0x05, 0xde, 0x46, 0x9f, 0x9e, 0x6f, 0x12, 0xfb,
+	0x50, 0xda, 0x71, 0xdb, 0xa7, 0xbd, 0xae, 0xba, 0x7a, 0x62, 0x7a, 0xee, 0x35, 0x6d, 0x08, 0x4b,
+	0x4d, 0x5f, 0xf4, 0x1c, 0xff, 0x0e, 0x76, 0xf7, 0x1b, 0x31, 0x0c, 0x7d, 0x00, 0x8b, 0x12, 0x23,
+	0x08, 0x0d, 0xd3, 0xb6, 0x43, 0x4c, 0x48, 0xa5, 0x70, 0x59, 0x59, 0x9f, 0xab, 0xed, 0xf4, 0xba,
+	0x6a, 0x45, 0x70, 0x1b, 0x40, 0xd1, 0xfe, 0xd9, 0x55, 0xdf, 0x1c, 0x41, 0xa6, 0x1b, 0x96, 0x75,
+	0x43, 0x50, 0xe8, 0x0b, 0x31, 0x13, 0x09, 0x61, 0x67, 0xdf, 0x8f, 0x5c, 0x12, 0x9f, 0x3d, 0x99,
+	0x3d, 0x7b, 0x00, 0x65, 0xd4, 0xb3, 0xf7, 0x4c, 0x37, 0x3e, 0x3b, 0x66, 0x12, 0x9d, 0xbd, 0x0c,
+	0x53, 0xed, 0x4e, 0xf3, 0x10, 0x9f, 0x54, 0xa6, 0x98, 0xa1, 0x75, 0xb9, 0x42, 0xeb, 0x30, 0x79,
+	0xdf, 0x74, 0x3b, 0xb8, 0x32, 0xcd, 0xfd, 0x39, 0x17, 0xf9, 0xb3, 0x1e, 0x38, 0x51, 0x10, 0x08,
+	0x84, 0x6b, 0x85, 0xbf, 0x3f, 0x54, 0x15, 0xed, 0x97, 0x79, 0x58, 0xd8, 0x21, 0xad, 0x9b, 0xb6,
+	0x43, 0x9f, 0x73, 0x78, 0xb5, 0x87, 0x59, 0x27, 0xc7, 0xad, 0x53, 0xef, 0x75, 0xd5, 0x92, 0xb0,
+	0xce, 0xf3, 0xb4, 0x89, 0x07, 0xe5, 0x24, 0x2e, 0x8d, 0xd0, 0xa4, 0x58, 0x46, 0x61, 0x63, 0xc4,
+	0x08, 0x6c, 0x60, 0xab, 0xd7, 0x55, 0x97, 0x85, 0x64, 0x19, 0x56, 0x9a, 0x5e, 0xb2, 0xfa, 0x72,
+	0x01, 0x1d, 0x0f, 0x0f, 0xfc, 0x02, 0x3f, 0x72, 0xeb, 0x33, 0x0c, 0x7a, 0xe9, 0xba, 0x5f, 0xe4,
+	0x60, 0x76, 0x87, 0xb4, 0x24, 0x1c, 0x0f, 0x4f, 0x05, 0xe5, 0x73, 0x4c, 0x85, 0xdc, 0x8b, 0x49,
+	0x85, 0x2f, 0xc0, 0x94, 0xe9, 0x05, 0x1d, 0x9f, 0x56, 0xf2, 0xa7, 0xc6, 0xbc, 0xc4, 0x90, 0x96,
+	0xfb, 0x7d, 0x9e, 0x57, 0xd5, 0x1a, 0x6e, 0x39, 0xbe, 0x8e, 0xed, 0x97, 0xc1, 0x80, 0xdf, 0x51,
+	0xe0, 0x42, 0x62, 0x1e, 0x12, 0x5a, 0x19, 0x2b, 0x7e, 0xbd, 0xd7, 0x55, 0x2f, 0x66, 0xad, 0x98,
+	0x42, 0x3b, 0x83, 0x25, 0x97, 0x62, 0x46, 0x77, 0x42, 0x6b, 0xb8, 0x1c, 0x36, 0xa1, 0xb1, 0x1c,
+	0xf9, 0xd3, 0xe5, 0x48, 0xa1, 0xfd, 0x57, 0x72, 0x34, 0x08, 0x1d, 0x74, 0x6a, 0x61, 0x44, 0xa7,
+	0xfe, 0x2a, 0x07, 0xf3, 0x3b, 0xa4, 0x75, 0xcf, 0xb7, 0xcf, 0x13, 0x62, 0xdc, 0x84, 0x78, 0x90,
+	0x87, 0x8b, 0xec, 0x99, 0x61, 0xfa, 0x16, 0x76, 0xef, 0xf9, 0xcd, 0xc0, 0xb7, 0x1d, 0xbf, 0xf5,
+	0xac, 0x6b, 0xf6, 0xdc, 0x94, 0x69, 0x53, 0xa2, 0x3a, 0x94, 0xad, 0x10, 0x73, 0x7b, 0x19, 0x07,
+	0xd8, 0x69, 0x1d, 0x88, 0xd8, 0xcd, 0xd7, 0x56, 0x53, 0x97, 0x4a, 0x3f, 0x02, 0xbb, 0x54, 0x24,
+	0x64, 0x8b, 0x03, 0xa4, 0x3f, 0x7e, 0x93, 0x87, 0xc5, 0x1d, 0xd2, 0xba, 0x1b, 0x1c, 0x62, 0xdf,
+	0xf9, 0x00, 0xdf, 0x39, 0x30, 0x43, 0x4c, 0xce, 0x9d, 0x30, 0x82, 0x13, 0x58, 0xfd, 0xa2, 0xd2,
+	0x6c, 0xb6, 0x41, 0x98, 0xe1, 0x8c, 0xe0, 0xc8, 0xc7, 0x61, 0xa5, 0x90, 0xad, 0x5f, 0x43, 0xd1,
+	0xce, 0x60, 0xac, 0xa5, 0x98, 0x11, 0xf7, 0xd3, 0x6d, 0xc6, 0x46, 0xfa, 0xf1, 0x91, 0x02, 0x95,
+	0x1d, 0xd2, 0x62, 0x77, 0x0c, 0xf6, 0xb8, 0x37, 0xc9, 0x66, 0x10, 0xbe, 0x04, 0xee, 0x4c, 0x4c,
+	0x9a, 0x1b, 0xb1, 0x44, 0xfc, 0x3c, 0x07, 0x6b, 0x2c, 0x24, 0x43, 0xd3, 0x27, 0xfb, 0x38, 0xec,
+	0x0b, 0x4d, 0x1d, 0x5b, 0x41, 0x68, 0xa3, 0xf7, 0xa0, 0x12, 0x99, 0x42, 0x9a, 0x34, 0xe4, 0x1b,
+	0x86, 0x63, 0x73, 0xbd, 0x0a, 0xb5, 0xd7, 0x7b, 0x5d, 0x55, 0xed, 0xb7, 0x7e, 0x16, 0x53, 0xd3,
+	0x2f, 0xd0, 0x41, 0xde, 0xdb, 0x36, 0xda, 0x86, 0x29, 0xc2, 0xbb, 0x30, 0x19, 0x76, 0x5f, 0x1c,
+	0xdf, 0x0e, 0x92, 0x01, 0x6a, 0x42, 0xd1, 0xc7, 0x47, 0x32, 0x2e, 0xc4, 0xbd, 0x76, 0xb3, 0xd7,
+	0x55, 0x17, 0x84, 0x64, 0xf1, 0xd6, 0x19, 0x2c, 0x3d, 0xe3, 0xe3, 0xa3, 0x74, 0x00, 0xfc, 0x4e,
+	0x81, 0x25, 0x16, 0x00, 0x01, 0x35, 0x29, 0xae, 0x07, 0x3e, 0xd9, 0xed, 0x34, 0xdf, 0xc1, 0x27,
+	0xc3, 0xd3, 0x49, 0x79, 0x31, 0xe9, 0xf4, 0x16, 0x00, 0x53, 0x51, 0xb6, 0x0f, 0x39, 0xfe, 0x5c,
+	0xbd, 0xd0, 0xeb, 0xaa, 0x8b, 0x89, 0xfa, 0x62, 0x4f, 0xd3, 0x99, 0x99, 0x76, 0xf9, 0x67, 0xa9,
+	0xcf, 0x4f, 0xf2, 0x80, 0x12, 0x35, 0xb8, 0x5a, 0xec, 0x7a, 0x38, 0x82, 0x85, 0xa0, 0x8d, 0xc3,
+	0x21, 0xda, 0xdc, 0xea, 0x75, 0xd5, 0x57, 0x05, 0xe3, 0x2c, 0xc6, 0x19, 0x94, 0x29, 0x47, 0x3c,
+	0x22, 0x5d, 0x6a, 0x50, 0x0e, 0x5c, 0xdb, 0xb0, 0x02, 0x9f, 0xf4, 0x2b, 0x94, 0xaa, 0xb9, 0x19,
+	0x04, 0x4d, 0x9f, 0x0f, 0x5c, 0x5b, 0x2a, 0xc1, 0x5a, 0xa6, 0x1a, 0x94, 0x99, 0xce, 0x69, 0x1e,
+	0xf9, 0x2c, 0x8f, 0x0c, 0x82, 0xa6, 0xcf, 0xfb, 0xf8, 0x28, 0xc5, 0x63, 0x19, 0xa6, 0xd2, 0x25,
+	0x5f, 0x97, 0x2b, 0xd4, 0xe2, 0x2d, 0x49, 0xdb, 0xc5, 0xbc, 0xe8, 0x53, 0xc7, 0xc3, 0xbc, 0x41,
+	0x9c, 0xbd, 0xba, 0x5a, 0x15, 0xe3, 0x80, 0x6a, 0x34, 0x0e, 0xa8, 0xde, 0x8d, 0xe6, 0x05, 0x35,
+	0x8d, 0xa5, 0x5f, 0x5f, 0x23, 0x92, 0x66, 0xa0, 0x7d, 0xf4, 0x67, 0x55, 0xd1, 0x4b, 0x09, 0x94,
+	0x11, 0x4a, 0xf7, 0x7c, 0x37, 0x07, 0x4b, 0xc3, 0x32, 0xb3, 0x04, 0xb9, 0x28, 0x07, 0xf5, 0x9c,
+	0x63, 0xa3, 0xaf, 0xc1, 0xa4, 0x08, 0xfe, 0x33, 0xa7, 0x92, 0xa0, 0x47, 0xd7, 0xa1, 0xe4, 0x05,
+	0x76, 0xc7, 0xc5, 0x86, 0x69, 0x59, 0x71, 0x89, 0x2e, 0xd6, 0x56, 0x7a, 0x5d, 0xf5, 0x82, 0x6c,
+	0x6a, 0xfa, 0xf6, 0x35, 0x7d, 0x5e, 0x00, 0x6e, 0x88, 0x35, 0xba, 0x0d, 0xc5, 0x38, 0x42, 0x2b,
+	0x85, 0xb1, 0xc4, 0x49, 0x05, 0x46, 0xc2, 0x43, 0x5a, 0xe2, 0x07, 0x0a, 0x94, 0xb6, 0x1c, 0x42,
+	0x83, 0xd0, 0xb1, 0x4c, 0x77, 0xdb, 0xdf, 0x0f, 0xd0, 0x97, 0x99, 0x8f, 0x4c, 0x56, 0x40, 0x44,
+	0x43, 0x7b, 0xa9, 0x9a, 0x4c, 0x75, 0xaa, 0x6c, 0xaa, 0x53, 0x15, 0x6c, 0xb7, 0x38, 0x52, 0x54,
+	0x04, 0x05, 0x09, 0x7a, 0x1b, 0xa6, 0xee, 0x9b, 0x2e, 0xc1, 0xac, 0x60, 0xe6, 0xd7, 0x67, 0xaf,
+	0xae, 0x64, 0xbb, 0xe1, 0xb8, 0x7b, 0x8e, 0x08, 0x05, 0xba, 0x14, 0xe7, 0x67, 0x39, 0x28, 0x67,
+	0x46, 0x29, 0xa8, 0x06, 0x05, 0xde, 0xa3, 0x2a, 0xdc, 0x62, 0xd5, 0x31, 0x26, 0x25, 0x0d, 0x6c,
+	0xe9, 0x9c, 0x16, 0xbd, 0x07, 0x33, 0x9e, 0x79, 0x2c, 0x7a, 0x5d, 0x11, 0xf8, 0x37, 0xc6, 0xe3,
+	0xd3, 0xeb, 0xaa, 0x65, 0xe9, 0x27, 0xc9, 0x47, 0xd3, 0xa7, 0x3d, 0xf3, 0x98, 0x77, 0xb8, 0x6d,
+	0x28, 0x33, 0xa8, 0x75, 0x60, 0xfa, 0x2d, 0x9c, 0x6e, 0xa8, 0xb7, 0xc6, 0x3e, 0x64, 0x39, 0x39,
+	0x24, 0xc5, 0x8e, 0x45, 0x83, 0x79, 0x5c, 0xe7, 0x00, 0x76, 0xe2, 0xb5, 0x99, 0x8f, 0x1f, 0xaa,
+	0x13, 0xdc, 0x62, 0xbf, 0x55, 0x00, 0x12, 0x8b, 0xa1, 0xbb, 0xb0, 0x90, 0x69, 0xc8, 0x49, 0x45,
+	0x19, 0x6d, 0x64, 0x35, 0xc3, 0x84, 0x7d, 0xdc, 0x55, 0x15, 0xbd, 0x6c, 0x65, 0x5c, 0xf0, 0x4d,
+	0x98, 0xed, 0xb4, 0x6d, 0x93, 0x62, 0x91, 0x9a, 0xb9, 0x67, 0xa6, 0xe6, 0x9a, 0x4c, 0x4d, 0x24,
+	0xd4, 0x49, 0x11, 0x8b, 0xb4, 0x04, 0x01, 0xe1, 0x29, 0xd9, 0xa7, 0xcb, 0x6c, 0x6a, 0x5a, 0x82,
+	0x2a, 0x30, 0xed, 0x05, 0xbe, 0x73, 0x28, 0x43, 0xb1, 0xa8, 0x47, 0x4b, 0xb4, 0x0a, 0x33, 0x8e,
+	0x8d, 0x7d, 0xea, 0x50, 0x59, 0xc8, 0xf4, 0x78, 0xcd, 0xa8, 0x8e, 0x70, 0x93, 0x38, 0x91, 0x17,
+	0xf4, 0x68, 0x89, 0x36, 0x61, 0x81, 0x60, 0xab, 0x13, 0x3a, 0xf4, 0x84, 0x55, 0x29, 0x6a, 0x5a,
+	0x54, 0x8e, 0x21, 0x5e, 0x4b, 0xca, 0x6f, 0x16, 0x43, 0xd3, 0xcb, 0x11, 0xa8, 0x2e, 0x20, 0xec,
+	0x04, 0x1b, 0x53, 0xd3, 0x71, 0xc5, 0x18, 0xab, 0xa8, 0x47, 0xcb, 0x94, 0x2e, 0x9f, 0x4c, 0x43,
+	0x31, 0x99, 0x14, 0x7d, 0x6e, 0x85, 0x7f, 0x93, 0xc5, 0x83, 0x4f, 0xb0, 0x4f, 0x3a, 0x99, 0xca,
+	0x9f, 0x52, 0x39, 0x8b, 0xa1, 0xe9, 0xe5, 0x18, 0x94, 0x14, 0xee, 0x6f, 0x99, 0x8e, 0x8b, 0x6d,
+	0x6e, 0xd3, 0x19, 0x5d, 0xae, 0xf8, 0x6b, 0x83, 0x9a, 0xb4, 0x23, 0x86, 0x89, 0x93, 0x23, 0xd7,
+	0xa4, 0x5a, 0xe0, 0xdb, 0x77, 0x38, 0xa1, 0x2e, 0x19, 0xa0, 0x4d, 0x98, 0xe2, 0x2f, 0x1a, 0x69,
+	0xd4, 0xb1, 0x32, 0x7d, 0xdb, 0xa7, 0xba, 0xa4, 0x46, 0x14, 0x92, 0x77, 0x9c, 0x78, 0x35, 0x11,
+	0x31, 0xfc, 0xab, 0x6d, 0x8f, 0x9d, 0x8e, 0xaf, 0x66, 0x1f, 0x97, 0x82, 0x9f, 0xa6, 0x97, 0x63,
+	0x90, 0x7c, 0xa5, 0x66, 0x66, 0x81, 0xd3, 0x67, 0x9a, 0x05, 0x6e, 0xc2, 0x42, 0x27, 0xea, 0x2a,
+	0xa3, 0xde, 0x68, 0x86, 0xf7, 0x46, 0x29, 0x6f, 0x65, 0x31, 0x34, 0xbd, 0x1c, 0x83, 0x44, 0x77,
+	0x84, 0x6c, 0x28, 0x25, 0x58, 0x3c, 0x65, 0x8b, 0xcf, 0x4c, 0xd9, 0x2b, 0x32, 0x65, 0x2f, 0x64,
+	0x4f, 0x49, 0xb2, 0x76, 0x3e, 0x06, 0x32, 0x32, 0x74, 0xbd, 0x6f, 0x30, 0x0e, 0xf2, 0x84, 0x53,
+	0xab, 0xcc, 0xe8, 0x33, 0xf1, 0xd9, 0x17, 0x33, 0x13, 0xbf, 0x0e, 0x25, 0xc7, 0x37, 0x3c, 0xd3,
+	0xf1, 0x29, 0xf6, 0x59, 0x4b, 0x5f, 0x99, 0x63, 0xb1, 0x9d, 0xbe, 0x94, 0xfb, 0xf7, 0x35, 0x7d,
+	0xde, 0xf1, 0x77, 0x92, 0xf5, 0xb5, 0xb9, 0xef, 0x3d, 0x54, 0x27, 0xe2, 0x94, 0xff, 0x7e, 0x0e,
+	0xa6, 0x1a, 0x7b, 0xbb, 0xa6, 0x13, 0xfe, 0xaf, 0xb6, 0xa0, 0xa9, 0xfa, 0xf7, 0x55, 0x98, 0x16,
+	0xb6, 0x20, 0xe8, 0x2a, 0x4c, 0xb6, 0xd9, 0x87, 0x8a, 0xc2, 0x9f, 0x04, 0xcb, 0x03, 0x49, 0xc1,
+	0xf1, 0xa2, 0xa9, 0x3b, 0x47, 0xd5, 0x7e, 0x9a, 0x07, 0x68, 0xec, 0xed, 0xdd, 0x0d, 0x1d, 0xf6,
+	0x7a, 0x3b, 0x1f, 0x39, 0xbe, 0x3c, 0x23, 0xc7, 0x94, 0x8f, 0xdf, 0x81, 0xd9, 0xc4, 0x47, 0x04,
+	0x7d, 0x05, 0x66, 0xa8, 0xfc, 0x2c, 0x5d, 0xbd, 0x3a, 0xe8, 0xea, 0x08, 0x5d, 0xba, 0x3b, 0xa6,
+	0xd0, 0xfe, 0x90, 0x03, 0x38, 0x9f, 0xa4, 0xb1, 0x5b, 0x50, 0xde, 0x59, 0xf9, 0x33, 0xbd, 0x77,
+	0x25, 0x75, 0xca, 0x4b, 0x7f, 0xcd, 0xc1, 0xd2, 0xf9, 0xac, 0x32, 0x39, 0x7b, 0x0b, 0xa6, 0xb1,
+	0x4f, 0x43, 0x87, 0x9b, 0x98, 0x45, 0xe9, 0x7a, 0x36, 0x4a, 0x87, 0x58, 0xeb, 0xa6, 0x4f, 0xc3,
+	0x13, 0x19, 0xb3, 0x11, 0x79, 0xca, 0xc6, 0x3f, 0xca, 0x43, 0xe5, 0x34, 0xaa, 0x61, 0x03, 0x4f,
+	0x65, 0xdc, 0x81, 0xe7, 0xb0, 0x0e, 0x39, 0xf7, 0x59, 0x74, 0xc8, 0xe8, 0x7d, 0x28, 0x3b, 0xbe,
+	0x43, 0x1d, 0xd3, 0x35, 0x9a, 0xa6, 0xcb, 0xaf, 0xc5, 0xf1, 0x9b, 0x19, 0x71, 0x1f, 0x2f, 0x47,
+	0x97, 0x68, 0x1f, 0x3b, 0x4d, 0x2f, 0x49, 0x48, 0x4d, 0x00, 0x98, 0x47, 0xa2, 0xa3, 0x0a, 0x67,
+	0x7a, 0xfa, 0x45, 0xe4, 0x29, 0x8f, 0x3c, 0xc8, 0xc3, 0x62, 0xfc, 0x9d, 0xd5, 0xb9, 0x2b, 0x46,
+	0x75, 0xc5, 0x0e, 0x80, 0x28, 0x20, 0xec, 0xe6, 0xa8, 0x14, 0xce, 0x54, 0x82, 0x8a, 0x82, 0x43,
+	0x83, 0xd0, 0x94, 0x3f, 0xfe, 0x96, 0x87, 0xb9, 0xb4, 0x3f, 0xce, 0xaf, 0xf4, 0x97, 0xe8, 0x5b,
+	0xc4, 0x1b, 0x49, 0x49, 0x2c, 0xf0, 0x92, 0x78, 0x25, 0x5b, 0x12, 0x07, 0x52, 0xe9, 0xf4, 0x5a,
+	0xf8, 0xaf, 0x69, 0x98, 0xda, 0x35, 0x43, 0xd3, 0x23, 0xc8, 0x1a, 0xe8, 0x43, 0xc4, 0x2c, 0x62,
+	0x65, 0x20, 0x51, 0x1a, 0xf2, 0x47, 0x3e, 0xcf, 0x68, 0x43, 0x3e, 0x1e, 0xda, 0x86, 0x94, 0xd8,
+	0xb8, 0x24, 0xd6, 0x4b, 0x38, 0x71, 0xbe, 0x6f, 0xb6, 0xd6, 0xb7, 0x2f, 0xa6, 0x29, 0x71, 0x73,
+	0x4e, 0xd0, 0xdb, 0x30, 0xcb, 0x30, 0x92, 0x5b, 0x81, 0x91, 0x2f, 0x27, 0xe3, 0x8b, 0xd4, 0xa6,
+	0xa6, 0x83, 0x67, 0x1e, 0xdf, 0x14, 0x0b, 0x74, 0x0b, 0xd0, 0x41, 0x3c, 0x3c, 0x33, 0x12, 0x13,
+	0x32, 0xfa, 0x4b, 0xbd, 0xae, 0xba, 0x22, 0xe8, 0x07, 0x71, 0x34, 0x7d, 0x31, 0x01, 0x46, 0xdc,
+	0xde, 0x02, 0x60, 0x7a, 0x19, 0x36, 0xf6, 0x03, 0xaf, 0x32, 0x99, 0x1d, 0x38, 0x27, 0x7b, 0x9a,
+	0x5e, 0x64, 0x8b, 0x06, 0xfb, 0x8c, 0x1e, 0x28, 0xb0, 0xd2, 0x72, 0x83, 0xa6, 0xe9, 0x1a, 0xae,
+	0xf3, 0x7e, 0xc7, 0xb1, 0x0d, 0xe9, 0x33, 0xc3, 0x32, 0xdb, 0xb2, 0xf1, 0xd5, 0xc7, 0x6e, 0x7c,
+	0x2f, 0x8b, 0x33, 0x4f, 0x65, 0xac, 0xe9, 0xcb, 0x62, 0xef, 0x16, 0xdf, 0xba, 0x23, 0x76, 0xea,
+	0x66, 0x1b, 0xfd, 0x58, 0x81, 0x8b, 0x49, 0xb0, 0x0e, 0x11, 0x69, 0x9a, 0x8b, 0x74, 0x6f, 0x6c,
+	0x91, 0x5e, 0xcf, 0x26, 0xc2, 0x30, 0xa9, 0x56, 0xe2, 0xed, 0x01, 0xc1, 0xde, 0x85, 0x85, 0x43,
+	0x7c, 0x62, 0x84, 0x72, 0x18, 0x6f, 0xec, 0x63, 0x5c, 0x99, 0x19, 0xf2, 0xad, 0x8e, 0x2a, 0x23,
+	0x50, 0xb6, 0xdb, 0x59, 0x1a, 0x4d, 0x2f, 0x1d, 0x26, 0x23, 0xfd, 0x4d, 0x8c, 0xa3, 0x2e, 0x36,
+	0xfb, 0x9b, 0x9a, 0xe2, 0xd8, 0x5d, 0xac, 0xd0, 0x33, 0xd5, 0xc5, 0x0e, 0xfc, 0xb6, 0x86, 0x75,
+	0xb1, 0xfd, 0x73, 0x3b, 0xf4, 0x6d, 0x05, 0x96, 0x79, 0x7c, 0x07, 0x94, 0x59, 0xa1, 0x1d, 0x1c,
+	0xe1, 0xd0, 0xe0, 0xc9, 0xc4, 0x5b, 0xf2, 0x62, 0xed, 0xf6, 0xd8, 0x12, 0x5c, 0x4a, 0x65, 0xcd,
+	0x00, 0x57, 0x4d, 0x5f, 0x62, 0xd9, 0xc3, 0xe1, 0xbb, 0x0c, 0xac, 0x33, 0x68, 0x2a, 0xff, 0x7f,
+	0xa8, 0x00, 0x4a, 0x9e, 0x40, 0x3a, 0x26, 0xed, 0xc0, 0x27, 0x7c, 0x5a, 0x90, 0x6a, 0xf1, 0x95,
+	0xe1, 0xd3, 0x82, 0x84, 0x2e, 0x9a, 0x16, 0xa4, 0x6e, 0x8c, 0x37, 0x92, 0x67, 0xc2, 0xe9, 0xdf,
+	0xc6, 0x0d, 0x79, 0x0a, 0xfc, 0x5a, 0x81, 0x95, 0x81, 0xfa, 0x15, 0xcb, 0xb5, 0x07, 0x28, 0x4c,
+	0x6d, 0xf2, 0x0c, 0x3d, 0x91, 0xf2, 0x8d, 0x5c, 0x06, 0x17, 0xc3, 0xec, 0xc6, 0x73, 0x7c, 0xd4,
+	0xc8, 0xd1, 0xb8, 0x02, 0xaf, 0xa4, 0x8f, 0x8f, 0x15, 0xd8, 0x84, 0xb9, 0xf4, 0xe9, 0x52, 0xf4,
+	0x8b, 0x9f, 0x26, 0xba, 0x94, 0xba, 0x8f, 0x0e, 0x6d, 0x27, 0x97, 0x80, 0x98, 0xdd, 0xff, 0xff,
+	0x33, 0xb5, 0x8f, 0x64, 0xc8, 0x5e, 0x06, 0x42, 0xe2, 0x7f, 0x2b, 0x50, 0xd8, 0x0d, 0x02, 0x17,
+	0x05, 0xb0, 0xe8, 0x07, 0xd4, 0x60, 0x35, 0x0b, 0xdb, 0x86, 0x1c, 0xf2, 0x89, 0x71, 0x7e, 0x7d,
+	0x3c, 0xa3, 0xfc, 0xa3, 0xab, 0x0e, 0xb2, 0xd2, 0xcb, 0x7e, 0x40, 0x6b, 0x1c, 0x22, 0xbe, 0x3b,
+	0x46, 0x1f, 0xc2, 0x7c, 0xff, 0x61, 0x62, 0xe4, 0xf9, 0xee, 0xd8, 0x87, 0xf5, 0xb3, 0xe9, 0x75,
+	0xd5, 0x57, 0x92, 0x5a, 0x1c, 0x83, 0x35, 0x7d, 0xae, 0x99, 0x3a, 0xfd, 0xda, 0x0c, 0xd3, 0xfe,
+	0x93, 0x87, 0xaa, 0x52, 0xdb, 0x7c, 0xf4, 0x64, 0x4d, 0x79, 0xfc, 0x64, 0x4d, 0xf9, 0xcb, 0x93,
+	0x35, 0xe5, 0xa3, 0xa7, 0x6b, 0x13, 0x8f, 0x9f, 0xae, 0x4d, 0xfc, 0xf1, 0xe9, 0xda, 0xc4, 0x37,
+	0xde, 0xf8, 0x54, 0x11, 0x8e, 0xe3, 0x5f, 0xe1, 0x72, 0x61, 0x9a, 0x53, 0xfc, 0x9e, 0xfc, 0xd2,
+	0x7f, 0x06, 0x00, 0xde, 0xa1, 0x7e, 0x86, 0xa4, 0x2b, 0x00, 0x00,
+}
+
+func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
+}
+func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
+	var gzipped = []byte{
+		// 10476 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x24, 0xc7,
+		0x79, 0xd8, 0xcd, 0xee, 0x02, 0xd8, 0xfd, 0x76, 0xb1, 0x58, 0x34, 0x70, 0xb8, 0xbd, 0xe5, 0xf1,
+		0xf6, 0x38, 0x47, 0xde, 0x83, 0x0f, 0x1c, 0x7d, 0x3c, 0x92, 0xba, 0x3d, 0x89, 0x14, 0x16, 0xc0,
+		0xdd, 0x81, 0x04, 0x0e, 0xe0, 0x00, 0x38, 0x52, 0x94, 0xed, 0xf5, 0x60, 0xb7, 0xb1, 0x18, 0xde,
+		0xee, 0xcc, 0x72, 0x66, 0xf6, 0x0e, 0x60, 0x29, 0x55, 0x4c, 0x59, 0xb6, 0x2c, 0x47, 0xb2, 0x64,
+		0xc7, 0x56, 0x64, 0x45, 0x92, 0x25, 0xe5, 0x21, 0x47, 0x79, 0xd8, 0x8e, 0x1d, 0x3d, 0xec, 0xfc,
+		0x50, 0xca, 0x71, 0xa2, 0x54, 0x39, 0x29, 0x29, 0xb1, 0x53, 0xae, 0x54, 0x0a, 0xb6, 0x48, 0x55,
+		0xd9, 0x51, 0x98, 0x44, 0x39, 0xd3, 0x29, 0x97, 0x54, 0xa9, 0xa4, 0xfa, 0x35, 0xaf, 0x9d, 0xdd,
+		0x99, 0x05, 0x8f, 0x14, 0x5d, 0xe6, 0x2f, 0x6c, 0x77, 0x7f, 0xdf, 0xd7, 0xdd, 0x5f, 0x7f, 0xfd,
+		0xbd, 0xba, 0x7b, 0x00, 0xbf, 0x7b, 0x09, 0x4e, 0x34, 0x0d, 0xa3, 0xd9, 0xc2, 0xe7, 0x3a, 0xa6,
+		0x61, 0x1b, 0x5b, 0xdd, 0xed, 0x73, 0x0d, 0x6c, 0xd5, 0x4d, 0xad, 0x63, 0x1b, 0xe6, 0x2c, 0xad,
+		0x43, 0x13, 0x0c, 0x62, 0x56, 0x40, 0xc8, 0x2b, 0x30, 0x79, 0x59, 0x6b, 0xe1, 0x05, 0x07, 0x70,
+		0x1d, 0xdb, 0xe8, 0x5d, 0x90, 0xda, 0xd6, 0x5a, 0xb8, 0x28, 0x9d, 0x48, 0x9e, 0xc9, 0x9e, 0xbf,
+		0x77, 0x36, 0x80, 0x34, 0xeb, 0xc7, 0x58, 0x23, 0xd5, 0x0a, 0xc5, 0x90, 0xbf, 0x93, 0x82, 0xa9,
+		0x90, 0x56, 0x84, 0x20, 0xa5, 0xab, 0x6d, 0x42, 0x51, 0x3a, 0x93, 0x51, 0xe8, 0x6f, 0x54, 0x84,
+		0xb1, 0x8e, 0x5a, 0xbf, 0xa1, 0x36, 0x71, 0x31, 0x41, 0xab, 0x45, 0x11, 0x1d, 0x07, 0x68, 0xe0,
+		0x0e, 0xd6, 0x1b, 0x58, 0xaf, 0xef, 0x15, 0x93, 0x27, 0x92, 0x67, 0x32, 0x8a, 0xa7, 0x06, 0x3d,
+		0x00, 0x93, 0x9d, 0xee, 0x56, 0x4b, 0xab, 0xd7, 0x3c, 0x60, 0x70, 0x22, 0x79, 0x66, 0x44, 0x29,
+		0xb0, 0x86, 0x05, 0x17, 0xf8, 0x34, 0x4c, 0xdc, 0xc2, 0xea, 0x0d, 0x2f, 0x68, 0x96, 0x82, 0xe6,
+		0x49, 0xb5, 0x07, 0x70, 0x1e, 0x72, 0x6d, 0x6c, 0x59, 0x6a, 0x13, 0xd7, 0xec, 0xbd, 0x0e, 0x2e,
+		0xa6, 0xe8, 0xec, 0x4f, 0xf4, 0xcc, 0x3e, 0x38, 0xf3, 0x2c, 0xc7, 0xda, 0xd8, 0xeb, 0x60, 0x34,
+		0x07, 0x19, 0xac, 0x77, 0xdb, 0x8c, 0xc2, 0x48, 0x1f, 0xfe, 0x2d, 0xea, 0xdd, 0x76, 0x90, 0x4a,
+		0x9a, 0xa0, 0x71, 0x12, 0x63, 0x16, 0x36, 0x6f, 0x6a, 0x75, 0x5c, 0x1c, 0xa5, 0x04, 0x4e, 0xf7,
+		0x10, 0x58, 0x67, 0xed, 0x41, 0x1a, 0x02, 0x0f, 0xcd, 0x43, 0x06, 0xef, 0xda, 0x58, 0xb7, 0x34,
+		0x43, 0x2f, 0x8e, 0x51, 0x22, 0xf7, 0x85, 0xac, 0x22, 0x6e, 0x35, 0x82, 0x24, 0x5c, 0x3c, 0xf4,
+		0x18, 0x8c, 0x19, 0x1d, 0x5b, 0x33, 0x74, 0xab, 0x98, 0x3e, 0x21, 0x9d, 0xc9, 0x9e, 0x3f, 0x16,
+		0x2a, 0x08, 0xab, 0x0c, 0x46, 0x11, 0xc0, 0x68, 0x09, 0x0a, 0x96, 0xd1, 0x35, 0xeb, 0xb8, 0x56,
+		0x37, 0x1a, 0xb8, 0xa6, 0xe9, 0xdb, 0x46, 0x31, 0x43, 0x09, 0x94, 0x7b, 0x27, 0x42, 0x01, 0xe7,
+		0x8d, 0x06, 0x5e, 0xd2, 0xb7, 0x0d, 0x25, 0x6f, 0xf9, 0xca, 0x68, 0x06, 0x46, 0xad, 0x3d, 0xdd,
+		0x56, 0x77, 0x8b, 0x39, 0x2a, 0x21, 0xbc, 0x24, 0x7f, 0x6d, 0x14, 0x26, 0xe2, 0x88, 0xd8, 0x25,
+		0x18, 0xd9, 0x26, 0xb3, 0x2c, 0x26, 0x86, 0xe1, 0x01, 0xc3, 0xf1, 0x33, 0x71, 0xf4, 0x80, 0x4c,
+		0x9c, 0x83, 0xac, 0x8e, 0x2d, 0x1b, 0x37, 0x98, 0x44, 0x24, 0x63, 0xca, 0x14, 0x30, 0xa4, 0x5e,
+		0x91, 0x4a, 0x1d, 0x48, 0xa4, 0x9e, 0x83, 0x09, 0x67, 0x48, 0x35, 0x53, 0xd5, 0x9b, 0x42, 0x36,
+		0xcf, 0x45, 0x8d, 0x64, 0x76, 0x51, 0xe0, 0x29, 0x04, 0x4d, 0xc9, 0x63, 0x5f, 0x19, 0x2d, 0x00,
+		0x18, 0x3a, 0x36, 0xb6, 0x6b, 0x0d, 0x5c, 0x6f, 0x15, 0xd3, 0x7d, 0xb8, 0xb4, 0x4a, 0x40, 0x7a,
+		0xb8, 0x64, 0xb0, 0xda, 0x7a, 0x0b, 0x5d, 0x74, 0x45, 0x6d, 0xac, 0x8f, 0xa4, 0xac, 0xb0, 0x4d,
+		0xd6, 0x23, 0x6d, 0x9b, 0x90, 0x37, 0x31, 0x91, 0x7b, 0xdc, 0xe0, 0x33, 0xcb, 0xd0, 0x41, 0xcc,
+		0x46, 0xce, 0x4c, 0xe1, 0x68, 0x6c, 0x62, 0xe3, 0xa6, 0xb7, 0x88, 0x4e, 0x82, 0x53, 0x51, 0xa3,
+		0x62, 0x05, 0x54, 0x0b, 0xe5, 0x44, 0xe5, 0x35, 0xb5, 0x8d, 0x4b, 0x2f, 0x41, 0xde, 0xcf, 0x1e,
+		0x34, 0x0d, 0x23, 0x96, 0xad, 0x9a, 0x36, 0x95, 0xc2, 0x11, 0x85, 0x15, 0x50, 0x01, 0x92, 0x58,
+		0x6f, 0x50, 0x2d, 0x37, 0xa2, 0x90, 0x9f, 0xe8, 0xbd, 0xee, 0x84, 0x93, 0x74, 0xc2, 0xa7, 0x7a,
+		0x57, 0xd4, 0x47, 0x39, 0x38, 0xef, 0xd2, 0xe3, 0x30, 0xee, 0x9b, 0x40, 0xdc, 0xae, 0xe5, 0x0f,
+		0xc0, 0xe1, 0x50, 0xd2, 0xe8, 0x39, 0x98, 0xee, 0xea, 0x9a, 0x6e, 0x63, 0xb3, 0x63, 0x62, 0x22,
+		0xb1, 0xac, 0xab, 0xe2, 0x9f, 0x8e, 0xf5, 0x91, 0xb9, 0x4d, 0x2f, 0x34, 0xa3, 0xa2, 0x4c, 0x75,
+		0x7b, 0x2b, 0xef, 0xcf, 0xa4, 0xff, 0x6c, 0xac, 0xf0, 0xf2, 0xcb, 0x2f, 0xbf, 0x9c, 0x90, 0xff,
+		0xd5, 0x28, 0x4c, 0x87, 0xed, 0x99, 0xd0, 0xed, 0x3b, 0x03, 0xa3, 0x7a, 0xb7, 0xbd, 0x85, 0x4d,
+		0xca, 0xa4, 0x11, 0x85, 0x97, 0xd0, 0x1c, 0x8c, 0xb4, 0xd4, 0x2d, 0xdc, 0x2a, 0xa6, 0x4e, 0x48,
+		0x67, 0xf2, 0xe7, 0x1f, 0x88, 0xb5, 0x2b, 0x67, 0x97, 0x09, 0x8a, 0xc2, 0x30, 0xd1, 0x13, 0x90,
+		0xe2, 0x2a, 0x9a, 0x50, 0xb8, 0x3f, 0x1e, 0x05, 0xb2, 0x97, 0x14, 0x8a, 0x87, 0xee, 0x82, 0x0c,
+		0xf9, 0xcb, 0x64, 0x63, 0x94, 0x8e, 0x39, 0x4d, 0x2a, 0x88, 0x5c, 0xa0, 0x12, 0xa4, 0xe9, 0x36,
+		0x69, 0x60, 0x61, 0xda, 0x9c, 0x32, 0x11, 0xac, 0x06, 0xde, 0x56, 0xbb, 0x2d, 0xbb, 0x76, 0x53,
+		0x6d, 0x75, 0x31, 0x15, 0xf8, 0x8c, 0x92, 0xe3, 0x95, 0xd7, 0x49, 0x1d, 0x2a, 0x43, 0x96, 0xed,
+		0x2a, 0x4d, 0x6f, 0xe0, 0x5d, 0xaa, 0x3d, 0x47, 0x14, 0xb6, 0xd1, 0x96, 0x48, 0x0d, 0xe9, 0xfe,
+		0x05, 0xcb, 0xd0, 0x85, 0x68, 0xd2, 0x2e, 0x48, 0x05, 0xed, 0xfe, 0xf1, 0xa0, 0xe2, 0xbe, 0x3b,
+		0x7c, 0x7a, 0x3d, 0x7b, 0xe9, 0x34, 0x4c, 0x50, 0x88, 0x47, 0xf8, 0xd2, 0xab, 0xad, 0xe2, 0xe4,
+		0x09, 0xe9, 0x4c, 0x5a, 0xc9, 0xb3, 0xea, 0x55, 0x5e, 0x2b, 0x7f, 0x39, 0x01, 0x29, 0xaa, 0x58,
+		0x26, 0x20, 0xbb, 0xf1, 0xbe, 0xb5, 0xc5, 0xda, 0xc2, 0xea, 0x66, 0x75, 0x79, 0xb1, 0x20, 0xa1,
+		0x3c, 0x00, 0xad, 0xb8, 0xbc, 0xbc, 0x3a, 0xb7, 0x51, 0x48, 0x38, 0xe5, 0xa5, 0x6b, 0x1b, 0x8f,
+		0x5d, 0x28, 0x24, 0x1d, 0x84, 0x4d, 0x56, 0x91, 0xf2, 0x02, 0x3c, 0x72, 0xbe, 0x30, 0x82, 0x0a,
+		0x90, 0x63, 0x04, 0x96, 0x9e, 0x5b, 0x5c, 0x78, 0xec, 0x42, 0x61, 0xd4, 0x5f, 0xf3, 0xc8, 0xf9,
+		0xc2, 0x18, 0x1a, 0x87, 0x0c, 0xad, 0xa9, 0xae, 0xae, 0x2e, 0x17, 0xd2, 0x0e, 0xcd, 0xf5, 0x0d,
+		0x65, 0xe9, 0xda, 0x95, 0x42, 0xc6, 0xa1, 0x79, 0x45, 0x59, 0xdd, 0x5c, 0x2b, 0x80, 0x43, 0x61,
+		0x65, 0x71, 0x7d, 0x7d, 0xee, 0xca, 0x62, 0x21, 0xeb, 0x40, 0x54, 0xdf, 0xb7, 0xb1, 0xb8, 0x5e,
+		0xc8, 0xf9, 0x86, 0xf5, 0xc8, 0xf9, 0xc2, 0xb8, 0xd3, 0xc5, 0xe2, 0xb5, 0xcd, 0x95, 0x42, 0x1e,
+		0x4d, 0xc2, 0x38, 0xeb, 0x42, 0x0c, 0x62, 0x22, 0x50, 0xf5, 0xd8, 0x85, 0x42, 0xc1, 0x1d, 0x08,
+		0xa3, 0x32, 0xe9, 0xab, 0x78, 0xec, 0x42, 0x01, 0xc9, 0xf3, 0x30, 0x42, 0xc5, 0x10, 0x21, 0xc8,
+		0x2f, 0xcf, 0x55, 0x17, 0x97, 0x6b, 0xab, 0x6b, 0x1b, 0x4b, 0xab, 0xd7, 0xe6, 0x96, 0x0b, 0x92,
+		0x5b, 0xa7, 0x2c, 0x3e, 0xb3, 0xb9, 0xa4, 0x2c, 0x2e, 0x14, 0x12, 0xde, 0xba, 0xb5, 0xc5, 0xb9,
+		0x8d, 0xc5, 0x85, 0x42, 0x52, 0xae, 0xc3, 0x74, 0x98, 0x42, 0x0d, 0xdd, 0x42, 0x1e, 0x59, 0x48,
+		0xf4, 0x91, 0x05, 0x4a, 0x2b, 0x28, 0x0b, 0xf2, 0xab, 0x09, 0x98, 0x0a, 0x31, 0x2a, 0xa1, 0x9d,
+		0x3c, 0x09, 0x23, 0x4c, 0x96, 0x99, 0x99, 0x3d, 0x1b, 0x6a, 0x9d, 0xa8, 0x64, 0xf7, 0x98, 0x5a,
+		0x8a, 0xe7, 0x75, 0x35, 0x92, 0x7d, 0x5c, 0x0d, 0x42, 0xa2, 0x47, 0x60, 0x7f, 0xac, 0x47, 0xf9,
+		0x33, 0xfb, 0xf8, 0x58, 0x1c, 0xfb, 0x48, 0xeb, 0x86, 0x33, 0x02, 0x23, 0x21, 0x46, 0xe0, 0x12,
+		0x4c, 0xf6, 0x10, 0x8a, 0xad, 0x8c, 0x7f, 0x52, 0x82, 0x62, 0x3f, 0xe6, 0x44, 0xa8, 0xc4, 0x84,
+		0x4f, 0x25, 0x5e, 0x0a, 0x72, 0xf0, 0x9e, 0xfe, 0x8b, 0xd0, 0xb3, 0xd6, 0x5f, 0x94, 0x60, 0x26,
+		0xdc, 0xa5, 0x0c, 0x1d, 0xc3, 0x13, 0x30, 0xda, 0xc6, 0xf6, 0x8e, 0x21, 0xdc, 0xaa, 0x53, 0x21,
+		0xc6, 0x9a, 0x34, 0x07, 0x17, 0x9b, 0x63, 0xa1, 0x8b, 0xc1, 0xb1, 0x96, 0xfb, 0x39, 0xb8, 0x3d,
+		0x23, 0xfd, 0x70, 0x02, 0x0e, 0x87, 0x12, 0x0f, 0x1d, 0xe8, 0xdd, 0x00, 0x9a, 0xde, 0xe9, 0xda,
+		0xcc, 0x75, 0x62, 0x9a, 0x38, 0x43, 0x6b, 0xa8, 0xf2, 0x22, 0x5a, 0xb6, 0x6b, 0x3b, 0xed, 0x49,
+		0xda, 0x0e, 0xac, 0x8a, 0x02, 0xbc, 0xcb, 0x1d, 0x68, 0x8a, 0x0e, 0xf4, 0x78, 0x9f, 0x99, 0xf6,
+		0x08, 0xe6, 0xc3, 0x50, 0xa8, 0xb7, 0x34, 0xac, 0xdb, 0x35, 0xcb, 0x36, 0xb1, 0xda, 0xd6, 0xf4,
+		0x26, 0x35, 0x35, 0xe9, 0xca, 0xc8, 0xb6, 0xda, 0xb2, 0xb0, 0x32, 0xc1, 0x9a, 0xd7, 0x45, 0x2b,
+		0xc1, 0xa0, 0x02, 0x64, 0x7a, 0x30, 0x46, 0x7d, 0x18, 0xac, 0xd9, 0xc1, 0x90, 0x7f, 0x3e, 0x03,
+		0x59, 0x8f, 0x03, 0x8e, 0xee, 0x81, 0xdc, 0x0b, 0xea, 0x4d, 0xb5, 0x26, 0x82, 0x2a, 0xc6, 0x89,
+		0x2c, 0xa9, 0x5b, 0x63, 0x55, 0xe8, 0x61, 0x98, 0xa6, 0x20, 0x46, 0xd7, 0xc6, 0x66, 0xad, 0xde,
+		0x52, 0x2d, 0x8b, 0x32, 0x2d, 0x4d, 0x41, 0x11, 0x69, 0x5b, 0x25, 0x4d, 0xf3, 0xa2, 0x05, 0x3d,
+		0x0a, 0x53, 0x14, 0xa3, 0xdd, 0x6d, 0xd9, 0x5a, 0xa7, 0x85, 0x6b, 0x24, 0xcc, 0xb3, 0x8a, 0xe0,
+		0x1d, 0xd9, 0x24, 0x81, 0x58, 0xe1, 0x00, 0x64, 0x44, 0x16, 0x5a, 0x80, 0xbb, 0x29, 0x5a, 0x13,
+		0xeb, 0xd8, 0x54, 0x6d, 0x5c, 0xc3, 0x2f, 0x76, 0xd5, 0x96, 0x55, 0x53, 0xf5, 0x46, 0x6d, 0x47,
+		0xb5, 0x76, 0x8a, 0xd3, 0x84, 0x40, 0x35, 0x51, 0x94, 0x94, 0xa3, 0x04, 0xf0, 0x0a, 0x87, 0x5b,
+		0xa4, 0x60, 0x73, 0x7a, 0xe3, 0xaa, 0x6a, 0xed, 0xa0, 0x0a, 0xcc, 0x50, 0x2a, 0x96, 0x6d, 0x6a,
+		0x7a, 0xb3, 0x56, 0xdf, 0xc1, 0xf5, 0x1b, 0xb5, 0xae, 0xbd, 0xfd, 0xae, 0xe2, 0x5d, 0xde, 0xfe,
+		0xe9, 0x08, 0xd7, 0x29, 0xcc, 0x3c, 0x01, 0xd9, 0xb4, 0xb7, 0xdf, 0x85, 0xd6, 0x21, 0x47, 0x16,
+		0xa3, 0xad, 0xbd, 0x84, 0x6b, 0xdb, 0x86, 0x49, 0x6d, 0x68, 0x3e, 0x44, 0x35, 0x79, 0x38, 0x38,
+		0xbb, 0xca, 0x11, 0x56, 0x8c, 0x06, 0xae, 0x8c, 0xac, 0xaf, 0x2d, 0x2e, 0x2e, 0x28, 0x59, 0x41,
+		0xe5, 0xb2, 0x61, 0x12, 0x81, 0x6a, 0x1a, 0x0e, 0x83, 0xb3, 0x4c, 0xa0, 0x9a, 0x86, 0x60, 0xef,
+		0xa3, 0x30, 0x55, 0xaf, 0xb3, 0x39, 0x6b, 0xf5, 0x1a, 0x0f, 0xc6, 0xac, 0x62, 0xc1, 0xc7, 0xac,
+		0x7a, 0xfd, 0x0a, 0x03, 0xe0, 0x32, 0x6e, 0xa1, 0x8b, 0x70, 0xd8, 0x65, 0x96, 0x17, 0x71, 0xb2,
+		0x67, 0x96, 0x41, 0xd4, 0x47, 0x61, 0xaa, 0xb3, 0xd7, 0x8b, 0x88, 0x7c, 0x3d, 0x76, 0xf6, 0x82,
+		0x68, 0x8f, 0xc3, 0x74, 0x67, 0xa7, 0xd3, 0x8b, 0x77, 0xbf, 0x17, 0x0f, 0x75, 0x76, 0x3a, 0x41,
+		0xc4, 0xfb, 0x68, 0x64, 0x6e, 0xe2, 0xba, 0x6a, 0xe3, 0x46, 0xf1, 0x88, 0x17, 0xdc, 0xd3, 0x80,
+		0x66, 0xa1, 0x50, 0xaf, 0xd7, 0xb0, 0xae, 0x6e, 0xb5, 0x70, 0x4d, 0x35, 0xb1, 0xae, 0x5a, 0xc5,
+		0x32, 0x05, 0x4e, 0xd9, 0x66, 0x17, 0x2b, 0xf9, 0x7a, 0x7d, 0x91, 0x36, 0xce, 0xd1, 0x36, 0x74,
+		0x3f, 0x4c, 0x1a, 0x5b, 0x2f, 0xd4, 0x99, 0x44, 0xd6, 0x3a, 0x26, 0xde, 0xd6, 0x76, 0x8b, 0xf7,
+		0x52, 0xf6, 0x4e, 0x90, 0x06, 0x2a, 0x8f, 0x6b, 0xb4, 0x1a, 0x9d, 0x85, 0x42, 0xdd, 0xda, 0x51,
+		0xcd, 0x0e, 0x55, 0xc9, 0x56, 0x47, 0xad, 0xe3, 0xe2, 0x7d, 0x0c, 0x94, 0xd5, 0x5f, 0x13, 0xd5,
+		0x64, 0x47, 0x58, 0xb7, 0xb4, 0x6d, 0x5b, 0x50, 0x3c, 0xcd, 0x76, 0x04, 0xad, 0xe3, 0xd4, 0xce,
+		0x40, 0x81, 0x70, 0xc2, 0xd7, 0xf1, 0x19, 0x0a, 0x96, 0xef, 0xec, 0x74, 0xbc, 0xfd, 0x9e, 0x84,
+		0xf1, 0xce, 0x8e, 0xb7, 0xd3, 0xb3, 0xcc, 0x71, 0xeb, 0xec, 0x78, 0x7a, 0xbc, 0x00, 0x33, 0x04,
+		0xa8, 0x8d, 0x6d, 0xb5, 0xa1, 0xda, 0xaa, 0x07, 0xfa, 0x41, 0x0a, 0x4d, 0xd8, 0xbe, 0xc2, 0x1b,
+		0x7d, 0xe3, 0x34, 0xbb, 0x5b, 0x7b, 0x8e, 0x60, 0x3d, 0xc4, 0xc6, 0x49, 0xea, 0x84, 0x68, 0xbd,
+		0x69, 0xce, 0xb9, 0x5c, 0x81, 0x9c, 0x57, 0xee, 0x51, 0x06, 0x98, 0xe4, 0x17, 0x24, 0xe2, 0x04,
+		0xcd, 0xaf, 0x2e, 0x10, 0xf7, 0xe5, 0xf9, 0xc5, 0x42, 0x82, 0xb8, 0x51, 0xcb, 0x4b, 0x1b, 0x8b,
+		0x35, 0x65, 0xf3, 0xda, 0xc6, 0xd2, 0xca, 0x62, 0x21, 0xe9, 0x71, 0xec, 0x9f, 0x4a, 0xa5, 0x4f,
+		0x15, 0x4e, 0xcb, 0xdf, 0x4a, 0x40, 0xde, 0x1f, 0xa9, 0xa1, 0x77, 0xc3, 0x11, 0x91, 0x56, 0xb1,
+		0xb0, 0x5d, 0xbb, 0xa5, 0x99, 0x74, 0x43, 0xb6, 0x55, 0x66, 0x1c, 0x1d, 0xf9, 0x99, 0xe6, 0x50,
+		0xeb, 0xd8, 0x7e, 0x56, 0x33, 0xc9, 0x76, 0x6b, 0xab, 0x36, 0x5a, 0x86, 0xb2, 0x6e, 0xd4, 0x2c,
+		0x5b, 0xd5, 0x1b, 0xaa, 0xd9, 0xa8, 0xb9, 0x09, 0xad, 0x9a, 0x5a, 0xaf, 0x63, 0xcb, 0x32, 0x98,
+		0x21, 0x74, 0xa8, 0x1c, 0xd3, 0x8d, 0x75, 0x0e, 0xec, 0x5a, 0x88, 0x39, 0x0e, 0x1a, 0x10, 0xdf,
+		0x64, 0x3f, 0xf1, 0xbd, 0x0b, 0x32, 0x6d, 0xb5, 0x53, 0xc3, 0xba, 0x6d, 0xee, 0x51, 0xff, 0x3c,
+		0xad, 0xa4, 0xdb, 0x6a, 0x67, 0x91, 0x94, 0xdf, 0x92, 0x30, 0xe9, 0xa9, 0x54, 0x3a, 0x5d, 0xc8,
+		0x3c, 0x95, 0x4a, 0x67, 0x0a, 0x20, 0xbf, 0x92, 0x84, 0x9c, 0xd7, 0x5f, 0x27, 0xe1, 0x4f, 0x9d,
+		0x5a, 0x2c, 0x89, 0xea, 0xb4, 0x93, 0x03, 0xbd, 0xfb, 0xd9, 0x79, 0x62, 0xca, 0x2a, 0xa3, 0xcc,
+		0x39, 0x56, 0x18, 0x26, 0x71, 0x23, 0x88, 0xb0, 0x61, 0xe6, 0x8c, 0xa4, 0x15, 0x5e, 0x42, 0x57,
+		0x60, 0xf4, 0x05, 0x8b, 0xd2, 0x1e, 0xa5, 0xb4, 0xef, 0x1d, 0x4c, 0xfb, 0xa9, 0x75, 0x4a, 0x3c,
+		0xf3, 0xd4, 0x7a, 0xed, 0xda, 0xaa, 0xb2, 0x32, 0xb7, 0xac, 0x70, 0x74, 0x74, 0x14, 0x52, 0x2d,
+		0xf5, 0xa5, 0x3d, 0xbf, 0xd1, 0xa3, 0x55, 0x71, 0x17, 0xe1, 0x28, 0xa4, 0x48, 0x82, 0xce, 0x6f,
+		0x6a, 0x68, 0xd5, 0x9b, 0xb8, 0x19, 0xce, 0xc1, 0x08, 0xe5, 0x17, 0x02, 0xe0, 0x1c, 0x2b, 0x1c,
+		0x42, 0x69, 0x48, 0xcd, 0xaf, 0x2a, 0x64, 0x43, 0x14, 0x20, 0xc7, 0x6a, 0x6b, 0x6b, 0x4b, 0x8b,
+		0xf3, 0x8b, 0x85, 0x84, 0xfc, 0x28, 0x8c, 0x32, 0x26, 0x90, 0xcd, 0xe2, 0xb0, 0xa1, 0x70, 0x88,
+		0x17, 0x39, 0x0d, 0x49, 0xb4, 0x6e, 0xae, 0x54, 0x17, 0x95, 0x42, 0xc2, 0xbf, 0xd4, 0xa9, 0xc2,
+		0x88, 0x6c, 0x41, 0xce, 0xeb, 0x87, 0xbf, 0x35, 0xc1, 0xf8, 0xd7, 0x25, 0xc8, 0x7a, 0xfc, 0x6a,
+		0xe2, 0x10, 0xa9, 0xad, 0x96, 0x71, 0xab, 0xa6, 0xb6, 0x34, 0xd5, 0xe2, 0xa2, 0x01, 0xb4, 0x6a,
+		0x8e, 0xd4, 0xc4, 0x5d, 0xba, 0xb7, 0x68, 0x8b, 0x8c, 0x14, 0x46, 0xe5, 0xcf, 0x4a, 0x50, 0x08,
+		0x3a, 0xb6, 0x81, 0x61, 0x4a, 0x3f, 0xcc, 0x61, 0xca, 0x9f, 0x96, 0x20, 0xef, 0xf7, 0x66, 0x03,
+		0xc3, 0xbb, 0xe7, 0x87, 0x3a, 0xbc, 0x3f, 0x49, 0xc0, 0xb8, 0xcf, 0x87, 0x8d, 0x3b, 0xba, 0x17,
+		0x61, 0x52, 0x6b, 0xe0, 0x76, 0xc7, 0xb0, 0x49, 0xf2, 0xbc, 0xd6, 0xc2, 0x37, 0x71, 0xab, 0x28,
+		0x53, 0xa5, 0x71, 0x6e, 0xb0, 0x97, 0x3c, 0xbb, 0xe4, 0xe2, 0x2d, 0x13, 0xb4, 0xca, 0xd4, 0xd2,
+		0xc2, 0xe2, 0xca, 0xda, 0xea, 0xc6, 0xe2, 0xb5, 0xf9, 0xf7, 0xd5, 0x36, 0xaf, 0x3d, 0x7d, 0x6d,
+		0xf5, 0xd9, 0x6b, 0x4a, 0x41, 0x0b, 0x80, 0xbd, 0x89, 0xdb, 0x7e, 0x0d, 0x0a, 0xc1, 0x41, 0xa1,
+		0x23, 0x10, 0x36, 0xac, 0xc2, 0x21, 0x34, 0x05, 0x13, 0xd7, 0x56, 0x6b, 0xeb, 0x4b, 0x0b, 0x8b,
+		0xb5, 0xc5, 0xcb, 0x97, 0x17, 0xe7, 0x37, 0xd6, 0x59, 0xde, 0xc3, 0x81, 0xde, 0xf0, 0x6d, 0x70,
+		0xf9, 0x53, 0x49, 0x98, 0x0a, 0x19, 0x09, 0x9a, 0xe3, 0x11, 0x0b, 0x0b, 0xa2, 0x1e, 0x8a, 0x33,
+		0xfa, 0x59, 0xe2, 0x33, 0xac, 0xa9, 0xa6, 0xcd, 0x03, 0x9c, 0xb3, 0x40, 0xb8, 0xa4, 0xdb, 0xda,
+		0xb6, 0x86, 0x4d, 0x9e, 0x4f, 0x62, 0x61, 0xcc, 0x84, 0x5b, 0xcf, 0x52, 0x4a, 0x0f, 0x02, 0xea,
+		0x18, 0x96, 0x66, 0x6b, 0x37, 0x49, 0x4a, 0x5e, 0x24, 0x9f, 0x48, 0x58, 0x93, 0x52, 0x0a, 0xa2,
+		0x65, 0x49, 0xb7, 0x1d, 0x68, 0x1d, 0x37, 0xd5, 0x00, 0x34, 0x51, 0xe6, 0x49, 0xa5, 0x20, 0x5a,
+		0x1c, 0xe8, 0x7b, 0x20, 0xd7, 0x30, 0xba, 0xc4, 0xd7, 0x63, 0x70, 0xc4, 0x76, 0x48, 0x4a, 0x96,
+		0xd5, 0x39, 0x20, 0xdc, 0x8b, 0x77, 0xb3, 0x5e, 0x39, 0x25, 0xcb, 0xea, 0x18, 0xc8, 0x69, 0x98,
+		0x50, 0x9b, 0x4d, 0x93, 0x10, 0x17, 0x84, 0x58, 0x5c, 0x92, 0x77, 0xaa, 0x29, 0x60, 0xe9, 0x29,
+		0x48, 0x0b, 0x3e, 0x10, 0x53, 0x4d, 0x38, 0x51, 0xeb, 0xb0, 0x60, 0x3b, 0x41, 0x12, 0x61, 0xba,
+		0x68, 0xbc, 0x07, 0x72, 0x9a, 0x55, 0x73, 0x93, 0xf8, 0x89, 0x13, 0x89, 0x33, 0x69, 0x25, 0xab,
+		0x59, 0x4e, 0x02, 0x54, 0xfe, 0x62, 0x02, 0xf2, 0xfe, 0x43, 0x08, 0xb4, 0x00, 0xe9, 0x96, 0x51,
+		0x57, 0xa9, 0x68, 0xb1, 0x13, 0xb0, 0x33, 0x11, 0xe7, 0x16, 0xb3, 0xcb, 0x1c, 0x5e, 0x71, 0x30,
+		0x4b, 0xff, 0x41, 0x82, 0xb4, 0xa8, 0x46, 0x33, 0x90, 0xea, 0xa8, 0xf6, 0x0e, 0x25, 0x37, 0x52,
+		0x4d, 0x14, 0x24, 0x85, 0x96, 0x49, 0xbd, 0xd5, 0x51, 0xf5, 0x62, 0xc2, 0xad, 0x27, 0x65, 0xb2,
+		0xae, 0x2d, 0xac, 0x36, 0x68, 0xd0, 0x63, 0xb4, 0xdb, 0x58, 0xb7, 0x2d, 0xb1, 0xae, 0xbc, 0x7e,
+		0x9e, 0x57, 0x93, 0xb3, 0x30, 0xdb, 0x54, 0xb5, 0x96, 0x0f, 0x36, 0x45, 0x61, 0x0b, 0xa2, 0xc1,
+		0x01, 0xae, 0xc0, 0x51, 0x41, 0xb7, 0x81, 0x6d, 0xb5, 0xbe, 0x83, 0x1b, 0x2e, 0xd2, 0x28, 0x4d,
+		0x6e, 0x1c, 0xe1, 0x00, 0x0b, 0xbc, 0x5d, 0xe0, 0xca, 0xdf, 0x92, 0x60, 0x52, 0x84, 0x69, 0x0d,
+		0x87, 0x59, 0x2b, 0x00, 0xaa, 0xae, 0x1b, 0xb6, 0x97, 0x5d, 0xbd, 0xa2, 0xdc, 0x83, 0x37, 0x3b,
+		0xe7, 0x20, 0x29, 0x1e, 0x02, 0xa5, 0x36, 0x80, 0xdb, 0xd2, 0x97, 0x6d, 0x65, 0xc8, 0xf2, 0x13,
+		0x26, 0x7a, 0x4c, 0xc9, 0x02, 0x7b, 0x60, 0x55, 0x24, 0x9e, 0x23, 0xe9, 0x97, 0x2d, 0xdc, 0xd4,
+		0x74, 0x9e, 0x37, 0x66, 0x05, 0x91, 0x7e, 0x49, 0x39, 0xe9, 0x97, 0xea, 0xc7, 0x24, 0x98, 0xaa,
+		0x1b, 0xed, 0xe0, 0x78, 0xab, 0x85, 0x40, 0x76, 0xc1, 0xba, 0x2a, 0x3d, 0xff, 0x44, 0x53, 0xb3,
+		0x77, 0xba, 0x5b, 0xb3, 0x75, 0xa3, 0x7d, 0xae, 0x69, 0xb4, 0x54, 0xbd, 0xe9, 0x9e, 0xb3, 0xd2,
+		0x1f, 0xf5, 0x87, 0x9a, 0x58, 0x7f, 0xa8, 0x69, 0x78, 0x4e, 0x5d, 0x2f, 0xb9, 0x3f, 0xff, 0x52,
+		0x92, 0xbe, 0x90, 0x48, 0x5e, 0x59, 0xab, 0x7e, 0x29, 0x51, 0xba, 0xc2, 0xba, 0x5b, 0x13, 0xec,
+		0x51, 0xf0, 0x76, 0x0b, 0xd7, 0xc9, 0x94, 0xe1, 0xbb, 0x0f, 0xc0, 0x74, 0xd3, 0x68, 0x1a, 0x94,
+		0xe2, 0x39, 0xf2, 0x8b, 0x9f, 0xdc, 0x66, 0x9c, 0xda, 0x52, 0xe4, 0x31, 0x6f, 0xe5, 0x1a, 0x4c,
+		0x71, 0xe0, 0x1a, 0x3d, 0x3a, 0x62, 0x81, 0x0d, 0x1a, 0x98, 0x55, 0x2b, 0xfe, 0xc6, 0x77, 0xa8,
+		0x41, 0x57, 0x26, 0x39, 0x2a, 0x69, 0x63, 0xb1, 0x4f, 0x45, 0x81, 0xc3, 0x3e, 0x7a, 0x6c, 0xdb,
+		0x62, 0x33, 0x82, 0xe2, 0xef, 0x71, 0x8a, 0x53, 0x1e, 0x8a, 0xeb, 0x1c, 0xb5, 0x32, 0x0f, 0xe3,
+		0xc3, 0xd0, 0xfa, 0x37, 0x9c, 0x56, 0x0e, 0x7b, 0x89, 0x5c, 0x81, 0x09, 0x4a, 0xa4, 0xde, 0xb5,
+		0x6c, 0xa3, 0x4d, 0x75, 0xe2, 0x60, 0x32, 0xff, 0xf6, 0x3b, 0x6c, 0x1f, 0xe5, 0x09, 0xda, 0xbc,
+		0x83, 0x55, 0xa9, 0x00, 0x3d, 0x2d, 0x23, 0xa7, 0x58, 0x11, 0x14, 0xbe, 0xc1, 0x07, 0xe2, 0xc0,
+		0x57, 0xae, 0xc3, 0x34, 0xf9, 0x4d, 0x55, 0x96, 0x77, 0x24, 0xd1, 0x29, 0xb8, 0xe2, 0xb7, 0x7e,
+		0x92, 0x6d, 0xd5, 0x29, 0x87, 0x80, 0x67, 0x4c, 0x9e, 0x55, 0x6c, 0x62, 0xdb, 0xc6, 0xa6, 0x55,
+		0x53, 0x5b, 0x61, 0xc3, 0xf3, 0xe4, 0x30, 0x8a, 0xbf, 0xfc, 0x9a, 0x7f, 0x15, 0xaf, 0x30, 0xcc,
+		0xb9, 0x56, 0xab, 0xb2, 0x09, 0x47, 0x42, 0xa4, 0x22, 0x06, 0xcd, 0x4f, 0x71, 0x9a, 0xd3, 0x3d,
+		0x92, 0x41, 0xc8, 0xae, 0x81, 0xa8, 0x77, 0xd6, 0x32, 0x06, 0xcd, 0xbf, 0xcb, 0x69, 0x22, 0x8e,
+		0x2b, 0x96, 0x94, 0x50, 0x7c, 0x0a, 0x26, 0x6f, 0x62, 0x73, 0xcb, 0xb0, 0x78, 0xde, 0x28, 0x06,
+		0xb9, 0x4f, 0x73, 0x72, 0x13, 0x1c, 0x91, 0x26, 0x92, 0x08, 0xad, 0x8b, 0x90, 0xde, 0x56, 0xeb,
+		0x38, 0x06, 0x89, 0xcf, 0x70, 0x12, 0x63, 0x04, 0x9e, 0xa0, 0xce, 0x41, 0xae, 0x69, 0x70, 0xab,
+		0x15, 0x8d, 0xfe, 0x59, 0x8e, 0x9e, 0x15, 0x38, 0x9c, 0x44, 0xc7, 0xe8, 0x74, 0x5b, 0xc4, 0xa4,
+		0x45, 0x93, 0xf8, 0x15, 0x41, 0x42, 0xe0, 0x70, 0x12, 0x43, 0xb0, 0xf5, 0x73, 0x82, 0x84, 0xe5,
+		0xe1, 0xe7, 0x93, 0xe4, 0x38, 0xa9, 0xb5, 0x67, 0xe8, 0x71, 0x06, 0xf1, 0x79, 0x4e, 0x01, 0x38,
+		0x0a, 0x21, 0x70, 0x09, 0x32, 0x71, 0x17, 0xe2, 0x1f, 0xbc, 0x26, 0xb6, 0x87, 0x58, 0x81, 0x2b,
+		0x30, 0x21, 0x14, 0x14, 0x39, 0x7e, 0x8e, 0x26, 0xf1, 0x0f, 0x39, 0x89, 0xbc, 0x07, 0x8d, 0x4f,
+		0xc3, 0xc6, 0x96, 0xdd, 0xc4, 0x71, 0x88, 0x7c, 0x51, 0x4c, 0x83, 0xa3, 0x70, 0x56, 0x6e, 0x61,
+		0xbd, 0xbe, 0x13, 0x8f, 0xc2, 0xaf, 0x0a, 0x56, 0x0a, 0x1c, 0x42, 0x62, 0x1e, 0xc6, 0xdb, 0xaa,
+		0x69, 0xed, 0xa8, 0xad, 0x58, 0xcb, 0xf1, 0x8f, 0x38, 0x8d, 0x9c, 0x83, 0xc4, 0x39, 0xd2, 0xd5,
+		0x87, 0x21, 0xf3, 0x25, 0xc1, 0x91, 0xae, 0xee, 0x23, 0xb4, 0x06, 0xd3, 0x96, 0x4d, 0x93, 0x6c,
+		0xc3, 0x50, 0xfb, 0xc7, 0x62, 0xeb, 0x31, 0xdc, 0x15, 0x2f, 0xc5, 0x4b, 0x90, 0xb1, 0xb4, 0x97,
+		0x62, 0x91, 0xf9, 0x27, 0x62, 0xa5, 0x29, 0x02, 0x41, 0x7e, 0x1f, 0x1c, 0x0d, 0x35, 0x13, 0x31,
+		0x88, 0xfd, 0x53, 0x4e, 0x6c, 0x26, 0xc4, 0x54, 0x70, 0x95, 0x30, 0x2c, 0xc9, 0x7f, 0x26, 0x54,
+		0x02, 0x0e, 0xd0, 0x5a, 0x23, 0x71, 0x84, 0xa5, 0x6e, 0x0f, 0xc7, 0xb5, 0x5f, 0x13, 0x5c, 0x63,
+		0xb8, 0x3e, 0xae, 0x6d, 0xc0, 0x0c, 0xa7, 0x38, 0xdc, 0xba, 0xfe, 0xba, 0x50, 0xac, 0x0c, 0x7b,
+		0xd3, 0xbf, 0xba, 0xef, 0x87, 0x92, 0xc3, 0x4e, 0xe1, 0xb0, 0x5a, 0x35, 0x92, 0x99, 0x8a, 0xa6,
+		0xfc, 0x1b, 0x9c, 0xb2, 0xd0, 0xf8, 0x8e, 0xc7, 0x6b, 0xad, 0xa8, 0x1d, 0x42, 0xfc, 0x39, 0x28,
+		0x0a, 0xe2, 0x5d, 0xdd, 0xc4, 0x75, 0xa3, 0xa9, 0x6b, 0x2f, 0xe1, 0x46, 0x0c, 0xd2, 0xff, 0x3c,
+		0xb0, 0x54, 0x9b, 0x1e, 0x74, 0x42, 0x79, 0x09, 0x0a, 0x8e, 0xaf, 0x52, 0xd3, 0xda, 0x1d, 0xc3,
+		0xb4, 0x23, 0x28, 0xfe, 0xa6, 0x58, 0x29, 0x07, 0x6f, 0x89, 0xa2, 0x55, 0x16, 0x81, 0x9d, 0x3c,
+		0xc7, 0x15, 0xc9, 0xdf, 0xe2, 0x84, 0xc6, 0x5d, 0x2c, 0xae, 0x38, 0xea, 0x46, 0xbb, 0xa3, 0x9a,
+		0x71, 0xf4, 0xdf, 0xbf, 0x10, 0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x41, 0xb2, 0x5a, 0xc4, 0xda, 0xc7,
+		0xa0, 0xf0, 0x65, 0xa1, 0x38, 0x04, 0x0e, 0x27, 0x21, 0x1c, 0x86, 0x18, 0x24, 0xbe, 0x22, 0x48,
+		0x08, 0x1c, 0x42, 0xe2, 0x19, 0xd7, 0xd0, 0x9a, 0xb8, 0xa9, 0x59, 0xb6, 0xc9, 0xdc, 0xe4, 0xc1,
+		0xa4, 0xbe, 0xfa, 0x9a, 0xdf, 0x09, 0x53, 0x3c, 0xa8, 0x44, 0x13, 0xf1, 0xb4, 0x2b, 0x8d, 0xa2,
+		0xa2, 0x07, 0xf6, 0x35, 0xa1, 0x89, 0x3c, 0x68, 0x64, 0x6c, 0x1e, 0x0f, 0x91, 0xb0, 0xbd, 0x4e,
+		0x62, 0x87, 0x18, 0xe4, 0x7e, 0x3b, 0x30, 0xb8, 0x75, 0x81, 0x4b, 0x68, 0x7a, 0xfc, 0x9f, 0xae,
+		0x7e, 0x03, 0xef, 0xc5, 0x92, 0xce, 0xdf, 0x09, 0xf8, 0x3f, 0x9b, 0x0c, 0x93, 0xe9, 0x90, 0x89,
+		0x80, 0x3f, 0x85, 0xa2, 0xee, 0x19, 0x15, 0xff, 0xe6, 0xeb, 0x7c, 0xbe, 0x7e, 0x77, 0xaa, 0xb2,
+		0x0c, 0x05, 0x5e, 0xe3, 0x3a, 0xb0, 0x91, 0xc4, 0x7e, 0xf2, 0x75, 0x47, 0xce, 0x7d, 0x3e, 0x4f,
+		0xe5, 0x32, 0x8c, 0xfb, 0x1c, 0x9e, 0x68, 0x52, 0x1f, 0xe4, 0xa4, 0x72, 0x5e, 0x7f, 0xa7, 0xf2,
+		0x28, 0xa4, 0x88, 0xf3, 0x12, 0x8d, 0xfe, 0x53, 0x1c, 0x9d, 0x82, 0x57, 0xde, 0x03, 0x69, 0xe1,
+		0xb4, 0x44, 0xa3, 0xfe, 0x34, 0x47, 0x75, 0x50, 0x08, 0xba, 0x70, 0x58, 0xa2, 0xd1, 0x3f, 0x24,
+		0xd0, 0x05, 0x0a, 0x41, 0x8f, 0xcf, 0xc2, 0xaf, 0xff, 0xad, 0x14, 0x43, 0x17, 0x28, 0x15, 0x72,
+		0xf2, 0xcd, 0x3c, 0x95, 0x68, 0xec, 0x0f, 0xf3, 0xce, 0x05, 0x46, 0xe5, 0x71, 0x18, 0x89, 0xc9,
+		0xf0, 0x8f, 0x72, 0x54, 0x06, 0x5f, 0x99, 0x87, 0xac, 0xc7, 0x3b, 0x89, 0x46, 0xff, 0x39, 0x8e,
+		0xee, 0xc5, 0x22, 0x43, 0xe7, 0xde, 0x49, 0x34, 0x81, 0x8f, 0x89, 0xa1, 0x73, 0x0c, 0xc2, 0x36,
+		0xe1, 0x98, 0x44, 0x63, 0x7f, 0x5c, 0x70, 0x5d, 0xa0, 0x54, 0x9e, 0x84, 0x8c, 0x63, 0x6c, 0xa2,
+		0xf1, 0x7f, 0x9e, 0xe3, 0xbb, 0x38, 0x84, 0x03, 0x5d, 0x7d, 0x08, 0x12, 0xbf, 0x20, 0x38, 0xe0,
+		0xc1, 0x22, 0xdb, 0x28, 0xe8, 0xc0, 0x44, 0x53, 0xfa, 0xdb, 0x62, 0x1b, 0x05, 0xfc, 0x17, 0xb2,
+		0x9a, 0x54, 0xe7, 0x47, 0x93, 0xf8, 0x45, 0xb1, 0x9a, 0x14, 0x9e, 0x0c, 0x23, 0xe8, 0x11, 0x44,
+		0xd3, 0xf8, 0x3b, 0x62, 0x18, 0x01, 0x87, 0xa0, 0xb2, 0x06, 0xa8, 0xd7, 0x1b, 0x88, 0xa6, 0xf7,
+		0x49, 0x4e, 0x6f, 0xb2, 0xc7, 0x19, 0xa8, 0x3c, 0x0b, 0x33, 0xe1, 0x9e, 0x40, 0x34, 0xd5, 0x5f,
+		0x7e, 0x3d, 0x10, 0xbb, 0x79, 0x1d, 0x81, 0xca, 0x06, 0x4c, 0x87, 0x79, 0x01, 0xd1, 0x64, 0x3f,
+		0xf5, 0xba, 0x5f, 0x71, 0x7b, 0x9d, 0x80, 0xca, 0x1c, 0x80, 0x6b, 0x80, 0xa3, 0x69, 0x7d, 0x9a,
+		0xd3, 0xf2, 0x20, 0x91, 0xad, 0xc1, 0xed, 0x6f, 0x34, 0xfe, 0x67, 0xc4, 0xd6, 0xe0, 0x18, 0x64,
+		0x6b, 0x08, 0xd3, 0x1b, 0x8d, 0xfd, 0x59, 0xb1, 0x35, 0x04, 0x0a, 0x91, 0x6c, 0x8f, 0x75, 0x8b,
+		0xa6, 0xf0, 0x79, 0x21, 0xd9, 0x1e, 0xac, 0xca, 0x35, 0x98, 0xec, 0x31, 0x88, 0xd1, 0xa4, 0xbe,
+		0xc0, 0x49, 0x15, 0x82, 0xf6, 0xd0, 0x6b, 0xbc, 0xb8, 0x31, 0x8c, 0xa6, 0xf6, 0xf7, 0x02, 0xc6,
+		0x8b, 0xdb, 0xc2, 0xca, 0x25, 0x48, 0xeb, 0xdd, 0x56, 0x8b, 0x6c, 0x1e, 0x34, 0xf8, 0x6e, 0x60,
+		0xf1, 0xbf, 0xfd, 0x80, 0x73, 0x47, 0x20, 0x54, 0x1e, 0x85, 0x11, 0xdc, 0xde, 0xc2, 0x8d, 0x28,
+		0xcc, 0xef, 0xfe, 0x40, 0x28, 0x4c, 0x02, 0x5d, 0x79, 0x12, 0x80, 0xa5, 0x46, 0xe8, 0xf1, 0x60,
+		0x04, 0xee, 0x7f, 0xff, 0x01, 0xbf, 0x8c, 0xe3, 0xa2, 0xb8, 0x04, 0xd8, 0xd5, 0x9e, 0xc1, 0x04,
+		0x5e, 0xf3, 0x13, 0xa0, 0x2b, 0x72, 0x11, 0xc6, 0xc8, 0x15, 0x49, 0x5b, 0x6d, 0x46, 0x61, 0xff,
+		0x0f, 0x8e, 0x2d, 0xe0, 0x09, 0xc3, 0xda, 0x86, 0x89, 0x6d, 0xb5, 0x69, 0x45, 0xe1, 0xfe, 0x4f,
+		0x8e, 0xeb, 0x20, 0x10, 0xe4, 0xba, 0x6a, 0xd9, 0x71, 0xe6, 0xfd, 0xbf, 0x04, 0xb2, 0x40, 0x20,
+		0x83, 0x26, 0xbf, 0x6f, 0xe0, 0xbd, 0x28, 0xdc, 0xef, 0x89, 0x41, 0x73, 0xf8, 0xca, 0x7b, 0x20,
+		0x43, 0x7e, 0xb2, 0x1b, 0x76, 0x11, 0xc8, 0xff, 0x9b, 0x23, 0xbb, 0x18, 0xa4, 0x67, 0xcb, 0x6e,
+		0xd8, 0x5a, 0x34, 0xb3, 0x6f, 0xf3, 0x95, 0x16, 0xf0, 0x95, 0x39, 0xc8, 0x5a, 0x76, 0xa3, 0xd1,
+		0xe5, 0xfe, 0x69, 0x04, 0xfa, 0x9f, 0xff, 0xc0, 0x49, 0x59, 0x38, 0x38, 0x64, 0xb5, 0x6f, 0xdd,
+		0xb0, 0x3b, 0x06, 0x3d, 0x02, 0x89, 0xa2, 0xf0, 0x3a, 0xa7, 0xe0, 0x41, 0xa9, 0xcc, 0x43, 0x8e,
+		0xcc, 0xc5, 0xc4, 0x1d, 0x4c, 0xcf, 0xab, 0x22, 0x48, 0xfc, 0x05, 0x67, 0x80, 0x0f, 0xa9, 0xfa,
+		0x63, 0xdf, 0x78, 0xe5, 0xb8, 0xf4, 0xcd, 0x57, 0x8e, 0x4b, 0x7f, 0xf2, 0xca, 0x71, 0xe9, 0xe3,
+		0xaf, 0x1e, 0x3f, 0xf4, 0xcd, 0x57, 0x8f, 0x1f, 0xfa, 0xa3, 0x57, 0x8f, 0x1f, 0x0a, 0x4f, 0x1b,
+		0xc3, 0x15, 0xe3, 0x8a, 0xc1, 0x12, 0xc6, 0xcf, 0xcb, 0xbe, 0x74, 0x71, 0xd3, 0x70, 0xb3, 0xb5,
+		0x4e, 0x90, 0x03, 0xbf, 0x9d, 0x80, 0xfb, 0xe8, 0xb5, 0x60, 0xb3, 0xad, 0xe9, 0xf6, 0xb9, 0xba,
+		0xb9, 0xd7, 0xb1, 0x8d, 0x73, 0x6d, 0x6c, 0xde, 0x68, 0x61, 0xfe, 0x87, 0x11, 0x47, 0x45, 0x17,
+		0x6c, 0x96, 0x81, 0xcd, 0xb2, 0xf6, 0x52, 0x68, 0xb6, 0x58, 0x9e, 0x87, 0xb1, 0x35, 0xd3, 0x20,
+		0xc7, 0xc9, 0xe4, 0x62, 0x9c, 0x73, 0x59, 0x20, 0xc3, 0x6f, 0x2f, 0x17, 0x20, 0x79, 0x03, 0xef,
+		0xd1, 0xc4, 0x79, 0x4e, 0x21, 0x3f, 0x09, 0x54, 0x43, 0xb5, 0x55, 0x9a, 0x30, 0xcf, 0x29, 0xf4,
+		0xb7, 0x5c, 0x85, 0x11, 0x4a, 0x04, 0x5d, 0x84, 0xa4, 0xd1, 0xb1, 0x78, 0x76, 0xff, 0x9e, 0xd9,
+		0x7e, 0x63, 0x99, 0xe5, 0x5d, 0x56, 0x53, 0xdf, 0xd8, 0x2f, 0x1f, 0x52, 0x08, 0x4e, 0x75, 0xed,
+		0x2f, 0xbf, 0x7d, 0x5c, 0xfa, 0xd5, 0x57, 0x8e, 0x4b, 0xfd, 0x38, 0xf9, 0xfc, 0xac, 0x87, 0x51,
+		0x1e, 0x66, 0xf4, 0xe3, 0xcb, 0xd6, 0x28, 0xbb, 0x8b, 0x0c, 0x3f, 0x9b, 0x80, 0xe3, 0x1e, 0xa0,
+		0x96, 0xb6, 0x65, 0x9d, 0xbb, 0x71, 0xf3, 0x1c, 0x99, 0x9f, 0xc5, 0xb9, 0x86, 0x3c, 0x23, 0x25,
+		0xed, 0xb3, 0x37, 0x6e, 0xf6, 0xe1, 0xd7, 0x2c, 0xa4, 0xd6, 0x54, 0xcd, 0x14, 0x8c, 0x91, 0x5c,
+		0xc6, 0x4c, 0xbb, 0x77, 0x5b, 0x49, 0x1d, 0x2b, 0xc8, 0xe7, 0x21, 0xfd, 0xf4, 0xd2, 0x63, 0x17,
+		0xe2, 0xe0, 0x24, 0x39, 0x4e, 0x55, 0x11, 0xac, 0xf8, 0x6a, 0x08, 0x3b, 0xbe, 0xfe, 0xea, 0x71,
+		0xc9, 0x61, 0xc9, 0x99, 0x48, 0x96, 0xf0, 0xd9, 0x3a, 0xcc, 0xf8, 0x78, 0x02, 0xca, 0xc1, 0x53,
+		0x01, 0xb2, 0x15, 0x2d, 0x5b, 0x6d, 0x77, 0xfa, 0xbd, 0xfd, 0xba, 0x04, 0x99, 0x0d, 0x01, 0x43,
+		0x5e, 0x63, 0x59, 0xb8, 0x6e, 0xe8, 0x0d, 0x8b, 0xce, 0x24, 0xa9, 0x88, 0x22, 0x99, 0x8d, 0xae,
+		0xea, 0x86, 0xc5, 0x6f, 0x9c, 0xb2, 0x42, 0xf5, 0x13, 0xd2, 0x70, 0x7b, 0x23, 0xef, 0x74, 0x45,
+		0x37, 0xc8, 0x9a, 0xf4, 0xfc, 0x03, 0x83, 0x0e, 0x54, 0xe8, 0x32, 0xba, 0x53, 0xf0, 0x9c, 0x9e,
+		0x1c, 0x0f, 0x9e, 0x9e, 0x3c, 0x8b, 0x5b, 0xad, 0xa7, 0x75, 0xe3, 0x96, 0x4e, 0xae, 0x61, 0x58,
+		0x0e, 0x4b, 0x3e, 0x92, 0x80, 0xe3, 0x3d, 0x07, 0x25, 0x5c, 0xbd, 0xf4, 0xe3, 0x48, 0x05, 0xd2,
+		0x0b, 0x42, 0x6b, 0x0d, 0xcb, 0x90, 0x5f, 0x1c, 0x92, 0x21, 0xe3, 0xa2, 0x27, 0xc1, 0x8f, 0xfb,
+		0xa3, 0xf9, 0x21, 0xc6, 0x7f, 0x00, 0x76, 0x7c, 0xf0, 0x09, 0xb8, 0xc7, 0x23, 0x40, 0xea, 0x56,
+		0x5d, 0x3b, 0xc7, 0x99, 0xec, 0xd9, 0x31, 0x87, 0x5d, 0x90, 0x59, 0x02, 0x32, 0x4b, 0x1b, 0xc3,
+		0x37, 0x4d, 0x29, 0x9e, 0xee, 0x2a, 0x45, 0xec, 0xd2, 0x52, 0x94, 0xe0, 0x96, 0x22, 0x96, 0x51,
+		0xfe, 0x3f, 0x23, 0x30, 0xa6, 0xe0, 0x17, 0xbb, 0xd8, 0xa2, 0x4f, 0x17, 0x71, 0x7d, 0xc7, 0xe0,
+		0x97, 0xdd, 0xe5, 0xd9, 0xd0, 0xf9, 0xcc, 0x72, 0xe8, 0xc5, 0xfa, 0x8e, 0x71, 0xf5, 0x90, 0x42,
+		0x31, 0xe8, 0x5b, 0xb1, 0x56, 0xd7, 0xda, 0xe1, 0x77, 0x92, 0x4f, 0x0e, 0x46, 0xbd, 0x4c, 0x40,
+		0xaf, 0x1e, 0x52, 0x18, 0x0e, 0xe9, 0x96, 0xbe, 0x73, 0x4b, 0xc5, 0xe9, 0x96, 0x1c, 0x80, 0x92,
+		0x6e, 0x09, 0x06, 0xba, 0x0a, 0x60, 0x61, 0x5b, 0x5c, 0x65, 0x18, 0xa1, 0xf8, 0xa7, 0x07, 0xe3,
+		0xaf, 0x63, 0x9b, 0x99, 0xad, 0xab, 0x87, 0x94, 0x8c, 0x25, 0x0a, 0x84, 0x92, 0xa6, 0x6b, 0x76,
+		0xad, 0xbe, 0xa3, 0x6a, 0x7a, 0x71, 0x34, 0x0e, 0xa5, 0x25, 0x5d, 0xb3, 0xe7, 0x09, 0x38, 0xa1,
+		0xa4, 0x89, 0x02, 0x61, 0xc5, 0x8b, 0x5d, 0xcc, 0xef, 0xbe, 0x45, 0xb2, 0xe2, 0x19, 0x02, 0x4a,
+		0x58, 0x41, 0x71, 0xd0, 0xd3, 0x90, 0xa5, 0xc7, 0xad, 0xb5, 0xad, 0x96, 0x51, 0xbf, 0xc1, 0x5f,
+		0xa0, 0x9c, 0x19, 0x4c, 0xa2, 0x4a, 0x10, 0xaa, 0x04, 0xfe, 0xea, 0x21, 0x05, 0xb6, 0x9c, 0x12,
+		0xaa, 0x42, 0x9a, 0xdd, 0xfa, 0xb5, 0x77, 0xf9, 0x1b, 0xc2, 0xfb, 0x06, 0x53, 0xa2, 0x17, 0x80,
+		0x37, 0x76, 0xaf, 0x1e, 0x52, 0xc6, 0xea, 0xec, 0x27, 0x5a, 0x24, 0xef, 0xe7, 0x1a, 0x7c, 0x38,
+		0x59, 0xfe, 0xda, 0x6a, 0xb0, 0x5c, 0xe8, 0x0d, 0x31, 0x98, 0x34, 0xe6, 0xbf, 0xc9, 0xad, 0x77,
+		0x72, 0x54, 0xae, 0xd9, 0xf4, 0x2d, 0x22, 0xb9, 0x6e, 0x32, 0x78, 0x20, 0x14, 0xf6, 0xea, 0x21,
+		0x85, 0x63, 0x91, 0xe5, 0x69, 0xe0, 0x96, 0x46, 0x6e, 0x78, 0xdb, 0xbb, 0xc5, 0xa9, 0x38, 0xcb,
+		0xb3, 0xc0, 0xe0, 0xe9, 0x74, 0x32, 0x0d, 0x51, 0xa8, 0x8e, 0x71, 0xf3, 0x22, 0x9f, 0x86, 0xac,
+		0x47, 0x92, 0x89, 0xc6, 0xe2, 0x11, 0x08, 0x37, 0xf6, 0xa2, 0x28, 0xe7, 0x21, 0xe7, 0x95, 0x5b,
+		0xb9, 0x0d, 0x59, 0x8f, 0x2c, 0x12, 0xc4, 0x9b, 0xd8, 0xb4, 0xd8, 0x09, 0x3e, 0x45, 0xe4, 0x45,
+		0x72, 0xe9, 0x95, 0xf2, 0xad, 0x26, 0xda, 0x13, 0xf4, 0xc2, 0x48, 0x8e, 0x56, 0x5e, 0xe7, 0x40,
+		0x65, 0xc8, 0x76, 0xce, 0x77, 0x1c, 0x90, 0x24, 0x05, 0x81, 0xce, 0xf9, 0x0e, 0x07, 0x90, 0x2b,
+		0x50, 0x08, 0x8a, 0xae, 0xd7, 0x6a, 0x66, 0x42, 0xac, 0x66, 0x46, 0x58, 0xda, 0x5f, 0x4f, 0x40,
+		0x21, 0x28, 0xad, 0x64, 0xbb, 0x11, 0x25, 0x41, 0xb1, 0xb3, 0xe7, 0x4b, 0x3d, 0xae, 0x9d, 0x63,
+		0x6b, 0xaa, 0x69, 0xe2, 0x8a, 0x7c, 0xfc, 0x8f, 0xcb, 0x92, 0x42, 0x31, 0xd0, 0x51, 0x22, 0x50,
+		0xaa, 0xa6, 0xd7, 0xb4, 0x86, 0x78, 0x75, 0x4c, 0xcb, 0x4b, 0x0d, 0xf4, 0x0c, 0x14, 0xea, 0x86,
+		0x6e, 0x61, 0xdd, 0xea, 0x5a, 0xe4, 0x3e, 0x89, 0xda, 0x76, 0x1f, 0xe7, 0x85, 0x2f, 0xd3, 0xbc,
+		0x00, 0x5f, 0xa3, 0xd0, 0xca, 0x44, 0xdd, 0x5f, 0x81, 0x96, 0x01, 0x6e, 0xaa, 0x2d, 0xad, 0xa1,
+		0xda, 0x86, 0x69, 0xf1, 0xb7, 0x29, 0xfd, 0x88, 0x5d, 0x17, 0x80, 0x9b, 0x9d, 0x86, 0x6a, 0x63,
+		0xee, 0x44, 0x79, 0xf0, 0xd1, 0x29, 0x98, 0x50, 0x3b, 0x9d, 0x9a, 0x65, 0x93, 0xd3, 0xc4, 0xad,
+		0x3d, 0x1b, 0x5b, 0x54, 0x5f, 0xe4, 0x94, 0x71, 0xb5, 0xd3, 0x59, 0x27, 0xb5, 0x55, 0x52, 0x29,
+		0x37, 0x20, 0xe7, 0xdd, 0x9a, 0x8e, 0x6f, 0x27, 0xb9, 0xbe, 0x1d, 0xa9, 0xa3, 0x57, 0x2b, 0x18,
+		0x0f, 0xc4, 0x6d, 0x94, 0xd1, 0x1d, 0xac, 0x35, 0x77, 0x6c, 0x3a, 0xed, 0xa4, 0xc2, 0x4b, 0x64,
+		0x61, 0x3a, 0xa6, 0x71, 0x93, 0xdd, 0x16, 0x4a, 0x2b, 0xac, 0x20, 0xff, 0x52, 0x02, 0x26, 0x7b,
+		0xb6, 0x2f, 0xa1, 0x4b, 0xef, 0xf7, 0xf3, 0xbe, 0xc8, 0x6f, 0x74, 0x89, 0xd0, 0x55, 0x1b, 0xd8,
+		0x74, 0x9e, 0x20, 0x85, 0x73, 0xe0, 0x2a, 0x05, 0xe2, 0x13, 0xe7, 0x28, 0x68, 0x13, 0x0a, 0x2d,
+		0xd5, 0xb2, 0x6b, 0x6c, 0x17, 0xb1, 0xd7, 0xc4, 0xc9, 0x81, 0x9a, 0x60, 0x59, 0x15, 0xbb, 0x8f,
+		0x08, 0x37, 0x27, 0x97, 0x6f, 0xf9, 0x6a, 0xc9, 0x5d, 0xb2, 0xad, 0xbd, 0x97, 0x54, 0xdd, 0xd6,
+		0x74, 0x5c, 0x73, 0x79, 0xcc, 0xd7, 0xa8, 0xdc, 0x87, 0xf4, 0xe2, 0x4d, 0x8d, 0xbc, 0x15, 0x17,
+		0x8b, 0x33, 0xe5, 0x90, 0x70, 0x16, 0xcf, 0x92, 0x9f, 0x83, 0xbc, 0x5f, 0x17, 0xa1, 0x3c, 0x24,
+		0xec, 0x5d, 0xce, 0x91, 0x84, 0xbd, 0x8b, 0x1e, 0xe3, 0x1e, 0x79, 0x82, 0xde, 0x96, 0xeb, 0x67,
+		0x2c, 0x38, 0xb6, 0xfb, 0xe6, 0x50, 0x96, 0xa1, 0x10, 0x54, 0x0c, 0x41, 0xda, 0xf2, 0x59, 0x98,
+		0x08, 0x28, 0x31, 0xcf, 0xb2, 0x4a, 0xde, 0x65, 0x95, 0x27, 0x60, 0x9c, 0x83, 0x32, 0xbe, 0xc8,
+		0xbf, 0x3f, 0x0a, 0x69, 0x05, 0x5b, 0x1d, 0x22, 0xc4, 0xe8, 0x2a, 0x79, 0xfd, 0x5c, 0xc7, 0x1d,
+		0x5b, 0x68, 0x85, 0x41, 0x4a, 0x9c, 0xe1, 0x2c, 0x0a, 0x78, 0xa2, 0xae, 0x1c, 0x64, 0x74, 0xd1,
+		0x67, 0x92, 0x4f, 0x46, 0x11, 0xf1, 0xda, 0xe4, 0x77, 0xfb, 0x6d, 0xf2, 0xbd, 0x11, 0xb8, 0x01,
+		0xa3, 0x7c, 0xd1, 0x67, 0x94, 0xa3, 0x3a, 0xf6, 0x59, 0xe5, 0xa5, 0x10, 0xab, 0x1c, 0x35, 0xfd,
+		0x3e, 0x66, 0x79, 0x29, 0xc4, 0x2c, 0x9f, 0x89, 0x1c, 0x4b, 0xa8, 0x5d, 0x7e, 0xb7, 0xdf, 0x2e,
+		0x47, 0xb1, 0x23, 0x60, 0x98, 0x97, 0xc3, 0x0c, 0xf3, 0xd9, 0x08, 0x1a, 0x7d, 0x2d, 0xf3, 0x7c,
+		0x8f, 0x65, 0x3e, 0x15, 0x41, 0x2a, 0xc4, 0x34, 0x2f, 0xf9, 0x6c, 0x22, 0xc4, 0xe2, 0x4d, 0xb8,
+		0x51, 0x44, 0x97, 0x7b, 0xad, 0xfc, 0xe9, 0x28, 0x51, 0x0b, 0x33, 0xf3, 0x4f, 0x06, 0xcc, 0xfc,
+		0x7d, 0x51, 0xb3, 0x0a, 0xd8, 0x79, 0xd7, 0x3a, 0x9f, 0x85, 0x49, 0x01, 0xe4, 0xec, 0x0c, 0xa2,
+		0x4b, 0xb1, 0x69, 0x1a, 0x26, 0x37, 0x7c, 0xac, 0x20, 0x9f, 0x81, 0x9c, 0x03, 0x3a, 0xd8, 0x92,
+		0xd3, 0x4d, 0xeb, 0x91, 0x76, 0xf9, 0xab, 0x12, 0xe4, 0xbc, 0x22, 0xec, 0xd3, 0xf6, 0x19, 0xae,
+		0xed, 0x3d, 0x06, 0x3e, 0xe1, 0x37, 0xf0, 0xe4, 0xca, 0x77, 0xa7, 0xc7, 0x76, 0xab, 0x1d, 0x61,
+		0xbb, 0xc9, 0xd3, 0x1c, 0xaa, 0x7f, 0x99, 0x1b, 0xc0, 0x15, 0x49, 0x8a, 0x2a, 0x92, 0x09, 0xd2,
+		0xc0, 0x38, 0x48, 0xab, 0xd1, 0x43, 0x30, 0xe5, 0x81, 0x25, 0x74, 0xa9, 0x2d, 0x60, 0x46, 0xaa,
+		0xe0, 0x40, 0xcf, 0x75, 0x3a, 0xe4, 0x79, 0x17, 0xf9, 0xf6, 0x48, 0xcf, 0xde, 0x21, 0xc3, 0xaf,
+		0x1b, 0x0d, 0x36, 0xef, 0x71, 0x85, 0xfe, 0x26, 0xbe, 0x42, 0xcb, 0x68, 0xf2, 0x1b, 0x90, 0xe4,
+		0x27, 0x81, 0x72, 0xb6, 0x76, 0x86, 0xed, 0x59, 0xf9, 0xb7, 0x24, 0x98, 0xec, 0xd9, 0x40, 0xa1,
+		0x56, 0x5d, 0xba, 0x93, 0x56, 0x3d, 0xf1, 0xc6, 0xac, 0xba, 0xfc, 0xba, 0x04, 0xe3, 0xbe, 0x1d,
+		0x7b, 0x70, 0x16, 0x10, 0xe9, 0x62, 0x2f, 0xc6, 0xd9, 0x4d, 0x5d, 0x56, 0x10, 0xae, 0xd6, 0x68,
+		0x48, 0x82, 0x62, 0xcc, 0x93, 0xd4, 0x40, 0x8f, 0x52, 0x3b, 0x6f, 0x6c, 0x73, 0xd5, 0x50, 0x8e,
+		0x48, 0xf4, 0x28, 0x0c, 0xda, 0x63, 0x5f, 0x32, 0x3e, 0xb7, 0xe1, 0x18, 0x64, 0xc8, 0xd0, 0xd9,
+		0xf3, 0x27, 0xe0, 0xe9, 0x45, 0x51, 0x21, 0x37, 0x00, 0xf5, 0xea, 0x18, 0x74, 0x0d, 0x46, 0xf1,
+		0x4d, 0x7a, 0x1b, 0x95, 0x25, 0x9b, 0x8e, 0xf5, 0x35, 0xc4, 0x58, 0xb7, 0xab, 0x45, 0xc2, 0xcc,
+		0xef, 0xee, 0x97, 0x0b, 0x0c, 0xe7, 0x41, 0xa3, 0xad, 0xd9, 0xb8, 0xdd, 0xb1, 0xf7, 0x14, 0x4e,
+		0x45, 0xfe, 0x50, 0x02, 0x26, 0x44, 0x37, 0xc2, 0x1c, 0x87, 0xb1, 0x57, 0x6c, 0x9a, 0x84, 0xc7,
+		0x45, 0x8a, 0xc7, 0x72, 0xf2, 0x24, 0x50, 0xb5, 0x6a, 0xb7, 0x54, 0xdd, 0xc6, 0x0d, 0xce, 0xf7,
+		0x4c, 0x53, 0xb5, 0x9e, 0xa5, 0x15, 0xc4, 0xdf, 0x24, 0xcd, 0x5d, 0x0b, 0x37, 0xe8, 0x02, 0x24,
+		0x95, 0xb1, 0xa6, 0x6a, 0x6d, 0x5a, 0xb8, 0xe1, 0x99, 0xeb, 0xd8, 0x9d, 0x98, 0xab, 0x9f, 0xdf,
+		0xe9, 0x20, 0xbf, 0x3f, 0x9c, 0x80, 0xc9, 0x1e, 0x15, 0xfa, 0xd7, 0x94, 0x17, 0x9f, 0xa1, 0x31,
+		0x85, 0xdf, 0x08, 0xa0, 0xf7, 0xc1, 0xa4, 0xb3, 0x2b, 0x6b, 0x5d, 0xba, 0x5b, 0x85, 0x14, 0x0e,
+		0xb7, 0xb9, 0x0b, 0x37, 0xfd, 0xd5, 0x16, 0xfa, 0x71, 0x38, 0x12, 0xd0, 0x41, 0x4e, 0x07, 0x89,
+		0xa1, 0x54, 0xd1, 0x61, 0xbf, 0x2a, 0x12, 0xf4, 0x5d, 0xee, 0x25, 0xef, 0xc8, 0xae, 0xb9, 0x17,
+		0xf2, 0x82, 0x3d, 0xcc, 0xbc, 0x85, 0xc9, 0x84, 0xfc, 0x07, 0x12, 0x4c, 0x04, 0x06, 0x88, 0xde,
+		0x05, 0x23, 0xcc, 0x02, 0x4b, 0x03, 0x13, 0x21, 0x94, 0xe3, 0x7c, 0x4e, 0x0c, 0x01, 0xcd, 0x41,
+		0x1a, 0x73, 0xef, 0xba, 0x98, 0x18, 0x68, 0x79, 0x85, 0x13, 0xce, 0xf1, 0x1d, 0x34, 0xb4, 0x00,
+		0x19, 0x87, 0xf5, 0x11, 0x91, 0x9b, 0xb3, 0x72, 0x9c, 0x88, 0x8b, 0x28, 0xcf, 0x43, 0xd6, 0x33,
+		0x3c, 0xf6, 0x16, 0x70, 0x97, 0x87, 0x5b, 0xcc, 0x81, 0x4e, 0xb7, 0xd5, 0x5d, 0x1a, 0x69, 0xa1,
+		0x23, 0x30, 0x46, 0x1a, 0x9b, 0xfc, 0xb1, 0x54, 0x52, 0x19, 0x6d, 0xab, 0xbb, 0x57, 0x54, 0x4b,
+		0xfe, 0x88, 0x04, 0x79, 0xff, 0x38, 0xd1, 0x03, 0x80, 0x08, 0x2c, 0x79, 0x07, 0x49, 0x2e, 0xb2,
+		0xd1, 0x59, 0x0b, 0x8a, 0x13, 0x6d, 0x75, 0x77, 0xae, 0x89, 0xaf, 0x75, 0xdb, 0xb4, 0x6b, 0x0b,
+		0xad, 0x40, 0x41, 0x00, 0x8b, 0x64, 0x17, 0xe7, 0xca, 0xd1, 0xde, 0xef, 0xda, 0x70, 0x00, 0x16,
+		0xeb, 0x7e, 0x92, 0xc4, 0xba, 0x79, 0x46, 0x4f, 0xb4, 0xc8, 0x8f, 0xc2, 0x44, 0x60, 0xc6, 0x48,
+		0x86, 0xf1, 0x4e, 0x77, 0xab, 0x76, 0x03, 0xef, 0xd1, 0xd7, 0xef, 0x4c, 0xd4, 0x33, 0x4a, 0xb6,
+		0xd3, 0xdd, 0x7a, 0x1a, 0xef, 0xd1, 0xdc, 0xa1, 0x5c, 0x87, 0xbc, 0x3f, 0x98, 0x22, 0x86, 0xc3,
+		0x34, 0xba, 0x7a, 0x43, 0x7c, 0xd7, 0x80, 0x16, 0x48, 0xbe, 0xe8, 0xa6, 0xc1, 0xa4, 0x79, 0x50,
+		0xf4, 0x74, 0xdd, 0xb0, 0xb1, 0x27, 0x24, 0x63, 0x38, 0xb2, 0x05, 0x23, 0x54, 0x2e, 0x43, 0x0f,
+		0x2a, 0xae, 0x03, 0xa8, 0xb6, 0x6d, 0x6a, 0x5b, 0x5d, 0x97, 0x7c, 0x71, 0xb6, 0x37, 0xad, 0x3f,
+		0x4b, 0x32, 0xf1, 0xd5, 0x63, 0x5c, 0xb2, 0xa7, 0x5d, 0x1c, 0x8f, 0x74, 0x7b, 0x28, 0xc9, 0xaf,
+		0xa5, 0x60, 0x94, 0x85, 0x9b, 0xe8, 0x09, 0x7f, 0xf2, 0x83, 0x3c, 0xf2, 0xef, 0x33, 0x7c, 0x06,
+		0xc5, 0x47, 0x2f, 0x90, 0xd0, 0xa9, 0x60, 0x46, 0xa1, 0x9a, 0x7d, 0x65, 0xbf, 0x3c, 0x46, 0xbd,
+		0x8f, 0xa5, 0x05, 0x37, 0xbd, 0xd0, 0x2f, 0xba, 0x16, 0xb9, 0x8c, 0xd4, 0xd0, 0xb9, 0x8c, 0xab,
+		0x30, 0xee, 0x71, 0xb7, 0xb4, 0x46, 0x71, 0x64, 0xe0, 0xf8, 0xa9, 0x68, 0x2d, 0x2d, 0xf0, 0xf1,
+		0x67, 0x1d, 0x77, 0x6c, 0xa9, 0x41, 0x5e, 0x41, 0x7b, 0x83, 0x6c, 0xea, 0xb5, 0x31, 0x77, 0xc1,
+		0x13, 0x37, 0xd3, 0x27, 0xf9, 0x77, 0x41, 0x86, 0x3e, 0x6c, 0xa6, 0x20, 0xcc, 0x7b, 0x48, 0x93,
+		0x0a, 0xda, 0x78, 0x1a, 0x26, 0x5c, 0xc7, 0x86, 0x81, 0xa4, 0x19, 0x15, 0xb7, 0x9a, 0x02, 0x3e,
+		0x0c, 0xd3, 0x3a, 0xde, 0xb5, 0x6b, 0x41, 0xe8, 0x0c, 0x85, 0x46, 0xa4, 0xed, 0xba, 0x1f, 0xe3,
+		0x3e, 0xc8, 0xbb, 0x2a, 0x94, 0xc2, 0x02, 0x4b, 0x7d, 0x38, 0xb5, 0x14, 0xec, 0x28, 0xa4, 0x1d,
+		0xb7, 0x33, 0x4b, 0x01, 0xc6, 0x54, 0xe6, 0x6d, 0x3a, 0x8e, 0xac, 0x89, 0xad, 0x6e, 0xcb, 0xe6,
+		0x44, 0x72, 0x14, 0x86, 0x3a, 0xb2, 0x0a, 0xab, 0xa7, 0xb0, 0x27, 0x61, 0x5c, 0x68, 0x15, 0x06,
+		0x37, 0x4e, 0xe1, 0x72, 0xa2, 0x92, 0x02, 0x9d, 0x85, 0x42, 0xc7, 0x34, 0x3a, 0x86, 0x45, 0xae,
+		0x26, 0x36, 0x1a, 0x26, 0xb6, 0xac, 0x62, 0x9e, 0xd1, 0x13, 0xf5, 0x73, 0xac, 0x5a, 0xfe, 0x11,
+		0x18, 0x13, 0xfe, 0xf4, 0x34, 0x8c, 0x54, 0x1d, 0x0d, 0x99, 0x52, 0x58, 0x81, 0xd8, 0xd7, 0xb9,
+		0x4e, 0x87, 0x67, 0xd7, 0xc8, 0x4f, 0xb9, 0x05, 0x63, 0x7c, 0xc1, 0x42, 0x73, 0x2a, 0x2b, 0x90,
+		0xeb, 0xa8, 0x26, 0x99, 0x86, 0x37, 0xb3, 0xd2, 0x2f, 0x22, 0x24, 0xaf, 0xa1, 0xd6, 0xb1, 0xed,
+		0x4b, 0xb0, 0x64, 0x29, 0x3e, 0xab, 0x92, 0x2f, 0xc2, 0xb8, 0x0f, 0x86, 0x0c, 0xd3, 0x36, 0x6c,
+		0xb5, 0x25, 0x36, 0x3a, 0x2d, 0x38, 0x23, 0x49, 0xb8, 0x23, 0x21, 0xa7, 0x48, 0xce, 0x5a, 0x91,
+		0x40, 0x43, 0xb0, 0x42, 0xe2, 0xec, 0x67, 0x45, 0x42, 0xb0, 0x63, 0xdc, 0xe2, 0x9f, 0x72, 0x4a,
+		0x2a, 0xac, 0x20, 0x63, 0x8f, 0x62, 0x62, 0xd6, 0x0c, 0xbd, 0x1b, 0xc6, 0xb8, 0x62, 0x2a, 0x4a,
+		0x03, 0xd3, 0x45, 0x6b, 0x54, 0x53, 0x89, 0x74, 0x11, 0xd3, 0x5b, 0x6e, 0x37, 0x09, 0x6f, 0x37,
+		0x1f, 0x80, 0xb4, 0x50, 0x3e, 0x7e, 0x2b, 0xc1, 0x7a, 0x38, 0x11, 0x65, 0x25, 0x78, 0x27, 0x2e,
+		0x22, 0x91, 0x26, 0x4b, 0x6b, 0xea, 0xb8, 0x51, 0x73, 0xb7, 0x20, 0x7f, 0x30, 0x3b, 0xc1, 0x1a,
+		0x96, 0xc5, 0xfe, 0x92, 0x1f, 0x86, 0x51, 0x36, 0xd6, 0x50, 0x15, 0x17, 0x66, 0x5a, 0xbf, 0x23,
+		0x41, 0x5a, 0x98, 0x8f, 0x50, 0x24, 0xdf, 0x24, 0x12, 0x07, 0x9d, 0xc4, 0x9d, 0x57, 0x49, 0x0f,
+		0x02, 0xa2, 0x92, 0x52, 0xbb, 0x69, 0xd8, 0xe4, 0xd9, 0x04, 0x5b, 0x0b, 0xfe, 0x6e, 0x90, 0xb6,
+		0x5c, 0xa7, 0x0d, 0x6b, 0xa4, 0xfe, 0xfe, 0x93, 0x90, 0xf5, 0x64, 0xb9, 0xd0, 0x18, 0x24, 0xaf,
+		0xe1, 0x5b, 0x85, 0x43, 0x28, 0x4b, 0xce, 0x73, 0x68, 0x8e, 0xa0, 0x20, 0x9d, 0x7f, 0x6d, 0x0c,
+		0x26, 0xe6, 0xaa, 0xf3, 0x4b, 0x73, 0x9d, 0x4e, 0x4b, 0xe3, 0xef, 0xe9, 0x56, 0x21, 0x45, 0xe3,
+		0xe4, 0x18, 0xe7, 0x3b, 0xa5, 0x38, 0x09, 0x27, 0xa4, 0xc0, 0x08, 0x0d, 0xa7, 0x51, 0x9c, 0x63,
+		0x9f, 0x52, 0xac, 0x3c, 0x14, 0x19, 0x24, 0x15, 0xb8, 0x18, 0xa7, 0x41, 0xa5, 0x38, 0xc9, 0x29,
+		0xf4, 0xe3, 0x90, 0x71, 0xe3, 0xe4, 0xb8, 0x67, 0x44, 0xa5, 0xd8, 0x69, 0x2b, 0x42, 0xdf, 0x8d,
+		0x0c, 0xe2, 0x1e, 0x4d, 0x94, 0x62, 0xe7, 0x6b, 0xd0, 0x73, 0x30, 0x26, 0x62, 0xb0, 0x78, 0xa7,
+		0x38, 0xa5, 0x98, 0x29, 0x25, 0xb2, 0x7c, 0x2c, 0x74, 0x8e, 0x73, 0x54, 0x55, 0x8a, 0x95, 0x37,
+		0x43, 0x9b, 0x30, 0xca, 0x9d, 0xdf, 0x58, 0x27, 0x3d, 0xa5, 0x78, 0x89, 0x22, 0xc2, 0x64, 0x37,
+		0x39, 0x11, 0xf7, 0x78, 0xae, 0x14, 0x3b, 0x61, 0x88, 0x54, 0x00, 0x4f, 0x3c, 0x1d, 0xfb, 0xdc,
+		0xad, 0x14, 0x3f, 0x11, 0x88, 0xde, 0x0f, 0x69, 0x27, 0x6a, 0x8a, 0x79, 0x92, 0x56, 0x8a, 0x9b,
+		0x8b, 0xab, 0x6e, 0xc6, 0xbe, 0x25, 0xf1, 0x40, 0xe4, 0x2d, 0x09, 0xf7, 0x90, 0xdb, 0x39, 0x06,
+		0xff, 0x0b, 0x09, 0x8e, 0x32, 0x75, 0xe6, 0x1e, 0x27, 0xab, 0xfa, 0x5e, 0x9f, 0x0b, 0x01, 0x7d,
+		0x6e, 0x8b, 0xbc, 0x1b, 0x92, 0x73, 0xfa, 0x1e, 0x71, 0x36, 0x08, 0xf1, 0x5a, 0xd7, 0x6c, 0x89,
+		0x34, 0x1d, 0x29, 0x6f, 0x9a, 0xad, 0xf0, 0x5b, 0x23, 0x95, 0xd4, 0xf7, 0x3e, 0x5f, 0x3e, 0x54,
+		0xbd, 0x11, 0x32, 0xab, 0x88, 0xbb, 0x02, 0xe9, 0x39, 0x7d, 0x4f, 0x5c, 0x13, 0x18, 0xa1, 0x13,
+		0x1a, 0xf6, 0xf8, 0xff, 0xd5, 0x1c, 0xa1, 0x6c, 0xb5, 0x0d, 0xeb, 0x1c, 0xfb, 0xc3, 0x67, 0x3c,
+		0xca, 0x4a, 0x7d, 0x4e, 0xf8, 0xa3, 0x6f, 0x0c, 0x94, 0xfa, 0x73, 0x53, 0xbe, 0x02, 0xa9, 0x79,
+		0x43, 0xa3, 0x2e, 0x4f, 0x03, 0xeb, 0x46, 0x5b, 0xe4, 0x3c, 0x69, 0x01, 0x9d, 0x84, 0x51, 0xb5,
+		0x6d, 0x74, 0x75, 0x5b, 0x78, 0xcd, 0xc4, 0x94, 0xfc, 0x97, 0xfd, 0x72, 0x72, 0x49, 0xb7, 0x15,
+		0xde, 0x54, 0x49, 0xfd, 0xd9, 0xe7, 0xca, 0x92, 0xfc, 0x14, 0x8c, 0x2d, 0xe0, 0xfa, 0x41, 0x68,
+		0x2d, 0xe0, 0x7a, 0x80, 0xd6, 0x59, 0x48, 0x2f, 0xe9, 0x36, 0x65, 0x17, 0xba, 0x1b, 0x92, 0x9a,
+		0xce, 0x8e, 0x45, 0x02, 0xfd, 0x93, 0x7a, 0x02, 0xba, 0x80, 0xeb, 0x0e, 0x68, 0x03, 0xd7, 0x8b,
+		0x52, 0x2f, 0x79, 0x52, 0x2f, 0x57, 0x21, 0x77, 0x5d, 0x6d, 0x71, 0x77, 0x0f, 0x5b, 0xe8, 0x41,
+		0xc8, 0xa8, 0xa2, 0x40, 0x23, 0xab, 0x5c, 0x35, 0xff, 0xfd, 0xfd, 0x32, 0xb8, 0x40, 0x8a, 0x0b,
+		0x50, 0x49, 0xbd, 0xfc, 0x5f, 0x4f, 0x48, 0xb2, 0x01, 0x63, 0x57, 0x54, 0x8b, 0x6a, 0xfa, 0x0b,
+		0xbe, 0x44, 0x0a, 0xf5, 0x14, 0xab, 0x87, 0x6f, 0xef, 0x97, 0x27, 0xf7, 0xd4, 0x76, 0xab, 0x22,
+		0xbb, 0x6d, 0xb2, 0x37, 0xbf, 0x32, 0xeb, 0xc9, 0xaf, 0x50, 0x4f, 0xb2, 0x3a, 0x75, 0x7b, 0xbf,
+		0x3c, 0xe1, 0xe2, 0x90, 0x16, 0xd9, 0x49, 0xba, 0xc8, 0x1d, 0x18, 0x65, 0x4e, 0x6f, 0xe8, 0x09,
+		0x21, 0x4f, 0xf9, 0x24, 0xdc, 0x94, 0x4f, 0x65, 0xa8, 0x34, 0x03, 0xf7, 0xcb, 0x18, 0x46, 0x25,
+		0xf5, 0x33, 0x9f, 0x2b, 0x1f, 0x92, 0x4d, 0x40, 0xeb, 0x5a, 0xbb, 0xdb, 0x62, 0x0f, 0xbf, 0xc5,
+		0x51, 0xd3, 0x05, 0x36, 0x6e, 0x9a, 0x4e, 0x62, 0x0e, 0xd9, 0xc4, 0x2c, 0x17, 0x52, 0xce, 0x10,
+		0xe6, 0x67, 0x7c, 0x73, 0xbf, 0x2c, 0xd1, 0xd1, 0x53, 0x1e, 0x9d, 0x82, 0x51, 0xe6, 0xca, 0x73,
+		0xff, 0x27, 0x2f, 0x70, 0xd8, 0x9c, 0x14, 0xde, 0x2a, 0x3f, 0x01, 0x63, 0x2b, 0x56, 0x73, 0x81,
+		0x4c, 0xe9, 0x28, 0xa4, 0xdb, 0x56, 0xb3, 0xe6, 0xf1, 0xa6, 0xc6, 0xda, 0x56, 0x73, 0xa3, 0x8f,
+		0x17, 0xc6, 0x97, 0xe5, 0x11, 0x18, 0xdd, 0xd8, 0xa5, 0xe8, 0x27, 0x1d, 0x2e, 0x25, 0xbd, 0x63,
+		0xe4, 0xd4, 0x7d, 0x48, 0x1f, 0x4c, 0x02, 0x6c, 0xec, 0x3a, 0x33, 0xec, 0x73, 0x04, 0x87, 0x64,
+		0x18, 0xb5, 0x77, 0x1d, 0x8f, 0x3a, 0x53, 0x85, 0x57, 0xf6, 0xcb, 0xa3, 0x1b, 0xbb, 0x24, 0xbc,
+		0x50, 0x78, 0x8b, 0x3f, 0x95, 0x95, 0x0c, 0xa4, 0xb2, 0x9c, 0x04, 0x5e, 0x2a, 0x24, 0x81, 0x37,
+		0xe2, 0x39, 0x01, 0x38, 0x02, 0x63, 0xa6, 0x7a, 0xab, 0x46, 0x56, 0x94, 0x7d, 0xad, 0x74, 0xd4,
+		0x54, 0x6f, 0x2d, 0x1b, 0x4d, 0x34, 0x0f, 0xa9, 0x96, 0xd1, 0x14, 0x79, 0xb7, 0x19, 0x31, 0x29,
+		0xe2, 0x71, 0xf1, 0xdb, 0xc4, 0xcb, 0x46, 0xb3, 0x7a, 0x84, 0xf0, 0xff, 0x4b, 0x7f, 0x5c, 0x9e,
+		0xf0, 0xd7, 0x5b, 0x0a, 0x45, 0x76, 0x92, 0x81, 0xe9, 0xbe, 0xc9, 0xc0, 0xcc, 0xa0, 0x64, 0x20,
+		0xf8, 0x93, 0x81, 0xf7, 0xd2, 0x33, 0x4d, 0x76, 0x86, 0x33, 0xdd, 0xe3, 0x7c, 0xce, 0xe9, 0x7b,
+		0xf4, 0x14, 0xf5, 0x18, 0x64, 0x9c, 0x8b, 0x42, 0xfc, 0xf3, 0xd0, 0x6e, 0x05, 0x97, 0xb7, 0x9f,
+		0x91, 0x20, 0xef, 0x1f, 0x31, 0xcd, 0xe7, 0x58, 0x4d, 0xfe, 0x61, 0x55, 0x96, 0xf6, 0x24, 0x42,
+		0xb1, 0x24, 0x32, 0xe5, 0x01, 0x99, 0x9f, 0x0b, 0xc8, 0xfc, 0x94, 0x60, 0x10, 0x7b, 0xbb, 0xc3,
+		0x44, 0x7d, 0x9a, 0x73, 0x27, 0xe7, 0xa9, 0xb4, 0x5c, 0xd1, 0xa7, 0x12, 0xf1, 0x13, 0x90, 0xf5,
+		0xb4, 0x86, 0x3a, 0xf5, 0x8f, 0x87, 0x24, 0x3b, 0x26, 0x9d, 0x05, 0x11, 0x2d, 0xe2, 0x08, 0xc1,
+		0x05, 0x75, 0x04, 0x35, 0xe3, 0x00, 0xc5, 0xbd, 0x5e, 0x51, 0x5d, 0xf8, 0xa3, 0x6f, 0x1f, 0x3f,
+		0xf4, 0xf2, 0x2b, 0xc7, 0x0f, 0xf5, 0xbd, 0x9f, 0xe9, 0xbd, 0xc8, 0xea, 0xb3, 0x20, 0x0f, 0x59,
+		0x8d, 0x1b, 0x01, 0xeb, 0xfa, 0xf7, 0x9f, 0x85, 0x63, 0x1c, 0xc6, 0xb2, 0xd5, 0x1b, 0x9a, 0xde,
+		0x14, 0x7f, 0xb9, 0xb9, 0xc9, 0xf3, 0xd9, 0xf0, 0xda, 0x83, 0x9b, 0x9d, 0x37, 0x7a, 0x69, 0xac,
+		0x14, 0x66, 0x0d, 0xe5, 0xfd, 0x14, 0xa0, 0x15, 0xab, 0x39, 0x6f, 0x62, 0xf6, 0xb1, 0x11, 0x1e,
+		0x27, 0xf9, 0x1f, 0xfb, 0x70, 0x1d, 0x75, 0xd7, 0xac, 0x7f, 0x2e, 0xce, 0xf7, 0xa5, 0xdd, 0x1c,
+		0x91, 0x17, 0x0b, 0x2d, 0x02, 0xd0, 0xf4, 0x8a, 0x65, 0xb9, 0xc9, 0xbc, 0x72, 0x90, 0xc6, 0xbc,
+		0x03, 0xa1, 0xa8, 0x36, 0xb6, 0xc4, 0x5a, 0xbb, 0x88, 0xe8, 0x03, 0x30, 0xd5, 0xd6, 0xf4, 0x9a,
+		0x85, 0x5b, 0xe4, 0x9b, 0xdb, 0x2d, 0xfa, 0x29, 0x16, 0x7e, 0x70, 0x97, 0xa9, 0x2e, 0x73, 0xc3,
+		0x74, 0x2a, 0x7a, 0xcd, 0x66, 0x97, 0x74, 0xfb, 0xf6, 0x7e, 0xb9, 0xc4, 0xac, 0x43, 0x08, 0x49,
+		0x59, 0x99, 0x6c, 0x6b, 0xfa, 0x3a, 0x6e, 0x6d, 0x2f, 0x38, 0x75, 0xe8, 0x25, 0x98, 0xe4, 0x10,
+		0x86, 0x9b, 0xf4, 0x20, 0xba, 0x27, 0x57, 0x5d, 0xb9, 0xbd, 0x5f, 0x2e, 0x32, 0x6a, 0x3d, 0x20,
+		0xf2, 0xf7, 0xf7, 0xcb, 0x0f, 0xc5, 0x18, 0xd3, 0x5c, 0xbd, 0x2e, 0xcc, 0x63, 0xc1, 0x21, 0xc2,
+		0x6b, 0x48, 0xdf, 0x6e, 0x82, 0x5e, 0xf4, 0x3d, 0x12, 0xec, 0xbb, 0x07, 0x24, 0x6e, 0xdf, 0x1e,
+		0xd3, 0xec, 0x66, 0xf0, 0x45, 0xdf, 0xe4, 0x7b, 0x69, 0xdd, 0x2d, 0x71, 0x8a, 0x96, 0x51, 0x78,
+		0x09, 0x9d, 0xf1, 0x1e, 0xa4, 0x65, 0xcf, 0xe7, 0xc4, 0x7a, 0x12, 0x5f, 0xc5, 0x49, 0x73, 0x32,
+		0xdf, 0x8f, 0x7a, 0x1f, 0x5f, 0x4d, 0x42, 0x61, 0xc5, 0x6a, 0x2e, 0x36, 0x34, 0xfb, 0x0e, 0x8b,
+		0x57, 0x27, 0x8c, 0x3b, 0xd4, 0x9a, 0x55, 0xe7, 0x6f, 0xef, 0x97, 0xf3, 0x8c, 0x3b, 0x77, 0x92,
+		0x27, 0x6d, 0x98, 0x70, 0xe5, 0xb2, 0x66, 0xaa, 0x36, 0x37, 0x4f, 0xd5, 0x85, 0x98, 0x12, 0xb8,
+		0x80, 0xeb, 0xb7, 0xf7, 0xcb, 0x33, 0x6c, 0x64, 0x01, 0x52, 0xb2, 0x92, 0xaf, 0xfb, 0xf6, 0x02,
+		0xda, 0x0d, 0x17, 0x7c, 0x7a, 0xfe, 0x54, 0xbd, 0xfa, 0x26, 0x0a, 0x3d, 0x5f, 0xba, 0xaf, 0x24,
+		0x20, 0x4b, 0x4c, 0x3d, 0xab, 0xc7, 0xe1, 0x5b, 0x41, 0xfa, 0x21, 0x6e, 0x85, 0xc4, 0x5b, 0xb3,
+		0x15, 0xee, 0x77, 0x7c, 0xed, 0x64, 0x5f, 0x99, 0xf7, 0xbb, 0xdc, 0xff, 0x31, 0x49, 0xb5, 0x2a,
+		0x8d, 0x20, 0x15, 0xdc, 0x78, 0x3b, 0x30, 0xf0, 0xa7, 0x24, 0x38, 0xec, 0xb2, 0xc7, 0x32, 0xeb,
+		0x01, 0x2e, 0x3e, 0x73, 0x7b, 0xbf, 0x7c, 0x2c, 0xc8, 0x45, 0x0f, 0xd8, 0x01, 0x38, 0x39, 0xe5,
+		0x10, 0x5a, 0x37, 0xeb, 0xe1, 0xe3, 0x68, 0x58, 0xb6, 0x33, 0x8e, 0x64, 0xff, 0x71, 0x78, 0xc0,
+		0xde, 0xd0, 0x38, 0x16, 0x2c, 0xbb, 0x77, 0x51, 0x53, 0x31, 0x17, 0xf5, 0x6b, 0xe4, 0xc3, 0x71,
+		0x56, 0x73, 0x53, 0x6f, 0xbc, 0xb3, 0x21, 0x86, 0xdd, 0x10, 0x1f, 0x4d, 0xc2, 0x31, 0xe2, 0x66,
+		0xa8, 0x7a, 0x1d, 0xb7, 0x36, 0xf5, 0x2d, 0x43, 0x67, 0x5f, 0xdc, 0x1a, 0x6c, 0x66, 0xdf, 0x61,
+		0xa5, 0x97, 0x95, 0x68, 0x1e, 0x26, 0xea, 0xc4, 0x4f, 0x23, 0x16, 0xc3, 0x7b, 0x2d, 0xa9, 0x5a,
+		0xf2, 0x18, 0x15, 0x3f, 0x00, 0x31, 0x2a, 0xbc, 0x86, 0xdd, 0x58, 0xe2, 0xeb, 0xf1, 0x7b, 0x49,
+		0x98, 0x5c, 0xb1, 0x9a, 0x1b, 0xc6, 0x0d, 0x4c, 0x1e, 0xb0, 0xae, 0xef, 0xa8, 0x26, 0xb6, 0xde,
+		0x59, 0x84, 0x18, 0x8b, 0x40, 0xf4, 0x97, 0xcd, 0xd9, 0xd6, 0xa8, 0x91, 0xcf, 0x2f, 0xe3, 0x9a,
+		0x71, 0x4b, 0xc7, 0x66, 0x31, 0x15, 0xd4, 0x5f, 0xa1, 0x60, 0x07, 0x60, 0xd6, 0x94, 0x43, 0x88,
+		0xae, 0xd3, 0x2a, 0x21, 0xc3, 0xd7, 0xf1, 0x1b, 0x12, 0x14, 0x57, 0xac, 0x26, 0xb1, 0x31, 0xb8,
+		0x4d, 0x57, 0xd3, 0xba, 0x6c, 0x98, 0x6f, 0x83, 0xe5, 0xbc, 0xdf, 0x97, 0x9f, 0x8a, 0xa3, 0x22,
+		0x7e, 0x33, 0x01, 0xc7, 0x89, 0x48, 0x9a, 0xaa, 0x6e, 0x6d, 0x63, 0xd3, 0x27, 0x9a, 0x0a, 0xae,
+		0x1b, 0x66, 0x03, 0xfd, 0x28, 0x14, 0x05, 0x2b, 0x38, 0x4b, 0x4d, 0xda, 0x40, 0x4e, 0x82, 0x59,
+		0xca, 0xe8, 0xe4, 0xed, 0xfd, 0x72, 0xd9, 0xcf, 0xfd, 0x20, 0xa4, 0xac, 0x1c, 0xb6, 0x7b, 0x69,
+		0x2f, 0x35, 0xd0, 0x12, 0x8c, 0x5a, 0x34, 0x0a, 0xe3, 0x62, 0xf7, 0x23, 0xc3, 0xf3, 0x81, 0x13,
+		0x40, 0x5b, 0x90, 0xd1, 0xf1, 0x2d, 0x2e, 0x17, 0xcc, 0xae, 0x2d, 0xde, 0xde, 0x2f, 0x17, 0xd8,
+		0xc8, 0x9c, 0xa6, 0x03, 0x70, 0x3a, 0xad, 0xe3, 0x5b, 0x5e, 0x01, 0xf8, 0xf7, 0x12, 0x4c, 0x11,
+		0x01, 0x30, 0x6c, 0xd5, 0xc6, 0xe4, 0x6e, 0x0a, 0x3f, 0x77, 0x0b, 0xdd, 0x4e, 0xd2, 0x5b, 0xb3,
+		0x9d, 0x2e, 0x00, 0x90, 0x29, 0xf2, 0xf0, 0x81, 0xe5, 0x79, 0x3c, 0xb9, 0x3c, 0xb7, 0x4d, 0x56,
+		0x08, 0x9b, 0xd6, 0xe8, 0x6f, 0x3e, 0x9f, 0x4f, 0x27, 0x01, 0xb9, 0xd3, 0x50, 0xc4, 0x77, 0x11,
+		0x6f, 0x41, 0xc1, 0xe8, 0x60, 0x33, 0x64, 0x36, 0xcb, 0xb7, 0xf7, 0xcb, 0x47, 0x18, 0xe1, 0x20,
+		0xc4, 0x01, 0x26, 0x33, 0x21, 0x68, 0x88, 0xb9, 0x54, 0x61, 0xc2, 0x68, 0x91, 0x4f, 0x46, 0xea,
+		0x96, 0x7f, 0x42, 0x1e, 0x9d, 0x1b, 0x00, 0x90, 0x95, 0x71, 0xa3, 0xd5, 0xe0, 0x93, 0x20, 0x21,
+		0x53, 0x15, 0x26, 0xc8, 0x9c, 0xbd, 0x34, 0x92, 0x41, 0x1a, 0x01, 0x00, 0x59, 0x19, 0xd7, 0xf1,
+		0x2d, 0x0f, 0x0d, 0x37, 0x9f, 0x96, 0xf2, 0xe5, 0xd3, 0x9a, 0x34, 0x24, 0xe9, 0xb4, 0x30, 0x55,
+		0xfa, 0xf4, 0x0c, 0x73, 0x24, 0xf2, 0x0c, 0x53, 0x26, 0xdb, 0xcf, 0x17, 0x88, 0x78, 0x09, 0xc8,
+		0xf4, 0x74, 0x33, 0xef, 0xd6, 0x12, 0x44, 0xbe, 0x3c, 0x1f, 0x4a, 0xc0, 0x54, 0xd8, 0xce, 0xcc,
+		0x43, 0x42, 0xec, 0x41, 0x25, 0xa1, 0x91, 0xaf, 0x6a, 0x8f, 0x30, 0xe1, 0x3f, 0xf0, 0x56, 0x62,
+		0xf8, 0xe8, 0xbd, 0x90, 0x6f, 0x1b, 0x8d, 0x6e, 0x0b, 0x93, 0xaf, 0x9f, 0x3b, 0x2a, 0x3a, 0x53,
+		0x3d, 0x7a, 0x7b, 0xbf, 0x7c, 0x98, 0x07, 0x35, 0xbe, 0x76, 0x59, 0x19, 0x67, 0x15, 0x73, 0xac,
+		0x8c, 0x56, 0xbd, 0x07, 0xc7, 0xa9, 0xa1, 0x86, 0xe3, 0xcd, 0x5d, 0x3b, 0x34, 0x38, 0x27, 0xc8,
+		0x7d, 0xa7, 0xab, 0x9a, 0x65, 0x1b, 0xa6, 0x56, 0x57, 0x5b, 0x34, 0x3f, 0xeb, 0xbe, 0xfa, 0x90,
+		0x86, 0x7f, 0xf5, 0xf1, 0x38, 0x8c, 0xde, 0x54, 0x5b, 0x16, 0xb6, 0x79, 0x1a, 0xec, 0x68, 0x30,
+		0x1a, 0x0e, 0x9e, 0x6a, 0x73, 0x70, 0x3e, 0x9c, 0x5f, 0x4b, 0x90, 0xab, 0x69, 0xbe, 0x54, 0x0a,
+		0xaa, 0x42, 0x8a, 0xc6, 0xa8, 0x2c, 0x85, 0x3f, 0x3b, 0x44, 0xa6, 0x84, 0x64, 0xf9, 0x29, 0x2e,
+		0xfa, 0x51, 0x20, 0x77, 0xbf, 0x58, 0xac, 0xcb, 0x04, 0x7f, 0x6e, 0x38, 0x3a, 0x6e, 0x3e, 0x5e,
+		0xd0, 0x91, 0x15, 0x72, 0x85, 0x8c, 0x46, 0xb8, 0x1d, 0x20, 0xf7, 0xc0, 0xc8, 0x43, 0x01, 0xbd,
+		0x89, 0xbd, 0x01, 0xf5, 0xd5, 0xa1, 0x3b, 0x99, 0x71, 0x3b, 0xf1, 0x90, 0x23, 0xd2, 0xa0, 0xee,
+		0xce, 0xd3, 0x0a, 0xd2, 0x63, 0x25, 0xfd, 0xc9, 0xcf, 0x95, 0x0f, 0x51, 0x8e, 0xfd, 0x3b, 0x09,
+		0xc0, 0xe5, 0x18, 0xda, 0x20, 0xb7, 0xa6, 0x7d, 0x01, 0xb9, 0xb8, 0x35, 0x1d, 0x99, 0xb2, 0x72,
+		0x53, 0xf5, 0x13, 0xf5, 0xc0, 0x12, 0xbc, 0x1f, 0xb2, 0xec, 0xde, 0x23, 0xdb, 0x9a, 0x89, 0xc8,
+		0xad, 0x79, 0x9c, 0x6f, 0x4d, 0xc4, 0xa6, 0xe3, 0x41, 0x66, 0xdb, 0x12, 0x58, 0x0d, 0xdd, 0x92,
+		0xbe, 0xb9, 0x64, 0x3d, 0xd9, 0x12, 0x7a, 0x9b, 0xde, 0xd0, 0xb5, 0x1b, 0xd8, 0x74, 0xb2, 0xfe,
+		0xac, 0x48, 0xfe, 0x51, 0x17, 0xfb, 0x4e, 0xb2, 0xbd, 0x27, 0xfe, 0x51, 0x97, 0x28, 0x13, 0xac,
+		0x5b, 0x78, 0xcb, 0xd2, 0xc4, 0x2a, 0x28, 0xa2, 0x88, 0x2e, 0x93, 0x7f, 0xd5, 0x52, 0xef, 0x9a,
+		0x9a, 0xbd, 0x47, 0xb4, 0x94, 0xad, 0xd6, 0x6d, 0x9e, 0x86, 0xb8, 0xcb, 0x55, 0xbf, 0x41, 0x08,
+		0x59, 0x99, 0x10, 0x55, 0xf3, 0xac, 0x86, 0xf4, 0xd0, 0xc0, 0xb6, 0xaa, 0xb5, 0x2c, 0x9e, 0xaa,
+		0x17, 0x45, 0xcf, 0x5c, 0xbe, 0x37, 0xe6, 0xbd, 0x5e, 0xf3, 0x43, 0x53, 0xfc, 0x97, 0x7d, 0xb7,
+		0xe8, 0xbd, 0x9a, 0xdf, 0x33, 0xe5, 0x20, 0x84, 0xec, 0xbd, 0x3a, 0xef, 0x28, 0xee, 0x17, 0x54,
+		0xad, 0x25, 0x3e, 0x1e, 0xaf, 0xf0, 0x12, 0xf5, 0x36, 0x6c, 0xd5, 0xee, 0xb2, 0x64, 0xe2, 0x48,
+		0x6c, 0x9d, 0x54, 0x35, 0xf4, 0xc6, 0x3a, 0x45, 0x54, 0x38, 0x01, 0x74, 0x19, 0x46, 0xa9, 0x47,
+		0xc3, 0x99, 0x3a, 0xd4, 0x4e, 0xa7, 0x47, 0x8f, 0x0c, 0x1b, 0xd9, 0xe0, 0xfa, 0x71, 0xcc, 0x6b,
+		0xb2, 0x58, 0xf2, 0xaf, 0xba, 0x34, 0xf4, 0x76, 0x3c, 0x12, 0x74, 0x2e, 0x19, 0x3d, 0x59, 0x99,
+		0x70, 0xaa, 0xb8, 0x97, 0x1a, 0xc8, 0x05, 0x8e, 0x1d, 0x28, 0x17, 0x78, 0x99, 0x7c, 0xce, 0x88,
+		0x47, 0x95, 0x22, 0x36, 0x4a, 0xd3, 0xd8, 0xc8, 0xb3, 0x5a, 0x41, 0x08, 0x59, 0x99, 0x70, 0xaa,
+		0xf8, 0x7b, 0x8e, 0x06, 0xe4, 0x5d, 0x28, 0xba, 0x65, 0x33, 0x91, 0x5b, 0xf6, 0x1e, 0xbe, 0x65,
+		0x0f, 0x07, 0x7b, 0x71, 0x77, 0xed, 0xb8, 0x53, 0x49, 0xd0, 0xd0, 0x7b, 0x7d, 0x89, 0x71, 0xe0,
+		0x3d, 0xf4, 0xd5, 0x32, 0xf1, 0x73, 0xe2, 0xd9, 0xb7, 0x26, 0x27, 0xfe, 0x5e, 0xc8, 0x6b, 0x7a,
+		0xad, 0xad, 0x6a, 0xba, 0x8d, 0x75, 0x12, 0xd2, 0xd3, 0xd3, 0xa8, 0xb4, 0xd7, 0x28, 0xfb, 0xdb,
+		0x65, 0x65, 0x5c, 0xd3, 0x57, 0xdc, 0x72, 0x25, 0x47, 0x0e, 0xab, 0x9c, 0x2d, 0xff, 0xb3, 0x09,
+		0x18, 0x5d, 0xb8, 0x4e, 0x3f, 0x2d, 0xf1, 0xd7, 0x34, 0x04, 0xf5, 0xe8, 0xbf, 0xf7, 0xc0, 0x18,
+		0xe3, 0x85, 0x85, 0xce, 0xc3, 0x48, 0x87, 0xfc, 0x28, 0x4a, 0xfe, 0xa3, 0x4a, 0x67, 0x53, 0x50,
+		0x38, 0x91, 0x75, 0xa7, 0xa0, 0xf2, 0x17, 0x92, 0x00, 0x0b, 0xd7, 0xaf, 0x6f, 0x98, 0x1a, 0xf1,
+		0xde, 0xde, 0x49, 0x39, 0xbe, 0x7d, 0x52, 0x8e, 0x9e, 0x35, 0x7e, 0x1a, 0xb2, 0xee, 0x1a, 0x91,
+		0x7f, 0x18, 0x94, 0xb6, 0xf9, 0x6f, 0xbe, 0xd4, 0xa5, 0xde, 0xa5, 0x16, 0xe0, 0x7c, 0xb9, 0x1d,
+		0x0c, 0xf9, 0x3f, 0x27, 0x00, 0xde, 0xc9, 0xa4, 0x11, 0x2b, 0xc8, 0x6d, 0x56, 0xf2, 0x40, 0xfe,
+		0x2e, 0xc7, 0xf6, 0xac, 0xd2, 0xb7, 0x13, 0xe4, 0xdf, 0x58, 0xbc, 0x93, 0xab, 0x14, 0x7d, 0x5f,
+		0x85, 0x31, 0xac, 0xdb, 0xa6, 0x86, 0xc5, 0xd5, 0x80, 0x33, 0x41, 0x29, 0x0d, 0xe1, 0x16, 0xfd,
+		0x17, 0x52, 0xe2, 0x05, 0x01, 0x47, 0xf7, 0xf0, 0xf8, 0x63, 0x49, 0x28, 0xf6, 0xc3, 0x0a, 0x4b,
+		0x78, 0x4a, 0xc3, 0x26, 0x3c, 0xc3, 0x22, 0xe4, 0xc4, 0x9b, 0x11, 0x21, 0xa3, 0x17, 0x61, 0x42,
+		0xd3, 0x35, 0x5b, 0x53, 0x5b, 0xb5, 0x2d, 0xb5, 0x45, 0xcd, 0xe2, 0xf0, 0xc1, 0x0c, 0xb3, 0xc7,
+		0x33, 0xc2, 0x88, 0xfa, 0xc8, 0xc9, 0x4a, 0x9e, 0xd7, 0x54, 0x59, 0x05, 0x59, 0x11, 0xd1, 0x55,
+		0xea, 0x40, 0xae, 0x9f, 0x40, 0xf7, 0xac, 0xc8, 0x47, 0x93, 0xe4, 0xd9, 0x5c, 0xe3, 0x9d, 0xa5,
+		0x18, 0x6e, 0x29, 0x56, 0x00, 0x98, 0x02, 0x21, 0x96, 0xa3, 0x98, 0x3a, 0x90, 0x0a, 0xca, 0x30,
+		0x0a, 0x0b, 0x96, 0xed, 0x59, 0x8f, 0x3f, 0x4d, 0x42, 0xce, 0xbb, 0x1e, 0xef, 0x98, 0xf4, 0xb7,
+		0xd1, 0x29, 0xe2, 0x9c, 0xab, 0x12, 0x53, 0xfc, 0x5b, 0x71, 0x01, 0x95, 0xd8, 0xb3, 0x95, 0xfa,
+		0xeb, 0xc2, 0xff, 0x3b, 0x06, 0xa3, 0xfc, 0xad, 0x5a, 0xbd, 0x27, 0x0e, 0x91, 0xa2, 0xde, 0xc2,
+		0x0d, 0x0e, 0x43, 0x3e, 0x19, 0x1a, 0x86, 0x90, 0x57, 0x73, 0x35, 0xdf, 0xcb, 0x6e, 0xe9, 0xcc,
+		0xb8, 0x2f, 0xb7, 0xe6, 0x6b, 0x67, 0xd9, 0x14, 0xf7, 0x9d, 0x12, 0x7a, 0x1c, 0xb2, 0x04, 0xc2,
+		0xb5, 0x0a, 0x04, 0x7d, 0xc6, 0x4d, 0x5f, 0x78, 0x1a, 0x65, 0x05, 0xda, 0xea, 0xee, 0x22, 0x2b,
+		0xa0, 0x65, 0x40, 0x3b, 0x4e, 0xf2, 0xac, 0xe6, 0xb2, 0x90, 0xe0, 0xdf, 0x7d, 0x7b, 0xbf, 0x7c,
+		0x94, 0xe1, 0xf7, 0xc2, 0xc8, 0xca, 0xa4, 0x5b, 0x29, 0xa8, 0x5d, 0x00, 0x20, 0xf3, 0xaa, 0xb1,
+		0x7b, 0xb2, 0x23, 0xc1, 0x84, 0xb3, 0xdb, 0x26, 0x2b, 0x19, 0x52, 0x58, 0x20, 0xbf, 0xd1, 0x47,
+		0xc9, 0xc5, 0xe8, 0x96, 0xb1, 0xa5, 0xb6, 0x6a, 0x2d, 0xed, 0xc5, 0xae, 0xd6, 0xa8, 0xf1, 0x35,
+		0xab, 0xd5, 0xd5, 0x0e, 0x0f, 0x7c, 0x95, 0xa1, 0x03, 0xdf, 0x13, 0xac, 0xcf, 0xbe, 0x84, 0x65,
+		0x65, 0x86, 0xb5, 0x2d, 0xd3, 0xa6, 0x75, 0xd6, 0x32, 0xaf, 0x76, 0xd0, 0x27, 0x24, 0x38, 0xe6,
+		0x0a, 0x6b, 0xc8, 0x90, 0xe8, 0xff, 0x4d, 0xaf, 0x6e, 0x0e, 0x3d, 0xa4, 0x93, 0xc1, 0x8d, 0x10,
+		0x36, 0xaa, 0xa3, 0x4e, 0x73, 0xcf, 0xc0, 0x9e, 0x85, 0x02, 0x79, 0x34, 0x69, 0xf2, 0x64, 0x7c,
+		0x6d, 0x1b, 0xe3, 0x62, 0x3a, 0xe4, 0x54, 0xa7, 0xcc, 0x25, 0x90, 0x87, 0xdb, 0x41, 0x1c, 0x59,
+		0xc9, 0xdf, 0x70, 0x53, 0xfa, 0x97, 0x31, 0x16, 0x51, 0x6c, 0xf0, 0x4e, 0x4d, 0x66, 0xe8, 0x28,
+		0x96, 0xcd, 0xd3, 0x13, 0xc5, 0xf6, 0xdc, 0xad, 0x21, 0x51, 0xac, 0x3f, 0x6f, 0x87, 0x3e, 0x28,
+		0xc1, 0x0c, 0x95, 0x6f, 0xcf, 0xc3, 0x9d, 0x1a, 0xdd, 0x4c, 0xec, 0xed, 0x7e, 0x75, 0x75, 0xe8,
+		0x11, 0xdc, 0xed, 0xd9, 0x35, 0x3d, 0x54, 0x65, 0x65, 0x8a, 0xec, 0x1e, 0xf7, 0x35, 0x90, 0x42,
+		0x6a, 0x3d, 0xfb, 0xff, 0xe7, 0x24, 0x40, 0xae, 0x0b, 0xe4, 0x5c, 0xa5, 0x7d, 0x2f, 0xfd, 0x64,
+		0x08, 0xaf, 0x75, 0x3e, 0x00, 0xd5, 0x93, 0x1f, 0x11, 0x10, 0x22, 0x5b, 0xe0, 0xe2, 0xa0, 0x07,
+		0x5d, 0x37, 0xa1, 0xff, 0x69, 0x5c, 0x88, 0x2b, 0xf0, 0xbb, 0x12, 0x1c, 0xed, 0xd1, 0x5f, 0xce,
+		0xb8, 0xae, 0x03, 0x32, 0x3d, 0x8d, 0xfc, 0x9f, 0x87, 0x4a, 0xfc, 0x7f, 0x71, 0xc7, 0x54, 0x83,
+		0x93, 0x66, 0xb0, 0xe1, 0x0e, 0x3a, 0x35, 0x3c, 0x35, 0x2e, 0xc1, 0xb4, 0xb7, 0x7b, 0x67, 0x02,
+		0x97, 0x21, 0xe7, 0xed, 0x9d, 0x0f, 0xfd, 0xd8, 0xa0, 0xa1, 0xf3, 0x51, 0xfb, 0xf0, 0xd0, 0x92,
+		0x6b, 0x04, 0xc4, 0xbf, 0x83, 0x8f, 0x9a, 0xbd, 0xf3, 0xc6, 0x24, 0x60, 0x0c, 0xd8, 0x88, 0xff,
+		0x9f, 0x04, 0xa9, 0x35, 0xc3, 0x68, 0x21, 0x03, 0x26, 0x75, 0xc3, 0xae, 0x11, 0x9d, 0x85, 0x1b,
+		0x35, 0x9e, 0xe4, 0x63, 0xe9, 0xfc, 0xf9, 0xe1, 0x98, 0xf2, 0xdd, 0xfd, 0x72, 0x2f, 0x29, 0x65,
+		0x42, 0x37, 0xec, 0x2a, 0xad, 0x61, 0x67, 0xc7, 0xe8, 0x03, 0x30, 0xee, 0xef, 0x8c, 0xa5, 0x3c,
+		0x9f, 0x1d, 0xba, 0x33, 0x3f, 0x99, 0xdb, 0xfb, 0xe5, 0x69, 0x57, 0x17, 0x3b, 0xd5, 0xb2, 0x92,
+		0xdb, 0xf2, 0xf4, 0x5e, 0x49, 0x93, 0xd9, 0x7f, 0xef, 0x73, 0x65, 0xa9, 0x7a, 0xb9, 0xef, 0xe5,
+		0xdc, 0x07, 0x07, 0x0e, 0x61, 0xd7, 0xb9, 0x85, 0xeb, 0xbf, 0xa6, 0xfb, 0xf9, 0x6c, 0xcf, 0x35,
+		0xdd, 0x26, 0xd6, 0xb1, 0xa5, 0x59, 0x43, 0x5d, 0xd3, 0x1d, 0x78, 0xd5, 0x57, 0xfe, 0xf3, 0x34,
+		0xe4, 0xae, 0x30, 0xaa, 0xf4, 0xdb, 0x64, 0xe4, 0x03, 0x7a, 0xbe, 0xef, 0xaf, 0xf4, 0x24, 0x70,
+		0x98, 0xb5, 0xaf, 0xe6, 0x09, 0x7b, 0xbf, 0xbf, 0x5f, 0xe6, 0xd6, 0x5f, 0xe1, 0x58, 0xc8, 0xe2,
+		0x8f, 0x94, 0xd9, 0x03, 0x43, 0xf7, 0x95, 0x67, 0xae, 0xba, 0x34, 0xb4, 0x1f, 0xcb, 0x95, 0x72,
+		0x90, 0x9e, 0xcc, 0xde, 0x3b, 0x6f, 0x90, 0x1a, 0xaa, 0x94, 0xd0, 0x2f, 0x48, 0x70, 0x98, 0x42,
+		0xb9, 0xf6, 0x82, 0x42, 0x8a, 0xa0, 0x4f, 0x0e, 0x4e, 0x82, 0x3c, 0xfb, 0x74, 0xdf, 0xda, 0x13,
+		0xd0, 0x6a, 0x85, 0x5b, 0x82, 0x63, 0x9e, 0x4e, 0x83, 0xe4, 0x88, 0x0b, 0x86, 0x7a, 0x71, 0x95,
+		0xa9, 0x56, 0x4f, 0x5d, 0xf8, 0x67, 0xe5, 0x06, 0x9c, 0x90, 0x4d, 0x72, 0x86, 0xba, 0x07, 0x09,
+		0xbe, 0xcf, 0xca, 0xad, 0x41, 0xd6, 0xdd, 0x8f, 0x24, 0x6b, 0x9e, 0x8c, 0x50, 0xa9, 0x88, 0xd3,
+		0xf3, 0xe4, 0x59, 0x14, 0x2f, 0x09, 0xf4, 0x4b, 0x12, 0x1c, 0x76, 0x3d, 0x2e, 0x2f, 0xf1, 0x51,
+		0x4a, 0xfc, 0x64, 0x8c, 0x48, 0xb9, 0x7a, 0xc9, 0xcf, 0xb5, 0x50, 0x7a, 0x84, 0x6b, 0x61, 0x49,
+		0x09, 0xf2, 0x0f, 0x75, 0x7a, 0x2a, 0xc9, 0x3f, 0xd5, 0x1d, 0xf7, 0xaa, 0x2a, 0xf7, 0x63, 0x23,
+		0x83, 0x74, 0xdc, 0x34, 0x9f, 0xad, 0x2f, 0xec, 0x50, 0xfc, 0x84, 0xc8, 0x41, 0x12, 0xde, 0xed,
+		0x18, 0xa6, 0x8d, 0x1b, 0xc5, 0x34, 0xff, 0xff, 0x57, 0xbc, 0x8c, 0x5e, 0x96, 0x60, 0x26, 0xf4,
+		0xfa, 0x85, 0x55, 0xcc, 0x84, 0xb3, 0x23, 0xe4, 0x4c, 0xb9, 0x7a, 0x1f, 0x67, 0xc7, 0xdd, 0x03,
+		0xee, 0x73, 0x58, 0xb2, 0x32, 0x1d, 0x72, 0x9b, 0xc3, 0x42, 0x2f, 0xc0, 0xdd, 0x5c, 0xd4, 0xfb,
+		0xdc, 0x17, 0x01, 0x7a, 0x5f, 0xe4, 0xcc, 0xed, 0xfd, 0xf2, 0xbd, 0xbe, 0x9d, 0xd1, 0xef, 0xd2,
+		0xc8, 0x51, 0xb6, 0x4d, 0xc2, 0x2e, 0x8e, 0x7c, 0x00, 0x0e, 0x7b, 0x8e, 0xee, 0x1d, 0x9f, 0xc7,
+		0x2a, 0x66, 0xc3, 0x37, 0x4c, 0xef, 0xfd, 0x86, 0xea, 0xbd, 0xfe, 0xa5, 0x0f, 0x25, 0x27, 0x2b,
+		0x53, 0x75, 0xe7, 0x42, 0x80, 0xe2, 0xd4, 0xde, 0x82, 0x90, 0x5d, 0x84, 0x9e, 0x0e, 0x3c, 0x4b,
+		0x3f, 0xc8, 0x99, 0x77, 0xef, 0x4b, 0x76, 0xef, 0x13, 0xf3, 0x3b, 0xae, 0xa3, 0xbf, 0x94, 0x80,
+		0xbb, 0x39, 0x34, 0xfd, 0x7c, 0xdb, 0xb9, 0x8e, 0xda, 0xd4, 0x74, 0xef, 0xd7, 0x8b, 0x73, 0x9c,
+		0x91, 0xb4, 0x59, 0xd6, 0x21, 0xbb, 0xa6, 0x36, 0xb1, 0xf8, 0x0e, 0x6e, 0xef, 0x47, 0xa9, 0x67,
+		0x60, 0xd4, 0xd8, 0xde, 0x66, 0x47, 0xea, 0xe4, 0x62, 0x02, 0x2f, 0x91, 0xe9, 0xb4, 0x34, 0xf2,
+		0xbd, 0x33, 0xf6, 0xed, 0x2f, 0x56, 0x20, 0xdf, 0x05, 0xa3, 0x17, 0x06, 0x98, 0x76, 0xe4, 0x5f,
+		0x7e, 0x04, 0x5a, 0x45, 0xb5, 0xa3, 0xfc, 0x24, 0xe4, 0x58, 0x7f, 0xdc, 0x7d, 0x38, 0x0a, 0x69,
+		0xfa, 0x49, 0x07, 0xb7, 0xd7, 0x31, 0x52, 0xe6, 0x6f, 0xf2, 0x19, 0x15, 0xd6, 0x31, 0x2b, 0x54,
+		0xab, 0x7d, 0x19, 0x76, 0x26, 0x7a, 0x61, 0x18, 0x4f, 0xdc, 0x8f, 0x1b, 0x3f, 0x0e, 0xa5, 0x80,
+		0x31, 0xa2, 0x00, 0x7d, 0xcc, 0xd9, 0x60, 0xc6, 0x1e, 0xc8, 0xda, 0xdd, 0x80, 0x19, 0xfa, 0x6c,
+		0xd8, 0x0d, 0x07, 0xc5, 0x4a, 0xcc, 0x38, 0x47, 0x9b, 0xec, 0xf0, 0x99, 0x97, 0xd0, 0x45, 0x00,
+		0xb7, 0x67, 0xe7, 0xe3, 0x2e, 0xde, 0x35, 0x9d, 0xf5, 0x2c, 0xa8, 0xe2, 0x01, 0x96, 0x3f, 0x21,
+		0xc1, 0x91, 0x9e, 0xde, 0xf8, 0x3a, 0x3c, 0xe9, 0xb3, 0x0d, 0x52, 0xbc, 0xdb, 0x13, 0x5e, 0x73,
+		0x50, 0x09, 0x19, 0x57, 0x29, 0x6c, 0x5c, 0xac, 0x43, 0xdf, 0xc0, 0x5e, 0x84, 0xc3, 0xfe, 0x71,
+		0x09, 0x26, 0x3c, 0x07, 0x79, 0x7f, 0x16, 0xf6, 0xe0, 0xfb, 0x70, 0xdc, 0x97, 0x89, 0x95, 0x9f,
+		0x0d, 0x32, 0xde, 0xe1, 0xc4, 0x7b, 0x7a, 0x3f, 0xf4, 0x10, 0xc9, 0x08, 0x17, 0x43, 0xfe, 0x8a,
+		0x04, 0x27, 0xfc, 0x94, 0x3d, 0xa6, 0xe4, 0x4d, 0x9f, 0xd7, 0x1b, 0x11, 0x8f, 0xff, 0x24, 0xc1,
+		0x3d, 0x03, 0x46, 0xce, 0xd9, 0x63, 0xc2, 0xb4, 0x27, 0x5c, 0x31, 0x79, 0xb5, 0x10, 0x19, 0xb9,
+		0xbf, 0xfd, 0x77, 0xbc, 0xf5, 0xbb, 0xf8, 0xb3, 0xb7, 0xa9, 0xde, 0x36, 0x4b, 0x99, 0xea, 0x0d,
+		0x31, 0xde, 0x98, 0x6c, 0x7d, 0x5d, 0x82, 0xb3, 0xfe, 0x59, 0x85, 0x18, 0xfe, 0xb7, 0xf7, 0xc2,
+		0xfc, 0x6b, 0x09, 0xee, 0x8f, 0x33, 0x05, 0xbe, 0x42, 0xcf, 0xc3, 0x94, 0xeb, 0xf5, 0x04, 0x17,
+		0x28, 0x96, 0x0f, 0xc5, 0x84, 0x1a, 0x39, 0x54, 0xee, 0xcc, 0x4a, 0xfc, 0xbe, 0xc4, 0xf7, 0x9c,
+		0x77, 0xdd, 0x1d, 0xb6, 0xfb, 0x53, 0xad, 0x43, 0xb2, 0xdd, 0x93, 0x6e, 0x1d, 0xf7, 0xa5, 0x5b,
+		0x43, 0x16, 0x34, 0x71, 0x87, 0x34, 0xc8, 0x4f, 0x0b, 0x6d, 0x1a, 0x92, 0x6d, 0xb8, 0x01, 0x53,
+		0x21, 0x9b, 0xc4, 0xf9, 0xba, 0x59, 0xf4, 0x1e, 0x99, 0x21, 0x7e, 0x7d, 0x6f, 0xbd, 0x82, 0x7a,
+		0xb7, 0x87, 0xfc, 0x87, 0x12, 0x94, 0xe9, 0x40, 0xc2, 0x3c, 0xda, 0xbf, 0xc2, 0x0c, 0xc6, 0x70,
+		0xa2, 0xff, 0xb4, 0x38, 0xa3, 0xe7, 0x60, 0x94, 0x49, 0x29, 0xe7, 0xed, 0x10, 0xe2, 0xcd, 0x11,
+		0x5d, 0x85, 0xbd, 0x20, 0xe6, 0x15, 0xae, 0x17, 0xde, 0x24, 0xfe, 0xbd, 0x01, 0xbd, 0xf0, 0x2f,
+		0x85, 0xc2, 0x0e, 0x1f, 0x39, 0x67, 0xd1, 0xfb, 0xdf, 0xb0, 0xc2, 0xe6, 0x5f, 0xad, 0x7e, 0xd3,
+		0x34, 0xb3, 0x33, 0xfc, 0x08, 0xcd, 0xfc, 0xf6, 0x5b, 0x01, 0x47, 0x33, 0x47, 0x4c, 0xe1, 0x6d,
+		0xae, 0x99, 0x6f, 0x27, 0xe0, 0x28, 0x9d, 0x86, 0x37, 0x56, 0x7d, 0x0b, 0x38, 0x5f, 0x03, 0x44,
+		0x8e, 0xb5, 0xee, 0x94, 0xfe, 0x28, 0x58, 0x66, 0xfd, 0xba, 0xcf, 0xe8, 0xd6, 0x00, 0x35, 0x2c,
+		0x3b, 0xd8, 0x41, 0xf2, 0xc0, 0x1d, 0x34, 0x3c, 0x21, 0x62, 0x88, 0xec, 0xa4, 0x86, 0x91, 0x9d,
+		0xdf, 0x91, 0xa0, 0x14, 0xc6, 0x74, 0x2e, 0x2b, 0x2a, 0xcc, 0xf8, 0x12, 0xc3, 0x41, 0x71, 0xb9,
+		0x77, 0x50, 0xf6, 0x21, 0xb0, 0x75, 0x0f, 0x9b, 0xf8, 0x4e, 0x6f, 0xde, 0x2f, 0x0b, 0xa3, 0xe3,
+		0x48, 0x7e, 0x6f, 0x08, 0xf3, 0xb6, 0xdc, 0xb2, 0xbf, 0xd2, 0xa3, 0xee, 0xdf, 0x6e, 0xd1, 0xd0,
+		0x1f, 0x48, 0x70, 0xbc, 0xcf, 0x08, 0xff, 0x2a, 0x9b, 0xf3, 0x9f, 0xe8, 0x2b, 0x30, 0x77, 0x2a,
+		0xf4, 0xba, 0xc0, 0x37, 0x94, 0xff, 0x45, 0x81, 0x27, 0xa0, 0x0e, 0xfd, 0x7f, 0x06, 0xcf, 0xc0,
+		0x5d, 0xa1, 0x58, 0x7c, 0x4c, 0xe7, 0x21, 0x45, 0xce, 0x4a, 0x9d, 0x8f, 0x7c, 0x06, 0x86, 0x13,
+		0xc0, 0xa2, 0xb0, 0x32, 0x82, 0x02, 0x25, 0x49, 0x8e, 0x1f, 0x78, 0xf7, 0xf2, 0x3c, 0x4c, 0x7a,
+		0xea, 0x38, 0xf1, 0x59, 0x48, 0x75, 0x0c, 0xa3, 0xc5, 0x89, 0x4f, 0xf7, 0x64, 0xb6, 0x0d, 0xa3,
+		0xc5, 0xa7, 0x49, 0xe1, 0xe4, 0x69, 0x40, 0x8c, 0x08, 0x4b, 0x71, 0x73, 0xd2, 0x1f, 0x92, 0x60,
+		0xca, 0x57, 0xed, 0x7c, 0x20, 0x27, 0x5e, 0xe6, 0x5c, 0x7c, 0x0a, 0x91, 0x96, 0xde, 0x90, 0xe8,
+		0x3e, 0x0a, 0x27, 0xe9, 0x40, 0xc2, 0x32, 0x91, 0x7b, 0x4b, 0x0d, 0xb1, 0x14, 0x81, 0x87, 0x2e,
+		0xb2, 0x06, 0xf7, 0x0e, 0x46, 0x73, 0xbd, 0x3d, 0x96, 0x4c, 0xec, 0xe7, 0xed, 0x85, 0x11, 0xe0,
+		0xb3, 0x63, 0x88, 0x72, 0x07, 0xee, 0xeb, 0xd7, 0x95, 0x45, 0x9e, 0x84, 0x39, 0x63, 0x74, 0x1e,
+		0xdf, 0x48, 0x6f, 0xec, 0xf1, 0x8d, 0xdc, 0x86, 0x53, 0x51, 0x3d, 0xf2, 0xe9, 0xcd, 0xc3, 0x98,
+		0xc8, 0xf0, 0x4a, 0x27, 0x92, 0xc3, 0xcd, 0x4f, 0x60, 0xca, 0x65, 0xb8, 0x9b, 0x77, 0x67, 0x7b,
+		0x8f, 0xc7, 0x9d, 0x89, 0xc9, 0x3b, 0x70, 0xbc, 0x1f, 0x80, 0x73, 0xa4, 0x37, 0xea, 0x39, 0x25,
+		0xcb, 0x1d, 0xf4, 0x2a, 0xfc, 0xf9, 0x3f, 0x9c, 0x10, 0xdf, 0xd7, 0xab, 0x01, 0x78, 0x2e, 0x3c,
+		0x9c, 0x0a, 0x4e, 0x2b, 0x3c, 0x05, 0x56, 0x3a, 0x1d, 0x09, 0xc7, 0x23, 0xa0, 0x43, 0xe8, 0x47,
+		0xbd, 0xcf, 0x1d, 0xee, 0x1b, 0x8c, 0x27, 0xc8, 0x9f, 0x8a, 0x02, 0x73, 0xa8, 0xff, 0x0d, 0x98,
+		0x0e, 0xcb, 0x89, 0xa0, 0x87, 0x07, 0x53, 0xe8, 0xf5, 0x62, 0x4b, 0x3f, 0x32, 0x04, 0x86, 0xd3,
+		0xfd, 0x27, 0x25, 0xb8, 0x7b, 0x60, 0xe8, 0x8f, 0x2e, 0x0e, 0x26, 0x3b, 0xc0, 0xaf, 0x2e, 0x55,
+		0x0e, 0x82, 0xea, 0x0c, 0xad, 0xe6, 0xbb, 0x35, 0x1b, 0xce, 0xd1, 0x9e, 0x68, 0xb4, 0x74, 0x3a,
+		0x12, 0xce, 0xe9, 0xe0, 0xa5, 0xf0, 0xdb, 0xa3, 0xe7, 0x42, 0x29, 0xf4, 0x0f, 0x80, 0x4b, 0x0f,
+		0xc7, 0x47, 0xf0, 0x2e, 0x7b, 0x58, 0x64, 0xd5, 0x67, 0xd9, 0x07, 0x84, 0x8f, 0x7d, 0x96, 0x7d,
+		0x50, 0xd8, 0xc6, 0x97, 0x7d, 0x60, 0x5c, 0xd1, 0x67, 0xd9, 0xe3, 0x84, 0x53, 0x7d, 0x96, 0x3d,
+		0x56, 0x18, 0x23, 0x1f, 0x42, 0x3b, 0xe4, 0x7f, 0x4f, 0x78, 0x0f, 0xb2, 0xce, 0x86, 0x92, 0x0b,
+		0x0b, 0x27, 0x4a, 0xf7, 0xc7, 0x01, 0xf5, 0xae, 0x7f, 0x88, 0xa3, 0xd6, 0x67, 0xfd, 0xfb, 0xfb,
+		0xa2, 0xa5, 0x87, 0xe3, 0x23, 0x38, 0x7d, 0xdf, 0x02, 0xd4, 0x0b, 0x80, 0x66, 0x63, 0x52, 0x12,
+		0x3d, 0x9f, 0x8b, 0x0d, 0xef, 0x74, 0x7c, 0xa3, 0xe7, 0x55, 0x64, 0x38, 0xd3, 0x42, 0x1d, 0x9d,
+		0xd2, 0x03, 0xb1, 0x60, 0x9d, 0xce, 0x56, 0xf8, 0x35, 0x89, 0x13, 0xa1, 0x68, 0x1e, 0x17, 0xa6,
+		0x74, 0xcf, 0x00, 0x08, 0x87, 0xdc, 0xba, 0x73, 0xfd, 0x4e, 0x0e, 0x07, 0xf7, 0xba, 0x2e, 0xa5,
+		0x93, 0x03, 0x61, 0x1c, 0xa2, 0x24, 0xd7, 0xd6, 0xc7, 0x39, 0x40, 0x8f, 0x84, 0x92, 0x18, 0xec,
+		0x81, 0x94, 0x2e, 0x0c, 0x87, 0xe4, 0x0c, 0xe4, 0x23, 0x12, 0x1c, 0xed, 0x6b, 0xc8, 0xd1, 0xa3,
+		0x71, 0xa9, 0xfa, 0x5c, 0x8d, 0xd2, 0x63, 0xc3, 0xa2, 0x39, 0xc3, 0xb1, 0x61, 0xb2, 0xc7, 0x8c,
+		0xa3, 0x87, 0xfa, 0x90, 0x0b, 0xf7, 0x07, 0x4a, 0xb3, 0x71, 0xc1, 0x45, 0xaf, 0x77, 0xfa, 0xcc,
+		0xf2, 0xff, 0x0f, 0x00, 0x49, 0xd0, 0x23, 0x48, 0x83, 0xac, 0x00, 0x00,
 	}
-	if this.Website != that1.Website {
-		return false
+	r := bytes.NewReader(gzipped)
+	gzipr, err := compress_gzip.NewReader(r)
+	if err != nil {
+		panic(err)
 	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
+	ungzipped, err := io_ioutil.ReadAll(gzipr)
+	if err != nil {
+		panic(err)
 	}
-	if this.Details != that1.Details {
-		return false
+	if err := github_com_gogo_protobuf_proto.Unmarshal(ungzipped, d); err != nil {
+		panic(err)
 	}
-	return true
+	return d
 }
-func (this *Validator) Equal(that interface{}) bool {
+func (this *MsgCreateValidator) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Validator)
+	that1, ok := that.(*MsgCreateValidator)
 	if !ok {
-		that2, ok := that.(Validator)
+		that2, ok := that.(MsgCreateValidator)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2510,49 +2788,37 @@ func (this *Validator) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.OperatorAddress, that1.OperatorAddress) {
-		return false
-	}
-	if this.ConsensusPubkey != that1.ConsensusPubkey {
-		return false
-	}
-	if this.Jailed != that1.Jailed {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if !this.Tokens.Equal(that1.Tokens) {
+	if !this.Description.Equal(&that1.Description) {
 		return false
 	}
-	if !this.DelegatorShares.Equal(that1.DelegatorShares) {
+	if !this.Commission.Equal(&that1.Commission) {
 		return false
 	}
-	if !this.Description.Equal(&that1.Description) {
+	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
 		return false
 	}
-	if this.UnbondingHeight != that1.UnbondingHeight {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !this.UnbondingTime.Equal(that1.UnbondingTime) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !this.Commission.Equal(&that1.Commission) {
+	if this.Pubkey != that1.Pubkey {
 		return false
 	}
-	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
+	if !this.Value.Equal(&that1.Value) {
 		return false
 	}
 	return true
 }
-func (this *DVPair) Equal(that interface{}) bool {
+func (this *MsgEditValidator) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DVPair)
+	that1, ok := that.(*MsgEditValidator)
 	if !ok {
-		that2, ok := that.(DVPair)
+		that2, ok := that.(MsgEditValidator)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2564,22 +2830,36 @@ func (this *DVPair) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+	if !this.Description.Equal(&that1.Description) {
 		return false
 	}
 	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
+	if that1.CommissionRate == nil {
+		if this.CommissionRate != nil {
+			return false
+		}
+	} else if !this.CommissionRate.Equal(*that1.CommissionRate) {
+		return false
+	}
+	if that1.MinSelfDelegation == nil {
+		if this.MinSelfDelegation != nil {
+			return false
+		}
+	} else if !this.MinSelfDelegation.Equal(*that1.MinSelfDelegation) {
+		return false
+	}
 	return true
 }
-func (this *DVVTriplet) Equal(that interface{}) bool {
+func (this *MsgDelegate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DVVTriplet)
+	that1, ok := that.(*MsgDelegate)
 	if !ok {
-		that2, ok := that.(DVVTriplet)
+		that2, ok := that.(MsgDelegate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2594,22 +2874,22 @@ func (this *DVVTriplet) Equal(that interface{}) bool {
 	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
 	return true
 }
-func (this *Delegation) Equal(that interface{}) bool {
+func (this *MsgBeginRedelegate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Delegation)
+	that1, ok := that.(*MsgBeginRedelegate)
 	if !ok {
-		that2, ok := that.(Delegation)
+		that2, ok := that.(MsgBeginRedelegate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2624,22 +2904,25 @@ func (this *Delegation) Equal(that interface{}) bool {
 	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
 		return false
 	}
-	if !this.Shares.Equal(that1.Shares) {
+	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
 	return true
 }
-func (this *UnbondingDelegation) Equal(that interface{}) bool {
+func (this *MsgUndelegate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnbondingDelegation)
+	that1, ok := that.(*MsgUndelegate)
 	if !ok {
-		that2, ok := that.(UnbondingDelegation)
+		that2, ok := that.(MsgUndelegate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2657,24 +2940,19 @@ func (this *UnbondingDelegation) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if len(this.Entries) != len(that1.Entries) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
-	for i := range this.Entries {
-		if !this.Entries[i].Equal(&that1.Entries[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+func (this *MsgCancelUnbondingDelegation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnbondingDelegationEntry)
+	that1, ok := that.(*MsgCancelUnbondingDelegation)
 	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
+		that2, ok := that.(MsgCancelUnbondingDelegation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2686,28 +2964,28 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CreationHeight != that1.CreationHeight {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
-	if !this.Balance.Equal(that1.Balance) {
+	if this.CreationHeight != that1.CreationHeight {
 		return false
 	}
 	return true
 }
-func (this *RedelegationEntry) Equal(that interface{}) bool {
+func (this *MsgTokenizeShares) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RedelegationEntry)
+	that1, ok := that.(*MsgTokenizeShares)
 	if !ok {
-		that2, ok := that.(RedelegationEntry)
+		that2, ok := that.(MsgTokenizeShares)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2719,28 +2997,28 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CreationHeight != that1.CreationHeight {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
-	if !this.SharesDst.Equal(that1.SharesDst) {
+	if !bytes.Equal(this.TokenizedShareOwner, that1.TokenizedShareOwner) {
 		return false
 	}
 	return true
 }
-func (this *Redelegation) Equal(that interface{}) bool {
+func (this *MsgRedeemTokensForShares) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Redelegation)
+	that1, ok := that.(*MsgRedeemTokensForShares)
 	if !ok {
-		that2, ok := that.(Redelegation)
+		that2, ok := that.(MsgRedeemTokensForShares)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2755,30 +3033,19 @@ func (this *Redelegation) Equal(that interface{}) bool {
 	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
-		return false
-	}
-	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
-		return false
-	}
-	if len(this.Entries) != len(that1.Entries) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
-	for i := range this.Entries {
-		if !this.Entries[i].Equal(&that1.Entries[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *MsgTransferTokenizeShareRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*MsgTransferTokenizeShareRecord)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(MsgTransferTokenizeShareRecord)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2790,31 +3057,25 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
-	}
-	if this.MaxValidators != that1.MaxValidators {
+	if this.TokenizeShareRecordId != that1.TokenizeShareRecordId {
 		return false
 	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
-	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
+	if !bytes.Equal(this.Sender, that1.Sender) {
 		return false
 	}
-	if this.BondDenom != that1.BondDenom {
+	if !bytes.Equal(this.NewOwner, that1.NewOwner) {
 		return false
 	}
 	return true
 }
-func (this *DelegationResponse) Equal(that interface{}) bool {
+func (this *MsgRotateConsPubKey) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegationResponse)
+	that1, ok := that.(*MsgRotateConsPubKey)
 	if !ok {
-		that2, ok := that.(DelegationResponse)
+		that2, ok := that.(MsgRotateConsPubKey)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2826,22 +3087,22 @@ func (this *DelegationResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Delegation.Equal(&that1.Delegation) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !this.Balance.Equal(&that1.Balance) {
+	if this.NewPubkey != that1.NewPubkey {
 		return false
 	}
 	return true
 }
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+func (this *ConsPubKeyRotation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RedelegationEntryResponse)
+	that1, ok := that.(*ConsPubKeyRotation)
 	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
+		that2, ok := that.(ConsPubKeyRotation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2853,22 +3114,31 @@ func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+	if !bytes.Equal(this.OperatorAddress, that1.OperatorAddress) {
 		return false
 	}
-	if !this.Balance.Equal(that1.Balance) {
+	if this.OldConsPubkey != that1.OldConsPubkey {
+		return false
+	}
+	if this.NewConsPubkey != that1.NewConsPubkey {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
 		return false
 	}
 	return true
 }
-func (this *RedelegationResponse) Equal(that interface{}) bool {
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RedelegationResponse)
+	that1, ok := that.(*TokenizeShareRecord)
 	if !ok {
-		that2, ok := that.(RedelegationResponse)
+		that2, ok := that.(TokenizeShareRecord)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2880,27 +3150,28 @@ func (this *RedelegationResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Redelegation.Equal(&that1.Redelegation) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if len(this.Entries) != len(that1.Entries) {
+	if !bytes.Equal(this.Owner, that1.Owner) {
 		return false
 	}
-	for i := range this.Entries {
-		if !this.Entries[i].Equal(&that1.Entries[i]) {
-			return false
-		}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
 	}
 	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
+func (this *HistoricalInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Pool)
+	that1, ok := that.(*HistoricalInfo)
 	if !ok {
-		that2, ok := that.(Pool)
+		that2, ok := that.(HistoricalInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2912,662 +3183,560 @@ func (this *Pool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+	if !this.Header.Equal(&that1.Header) {
 		return false
 	}
-	if !this.BondedTokens.Equal(that1.BondedTokens) {
+	if len(this.Valset) != len(that1.Valset) {
 		return false
 	}
+	for i := range this.Valset {
+		if !this.Valset[i].Equal(&that1.Valset[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size := m.MinSelfDelegation.Size()
-		i -= size
-		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.Rate.Equal(that1.Rate) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinSelfDelegation != nil {
-		{
-			size := m.MinSelfDelegation.Size()
-			i -= size
-			if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintStaking(dAtA, i, uint64(size))
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.CommissionRate != nil {
-		{
-			size := m.CommissionRate.Size()
-			i -= size
-			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintStaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Description) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Description)
+	if !ok {
+		that2, ok := that.(Description)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Moniker != that1.Moniker {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
+		return false
+	}
+	return true
 }
+func (this *Validator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Validator)
+	if !ok {
+		that2, ok := that.(Validator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.OperatorAddress, that1.OperatorAddress) {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.ConsensusPubkey != that1.ConsensusPubkey {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Jailed != that1.Jailed {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if this.Status != that1.Status {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Tokens.Equal(that1.Tokens) {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if !this.DelegatorShares.Equal(that1.DelegatorShares) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Description.Equal(&that1.Description) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.UnbondingHeight != that1.UnbondingHeight {
+		return false
+	}
+	if !this.UnbondingTime.Equal(that1.UnbondingTime) {
+		return false
+	}
+	if !this.Commission.Equal(&that1.Commission) {
+		return false
+	}
+	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
+		return false
+	}
+	if this.InMaintenance != that1.InMaintenance {
+		return false
+	}
+	return true
 }
+func (this *DVPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*DVPair)
+	if !ok {
+		that2, ok := that.(DVPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	return true
 }
+func (this *DVVTriplet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*DVVTriplet)
+	if !ok {
+		that2, ok := that.(DVVTriplet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MaxRate.Size()
-		i -= size
-		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
+		return false
+	}
+	return true
 }
-
-func (m *Commission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Delegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Delegation)
+	if !ok {
+		that2, ok := that.(Delegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Shares.Equal(that1.Shares) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UnbondingDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinSelfDelegation.Size()
-		i -= size
-		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*UnbondingDelegation)
+	if !ok {
+		that2, ok := that.(UnbondingDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x52
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x4a
-	if m.UnbondingHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
-		i--
-		dAtA[i] = 0x40
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.DelegatorShares.Size()
-		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(this.Entries) != len(that1.Entries) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Tokens.Size()
-		i -= size
-		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Status != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+	return true
+}
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
+
+	that1, ok := that.(*UnbondingDelegationEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationEntry)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if len(m.ConsensusPubkey) > 0 {
-		i -= len(m.ConsensusPubkey)
-		copy(dAtA[i:], m.ConsensusPubkey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ConsensusPubkey)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.CreationHeight != that1.CreationHeight {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DVPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
 	}
-	return dAtA[:n], nil
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
 }
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.SharesDst.Equal(that1.SharesDst) {
+		return false
+	}
+	return true
 }
+func (this *Redelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*Redelegation)
+	if !ok {
+		that2, ok := that.(Redelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorSrcAddress, that1.ValidatorSrcAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorDstAddress, that1.ValidatorDstAddress) {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPairs) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.MaxVotingPowerRatio.Equal(that1.MaxVotingPowerRatio) {
+		return false
+	}
+	return true
+}
+func (this *DelegationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationResponse)
+	if !ok {
+		that2, ok := that.(DelegationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Delegation.Equal(&that1.Delegation) {
+		return false
+	}
+	if !this.Balance.Equal(&that1.Balance) {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
+}
+func (this *RedelegationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationResponse)
+	if !ok {
+		that2, ok := that.(RedelegationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Redelegation.Equal(&that1.Redelegation) {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+		return false
+	}
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
+	}
+	return true
+}
+func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3577,34 +3746,81 @@ func (m *DVPairs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3614,41 +3830,61 @@ func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+	if m.MinSelfDelegation != nil {
+		{
+			size := m.MinSelfDelegation.Size()
+			i -= size
+			if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3658,34 +3894,44 @@ func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
+func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3695,30 +3941,37 @@ func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3732,7 +3985,7 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3742,30 +3995,26 @@ func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -3783,7 +4032,7 @@ func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3793,53 +4042,49 @@ func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.InitialBalance.Size()
-		i -= size
-		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err14 != nil {
-		return 0, err14
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3849,53 +4094,51 @@ func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SharesDst.Size()
-		i -= size
-		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.InitialBalance.Size()
-		i -= size
-		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err15 != nil {
-		return 0, err15
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i -= n15
-	i = encodeVarintStaking(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3905,44 +4148,26 @@ func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -3953,7 +4178,7 @@ func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferTokenizeShareRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3963,50 +4188,76 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferTokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferTokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewOwner)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TokenizeShareRecordId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x8
 	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPubkey) > 0 {
+		i -= len(m.NewPubkey)
+		copy(dAtA[i:], m.NewPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewPubkey)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err16 != nil {
-		return 0, err16
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n16
-	i = encodeVarintStaking(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4016,40 +4267,54 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewConsPubkey) > 0 {
+		i -= len(m.NewConsPubkey)
+		copy(dAtA[i:], m.NewConsPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewConsPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldConsPubkey) > 0 {
+		i -= len(m.OldConsPubkey)
+		copy(dAtA[i:], m.OldConsPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OldConsPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4059,40 +4324,46 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4102,20 +4373,20 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4127,7 +4398,7 @@ func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4139,7 +4410,7 @@ func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4149,20 +4420,30 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BondedTokens.Size()
+		size := m.MaxChangeRate.Size()
 		i -= size
-		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
@@ -4170,9 +4451,9 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.NotBondedTokens.Size()
+		size := m.Rate.Size()
 		i -= size
-		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
@@ -4182,502 +4463,2562 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Commission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.CommissionRate != nil {
-		l = m.CommissionRate.Size()
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.MinSelfDelegation != nil {
-		l = m.MinSelfDelegation.Size()
-		n += 1 + l + sovStaking(uint64(l))
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBeginRedelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func (m *MsgUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.InMaintenance {
+		i--
+		if m.InMaintenance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x4a
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ConsensusPubkey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Jailed {
-		n += 2
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if len(m.ConsensusPubkey) > 0 {
+		i -= len(m.ConsensusPubkey)
+		copy(dAtA[i:], m.ConsensusPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ConsensusPubkey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DVPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DVPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Shares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.SharesDst.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
 	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	i--
+	dAtA[i] = 0x1a
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.SharesDst.Size()
+		i -= size
+		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVotingPowerRatio.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintStaking(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotBondedTokens.Size()
+		i -= size
+		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgTransferTokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.TokenizeShareRecordId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.OldConsPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewConsPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ConsensusPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.InMaintenance {
+		n += 2
+	}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxVotingPowerRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSrcAddress == nil {
+				m.ValidatorSrcAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorDstAddress == nil {
+				m.ValidatorDstAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.NotBondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4700,17 +7041,17 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4720,30 +7061,31 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4753,30 +7095,31 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4786,27 +7129,98 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
@@ -4840,7 +7254,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -4874,11 +7288,11 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4888,29 +7302,30 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4920,23 +7335,24 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenizedShareOwner = append(m.TokenizedShareOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenizedShareOwner == nil {
+				m.TokenizedShareOwner = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4963,7 +7379,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4986,17 +7402,17 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5006,30 +7422,31 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5039,31 +7456,102 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordId", wireType)
+			}
+			m.TokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5073,33 +7561,31 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5109,26 +7595,24 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5155,7 +7639,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5178,47 +7662,13 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -5252,11 +7702,11 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5266,24 +7716,23 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5309,7 +7758,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5332,15 +7781,15 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5367,16 +7816,16 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5386,31 +7835,61 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorSrcAddress == nil {
-				m.ValidatorSrcAddress = []byte{}
+			m.OldConsPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewConsPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5420,29 +7899,14 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorDstAddress == nil {
-				m.ValidatorDstAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5469,7 +7933,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5497,7 +7961,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5520,15 +7984,34 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5555,16 +8038,16 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5574,31 +8057,29 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
-			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5608,23 +8089,24 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6612,6 +9094,26 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InMaintenance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InMaintenance = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8082,6 +10584,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
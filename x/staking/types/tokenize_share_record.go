@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the name of the module
+// account holding the delegation of a tokenize share record.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record, derived by the staking module from the account name.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleDerivedAddress(ModuleName, []byte(r.ModuleAccount))
+}
+
+// GetShareTokenDenom returns the denom of the tokens representing the shares
+// of the record, i.e. {validator}/{id}.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator.String()), r.Id)
+}
+
+// ParseShareTokenDenom returns the validator and the tokenize share record id
+// of a share token denom.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return nil, 0, sdkerrors.Wrap(ErrInvalidShareTokenDenom, denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(ErrInvalidShareTokenDenom, denom)
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(ErrInvalidShareTokenDenom, denom)
+	}
+
+	return valAddr, id, nil
+}
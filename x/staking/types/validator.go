@@ -39,6 +39,7 @@ func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Des
 		OperatorAddress:   operator,
 		ConsensusPubkey:   pkStr,
 		Jailed:            false,
+		InMaintenance:     false,
 		Status:            sdk.Unbonded,
 		Tokens:            sdk.ZeroInt(),
 		DelegatorShares:   sdk.ZeroDec(),
@@ -402,10 +403,12 @@ func (v Validator) MinEqual(other Validator) bool {
 		v.Tokens.Equal(other.Tokens) &&
 		v.DelegatorShares.Equal(other.DelegatorShares) &&
 		v.Description == other.Description &&
-		v.Commission.Equal(other.Commission)
+		v.Commission.Equal(other.Commission) &&
+		v.InMaintenance == other.InMaintenance
 }
 
 func (v Validator) IsJailed() bool              { return v.Jailed }
+func (v Validator) IsInMaintenance() bool       { return v.InMaintenance }
 func (v Validator) GetMoniker() string          { return v.Description.Moniker }
 func (v Validator) GetStatus() sdk.BondStatus   { return v.Status }
 func (v Validator) GetOperator() sdk.ValAddress { return v.OperatorAddress }
@@ -0,0 +1,44 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+	handler := tokenfactory.NewHandler(app.TokenFactoryKeeper)
+
+	res, err := handler(ctx, types.NewMsgCreateDenom(addrs[0], "bitcoin"))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Events)
+
+	denom, err := types.GetTokenDenom(addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgMint(addrs[0], sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgBurn(addrs[0], sdk.NewInt64Coin(denom, 30)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 70), app.BankKeeper.GetBalance(ctx, addrs[0], denom))
+
+	_, err = handler(ctx, types.NewMsgChangeAdmin(addrs[0], denom, addrs[1]))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgMint(addrs[0], sdk.NewInt64Coin(denom, 100)))
+	require.Error(t, err)
+
+	_, err = handler(ctx, testdata.NewTestMsg(addrs[0]))
+	require.Error(t, err)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, charging the
+// creator the denom creation fee, and makes the creator its admin. It returns
+// the new denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	// a denom with bank metadata is already in use, e.g. through genesis
+	if metadata := k.bankKeeper.GetDenomMetaData(ctx, denom); metadata.Base != "" {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	fee := k.GetParams(ctx).DenomCreationFee
+	if !fee.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.createDenom(ctx, creator, denom, types.DenomAuthorityMetadata{Admin: creator})
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+	})
+
+	return denom, nil
+}
+
+// createDenom stores the authority metadata of a new denom and indexes it by
+// its creator.
+func (k Keeper) createDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) {
+	k.SetAuthorityMetadata(ctx, denom, metadata)
+	k.addDenomFromCreator(ctx, creator, denom)
+}
+
+// Mint mints amount to admin, which must be the admin of the denom.
+func (k Keeper) Mint(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertIsAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, admin, coins)
+}
+
+// Burn burns amount from admin, which must be the admin of the denom.
+func (k Keeper) Burn(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertIsAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, admin, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeAdmin transfers the admin rights of a denom from admin to newAdmin.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.assertIsAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin})
+	return nil
+}
+
+// SetDenomMetadata sets the bank metadata of the denom metadata.Base, which
+// admin must be the admin of.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.assertIsAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// assertIsAdmin returns an error if addr is not the admin of denom.
+func (k Keeper) assertIsAdmin(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !metadata.Admin.Equals(addr) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", addr, denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state. Bank metadata of the denoms is part of the x/bank genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		k.createDenom(ctx, creator, genDenom.Denom, genDenom.AuthorityMetadata)
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genDenoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), genDenoms)
+}
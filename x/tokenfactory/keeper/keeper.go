@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc         codec.BinaryMarshaler
+	storeKey    sdk.StoreKey
+	paramSpace  paramtypes.Subspace
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the tokenfactory module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		paramSpace:  paramSpace,
+		bankKeeper:  bk,
+		distrKeeper: dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAuthorityMetadata returns the authority metadata of a token factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	var metadata types.DenomAuthorityMetadata
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomAuthorityKey(denom))
+	if bz == nil {
+		return metadata, sdkerrors.Wrap(types.ErrDenomNotFound, denom)
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return metadata, nil
+}
+
+// SetAuthorityMetadata sets the authority metadata of a token factory denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	bz := k.cdc.MustMarshalBinaryBare(&metadata)
+	ctx.KVStore(k.storeKey).Set(types.GetDenomAuthorityKey(denom), bz)
+}
+
+// IterateAuthorityMetadata iterates over the authority metadata of all the
+// token factory denoms and performs a callback function.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomAuthorityKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// addDenomFromCreator records that denom was created by creator.
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.GetCreatorDenomKey(creator, denom), []byte{})
+}
+
+// GetDenomsFromCreator returns all the denoms created by creator.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCreatorDenomsPrefix(creator))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
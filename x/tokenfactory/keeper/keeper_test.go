@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+}
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	app, ctx, creator := suite.app, suite.ctx, suite.addrs[0]
+
+	fee := app.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	balance := app.BankKeeper.GetAllBalances(ctx, creator)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal("factory/"+creator.String()+"/bitcoin", denom)
+
+	// the creation fee is paid to the community pool
+	suite.Require().Equal(balance.Sub(fee), app.BankKeeper.GetAllBalances(ctx, creator))
+	suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(creator, metadata.Admin)
+	suite.Require().Equal(denom, app.BankKeeper.GetDenomMetaData(ctx, denom).Base)
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator))
+
+	// a denom cannot be created twice
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().Error(err)
+
+	// the same subdenom is namespaced under another creator
+	other, err := app.TokenFactoryKeeper.CreateDenom(ctx, suite.addrs[1], "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(denom, other)
+
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, creator, "Invalid")
+	suite.Require().Error(err)
+
+	// the creator cannot pay the fee
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balance.AmountOf(sdk.DefaultBondDenom)))))
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, creator, "litecoin")
+	suite.Require().Error(err)
+
+	// no fee
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins()))
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, creator, "litecoin")
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMintBurn() {
+	app, ctx, admin, other := suite.app, suite.ctx, suite.addrs[0], suite.addrs[1]
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin, "bitcoin")
+	suite.Require().NoError(err)
+
+	supply := app.BankKeeper.GetTotalSupply(ctx)
+
+	suite.Require().NoError(app.TokenFactoryKeeper.Mint(ctx, admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(supply.Add(sdk.NewInt64Coin(denom, 100)), app.BankKeeper.GetTotalSupply(ctx))
+
+	suite.Require().Error(app.TokenFactoryKeeper.Mint(ctx, other, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Error(app.TokenFactoryKeeper.Mint(ctx, admin, sdk.NewInt64Coin("factory/"+admin.String()+"/unknown", 100)))
+
+	suite.Require().NoError(app.TokenFactoryKeeper.Burn(ctx, admin, sdk.NewInt64Coin(denom, 40)))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(supply.Add(sdk.NewInt64Coin(denom, 60)), app.BankKeeper.GetTotalSupply(ctx))
+
+	// the admin can only burn tokens it holds
+	suite.Require().Error(app.TokenFactoryKeeper.Burn(ctx, admin, sdk.NewInt64Coin(denom, 61)))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, admin, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().Error(app.TokenFactoryKeeper.Burn(ctx, other, sdk.NewInt64Coin(denom, 10)))
+}
+
+func (suite *KeeperTestSuite) TestChangeAdmin() {
+	app, ctx, admin, newAdmin := suite.app, suite.ctx, suite.addrs[0], suite.addrs[1]
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin, "bitcoin")
+	suite.Require().NoError(err)
+
+	suite.Require().Error(app.TokenFactoryKeeper.ChangeAdmin(ctx, newAdmin, denom, newAdmin))
+	suite.Require().NoError(app.TokenFactoryKeeper.ChangeAdmin(ctx, admin, denom, newAdmin))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newAdmin, metadata.Admin)
+
+	suite.Require().Error(app.TokenFactoryKeeper.Mint(ctx, admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(app.TokenFactoryKeeper.Mint(ctx, newAdmin, sdk.NewInt64Coin(denom, 100)))
+
+	// the denom is still indexed under its creator
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, admin))
+}
+
+func (suite *KeeperTestSuite) TestSetDenomMetadata() {
+	app, ctx, admin := suite.app, suite.ctx, suite.addrs[0]
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin, "ubitcoin")
+	suite.Require().NoError(err)
+
+	metadata := banktypes.Metadata{
+		Description: "bitcoin",
+		DenomUnits: []*banktypes.DenomUnits{
+			{Denom: denom, Exponent: 0},
+			{Denom: "bitcoin", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "bitcoin",
+	}
+
+	suite.Require().Error(app.TokenFactoryKeeper.SetDenomMetadata(ctx, suite.addrs[1], metadata))
+	suite.Require().NoError(app.TokenFactoryKeeper.SetDenomMetadata(ctx, admin, metadata))
+	suite.Require().Equal(metadata, app.BankKeeper.GetDenomMetaData(ctx, denom))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, suite.addrs[0], "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.TokenFactoryKeeper.ChangeAdmin(ctx, suite.addrs[0], denom, suite.addrs[1]))
+
+	genState := app.TokenFactoryKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.GenesisDenom{
+		{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: suite.addrs[1]}},
+	}, genState.FactoryDenoms)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{})
+	app2.TokenFactoryKeeper.InitGenesis(ctx2, *genState)
+
+	suite.Require().Equal(genState, app2.TokenFactoryKeeper.ExportGenesis(ctx2))
+	suite.Require().Equal([]string{denom}, app2.TokenFactoryKeeper.GetDenomsFromCreator(ctx2, suite.addrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient, creator := suite.app, suite.ctx, suite.queryClient, suite.addrs[0]
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.TokenFactoryKeeper.GetParams(ctx), params.Params)
+
+	denom1, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().NoError(err)
+	denom2, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator, "litecoin")
+	suite.Require().NoError(err)
+
+	metadata, err := queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creator, metadata.AuthorityMetadata.Admin)
+
+	_, err = queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	suite.Require().Error(err)
+
+	denoms, err := queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1}, denoms.Denoms)
+	suite.Require().Equal(uint64(2), denoms.Pagination.Total)
+
+	denoms, err = queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{Key: denoms.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom2}, denoms.Denoms)
+
+	_, err = queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{})
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// MigrateParams sets the params of the module, which don't exist in the params
+// store of the chains created before it. It is meant to be called once from an
+// x/upgrade handler.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	if !k.paramSpace.Has(ctx, types.KeyDenomCreationFee) {
+		k.paramSpace.Set(ctx, types.KeyDenomCreationFee, types.DefaultParams().DenomCreationFee)
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the necessary x/tokenfactory interfaces and concrete
+// types on the provided Amino codec. These types are used for Amino JSON
+// serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "cosmos-sdk/MsgTokenFactoryMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "cosmos-sdk/MsgTokenFactoryBurn", nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata", nil)
+}
+
+// RegisterInterfaces registers the x/tokenfactory interfaces types with the
+// interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/tokenfactory module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON
+	// encoding as Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to
+	// x/tokenfactory and defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
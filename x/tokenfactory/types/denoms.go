@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DenomPrefix is the first segment of every token factory denom.
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom segment.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the full denom of the subdenom created by creator:
+// factory/{creator}/{subdenom}. It returns an error if the resulting denom is
+// invalid.
+func GetTokenDenom(creator sdk.AccAddress, subdenom string) (string, error) {
+	if len(subdenom) == 0 {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	if strings.Contains(subdenom, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom cannot contain '/': %s", subdenom)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator.String(), subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a token factory denom into its creator and subdenom.
+// It returns an error if the denom was not created by the token factory.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom must be of the form %s/{creator}/{subdenom}: %s", DenomPrefix, denom)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", parts[1], err)
+	}
+
+	return creator, parts[2], nil
+}
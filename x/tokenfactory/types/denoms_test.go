@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	testCases := []struct {
+		name     string
+		subdenom string
+		expPass  bool
+	}{
+		{"valid", "bitcoin", true},
+		{"digits", "btc2", true},
+		{"max length", strings.Repeat("a", types.MaxSubdenomLength), true},
+		{"empty", "", false},
+		{"too long", strings.Repeat("a", types.MaxSubdenomLength+1), false},
+		{"slash", "bit/coin", false},
+		{"uppercase", "Bitcoin", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(creator, tc.subdenom)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+creator.String()+"/"+tc.subdenom, denom)
+
+			gotCreator, gotSubdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	testCases := []struct {
+		name  string
+		denom string
+	}{
+		{"native denom", "stake"},
+		{"wrong prefix", "ibc/" + creator.String() + "/bitcoin"},
+		{"missing subdenom segment", "factory/" + creator.String()},
+		{"too many segments", "factory/" + creator.String() + "/bit/coin"},
+		{"invalid creator", "factory/cosmos1invalid/bitcoin"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := types.DeconstructDenom(tc.denom)
+			require.Error(t, err)
+		})
+	}
+}
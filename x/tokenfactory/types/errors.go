@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom    = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists     = sdkerrors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomNotFound   = sdkerrors.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 5, "sender is not the denom admin")
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, 6, "invalid denom metadata")
+	ErrInvalidGenesis  = sdkerrors.Register(ModuleName, 7, "invalid genesis state")
+)
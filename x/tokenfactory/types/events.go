@@ -0,0 +1,17 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyAdmin    = "admin"
+	AttributeKeyNewAdmin = "new_admin"
+
+	AttributeValueCategory = ModuleName
+)
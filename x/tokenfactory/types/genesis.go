@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the tokenfactory
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if seen[denom.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate denom: %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if denom.AuthorityMetadata.Admin.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "denom %s has no admin", denom.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	denom, err := types.GetTokenDenom(addr1, "bitcoin")
+	require.NoError(t, err)
+
+	genDenom := types.GenesisDenom{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2}}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid denom", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{genDenom}), true},
+		{
+			"invalid fee",
+			types.NewGenesisState(types.NewParams(sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}), nil),
+			false,
+		},
+		{
+			"duplicate denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{genDenom, genDenom}),
+			false,
+		},
+		{
+			"not a factory denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: "stake", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2}},
+			}),
+			false,
+		},
+		{
+			"missing admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
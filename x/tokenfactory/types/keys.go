@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the tokenfactory querier
+	QueryParameters             = "parameters"
+	QueryDenomAuthorityMetadata = "denom_authority_metadata"
+	QueryDenomsFromCreator      = "denoms_from_creator"
+)
+
+// Keys for tokenfactory store
+// Items are stored with the following key: values
+//
+// - 0x01<denom_Bytes>: DenomAuthorityMetadata
+//
+// - 0x02<creatorAddrLen (1 Byte)><creator_Bytes><denom_Bytes>: []byte{}
+var (
+	DenomAuthorityKeyPrefix = []byte{0x01}
+	CreatorDenomKeyPrefix   = []byte{0x02}
+)
+
+// GetDenomAuthorityKey returns the store key of the authority metadata of a
+// denom.
+func GetDenomAuthorityKey(denom string) []byte {
+	return append(DenomAuthorityKeyPrefix, []byte(denom)...)
+}
+
+// GetCreatorDenomsPrefix returns the prefix of all the denoms created by an
+// account.
+func GetCreatorDenomsPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomKeyPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// GetCreatorDenomKey returns the key recording that a denom was created by
+// creator.
+func GetCreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(GetCreatorDenomsPrefix(creator), []byte(denom)...)
+}
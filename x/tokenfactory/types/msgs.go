@@ -0,0 +1,245 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "tf_mint"
+	TypeMsgBurn             = "tf_burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	_, err := GetTokenDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgMint creates a new MsgMint instance.
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin) *MsgMint {
+	return &MsgMint{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMint) ValidateBasic() error {
+	return validateSenderAndAmount(msg.Sender, msg.Amount)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBurn) ValidateBasic() error {
+	return validateSenderAndAmount(msg.Sender, msg.Amount)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance.
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Metadata.Base); err != nil {
+		return err
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateSenderAndAmount(sender sdk.AccAddress, amount sdk.Coin) error {
+	if sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	_, _, err := DeconstructDenom(amount.Denom)
+	return err
+}
+
+// validateMetadata checks that the denom units of the metadata are valid and
+// unique, that the base denom is a unit of exponent 0 and that the display
+// denom, if any, is one of the units.
+func validateMetadata(metadata banktypes.Metadata) error {
+	var hasBase, hasDisplay bool
+
+	seen := make(map[string]bool, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		if err := sdk.ValidateDenom(unit.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+		}
+
+		if seen[unit.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "duplicate denom unit %s", unit.Denom)
+		}
+		seen[unit.Denom] = true
+
+		if unit.Denom == metadata.Base {
+			if unit.Exponent != 0 {
+				return sdkerrors.Wrapf(ErrInvalidMetadata, "base denom unit %s must have exponent 0", unit.Denom)
+			}
+			hasBase = true
+		}
+
+		if unit.Denom == metadata.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasBase {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "missing denom unit for base denom %s", metadata.Base)
+	}
+
+	if metadata.Display != "" && !hasDisplay {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "missing denom unit for display denom %s", metadata.Display)
+	}
+
+	return nil
+}
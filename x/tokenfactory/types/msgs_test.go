@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+)
+
+func TestMsgCreateDenom(t *testing.T) {
+	require.NoError(t, types.NewMsgCreateDenom(addr1, "bitcoin").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom(nil, "bitcoin").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom(addr1, "").ValidateBasic())
+
+	msg := types.NewMsgCreateDenom(addr1, "bitcoin")
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgCreateDenom, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgMintBurn(t *testing.T) {
+	denom, err := types.GetTokenDenom(addr1, "bitcoin")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		sender  sdk.AccAddress
+		amount  sdk.Coin
+		expPass bool
+	}{
+		{"valid", addr1, sdk.NewInt64Coin(denom, 10), true},
+		{"missing sender", nil, sdk.NewInt64Coin(denom, 10), false},
+		{"zero amount", addr1, sdk.NewInt64Coin(denom, 0), false},
+		{"negative amount", addr1, sdk.Coin{Denom: denom, Amount: sdk.NewInt(-1)}, false},
+		{"not a factory denom", addr1, sdk.NewInt64Coin("stake", 10), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{types.NewMsgMint(tc.sender, tc.amount), types.NewMsgBurn(tc.sender, tc.amount)} {
+				if tc.expPass {
+					require.NoError(t, msg.ValidateBasic())
+				} else {
+					require.Error(t, msg.ValidateBasic())
+				}
+			}
+		})
+	}
+}
+
+func TestMsgChangeAdmin(t *testing.T) {
+	denom, err := types.GetTokenDenom(addr1, "bitcoin")
+	require.NoError(t, err)
+
+	require.NoError(t, types.NewMsgChangeAdmin(addr1, denom, addr2).ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(nil, denom, addr2).ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(addr1, denom, nil).ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(addr1, "stake", addr2).ValidateBasic())
+}
+
+func TestMsgSetDenomMetadata(t *testing.T) {
+	denom, err := types.GetTokenDenom(addr1, "ubitcoin")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		metadata banktypes.Metadata
+		expPass  bool
+	}{
+		{
+			"valid",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}, {Denom: "bitcoin", Exponent: 6}},
+				Base:       denom,
+				Display:    "bitcoin",
+			},
+			true,
+		},
+		{
+			"not a factory denom",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: "stake", Exponent: 0}},
+				Base:       "stake",
+			},
+			false,
+		},
+		{
+			"missing base unit",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: "bitcoin", Exponent: 6}},
+				Base:       denom,
+			},
+			false,
+		},
+		{
+			"base unit with non-zero exponent",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 6}},
+				Base:       denom,
+			},
+			false,
+		},
+		{
+			"missing display unit",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}},
+				Base:       denom,
+				Display:    "bitcoin",
+			},
+			false,
+		},
+		{
+			"duplicate unit",
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}, {Denom: denom, Exponent: 0}},
+				Base:       denom,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewMsgSetDenomMetadata(addr1, tc.metadata).ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, types.NewMsgSetDenomMetadata(nil, testCases[0].metadata).ValidateBasic())
+}
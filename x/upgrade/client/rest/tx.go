@@ -86,7 +86,7 @@ func newPostPlanHandler(clientCtx client.Context) http.HandlerFunc {
 
 		plan := types.Plan{Name: req.UpgradeName, Time: t, Height: req.UpgradeHeight, Info: req.UpgradeInfo}
 		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
-		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, false)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -118,7 +118,7 @@ func newCancelPlanHandler(clientCtx client.Context) http.HandlerFunc {
 
 		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
 
-		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, false)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
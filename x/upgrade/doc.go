@@ -82,6 +82,7 @@ Here is a sample code to set store migrations with an upgrade:
 
 	if upgradeInfo.Name == "my-fancy-upgrade" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := store.StoreUpgrades{
+			Added:   []string{"baz"},
 			Renamed: []store.StoreRename{{
 				OldKey: "foo",
 				NewKey: "bar",